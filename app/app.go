@@ -594,6 +594,14 @@ func NewBabylonApp(
 		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
 			cmtos.Exit(fmt.Sprintf("failed initialize pinned codes %s", err))
 		}
+
+		// ensure the BLS signer has the pending BLS key of the validator, if
+		// any, before it takes effect
+		if app.LastBlockHeight() > 0 {
+			if err := app.CheckpointingKeeper.CheckPendingBlsKey(ctx); err != nil {
+				cmtos.Exit(fmt.Sprintf("failed to check the pending BLS key of the validator: %s", err))
+			}
+		}
 	}
 
 	return app
@@ -254,7 +254,7 @@ func (ak *AppKeepers) InitKeepers(
 	checkpointingKeeper := checkpointingkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[checkpointingtypes.StoreKey]),
-		privSigner.WrappedPV,
+		privSigner.BlsSigner,
 		epochingKeeper,
 	)
 
@@ -535,10 +535,11 @@ func (ak *AppKeepers) InitKeepers(
 		runtime.NewKVStoreService(keys[finalitytypes.StoreKey]),
 		ak.BTCStakingKeeper,
 		ak.IncentiveKeeper,
+		&ak.EpochingKeeper,
+		storeQuerier,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	ak.BTCStakingKeeper = *ak.BTCStakingKeeper.SetHooks(btcstakingtypes.NewMultiBtcStakingHooks(ak.FinalityKeeper.Hooks()))
-	ak.FinalityKeeper = *ak.FinalityKeeper.SetHooks(finalitytypes.NewMultiFinalityHooks(ak.BTCStakingKeeper.Hooks()))
 
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -574,6 +575,12 @@ func (ak *AppKeepers) InitKeepers(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		wasmOpts...,
 	)
+	// set finality hooks after the wasm keeper is created, so that contracts
+	// registered for finality hooks can be notified via sudo calls
+	ak.FinalityKeeper = *ak.FinalityKeeper.SetHooks(finalitytypes.NewMultiFinalityHooks(
+		ak.BTCStakingKeeper.Hooks(),
+		owasm.NewFinalityHooks(wasmkeeper.NewDefaultPermissionKeeper(&ak.WasmKeeper), &ak.FinalityKeeper),
+	))
 
 	ibcWasmConfig :=
 		ibcwasmtypes.WasmConfig{
@@ -6,12 +6,15 @@ import (
 	"os"
 	"path/filepath"
 	"text/template"
+	"time"
 
 	cmtconfig "github.com/cometbft/cometbft/config"
 	cmtos "github.com/cometbft/cometbft/libs/os"
 	"github.com/cosmos/cosmos-sdk/client/config"
 
 	"github.com/babylonchain/babylon/privval"
+	"github.com/babylonchain/babylon/privval/remote"
+	checkpointingkeeper "github.com/babylonchain/babylon/x/checkpointing/keeper"
 )
 
 const defaultConfigTemplate = `# This is a TOML config file.
@@ -35,6 +38,9 @@ broadcast-mode = "{{ .BroadcastMode }}"
 
 type PrivSigner struct {
 	WrappedPV *privval.WrappedFilePV
+	// BlsSigner signs BLS signatures over checkpoints. It is either the
+	// wrapped file private validator or a remote BLS signer.
+	BlsSigner checkpointingkeeper.BlsSigner
 }
 
 func InitPrivSigner(nodeDir string) (*PrivSigner, error) {
@@ -53,6 +59,25 @@ func InitPrivSigner(nodeDir string) (*PrivSigner, error) {
 
 	return &PrivSigner{
 		WrappedPV: wrappedPV,
+		BlsSigner: wrappedPV,
+	}, nil
+}
+
+// InitRemotePrivSigner initialises a PrivSigner where BLS signatures are
+// signed by the remote BLS signer at the given address, so that the BLS key
+// does not need to be present on the node. The connection uses mutual TLS
+// with the given files unless they are empty.
+func InitRemotePrivSigner(addr string, timeout time.Duration, tlsFiles remote.TLSFiles) (*PrivSigner, error) {
+	tlsConfig, err := remote.NewClientTLSConfig(tlsFiles)
+	if err != nil {
+		return nil, err
+	}
+	remoteSigner, err := remote.NewRemoteBlsSigner(addr, timeout, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivSigner{
+		BlsSigner: remoteSigner,
 	}, nil
 }
 
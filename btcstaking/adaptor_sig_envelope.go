@@ -0,0 +1,321 @@
+package btcstaking
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+)
+
+const (
+	// AdaptorSigEnvelopeVersion is the version of the adaptor signature
+	// envelope format produced by this package
+	AdaptorSigEnvelopeVersion uint8 = 1
+
+	// AdaptorSigEnvelopeSize is the size of a serialized adaptor signature
+	// envelope of version 1, i.e.,
+	// version (1) || network (4) || tx type (1) || staking tx hash (32) ||
+	// tx hash (32) || sighash (32) || signer PK (32) || encryption key (33) ||
+	// adaptor signature (65)
+	AdaptorSigEnvelopeSize = 1 + 4 + 1 + 3*chainhash.HashSize + schnorr.PubKeyBytesLen +
+		asig.JacobianPointSize + asig.AdaptorSignatureSize
+)
+
+// SlashingTxType is the type of the slashing tx that an adaptor signature in
+// an envelope is over
+type SlashingTxType uint8
+
+const (
+	// StakingSlashingTx is the slashing tx spending the staking output of a
+	// staking tx
+	StakingSlashingTx SlashingTxType = 1
+	// UnbondingSlashingTx is the slashing tx spending the unbonding output of
+	// an unbonding tx
+	UnbondingSlashingTx SlashingTxType = 2
+)
+
+func (t SlashingTxType) String() string {
+	switch t {
+	case StakingSlashingTx:
+		return "staking_slashing_tx"
+	case UnbondingSlashingTx:
+		return "unbonding_slashing_tx"
+	default:
+		return fmt.Sprintf("unknown_slashing_tx(%d)", uint8(t))
+	}
+}
+
+func (t SlashingTxType) validate() error {
+	if t != StakingSlashingTx && t != UnbondingSlashingTx {
+		return fmt.Errorf("unknown slashing tx type %d", uint8(t))
+	}
+	return nil
+}
+
+// AdaptorSigEnvelope is a self-describing adaptor signature on a slashing tx.
+// Apart from the adaptor signature itself, it carries the Bitcoin network, the
+// type of the slashing tx and the staking tx hash of the BTC delegation, the
+// hash of the slashing tx and the sighash the signature commits to, as well as
+// the signer's PK and the encryption key. This allows the adaptor signatures
+// on the slashing tx and the unbonding slashing tx of a BTC delegation to be
+// exchanged and stored without being mixed up.
+type AdaptorSigEnvelope struct {
+	Version uint8
+	// Net is the Bitcoin network of the slashing tx
+	Net wire.BitcoinNet
+	// TxType is the type of the slashing tx
+	TxType SlashingTxType
+	// StakingTxHash is the hash of the staking tx of the BTC delegation
+	StakingTxHash chainhash.Hash
+	// TxHash is the hash of the slashing tx
+	TxHash chainhash.Hash
+	// SigHash is the taproot script-path sighash of the slashing tx that the
+	// adaptor signature commits to
+	SigHash [chainhash.HashSize]byte
+	// SignerPK is the PK of the signer, e.g., a covenant member
+	SignerPK *btcec.PublicKey
+	// EncKey is the encryption key, i.e., a finality provider's PK
+	EncKey *asig.EncryptionKey
+	// Sig is the adaptor signature
+	Sig *asig.AdaptorSignature
+}
+
+// calcSlashingTxSigHash computes the sighash of the given slashing tx spending
+// the given funding output via the given script
+func calcSlashingTxSigHash(tx *wire.MsgTx, fundingOut *wire.TxOut, script []byte) ([]byte, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("tx must not be nil")
+	}
+	if len(tx.TxIn) != 1 {
+		return nil, fmt.Errorf("tx must have exactly one input")
+	}
+	if fundingOut == nil {
+		return nil, fmt.Errorf("funding output must not be nil")
+	}
+
+	tapLeaf := txscript.NewBaseTapLeaf(script)
+
+	inputFetcher := txscript.NewCannedPrevOutputFetcher(
+		fundingOut.PkScript,
+		fundingOut.Value,
+	)
+
+	sigHashes := txscript.NewTxSigHashes(tx, inputFetcher)
+
+	return txscript.CalcTapscriptSignaturehash(
+		sigHashes, txscript.SigHashDefault, tx, 0, inputFetcher, tapLeaf,
+	)
+}
+
+// NewAdaptorSigEnvelope wraps the given adaptor signature on the given slashing
+// tx into an envelope. The slashing tx spends the given funding output via the
+// given script. The adaptor signature is verified against the sighash of the
+// slashing tx before being wrapped.
+func NewAdaptorSigEnvelope(
+	net *chaincfg.Params,
+	txType SlashingTxType,
+	stakingTxHash chainhash.Hash,
+	tx *wire.MsgTx,
+	fundingOut *wire.TxOut,
+	script []byte,
+	signerPK *btcec.PublicKey,
+	encKey *asig.EncryptionKey,
+	sig *asig.AdaptorSignature,
+) (*AdaptorSigEnvelope, error) {
+	if net == nil {
+		return nil, fmt.Errorf("network params must not be nil")
+	}
+	if err := txType.validate(); err != nil {
+		return nil, err
+	}
+	if signerPK == nil || encKey == nil || sig == nil {
+		return nil, fmt.Errorf("signer PK, encryption key and adaptor signature must not be nil")
+	}
+
+	sigHash, err := calcSlashingTxSigHash(tx, fundingOut, script)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute the sighash of the %s: %w", txType, err)
+	}
+	if err := sig.EncVerify(signerPK, encKey, sigHash); err != nil {
+		return nil, fmt.Errorf("invalid adaptor signature on the %s: %w", txType, err)
+	}
+
+	env := &AdaptorSigEnvelope{
+		Version:       AdaptorSigEnvelopeVersion,
+		Net:           net.Net,
+		TxType:        txType,
+		StakingTxHash: stakingTxHash,
+		TxHash:        tx.TxHash(),
+		SignerPK:      signerPK,
+		EncKey:        encKey,
+		Sig:           sig,
+	}
+	copy(env.SigHash[:], sigHash)
+
+	return env, nil
+}
+
+// Marshal serializes the envelope
+func (e *AdaptorSigEnvelope) Marshal() ([]byte, error) {
+	if e.Version != AdaptorSigEnvelopeVersion {
+		return nil, fmt.Errorf("unsupported adaptor signature envelope version %d", e.Version)
+	}
+	if err := e.TxType.validate(); err != nil {
+		return nil, err
+	}
+	if e.SignerPK == nil || e.EncKey == nil || e.Sig == nil {
+		return nil, fmt.Errorf("signer PK, encryption key and adaptor signature must not be nil")
+	}
+
+	sigBytes, err := e.Sig.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	bz := make([]byte, 0, AdaptorSigEnvelopeSize)
+	bz = append(bz, e.Version)
+	bz = binary.BigEndian.AppendUint32(bz, uint32(e.Net))
+	bz = append(bz, byte(e.TxType))
+	bz = append(bz, e.StakingTxHash[:]...)
+	bz = append(bz, e.TxHash[:]...)
+	bz = append(bz, e.SigHash[:]...)
+	bz = append(bz, schnorr.SerializePubKey(e.SignerPK)...)
+	bz = append(bz, e.EncKey.ToBytes()...)
+	bz = append(bz, sigBytes...)
+
+	return bz, nil
+}
+
+// MarshalHex serializes the envelope to a hex string
+func (e *AdaptorSigEnvelope) MarshalHex() (string, error) {
+	bz, err := e.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bz), nil
+}
+
+// NewAdaptorSigEnvelopeFromBytes parses the given bytes to an adaptor signature
+// envelope. Envelopes of unknown versions or tx types are rejected.
+func NewAdaptorSigEnvelopeFromBytes(bz []byte) (*AdaptorSigEnvelope, error) {
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("empty adaptor signature envelope")
+	}
+	if bz[0] != AdaptorSigEnvelopeVersion {
+		return nil, fmt.Errorf("unsupported adaptor signature envelope version %d", bz[0])
+	}
+	if len(bz) != AdaptorSigEnvelopeSize {
+		return nil, fmt.Errorf(
+			"the length of the given bytes for adaptor signature envelope is incorrect (expected: %d, actual: %d)",
+			AdaptorSigEnvelopeSize,
+			len(bz),
+		)
+	}
+
+	env := &AdaptorSigEnvelope{Version: bz[0]}
+	r := bz[1:]
+	env.Net = wire.BitcoinNet(binary.BigEndian.Uint32(r[:4]))
+	r = r[4:]
+	env.TxType = SlashingTxType(r[0])
+	if err := env.TxType.validate(); err != nil {
+		return nil, err
+	}
+	r = r[1:]
+	copy(env.StakingTxHash[:], r[:chainhash.HashSize])
+	r = r[chainhash.HashSize:]
+	copy(env.TxHash[:], r[:chainhash.HashSize])
+	r = r[chainhash.HashSize:]
+	copy(env.SigHash[:], r[:chainhash.HashSize])
+	r = r[chainhash.HashSize:]
+
+	signerPK, err := schnorr.ParsePubKey(r[:schnorr.PubKeyBytesLen])
+	if err != nil {
+		return nil, fmt.Errorf("invalid signer PK: %w", err)
+	}
+	env.SignerPK = signerPK
+	r = r[schnorr.PubKeyBytesLen:]
+
+	encKey, err := asig.NewEncryptionKeyFromBytes(r[:asig.JacobianPointSize])
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+	env.EncKey = encKey
+	r = r[asig.JacobianPointSize:]
+
+	sig, err := asig.NewAdaptorSignatureFromBytes(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid adaptor signature: %w", err)
+	}
+	env.Sig = sig
+
+	return env, nil
+}
+
+// NewAdaptorSigEnvelopeFromHex parses the given hex string to an adaptor
+// signature envelope
+func NewAdaptorSigEnvelopeFromHex(envHex string) (*AdaptorSigEnvelope, error) {
+	bz, err := hex.DecodeString(envHex)
+	if err != nil {
+		return nil, err
+	}
+	return NewAdaptorSigEnvelopeFromBytes(bz)
+}
+
+// Verify verifies that the envelope is an adaptor signature on the given
+// slashing tx of the given type and network, which spends the given funding
+// output via the given script. It rejects envelopes whose metadata does not
+// match the given slashing tx, before verifying the adaptor signature against
+// the sighash recomputed from the slashing tx.
+func (e *AdaptorSigEnvelope) Verify(
+	net *chaincfg.Params,
+	txType SlashingTxType,
+	stakingTxHash chainhash.Hash,
+	tx *wire.MsgTx,
+	fundingOut *wire.TxOut,
+	script []byte,
+) error {
+	if e.Version != AdaptorSigEnvelopeVersion {
+		return fmt.Errorf("unsupported adaptor signature envelope version %d", e.Version)
+	}
+	if net == nil {
+		return fmt.Errorf("network params must not be nil")
+	}
+	if e.Net != net.Net {
+		return fmt.Errorf("mismatched network: envelope is for %s, expected %s", e.Net, net.Net)
+	}
+	if e.TxType != txType {
+		return fmt.Errorf("mismatched slashing tx type: envelope is for %s, expected %s", e.TxType, txType)
+	}
+	if !e.StakingTxHash.IsEqual(&stakingTxHash) {
+		return fmt.Errorf("mismatched staking tx hash: envelope is for %s, expected %s", e.StakingTxHash, stakingTxHash)
+	}
+	if tx == nil {
+		return fmt.Errorf("tx to verify must not be nil")
+	}
+	txHash := tx.TxHash()
+	if !e.TxHash.IsEqual(&txHash) {
+		return fmt.Errorf("mismatched %s hash: envelope is for %s, expected %s", txType, e.TxHash, txHash)
+	}
+	if e.SignerPK == nil || e.EncKey == nil || e.Sig == nil {
+		return fmt.Errorf("signer PK, encryption key and adaptor signature must not be nil")
+	}
+
+	sigHash, err := calcSlashingTxSigHash(tx, fundingOut, script)
+	if err != nil {
+		return fmt.Errorf("failed to compute the sighash of the %s: %w", txType, err)
+	}
+	if !bytes.Equal(e.SigHash[:], sigHash) {
+		return fmt.Errorf("mismatched sighash of the %s", txType)
+	}
+
+	return e.Sig.EncVerify(e.SignerPK, e.EncKey, sigHash)
+}
@@ -0,0 +1,96 @@
+package btcstaking_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+	"github.com/babylonchain/babylon/testutil/datagen"
+)
+
+func FuzzAdaptorSigEnvelope(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		net := &chaincfg.SimNetParams
+
+		signerSK, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		encKey, _, err := asig.GenKeyPair()
+		require.NoError(t, err)
+
+		// a funding tx and a tx spending its output via a random script
+		fundingTx := wire.NewMsgTx(2)
+		fundingTx.AddTxOut(wire.NewTxOut(int64(r.Intn(1000)+1), datagen.GenRandomByteArray(r, 32)))
+		fundingTxHash := fundingTx.TxHash()
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&fundingTxHash, 0), nil, nil))
+		tx.AddTxOut(wire.NewTxOut(int64(r.Intn(1000)), datagen.GenRandomByteArray(r, 32)))
+		script := datagen.GenRandomByteArray(r, 150)
+
+		sig, err := btcstaking.EncSignTxWithOneScriptSpendInputStrict(tx, fundingTx, 0, script, signerSK, encKey)
+		require.NoError(t, err)
+
+		txType := btcstaking.StakingSlashingTx
+		if r.Intn(2) == 0 {
+			txType = btcstaking.UnbondingSlashingTx
+		}
+		env, err := btcstaking.NewAdaptorSigEnvelope(net, txType, fundingTxHash, tx, fundingTx.TxOut[0], script, signerSK.PubKey(), encKey, sig)
+		require.NoError(t, err)
+		require.NoError(t, env.Verify(net, txType, fundingTxHash, tx, fundingTx.TxOut[0], script))
+
+		// round trip
+		envHex, err := env.MarshalHex()
+		require.NoError(t, err)
+		parsedEnv, err := btcstaking.NewAdaptorSigEnvelopeFromHex(envHex)
+		require.NoError(t, err)
+		require.Equal(t, env.Net, parsedEnv.Net)
+		require.Equal(t, env.TxType, parsedEnv.TxType)
+		require.Equal(t, env.StakingTxHash, parsedEnv.StakingTxHash)
+		require.Equal(t, env.TxHash, parsedEnv.TxHash)
+		require.Equal(t, env.SigHash, parsedEnv.SigHash)
+		require.Equal(t, env.EncKey.ToBytes(), parsedEnv.EncKey.ToBytes())
+		require.True(t, env.Sig.Equals(*parsedEnv.Sig))
+		require.NoError(t, parsedEnv.Verify(net, txType, fundingTxHash, tx, fundingTx.TxOut[0], script))
+
+		// mismatched metadata is rejected
+		otherTxType := btcstaking.UnbondingSlashingTx
+		if txType == btcstaking.UnbondingSlashingTx {
+			otherTxType = btcstaking.StakingSlashingTx
+		}
+		require.Error(t, parsedEnv.Verify(&chaincfg.MainNetParams, txType, fundingTxHash, tx, fundingTx.TxOut[0], script))
+		require.Error(t, parsedEnv.Verify(net, otherTxType, fundingTxHash, tx, fundingTx.TxOut[0], script))
+		require.Error(t, parsedEnv.Verify(net, txType, chainhash.Hash{}, tx, fundingTx.TxOut[0], script))
+		otherTx := tx.Copy()
+		otherTx.TxOut[0].Value++
+		require.Error(t, parsedEnv.Verify(net, txType, fundingTxHash, otherTx, fundingTx.TxOut[0], script))
+		require.Error(t, parsedEnv.Verify(net, txType, fundingTxHash, tx, fundingTx.TxOut[0], datagen.GenRandomByteArray(r, 150)))
+
+		// an adaptor signature that does not verify cannot be wrapped
+		_, err = btcstaking.NewAdaptorSigEnvelope(net, txType, fundingTxHash, otherTx, fundingTx.TxOut[0], script, signerSK.PubKey(), encKey, sig)
+		require.Error(t, err)
+
+		// malformed envelopes are rejected
+		bz, err := env.Marshal()
+		require.NoError(t, err)
+		_, err = btcstaking.NewAdaptorSigEnvelopeFromBytes(bz[:len(bz)-1])
+		require.Error(t, err)
+		_, err = btcstaking.NewAdaptorSigEnvelopeFromBytes(append(bz, 0x00))
+		require.Error(t, err)
+		badVersion := append([]byte{}, bz...)
+		badVersion[0] = btcstaking.AdaptorSigEnvelopeVersion + 1
+		_, err = btcstaking.NewAdaptorSigEnvelopeFromBytes(badVersion)
+		require.Error(t, err)
+		badTxType := append([]byte{}, bz...)
+		badTxType[5] = 0
+		_, err = btcstaking.NewAdaptorSigEnvelopeFromBytes(badTxType)
+		require.Error(t, err)
+	})
+}
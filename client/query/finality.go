@@ -92,6 +92,48 @@ func (c *QueryClient) Block(height uint64) (*finalitytypes.QueryBlockResponse, e
 	return resp, err
 }
 
+// BlockByHash queries a block with a given CometBFT block hash or AppHash.
+func (c *QueryClient) BlockByHash(hashHex string, hashType finalitytypes.QueriedHashType) (*finalitytypes.QueryBlockByHashResponse, error) {
+	var resp *finalitytypes.QueryBlockByHashResponse
+	err := c.QueryFinality(func(ctx context.Context, queryClient finalitytypes.QueryClient) error {
+		var err error
+		req := &finalitytypes.QueryBlockByHashRequest{
+			HashHex:  hashHex,
+			HashType: hashType,
+		}
+		resp, err = queryClient.BlockByHash(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// LastFinalizedBlock queries the BTC-finalized block at the highest height.
+func (c *QueryClient) LastFinalizedBlock() (*finalitytypes.QueryLastFinalizedBlockResponse, error) {
+	var resp *finalitytypes.QueryLastFinalizedBlockResponse
+	err := c.QueryFinality(func(ctx context.Context, queryClient finalitytypes.QueryClient) error {
+		var err error
+		req := &finalitytypes.QueryLastFinalizedBlockRequest{}
+		resp, err = queryClient.LastFinalizedBlock(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// FinalityHaltStatus queries whether the finality is halted at a block without quorum.
+func (c *QueryClient) FinalityHaltStatus() (*finalitytypes.QueryFinalityHaltStatusResponse, error) {
+	var resp *finalitytypes.QueryFinalityHaltStatusResponse
+	err := c.QueryFinality(func(ctx context.Context, queryClient finalitytypes.QueryClient) error {
+		var err error
+		req := &finalitytypes.QueryFinalityHaltStatusRequest{}
+		resp, err = queryClient.FinalityHaltStatus(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
 // ListEvidences queries the Finality module to get evidences after a given height.
 func (c *QueryClient) ListEvidences(startHeight uint64, pagination *sdkquerytypes.PageRequest) (*finalitytypes.QueryListEvidencesResponse, error) {
 	var resp *finalitytypes.QueryListEvidencesResponse
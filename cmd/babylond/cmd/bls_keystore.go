@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	cmtconfig "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/spf13/cobra"
+
+	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/privval"
+)
+
+const (
+	flagPassphraseFile    = "passphrase-file"
+	flagNewPassphraseFile = "new-passphrase-file"
+	flagKDF               = "kdf"
+	flagEncrypt           = "encrypt"
+)
+
+// BlsKeystoreCmd returns the commands for managing the encryption of the BLS
+// key of a validator
+func BlsKeystoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls-keystore",
+		Short: "Manage the encryption of the BLS key of a validator",
+		Long: strings.TrimSpace(fmt.Sprintf(`Manage the encryption of the BLS key of a validator.
+
+An encrypted BLS key is stored in priv_validator_key.json in the EIP-2335
+keystore format instead of plaintext. When the BLS key is encrypted, the node
+loads the passphrase from the %s environment variable, or else from the file
+given by the %s environment variable.
+`, privval.BlsPassphraseEnvVar, privval.BlsPassphraseFileEnvVar)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(
+		EncryptBlsKeyCmd(),
+		DecryptBlsKeyCmd(),
+		ChangeBlsPassphraseCmd(),
+	)
+
+	return cmd
+}
+
+func EncryptBlsKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypt",
+		Args:  cobra.NoArgs,
+		Short: "Encrypt the BLS key of a validator with a passphrase",
+		Long: strings.TrimSpace(`Encrypt the BLS key of a validator with a passphrase.
+
+The passphrase is read from the file given by --passphrase-file, or else from
+the environment variables, or else from a prompt.
+
+Example:
+$ babylond bls-keystore encrypt --passphrase-file ./passphrase.txt --home ./
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			kdf, _ := cmd.Flags().GetString(flagKDF)
+
+			passphrase, err := getNewBlsPassphrase(cmd, flagPassphraseFile, true)
+			if err != nil {
+				return err
+			}
+			return EncryptBlsKey(homeDir, passphrase, kdf, 0)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The node home directory")
+	cmd.Flags().String(flagPassphraseFile, "", "The file containing the passphrase")
+	cmd.Flags().String(flagKDF, bls12381.KDFScrypt, fmt.Sprintf("The key derivation function (%s|%s)", bls12381.KDFScrypt, bls12381.KDFPBKDF2))
+
+	return cmd
+}
+
+func DecryptBlsKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrypt",
+		Args:  cobra.NoArgs,
+		Short: "Decrypt the BLS key of a validator and store it in plaintext",
+		Long: strings.TrimSpace(`Decrypt the BLS key of a validator and store it in plaintext.
+
+The passphrase is read from the file given by --passphrase-file, or else from
+the environment variables, or else from a prompt.
+
+Example:
+$ babylond bls-keystore decrypt --passphrase-file ./passphrase.txt --home ./
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+
+			passphrase, err := getBlsPassphrase(cmd)
+			if err != nil {
+				return err
+			}
+			return DecryptBlsKey(homeDir, passphrase)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The node home directory")
+	cmd.Flags().String(flagPassphraseFile, "", "The file containing the passphrase")
+
+	return cmd
+}
+
+func ChangeBlsPassphraseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-passphrase",
+		Args:  cobra.NoArgs,
+		Short: "Change the passphrase of the encrypted BLS key of a validator",
+		Long: strings.TrimSpace(`Change the passphrase of the encrypted BLS key of a validator.
+
+The current passphrase is read from the file given by --passphrase-file, or
+else from the environment variables, or else from a prompt. The new passphrase
+is read from the file given by --new-passphrase-file, or else from a prompt.
+
+Example:
+$ babylond bls-keystore change-passphrase --passphrase-file ./passphrase.txt --new-passphrase-file ./new-passphrase.txt --home ./
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			kdf, _ := cmd.Flags().GetString(flagKDF)
+
+			passphrase, err := getBlsPassphrase(cmd)
+			if err != nil {
+				return err
+			}
+			newPassphrase, err := getNewBlsPassphrase(cmd, flagNewPassphraseFile, false)
+			if err != nil {
+				return err
+			}
+			return ChangeBlsPassphrase(homeDir, passphrase, newPassphrase, kdf, 0)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The node home directory")
+	cmd.Flags().String(flagPassphraseFile, "", "The file containing the current passphrase")
+	cmd.Flags().String(flagNewPassphraseFile, "", "The file containing the new passphrase")
+	cmd.Flags().String(flagKDF, bls12381.KDFScrypt, fmt.Sprintf("The key derivation function (%s|%s)", bls12381.KDFScrypt, bls12381.KDFPBKDF2))
+
+	return cmd
+}
+
+// EncryptBlsKey encrypts the plaintext BLS key in the node home directory
+// with the given passphrase, KDF and cost (see bls12381.EncryptKeystore)
+func EncryptBlsKey(home string, passphrase string, kdf string, cost int) error {
+	pv, err := loadWrappedFilePVWithBlsPassphrase(home, passphrase)
+	if err != nil {
+		return err
+	}
+	if pv.IsBlsKeyEncrypted() {
+		return errors.New("the BLS key is already encrypted, use change-passphrase to change its passphrase")
+	}
+	if err := pv.EncryptBlsKey(passphrase, kdf, cost); err != nil {
+		return err
+	}
+	pv.Key.Save()
+	return nil
+}
+
+// DecryptBlsKey decrypts the encrypted BLS key in the node home directory
+// with the given passphrase and stores it in plaintext
+func DecryptBlsKey(home string, passphrase string) error {
+	pv, err := loadEncryptedWrappedFilePV(home, passphrase)
+	if err != nil {
+		return err
+	}
+	pv.DecryptBlsKey()
+	pv.Key.Save()
+	return nil
+}
+
+// ChangeBlsPassphrase re-encrypts the encrypted BLS key in the node home
+// directory with the new passphrase, KDF and cost
+func ChangeBlsPassphrase(home string, passphrase string, newPassphrase string, kdf string, cost int) error {
+	pv, err := loadEncryptedWrappedFilePV(home, passphrase)
+	if err != nil {
+		return err
+	}
+	if err := pv.EncryptBlsKey(newPassphrase, kdf, cost); err != nil {
+		return err
+	}
+	pv.Key.Save()
+	return nil
+}
+
+// loadWrappedFilePVWithBlsPassphrase loads the wrapped file private key from
+// the node home directory, where the BLS key is decrypted with the given
+// passphrase if it is encrypted
+func loadWrappedFilePVWithBlsPassphrase(home string, passphrase string) (*privval.WrappedFilePV, error) {
+	nodeCfg := cmtconfig.DefaultConfig()
+	keyPath := filepath.Join(home, nodeCfg.PrivValidatorKeyFile())
+	statePath := filepath.Join(home, nodeCfg.PrivValidatorStateFile())
+	if err := ensureWrappedFilePV(keyPath, statePath); err != nil {
+		return nil, err
+	}
+	return privval.LoadWrappedFilePVWithBlsPassphrase(keyPath, statePath, passphrase)
+}
+
+// loadEncryptedWrappedFilePV loads the wrapped file private key with an
+// encrypted BLS key from the node home directory
+func loadEncryptedWrappedFilePV(home string, passphrase string) (*privval.WrappedFilePV, error) {
+	pv, err := loadWrappedFilePVWithBlsPassphrase(home, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if !pv.IsBlsKeyEncrypted() {
+		return nil, errors.New("the BLS key is not encrypted")
+	}
+	return pv, nil
+}
+
+// getBlsPassphrase returns the current passphrase of the BLS key from the
+// passphrase file flag, or else from the environment variables, or else
+// from a prompt
+func getBlsPassphrase(cmd *cobra.Command) (string, error) {
+	if passphraseFile, _ := cmd.Flags().GetString(flagPassphraseFile); passphraseFile != "" {
+		return privval.ReadPassphraseFile(passphraseFile)
+	}
+	if passphrase, err := privval.LoadBlsPassphrase(); err == nil {
+		return passphrase, nil
+	}
+	return input.GetPassword("Enter the passphrase of the BLS key:", bufio.NewReader(cmd.InOrStdin()))
+}
+
+// getNewBlsPassphrase returns a new passphrase of the BLS key from the given
+// passphrase file flag, or else from the environment variables if fromEnv is
+// true, or else from a prompt with confirmation
+func getNewBlsPassphrase(cmd *cobra.Command, fileFlag string, fromEnv bool) (string, error) {
+	if passphraseFile, _ := cmd.Flags().GetString(fileFlag); passphraseFile != "" {
+		return privval.ReadPassphraseFile(passphraseFile)
+	}
+	if fromEnv {
+		if passphrase, err := privval.LoadBlsPassphrase(); err == nil {
+			return passphrase, nil
+		}
+	}
+	buf := bufio.NewReader(cmd.InOrStdin())
+	passphrase, err := input.GetPassword("Enter a new passphrase for the BLS key:", buf)
+	if err != nil {
+		return "", err
+	}
+	confirmation, err := input.GetPassword("Repeat the passphrase:", buf)
+	if err != nil {
+		return "", err
+	}
+	if passphrase != confirmation {
+		return "", errors.New("passphrases do not match")
+	}
+	return passphrase, nil
+}
@@ -0,0 +1,94 @@
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	cmtconfig "github.com/cometbft/cometbft/config"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/cmd/babylond/cmd"
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/privval"
+	"github.com/babylonchain/babylon/testutil/datagen"
+)
+
+func TestBlsKeystore(t *testing.T) {
+	home := t.TempDir()
+	nodeCfg := cmtconfig.DefaultConfig()
+	keyPath := filepath.Join(home, nodeCfg.PrivValidatorKeyFile())
+	statePath := filepath.Join(home, nodeCfg.PrivValidatorStateFile())
+	require.NoError(t, cmtos.EnsureDir(filepath.Dir(keyPath), 0777))
+	require.NoError(t, cmtos.EnsureDir(filepath.Dir(statePath), 0777))
+
+	// create validator keys with a plaintext BLS key
+	addr := sdk.MustAccAddressFromBech32(datagen.GenRandomAccount().Address)
+	filePV := privval.GenWrappedFilePV(keyPath, statePath)
+	filePV.SetAccAddress(addr)
+	filePV.Save()
+	blsPrivKey := filePV.Key.BlsPrivKey
+
+	// the BLS key is not stored in plaintext once encrypted
+	err := cmd.EncryptBlsKey(home, "passphrase", bls12381.KDFPBKDF2, bls12381.LightPBKDF2C)
+	require.NoError(t, err)
+	keyJSON, err := os.ReadFile(keyPath)
+	require.NoError(t, err)
+	require.NotContains(t, string(keyJSON), "bls_priv_key")
+	require.Contains(t, string(keyJSON), "bls_keystore")
+	err = cmd.EncryptBlsKey(home, "passphrase", bls12381.KDFPBKDF2, bls12381.LightPBKDF2C)
+	require.Error(t, err)
+
+	// the encrypted BLS key is loaded with the passphrase
+	pv, err := privval.LoadWrappedFilePVWithBlsPassphrase(keyPath, statePath, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, blsPrivKey, pv.Key.BlsPrivKey)
+	require.Equal(t, sdk.ValAddress(addr), pv.GetAddress())
+	_, err = privval.LoadWrappedFilePVWithBlsPassphrase(keyPath, statePath, "wrong passphrase")
+	require.ErrorIs(t, err, bls12381.ErrInvalidPassphrase)
+
+	// the passphrase is loaded from the environment variables
+	t.Setenv(privval.BlsPassphraseEnvVar, "passphrase")
+	pv = privval.LoadWrappedFilePV(keyPath, statePath)
+	require.Equal(t, blsPrivKey, pv.Key.BlsPrivKey)
+	passphraseFile := filepath.Join(home, "passphrase.txt")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("passphrase\n"), 0600))
+	require.NoError(t, os.Unsetenv(privval.BlsPassphraseEnvVar))
+	t.Setenv(privval.BlsPassphraseFileEnvVar, passphraseFile)
+	pv = privval.LoadWrappedFilePV(keyPath, statePath)
+	require.Equal(t, blsPrivKey, pv.Key.BlsPrivKey)
+
+	// change the passphrase
+	err = cmd.ChangeBlsPassphrase(home, "wrong passphrase", "new passphrase", bls12381.KDFScrypt, bls12381.LightScryptN)
+	require.Error(t, err)
+	err = cmd.ChangeBlsPassphrase(home, "passphrase", "new passphrase", bls12381.KDFScrypt, bls12381.LightScryptN)
+	require.NoError(t, err)
+	_, err = privval.LoadWrappedFilePVWithBlsPassphrase(keyPath, statePath, "passphrase")
+	require.Error(t, err)
+	pv, err = privval.LoadWrappedFilePVWithBlsPassphrase(keyPath, statePath, "new passphrase")
+	require.NoError(t, err)
+	require.Equal(t, blsPrivKey, pv.Key.BlsPrivKey)
+
+	// decrypt the BLS key
+	err = cmd.DecryptBlsKey(home, "new passphrase")
+	require.NoError(t, err)
+	keyJSON, err = os.ReadFile(keyPath)
+	require.NoError(t, err)
+	require.Contains(t, string(keyJSON), "bls_priv_key")
+	require.NotContains(t, string(keyJSON), "bls_keystore")
+	err = cmd.DecryptBlsKey(home, "new passphrase")
+	require.Error(t, err)
+
+	// create a new BLS key which is encrypted directly
+	err = cmd.CreateEncryptedBlsKey(home, addr, "passphrase", bls12381.KDFPBKDF2, bls12381.LightPBKDF2C)
+	require.NoError(t, err)
+	keyJSON, err = os.ReadFile(keyPath)
+	require.NoError(t, err)
+	require.NotContains(t, string(keyJSON), "bls_priv_key")
+	pv, err = privval.LoadWrappedFilePVWithBlsPassphrase(keyPath, statePath, "passphrase")
+	require.NoError(t, err)
+	require.NotEqual(t, blsPrivKey, pv.Key.BlsPrivKey)
+	require.Equal(t, sdk.ValAddress(addr), pv.GetAddress())
+}
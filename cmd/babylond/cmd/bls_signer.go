@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	cmtconfig "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/privval"
+	"github.com/babylonchain/babylon/privval/remote"
+)
+
+const (
+	flagListenAddr  = "listen-addr"
+	flagTLSCert     = "tls-cert"
+	flagTLSKey      = "tls-key"
+	flagTLSClientCA = "tls-client-ca"
+
+	// blsSignerStateFile is the file of the sign state of the BLS signer,
+	// relative to the home directory
+	blsSignerStateFile = "data/bls_signer_state.json"
+)
+
+// BlsSignerCmd returns the commands of the reference remote BLS signer
+func BlsSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls-signer",
+		Short: "Run a remote BLS signer for a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(StartBlsSignerCmd())
+
+	return cmd
+}
+
+// StartBlsSignerCmd returns the command starting the reference remote BLS
+// signer
+func StartBlsSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Args:  cobra.NoArgs,
+		Short: "Start a remote BLS signer serving the BLS key of a validator",
+		Long: strings.TrimSpace(fmt.Sprintf(`Start a remote BLS signer serving the BLS key of a validator.
+
+The signer loads the validator keys from priv_validator_key.json in its home
+directory, which can be created via babylond init and babylond create-bls-key
+on the signer host, and signs BLS signatures over checkpoints requested by the
+validator node. If the BLS key is encrypted, the passphrase is loaded from the
+%s or %s environment variable.
+
+The signer never signs two different block hashes for the same epoch, nor an
+epoch before the last signed one. The last signed checkpoint is persisted in
+%s in its home directory.
+
+The validator node uses the signer by setting remote-addr in the [bls-signer]
+section of app.toml to the listen address of the signer. A unix socket is only
+accessible to the user running the signer. A TCP address requires mutual TLS,
+where the signer presents the certificate in --%s and only accepts nodes
+presenting a certificate signed by the CA in --%s.
+
+Example:
+$ babylond bls-signer start --listen-addr unix:///var/run/bls_signer.sock --home ./signer
+$ babylond bls-signer start --listen-addr tcp://0.0.0.0:26659 --home ./signer \
+    --tls-cert signer.crt --tls-key signer.key --tls-client-ca ca.crt
+`, privval.BlsPassphraseEnvVar, privval.BlsPassphraseFileEnvVar, blsSignerStateFile, flagTLSCert, flagTLSClientCA)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			listenAddr, _ := cmd.Flags().GetString(flagListenAddr)
+			tlsCert, _ := cmd.Flags().GetString(flagTLSCert)
+			tlsKey, _ := cmd.Flags().GetString(flagTLSKey)
+			tlsClientCA, _ := cmd.Flags().GetString(flagTLSClientCA)
+
+			nodeCfg := cmtconfig.DefaultConfig()
+			keyPath := filepath.Join(homeDir, nodeCfg.PrivValidatorKeyFile())
+			statePath := filepath.Join(homeDir, nodeCfg.PrivValidatorStateFile())
+			pv, err := LoadWrappedFilePV(keyPath, statePath)
+			if err != nil {
+				return err
+			}
+			signState, err := remote.LoadOrGenSignState(filepath.Join(homeDir, blsSignerStateFile))
+			if err != nil {
+				return err
+			}
+
+			tlsConfig, err := remote.NewServerTLSConfig(remote.TLSFiles{
+				CertFile: tlsCert,
+				KeyFile:  tlsKey,
+				CAFile:   tlsClientCA,
+			})
+			if err != nil {
+				return err
+			}
+			lis, err := remote.Listen(listenAddr, tlsConfig)
+			if err != nil {
+				return err
+			}
+			grpcServer := remote.NewSignerServer(pv, signState).NewGRPCServer(tlsConfig)
+
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigCh
+				grpcServer.GracefulStop()
+			}()
+
+			cmd.Printf("BLS signer for validator %s is listening at %s\n", pv.GetAddress(), listenAddr)
+			return grpcServer.Serve(lis)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The signer home directory")
+	cmd.Flags().String(flagListenAddr, "unix://bls_signer.sock", "The address to listen at (unix://<path> or tcp://<host>:<port>)")
+	cmd.Flags().String(flagTLSCert, "", "The PEM certificate of the signer for mutual TLS, required for a TCP address")
+	cmd.Flags().String(flagTLSKey, "", "The PEM key of the certificate of the signer for mutual TLS")
+	cmd.Flags().String(flagTLSClientCA, "", "The PEM CA that signs the certificates of the nodes allowed to connect")
+
+	return cmd
+}
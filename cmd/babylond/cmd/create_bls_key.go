@@ -31,6 +31,9 @@ send BLS signatures for checkpointing.
 
 BLS keys are stored along with other validator keys in priv_validator_key.json,
 which should exist before running the command (via babylond init or babylond testnet).
+With --encrypt, the BLS private key is stored encrypted with a passphrase read
+from the file given by --passphrase-file, or else from the environment
+variables, or else from a prompt (see babylond bls-keystore).
 
 Example:
 $ babylond create-bls-key %s1f5tnl46mk4dfp4nx3n2vnrvyw2h2ydz6ykhk3r --home ./
@@ -47,38 +50,84 @@ $ babylond create-bls-key %s1f5tnl46mk4dfp4nx3n2vnrvyw2h2ydz6ykhk3r --home ./
 				return err
 			}
 
-			return CreateBlsKey(homeDir, addr)
+			encrypt, _ := cmd.Flags().GetBool(flagEncrypt)
+			if !encrypt {
+				return CreateBlsKey(homeDir, addr)
+			}
+
+			kdf, _ := cmd.Flags().GetString(flagKDF)
+			passphrase, err := getNewBlsPassphrase(cmd, flagPassphraseFile, true)
+			if err != nil {
+				return err
+			}
+			return CreateEncryptedBlsKey(homeDir, addr, passphrase, kdf, 0)
 		},
 	}
 
 	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The node home directory")
+	cmd.Flags().Bool(flagEncrypt, false, "Encrypt the BLS private key with a passphrase")
+	cmd.Flags().String(flagPassphraseFile, "", "The file containing the passphrase")
+	cmd.Flags().String(flagKDF, bls12381.KDFScrypt, fmt.Sprintf("The key derivation function (%s|%s)", bls12381.KDFScrypt, bls12381.KDFPBKDF2))
 
 	return cmd
 }
 
 func CreateBlsKey(home string, addr sdk.AccAddress) error {
+	wrappedPV, err := newBlsKey(home)
+	if err != nil {
+		return err
+	}
+	wrappedPV.SetAccAddress(addr)
+
+	return nil
+}
+
+// CreateEncryptedBlsKey is the same as CreateBlsKey, except that the BLS private
+// key is encrypted with the given passphrase, KDF and cost (see
+// bls12381.EncryptKeystore) and is never stored in plaintext
+func CreateEncryptedBlsKey(home string, addr sdk.AccAddress, passphrase, kdf string, cost int) error {
+	wrappedPV, err := newBlsKey(home)
+	if err != nil {
+		return err
+	}
+	if err := wrappedPV.EncryptBlsKey(passphrase, kdf, cost); err != nil {
+		return err
+	}
+	wrappedPV.SetAccAddress(addr)
+
+	return nil
+}
+
+// newBlsKey generates a new BLS key along with the existing validator key
+// without saving it
+func newBlsKey(home string) (*privval.WrappedFilePV, error) {
 	nodeCfg := cmtconfig.DefaultConfig()
 	keyPath := filepath.Join(home, nodeCfg.PrivValidatorKeyFile())
 	statePath := filepath.Join(home, nodeCfg.PrivValidatorStateFile())
 
 	pv, err := LoadWrappedFilePV(keyPath, statePath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	wrappedPV := privval.NewWrappedFilePV(pv.GetValPrivKey(), bls12381.GenPrivKey(), keyPath, statePath)
-	wrappedPV.SetAccAddress(addr)
-
-	return nil
+	return privval.NewWrappedFilePV(pv.GetValPrivKey(), bls12381.GenPrivKey(), keyPath, statePath), nil
 }
 
 // LoadWrappedFilePV loads the wrapped file private key from the file path.
 func LoadWrappedFilePV(keyPath, statePath string) (*privval.WrappedFilePV, error) {
+	if err := ensureWrappedFilePV(keyPath, statePath); err != nil {
+		return nil, err
+	}
+	return privval.LoadWrappedFilePV(keyPath, statePath), nil
+}
+
+// ensureWrappedFilePV ensures the validator key and state files exist
+func ensureWrappedFilePV(keyPath, statePath string) error {
 	if !cmtos.FileExists(keyPath) {
-		return nil, errors.New("validator key file does not exist")
+		return errors.New("validator key file does not exist")
 	}
 	if !cmtos.FileExists(statePath) {
-		return nil, errors.New("validator state file does not exist")
+		return errors.New("validator state file does not exist")
 	}
-	return privval.LoadWrappedFilePV(keyPath, statePath), nil
+	return nil
 }
@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"time"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 
+	"github.com/babylonchain/babylon/privval/remote"
 	bbn "github.com/babylonchain/babylon/types"
 )
 
@@ -17,12 +20,33 @@ func defaultBabylonBtcConfig() BtcConfig {
 	}
 }
 
+// BlsSignerConfig configures the remote BLS signer of the validator. If
+// RemoteAddr is empty, the BLS key in priv_validator_key.json is used. The
+// TLS files configure the mutual TLS with the signer, which is required for
+// a TCP address.
+type BlsSignerConfig struct {
+	RemoteAddr  string        `mapstructure:"remote-addr"`
+	Timeout     time.Duration `mapstructure:"timeout"`
+	TLSCertFile string        `mapstructure:"tls-cert"`
+	TLSKeyFile  string        `mapstructure:"tls-key"`
+	TLSCAFile   string        `mapstructure:"tls-ca"`
+}
+
+func defaultBlsSignerConfig() BlsSignerConfig {
+	return BlsSignerConfig{
+		RemoteAddr: "",
+		Timeout:    remote.DefaultTimeout,
+	}
+}
+
 type BabylonAppConfig struct {
 	serverconfig.Config `mapstructure:",squash"`
 
 	Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
 
 	BtcConfig BtcConfig `mapstructure:"btc-config"`
+
+	BlsSigner BlsSignerConfig `mapstructure:"bls-signer"`
 }
 
 func DefaultBabylonConfig() *BabylonAppConfig {
@@ -30,6 +54,7 @@ func DefaultBabylonConfig() *BabylonAppConfig {
 		Config:    *serverconfig.DefaultConfig(),
 		Wasm:      wasmtypes.DefaultWasmConfig(),
 		BtcConfig: defaultBabylonBtcConfig(),
+		BlsSigner: defaultBlsSignerConfig(),
 	}
 }
 
@@ -44,5 +69,26 @@ func DefaultBabylonTemplate() string {
 # Configures which bitcoin network should be used for checkpointing
 # valid values are: [mainnet, testnet, simnet, signet, regtest]
 network = "{{ .BtcConfig.Network }}"
+
+###############################################################################
+###                      Babylon BLS signer configuration                   ###
+###############################################################################
+
+[bls-signer]
+
+# Address of the remote BLS signer signing checkpoints on behalf of the
+# validator, e.g., unix:///var/run/bls_signer.sock or tcp://127.0.0.1:26659.
+# If empty, the BLS key in priv_validator_key.json is used.
+remote-addr = "{{ .BlsSigner.RemoteAddr }}"
+
+# Timeout of requests to the remote BLS signer
+timeout = "{{ .BlsSigner.Timeout }}"
+
+# PEM files of the mutual TLS with the remote BLS signer, i.e., the
+# certificate and the key of the node, and the CA that signs the certificate
+# of the signer. They are required for a TCP address of the signer.
+tls-cert = "{{ .BlsSigner.TLSCertFile }}"
+tls-key = "{{ .BlsSigner.TLSKeyFile }}"
+tls-ca = "{{ .BlsSigner.TLSCAFile }}"
 `
 }
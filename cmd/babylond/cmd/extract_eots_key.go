@@ -0,0 +1,321 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	ftypes "github.com/babylonchain/babylon/x/finality/types"
+)
+
+const (
+	flagFpBtcPk        = "fp-btc-pk"
+	flagEvidenceHeight = "evidence-height"
+	flagSlashingTxs    = "slashing-txs"
+)
+
+// ExtractedEOTSKey is the BTC SK of a finality provider extracted from an
+// equivocation evidence, together with the messages signed by the two
+// conflicting finality signatures
+type ExtractedEOTSKey struct {
+	FpBtcPkHex      string `json:"fp_btc_pk_hex"`
+	BlockHeight     uint64 `json:"block_height"`
+	CanonicalMsgHex string `json:"canonical_msg_hex"`
+	ForkMsgHex      string `json:"fork_msg_hex"`
+	BtcSkHex        string `json:"btc_sk_hex"`
+	// SlashingTxs are the slashing txs with full witness of the BTC
+	// delegations under the finality provider, if requested
+	SlashingTxs []*SlashingTxWithWitness `json:"slashing_txs,omitempty"`
+}
+
+// SlashingTxWithWitness is the slashing tx and the unbonding slashing tx with
+// full witness of a BTC delegation, or the error upon building them
+type SlashingTxWithWitness struct {
+	StakingTxHashHex       string `json:"staking_tx_hash_hex"`
+	SlashingTxHex          string `json:"slashing_tx_hex,omitempty"`
+	UnbondingSlashingTxHex string `json:"unbonding_slashing_tx_hex,omitempty"`
+	Error                  string `json:"error,omitempty"`
+}
+
+// ExtractEOTSKeyCmd returns the command for extracting the BTC SK of an
+// equivocating finality provider from an evidence
+func ExtractEOTSKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extract-eots-key [evidence-json-file]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Extract the BTC SK of an equivocating finality provider from an evidence",
+		Long: strings.TrimSpace(fmt.Sprintf(`Extract the BTC SK of an equivocating finality provider from an evidence.
+
+The evidence is read from the given JSON file, which contains either an
+evidence or the output of "babylond query finality evidence", or else it is
+fetched over gRPC given --%s and optionally --%s,
+where the first slashable evidence of the finality provider is fetched if no
+height is given.
+
+The messages signed by the two finality signatures in the evidence are
+recomputed and the two finality signatures are verified, after which the BTC
+SK is extracted and checked against the BTC PK of the finality provider.
+With --%s, the slashing txs of the BTC delegations under the finality
+provider are fetched over gRPC and output with full witness.
+
+Example:
+$ babylond debug extract-eots-key ./evidence.json
+$ babylond debug extract-eots-key --%s <fp-btc-pk-hex> --%s 100 --%s --%s mainnet --node tcp://localhost:26657
+`, flagFpBtcPk, flagEvidenceHeight, flagSlashingTxs, flagFpBtcPk, flagEvidenceHeight, flagSlashingTxs, flagBtcNetwork)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fpBtcPkHex, _ := cmd.Flags().GetString(flagFpBtcPk)
+			height, _ := cmd.Flags().GetUint64(flagEvidenceHeight)
+			withSlashingTxs, _ := cmd.Flags().GetBool(flagSlashingTxs)
+			btcNetwork, _ := cmd.Flags().GetString(flagBtcNetwork)
+
+			if (len(args) == 0) == (fpBtcPkHex == "") {
+				return fmt.Errorf("exactly one of the evidence JSON file and --%s should be given", flagFpBtcPk)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var evidence *ftypes.Evidence
+			if len(args) == 1 {
+				evidence, err = ReadEvidence(clientCtx.Codec, args[0])
+			} else {
+				evidence, err = QueryEvidence(cmd.Context(), ftypes.NewQueryClient(clientCtx), fpBtcPkHex, height)
+			}
+			if err != nil {
+				return err
+			}
+
+			res, fpSK, err := ExtractEOTSKey(evidence)
+			if err != nil {
+				return err
+			}
+
+			if withSlashingTxs {
+				btcNet, err := bbn.NetParamsFromString(btcNetwork)
+				if err != nil {
+					return err
+				}
+				res.SlashingTxs, err = QuerySlashingTxsWithWitness(cmd.Context(), bstypes.NewQueryClient(clientCtx), btcNet, fpSK)
+				if err != nil {
+					return err
+				}
+			}
+
+			out, err := json.MarshalIndent(res, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagFpBtcPk, "", "The hex BTC PK (in BIP-340 format) of the finality provider whose evidence is fetched")
+	cmd.Flags().Uint64(flagEvidenceHeight, 0, "The height of the evidence to fetch (default the first slashable evidence)")
+	cmd.Flags().Bool(flagSlashingTxs, false, "Whether to output the slashing txs with full witness of the BTC delegations under the finality provider")
+	cmd.Flags().String(flagBtcNetwork, string(bbn.BtcMainnet), "Bitcoin network of the BTC delegations. Available networks: mainnet, testnet, signet, regtest, simnet")
+
+	return cmd
+}
+
+// ReadEvidence reads an evidence from a JSON file, which contains either an
+// evidence or a QueryEvidenceResponse
+func ReadEvidence(cdc codec.JSONCodec, path string) (*ftypes.Evidence, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var resp ftypes.QueryEvidenceResponse
+	if err := cdc.UnmarshalJSON(bz, &resp); err == nil && resp.Evidence != nil {
+		return resp.Evidence, nil
+	}
+	var evidence ftypes.Evidence
+	if err := cdc.UnmarshalJSON(bz, &evidence); err != nil {
+		return nil, fmt.Errorf("failed to parse evidence from %s: %w", path, err)
+	}
+	return &evidence, nil
+}
+
+// QueryEvidence fetches the evidence of the finality provider at the given
+// height, or the first slashable evidence of the finality provider if height
+// is zero
+func QueryEvidence(ctx context.Context, queryClient ftypes.QueryClient, fpBtcPkHex string, height uint64) (*ftypes.Evidence, error) {
+	fpBtcPk, err := bbn.NewBIP340PubKeyFromHex(fpBtcPkHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid finality provider BTC PK: %w", err)
+	}
+
+	if height == 0 {
+		res, err := queryClient.Evidence(ctx, &ftypes.QueryEvidenceRequest{FpBtcPkHex: fpBtcPk.MarshalHex()})
+		if err != nil {
+			return nil, err
+		}
+		return res.Evidence, nil
+	}
+
+	// there is no query by finality provider and height, so look for the
+	// evidence among all evidences since the height
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.ListEvidences(ctx, &ftypes.QueryListEvidencesRequest{
+			StartHeight: height,
+			Pagination:  pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, evidence := range res.Evidences {
+			if evidence.BlockHeight == height && evidence.FpBtcPk.Equals(fpBtcPk) {
+				return evidence, nil
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+	return nil, fmt.Errorf("no evidence of finality provider %s at height %d", fpBtcPk.MarshalHex(), height)
+}
+
+// ExtractEOTSKey verifies the evidence and extracts the BTC SK of the
+// finality provider from it
+func ExtractEOTSKey(evidence *ftypes.Evidence) (*ExtractedEOTSKey, *btcec.PrivateKey, error) {
+	fpSK, err := evidence.VerifyAndExtractBTCSK()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &ExtractedEOTSKey{
+		FpBtcPkHex:      evidence.FpBtcPk.MarshalHex(),
+		BlockHeight:     evidence.BlockHeight,
+		CanonicalMsgHex: hex.EncodeToString(evidence.CanonicalMsgToSign()),
+		ForkMsgHex:      hex.EncodeToString(evidence.ForkMsgToSign()),
+		BtcSkHex:        hex.EncodeToString(fpSK.Serialize()),
+	}, fpSK, nil
+}
+
+// QuerySlashingTxsWithWitness fetches the BTC delegations under the finality
+// provider and builds their slashing txs with full witness with the extracted
+// BTC SK of the finality provider
+func QuerySlashingTxsWithWitness(
+	ctx context.Context,
+	queryClient bstypes.QueryClient,
+	btcNet *chaincfg.Params,
+	fpSK *btcec.PrivateKey,
+) ([]*SlashingTxWithWitness, error) {
+	fpBtcPkHex := bbn.NewBIP340PubKeyFromBTCPK(fpSK.PubKey()).MarshalHex()
+	paramsByVersion := make(map[uint32]*bstypes.Params)
+	var slashingTxs []*SlashingTxWithWitness
+
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.FinalityProviderDelegations(ctx, &bstypes.QueryFinalityProviderDelegationsRequest{
+			FpBtcPkHex: fpBtcPkHex,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, btcDels := range res.BtcDelegatorDelegations {
+			for _, delResp := range btcDels.Dels {
+				params, ok := paramsByVersion[delResp.ParamsVersion]
+				if !ok {
+					paramsRes, err := queryClient.ParamsByVersion(ctx, &bstypes.QueryParamsByVersionRequest{Version: delResp.ParamsVersion})
+					if err != nil {
+						return nil, fmt.Errorf("failed to get params of version %d: %w", delResp.ParamsVersion, err)
+					}
+					params = &paramsRes.Params
+					paramsByVersion[delResp.ParamsVersion] = params
+				}
+				slashingTxs = append(slashingTxs, BuildSlashingTxWithWitness(delResp, params, btcNet, fpSK))
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+	return slashingTxs, nil
+}
+
+// BuildSlashingTxWithWitness builds the slashing tx, and the unbonding slashing
+// tx if the BTC delegation has an unbonding tx, with full witness with the BTC
+// SK of the finality provider. Upon failure, the error is recorded in the
+// result rather than returned, e.g., as a pending BTC delegation does not have
+// a quorum of covenant signatures yet.
+func BuildSlashingTxWithWitness(
+	delResp *bstypes.BTCDelegationResponse,
+	params *bstypes.Params,
+	btcNet *chaincfg.Params,
+	fpSK *btcec.PrivateKey,
+) *SlashingTxWithWitness {
+	res := &SlashingTxWithWitness{}
+	btcDel, err := delResp.ToBTCDelegation()
+	if err != nil {
+		res.Error = fmt.Sprintf("invalid BTC delegation: %v", err)
+		return res
+	}
+	stakingTxHash, err := btcDel.GetStakingTxHash()
+	if err != nil {
+		res.Error = fmt.Sprintf("invalid staking tx: %v", err)
+		return res
+	}
+	res.StakingTxHashHex = stakingTxHash.String()
+
+	// a slashing tx without a quorum of covenant signatures cannot be
+	// executed even with full witness
+	if uint32(len(btcDel.CovenantSigs)) < params.CovenantQuorum {
+		res.Error = "the BTC delegation does not have a quorum of covenant signatures on the slashing tx"
+		return res
+	}
+	slashingTx, err := btcDel.BuildSlashingTxWithWitness(params, btcNet, fpSK)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	if res.SlashingTxHex, err = serializeBTCTxToHex(slashingTx); err != nil {
+		res.Error = err.Error()
+		return res
+	}
+
+	if btcDel.BtcUndelegation != nil {
+		if !btcDel.BtcUndelegation.HasCovenantQuorumOnSlashing(params.CovenantQuorum) {
+			res.Error = "the BTC delegation does not have a quorum of covenant signatures on the unbonding slashing tx"
+			return res
+		}
+		unbondingSlashingTx, err := btcDel.BuildUnbondingSlashingTxWithWitness(params, btcNet, fpSK)
+		if err != nil {
+			res.Error = err.Error()
+			return res
+		}
+		if res.UnbondingSlashingTxHex, err = serializeBTCTxToHex(unbondingSlashingTx); err != nil {
+			res.Error = err.Error()
+			return res
+		}
+	}
+	return res
+}
+
+func serializeBTCTxToHex(tx *wire.MsgTx) (string, error) {
+	txBytes, err := bbn.SerializeBTCTx(tx)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(txBytes), nil
+}
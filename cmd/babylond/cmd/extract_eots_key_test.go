@@ -0,0 +1,149 @@
+package cmd_test
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/cmd/babylond/cmd"
+	btctest "github.com/babylonchain/babylon/testutil/bitcoin"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbntypes "github.com/babylonchain/babylon/types"
+	btcstktypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
+)
+
+func TestExtractEOTSKey(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	cdc := app.GetEncodingConfig().Codec
+	dir := t.TempDir()
+
+	sk, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	evidence, err := datagen.GenRandomEvidence(r, sk, datagen.RandomInt(r, 1000)+1)
+	require.NoError(t, err)
+
+	// the evidence can be read from both an evidence and a query response
+	evidenceJSON, err := cdc.MarshalJSON(evidence)
+	require.NoError(t, err)
+	respJSON, err := cdc.MarshalJSON(&finalitytypes.QueryEvidenceResponse{Evidence: evidence})
+	require.NoError(t, err)
+	for name, bz := range map[string][]byte{"evidence.json": evidenceJSON, "response.json": respJSON} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, bz, 0600))
+
+		readEvidence, err := cmd.ReadEvidence(cdc, path)
+		require.NoError(t, err)
+		require.Equal(t, evidence, readEvidence)
+
+		res, fpSK, err := cmd.ExtractEOTSKey(readEvidence)
+		require.NoError(t, err)
+		// the extracted BTC SK corresponds to the BIP-340 PK of the finality
+		// provider, i.e., it is either sk or its negation
+		fpBtcPk := bbntypes.NewBIP340PubKeyFromBTCPK(sk.PubKey())
+		require.True(t, fpBtcPk.Equals(bbntypes.NewBIP340PubKeyFromBTCPK(fpSK.PubKey())))
+		require.Equal(t, hex.EncodeToString(fpSK.Serialize()), res.BtcSkHex)
+		require.Equal(t, fpBtcPk.MarshalHex(), res.FpBtcPkHex)
+		require.Equal(t, evidence.BlockHeight, res.BlockHeight)
+		require.Equal(t, hex.EncodeToString(evidence.CanonicalMsgToSign()), res.CanonicalMsgHex)
+		require.Equal(t, hex.EncodeToString(evidence.ForkMsgToSign()), res.ForkMsgHex)
+	}
+
+	// an evidence with a finality signature that does not verify
+	invalidEvidence := *evidence
+	invalidEvidence.ForkAppHash = datagen.GenRandomByteArray(r, 32)
+	_, _, err = cmd.ExtractEOTSKey(&invalidEvidence)
+	require.Error(t, err)
+
+	// an evidence of another finality provider
+	otherSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	invalidEvidence = *evidence
+	invalidEvidence.FpBtcPk = bbntypes.NewBIP340PubKeyFromBTCPK(otherSK.PubKey())
+	_, _, err = cmd.ExtractEOTSKey(&invalidEvidence)
+	require.Error(t, err)
+
+	// a file that is not an evidence
+	path := filepath.Join(dir, "invalid.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"foo": "bar"}`), 0600))
+	_, err = cmd.ReadEvidence(cdc, path)
+	require.Error(t, err)
+}
+
+func TestBuildSlashingTxWithWitness(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	net := &chaincfg.SimNetParams
+
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	fpSK, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	fpBTCPKs := []bbntypes.BIP340PubKey{*bbntypes.NewBIP340PubKeyFromBTCPK(fpPK)}
+
+	// (3, 5) covenant committee
+	covenantSKs, covenantPKs, err := datagen.GenRandomBTCKeyPairs(r, 5)
+	require.NoError(t, err)
+	covenantQuorum := uint32(3)
+	bsParams := &btcstktypes.Params{
+		CovenantPks:    bbntypes.NewBIP340PKsFromBTCPKs(covenantPKs),
+		CovenantQuorum: covenantQuorum,
+	}
+	slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+	require.NoError(t, err)
+
+	btcDel, err := datagen.GenRandomBTCDelegation(
+		r,
+		t,
+		net,
+		fpBTCPKs,
+		delSK,
+		covenantSKs[:covenantQuorum],
+		covenantPKs,
+		covenantQuorum,
+		slashingAddress.EncodeAddress(),
+		1000,
+		1005,
+		2*10e8,
+		sdkmath.LegacyNewDecWithPrec(10, 2),
+		101,
+	)
+	require.NoError(t, err)
+
+	// the BTC SK of the finality provider extracted from its evidence
+	evidence, err := datagen.GenRandomEvidence(r, fpSK, 1)
+	require.NoError(t, err)
+	_, extractedSK, err := cmd.ExtractEOTSKey(evidence)
+	require.NoError(t, err)
+
+	// the slashing txs built from the BTC delegation in the query response
+	// have full witness
+	delResp := btcstktypes.NewBTCDelegationResponse(btcDel, btcstktypes.BTCDelegationStatus_ACTIVE)
+	res := cmd.BuildSlashingTxWithWitness(delResp, bsParams, net, extractedSK)
+	require.Empty(t, res.Error)
+	require.Equal(t, btcDel.MustGetStakingTxHash().String(), res.StakingTxHashHex)
+
+	stakingInfo, err := btcDel.GetStakingInfo(bsParams, net)
+	require.NoError(t, err)
+	slashingTx, _, err := bbntypes.NewBTCTxFromHex(res.SlashingTxHex)
+	require.NoError(t, err)
+	btctest.AssertSlashingTxExecution(t, stakingInfo.StakingOutput, slashingTx)
+
+	unbondingInfo, err := btcDel.GetUnbondingInfo(bsParams, net)
+	require.NoError(t, err)
+	unbondingSlashingTx, _, err := bbntypes.NewBTCTxFromHex(res.UnbondingSlashingTxHex)
+	require.NoError(t, err)
+	btctest.AssertSlashingTxExecution(t, unbondingInfo.UnbondingOutput, unbondingSlashingTx)
+
+	// the error is recorded for a BTC delegation without covenant signatures
+	delResp.CovenantSigs = nil
+	res = cmd.BuildSlashingTxWithWitness(delResp, bsParams, net, extractedSK)
+	require.NotEmpty(t, res.Error)
+	require.Empty(t, res.SlashingTxHex)
+}
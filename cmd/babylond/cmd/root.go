@@ -43,6 +43,7 @@ import (
 	"github.com/babylonchain/babylon/app"
 	"github.com/babylonchain/babylon/app/params"
 	"github.com/babylonchain/babylon/cmd/babylond/cmd/genhelpers"
+	"github.com/babylonchain/babylon/privval/remote"
 )
 
 // NewRootCmd creates a new root command for babylond. It is called once in the
@@ -178,6 +179,9 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxEncodingConfig, basic
 
 	gentxModule := basicManager[genutiltypes.ModuleName].(genutil.AppModuleBasic)
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(ExtractEOTSKeyCmd())
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager, app.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome, gentxModule.GenTxValidator, authcodec.NewBech32Codec(params.Bech32PrefixValAddr)),
@@ -190,8 +194,10 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxEncodingConfig, basic
 		TestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
 		genhelpers.CmdGenHelpers(gentxModule.GenTxValidator),
 		CreateBlsKeyCmd(),
+		BlsKeystoreCmd(),
+		BlsSignerCmd(),
 		ModuleSizeCmd(),
-		debug.Cmd(),
+		debugCmd,
 		confixcmd.ConfigCommand(),
 	)
 
@@ -275,7 +281,7 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 	}
 
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
-	privSigner, err := appkeepers.InitPrivSigner(homeDir)
+	privSigner, err := initPrivSigner(homeDir, appOpts)
 	if err != nil {
 		panic(err)
 	}
@@ -295,6 +301,25 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 	)
 }
 
+// initPrivSigner initialises the PrivSigner of the node, which signs BLS
+// signatures via the remote BLS signer if bls-signer.remote-addr is set
+func initPrivSigner(homeDir string, appOpts servertypes.AppOptions) (*appkeepers.PrivSigner, error) {
+	remoteAddr := cast.ToString(appOpts.Get("bls-signer.remote-addr"))
+	if remoteAddr == "" {
+		return appkeepers.InitPrivSigner(homeDir)
+	}
+	timeout := cast.ToDuration(appOpts.Get("bls-signer.timeout"))
+	if timeout == 0 {
+		timeout = remote.DefaultTimeout
+	}
+	tlsFiles := remote.TLSFiles{
+		CertFile: cast.ToString(appOpts.Get("bls-signer.tls-cert")),
+		KeyFile:  cast.ToString(appOpts.Get("bls-signer.tls-key")),
+		CAFile:   cast.ToString(appOpts.Get("bls-signer.tls-ca")),
+	}
+	return appkeepers.InitRemotePrivSigner(remoteAddr, timeout, tlsFiles)
+}
+
 // appExport creates a new app (optionally at a given height)
 // and exports state.
 func appExport(
@@ -13,7 +13,10 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/cobra"
 
+	bbn "github.com/babylonchain/babylon/types"
+	btcstktypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
 )
 
 const chainUpgradeGuide = "https://github.com/cosmos/cosmos-sdk/blob/a51aa517c46c70df04a06f586c67fb765e45322a/UPGRADING.md"
@@ -23,6 +26,8 @@ const chainUpgradeGuide = "https://github.com/cosmos/cosmos-sdk/blob/a51aa517c46
 // validation rule
 // 2. each genesis BLS key or gentx should have a corresponding gentx or genesis
 // BLS key
+// 3. each finality provider referred by the finality module should be registered
+// in the BTC staking module
 // modified based on "https://github.com/cosmos/cosmos-sdk/blob/6d32debf1aca4b7f1ed1429d87be1d02c315f02d/x/genutil/client/cli/validate_genesis.go"
 func ValidateGenesisCmd(mbm module.BasicManager, validator genutiltypes.MessageValidator) *cobra.Command {
 	return &cobra.Command{
@@ -61,6 +66,10 @@ func ValidateGenesisCmd(mbm module.BasicManager, validator genutiltypes.MessageV
 				return fmt.Errorf("error validating genesis file correspondence %s: %s", genesis, err.Error())
 			}
 
+			if err = CheckFinalityProviders(clientCtx, genState); err != nil {
+				return fmt.Errorf("error validating genesis file finality providers %s: %s", genesis, err.Error())
+			}
+
 			fmt.Printf("File at %s is a valid genesis file\n", genesis)
 			return nil
 		},
@@ -120,3 +129,50 @@ func CheckCorrespondence(ctx client.Context, genesis map[string]json.RawMessage,
 
 	return nil
 }
+
+// CheckFinalityProviders checks that the finality providers referred by the
+// votes, public randomness, evidences and signing infos in the finality module
+// are registered in the BTC staking module
+func CheckFinalityProviders(ctx client.Context, genesis map[string]json.RawMessage) error {
+	btcstkGenState := btcstktypes.GenesisStateFromAppState(ctx.Codec, genesis)
+	finalityGenState := finalitytypes.GenesisStateFromAppState(ctx.Codec, genesis)
+
+	fps := make(map[string]struct{}, len(btcstkGenState.FinalityProviders))
+	for _, fp := range btcstkGenState.FinalityProviders {
+		fps[fp.BtcPk.MarshalHex()] = struct{}{}
+	}
+	checkFp := func(fpBTCPK *bbn.BIP340PubKey, kind string) error {
+		if _, ok := fps[fpBTCPK.MarshalHex()]; !ok {
+			return fmt.Errorf("%s refers to non-existing finality provider %s", kind, fpBTCPK.MarshalHex())
+		}
+		return nil
+	}
+
+	for _, v := range finalityGenState.VoteSigs {
+		if err := checkFp(v.FpBtcPk, "vote"); err != nil {
+			return err
+		}
+	}
+	for _, pr := range finalityGenState.PublicRandomness {
+		if err := checkFp(pr.FpBtcPk, "public randomness"); err != nil {
+			return err
+		}
+	}
+	for _, prc := range finalityGenState.PubRandCommit {
+		if err := checkFp(prc.FpBtcPk, "public randomness commitment"); err != nil {
+			return err
+		}
+	}
+	for _, e := range finalityGenState.Evidences {
+		if err := checkFp(e.FpBtcPk, "evidence"); err != nil {
+			return err
+		}
+	}
+	for _, info := range finalityGenState.SigningInfos {
+		if err := checkFp(info.FpBtcPk, "signing info"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
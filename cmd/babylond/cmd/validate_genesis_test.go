@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
@@ -24,6 +25,10 @@ import (
 
 	"github.com/babylonchain/babylon/app"
 	"github.com/babylonchain/babylon/cmd/babylond/cmd"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbntypes "github.com/babylonchain/babylon/types"
+	btcstktypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
 )
 
 func TestCheckCorrespondence(t *testing.T) {
@@ -85,6 +90,50 @@ func TestCheckCorrespondence(t *testing.T) {
 	}
 }
 
+func TestCheckFinalityProviders(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	homePath := t.TempDir()
+	bbn, appState := generateTestGenesisState(t, homePath, 1)
+	clientCtx := client.Context{}.WithCodec(bbn.AppCodec()).WithTxConfig(bbn.TxConfig())
+
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+
+	// register the finality provider in the BTC staking module
+	btcstkGenState := btcstktypes.GenesisStateFromAppState(clientCtx.Codec, appState)
+	btcstkGenState.FinalityProviders = []*btcstktypes.FinalityProvider{fp}
+	btcstkGenStateBz, err := clientCtx.Codec.MarshalJSON(&btcstkGenState)
+	require.NoError(t, err)
+	appState[btcstktypes.ModuleName] = btcstkGenStateBz
+
+	// genAppState returns an app state where the finality module refers to
+	// the given finality provider
+	genAppState := func(fpBTCPK *bbntypes.BIP340PubKey) map[string]json.RawMessage {
+		finalityGenState := finalitytypes.GenesisStateFromAppState(clientCtx.Codec, appState)
+		finalityGenState.SigningInfos = []finalitytypes.SigningInfo{{
+			FpBtcPk:       fpBTCPK,
+			FpSigningInfo: finalitytypes.NewFinalityProviderSigningInfo(fpBTCPK, 1, 0),
+		}}
+		finalityGenStateBz, err := clientCtx.Codec.MarshalJSON(&finalityGenState)
+		require.NoError(t, err)
+
+		newAppState := make(map[string]json.RawMessage, len(appState))
+		for k, v := range appState {
+			newAppState[k] = v
+		}
+		newAppState[finalitytypes.ModuleName] = finalityGenStateBz
+		return newAppState
+	}
+
+	unknownFpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+	require.NoError(t, err)
+
+	err = cmd.CheckFinalityProviders(clientCtx, genAppState(fp.BtcPk))
+	require.NoError(t, err)
+	err = cmd.CheckFinalityProviders(clientCtx, genAppState(unknownFpBTCPK))
+	require.Error(t, err)
+}
+
 func generateTestGenesisState(t *testing.T, home string, n int) (*app.BabylonApp, map[string]json.RawMessage) {
 	logger := log.NewNopLogger()
 	cfg, _ := genutiltest.CreateDefaultCometConfig(home)
@@ -139,6 +139,8 @@ func GetToSignTx(toSpend *wire.MsgTx) *wire.MsgTx {
 	return toSign
 }
 
+// Verify verifies a simple BIP-322 signature, i.e., the witness spending the
+// toSpend transaction of the message and address
 func Verify(
 	msg []byte,
 	witness wire.TxWitness,
@@ -158,6 +160,12 @@ func Verify(
 	// https://github.com/bitcoin/bips/blob/master/bip-0322.mediawiki#verification-process
 	// We only need to perform verification of whether toSign spends toSpend properly
 	// given that the signature is a simple one and we construct both toSpend and toSign
+	return verifyToSign(toSpend, toSign)
+}
+
+// verifyToSign verifies whether the first input of the toSign transaction
+// spends the output of the toSpend transaction properly
+func verifyToSign(toSpend *wire.MsgTx, toSign *wire.MsgTx) error {
 	inputFetcher := txscript.NewCannedPrevOutputFetcher(toSpend.TxOut[0].PkScript, 0)
 	sigHashes := txscript.NewTxSigHashes(toSign, inputFetcher)
 	vm, err := txscript.NewEngine(
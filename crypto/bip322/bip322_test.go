@@ -116,3 +116,107 @@ func FuzzBip322ValidP2TrSpendSignature(f *testing.F) {
 		require.NoError(t, err)
 	})
 }
+
+func FuzzBip322ValidFullSignature(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		privkey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		dataLen := r.Int31n(200) + 1
+		dataToSign := datagen.GenRandomByteArray(r, uint64(dataLen))
+
+		signFns := map[string]func([]byte, *btcec.PrivateKey, *chaincfg.Params) (btcutil.Address, []byte, error){
+			"p2pkh": func(msg []byte, sk *btcec.PrivateKey, net *chaincfg.Params) (btcutil.Address, []byte, error) {
+				return bip322.SignFullWithP2PKHAddress(msg, sk, net)
+			},
+			"p2sh-p2wpkh": func(msg []byte, sk *btcec.PrivateKey, net *chaincfg.Params) (btcutil.Address, []byte, error) {
+				return bip322.SignFullWithP2SHP2WPKHAddress(msg, sk, net)
+			},
+			"p2wpkh": func(msg []byte, sk *btcec.PrivateKey, net *chaincfg.Params) (btcutil.Address, []byte, error) {
+				return bip322.SignFullWithP2WPKHAddress(msg, sk, net)
+			},
+			"p2tr": func(msg []byte, sk *btcec.PrivateKey, net *chaincfg.Params) (btcutil.Address, []byte, error) {
+				return bip322.SignFullWithP2TrSpendAddress(msg, sk, net)
+			},
+		}
+
+		for name, signFn := range signFns {
+			address, fullSig, err := signFn(dataToSign, privkey, net)
+			require.NoError(t, err, name)
+			toSign, err := bip322.DecodeFullSig(fullSig)
+			require.NoError(t, err, name)
+
+			err = bip322.VerifyFull(dataToSign, toSign, address, net)
+			require.NoError(t, err, name)
+
+			// the signature is not valid over another message
+			err = bip322.VerifyFull(append(dataToSign, 0x00), toSign, address, net)
+			require.Error(t, err, name)
+
+			// the toSign transaction must have a single OP_RETURN output
+			toSign.AddTxOut(toSign.TxOut[0])
+			err = bip322.VerifyFull(dataToSign, toSign, address, net)
+			require.Error(t, err, name)
+		}
+	})
+}
+
+func FuzzBip322ValidP2WSHMultisigSignature(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		dataLen := r.Int31n(200) + 1
+		dataToSign := datagen.GenRandomByteArray(r, uint64(dataLen))
+
+		// generate a random m-of-n multisig
+		n := int(r.Int31n(5)) + 1
+		m := int(r.Int31n(int32(n))) + 1
+		privKeys := make([]*btcec.PrivateKey, 0, n)
+		pubKeys := make([]*btcec.PublicKey, 0, n)
+		for i := 0; i < n; i++ {
+			privkey, err := btcec.NewPrivateKey()
+			require.NoError(t, err)
+			privKeys = append(privKeys, privkey)
+			pubKeys = append(pubKeys, privkey.PubKey())
+		}
+		signers := make([]*btcec.PrivateKey, 0, m)
+		for _, i := range r.Perm(n)[:m] {
+			signers = append(signers, privKeys[i])
+		}
+
+		// simple signature
+		address, witness, err := bip322.SignWithP2WSHMultisigAddress(dataToSign, signers, pubKeys, m, net)
+		require.NoError(t, err)
+		witnessDecoded, err := bip322.SimpleSigToWitness(witness)
+		require.NoError(t, err)
+		err = bip322.Verify(dataToSign, witnessDecoded, address, net)
+		require.NoError(t, err)
+
+		// full signature
+		address, fullSig, err := bip322.SignFullWithP2WSHMultisigAddress(dataToSign, signers, pubKeys, m, net)
+		require.NoError(t, err)
+		toSign, err := bip322.DecodeFullSig(fullSig)
+		require.NoError(t, err)
+		err = bip322.VerifyFull(dataToSign, toSign, address, net)
+		require.NoError(t, err)
+
+		// fewer signers than required cannot sign
+		_, _, err = bip322.SignWithP2WSHMultisigAddress(dataToSign, signers[1:], pubKeys, m, net)
+		require.Error(t, err)
+	})
+}
+
+func TestBip322DecodeFullSig(t *testing.T) {
+	privkey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	_, fullSig, err := bip322.SignFullWithP2PKHAddress(helloWorldBytes, privkey, net)
+	require.NoError(t, err)
+
+	_, err = bip322.DecodeFullSig(fullSig)
+	require.NoError(t, err)
+	_, err = bip322.DecodeFullSig(append(fullSig, 0x00))
+	require.Error(t, err)
+	_, err = bip322.DecodeFullSig(fullSig[:len(fullSig)-1])
+	require.Error(t, err)
+}
@@ -0,0 +1,393 @@
+package bip322
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// this file provides functionality for the full BIP-322 signature format,
+// i.e., signatures consisting of the entire toSign transaction, as well as
+// signing with the address types whose witness and signature script cannot
+// be expressed by the simple format (P2PKH, P2SH-P2WPKH) or which require
+// more than a single key (P2WSH multisig).
+// https://github.com/bitcoin/bips/blob/e643d247c8bc086745f3031cdee0899803edea2f/bip-0322.mediawiki#full
+
+// DecodeFullSig decodes a full signature, i.e., the toSign transaction in
+// standard network serialization, into the toSign transaction
+func DecodeFullSig(sig []byte) (*wire.MsgTx, error) {
+	r := bytes.NewReader(sig)
+	toSign := wire.NewMsgTx(toSignVersion)
+	if err := toSign.Deserialize(r); err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("full signature has %d trailing bytes", r.Len())
+	}
+	return toSign, nil
+}
+
+// SerializeFullSig serializes the toSign transaction into a full signature
+func SerializeFullSig(toSign *wire.MsgTx) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := toSign.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// checkFullToSign checks that the given toSign transaction is a valid toSign
+// transaction of the given toSpend transaction as per the BIP-322 spec.
+// The spec allows the version, lock time and sequence of the toSign
+// transaction to be set as appropriate in the full format, so that they are
+// left to the script execution. Additional inputs for proving the control of
+// funds are not supported, as their outputs cannot be looked up here.
+func checkFullToSign(toSpend *wire.MsgTx, toSign *wire.MsgTx) error {
+	if len(toSign.TxIn) != 1 {
+		return fmt.Errorf("toSign transaction must have exactly 1 input, got %d", len(toSign.TxIn))
+	}
+	toSpendHash := toSpend.TxHash()
+	if toSign.TxIn[0].PreviousOutPoint != *wire.NewOutPoint(&toSpendHash, 0) {
+		return fmt.Errorf("toSign transaction does not spend the toSpend transaction")
+	}
+	if len(toSign.TxOut) != 1 {
+		return fmt.Errorf("toSign transaction must have exactly 1 output, got %d", len(toSign.TxOut))
+	}
+	if toSign.TxOut[0].Value != toSignOutputValue || !bytes.Equal(toSign.TxOut[0].PkScript, toSignPkScript()) {
+		return fmt.Errorf("toSign transaction output must be an OP_RETURN output with zero value")
+	}
+	return nil
+}
+
+// VerifyFull verifies a full BIP-322 signature, i.e., that the given toSign
+// transaction is a valid toSign transaction for the given message and address
+// and that it spends the toSpend transaction properly
+func VerifyFull(
+	msg []byte,
+	toSign *wire.MsgTx,
+	address btcutil.Address,
+	net *chaincfg.Params) error {
+
+	toSpend, err := GetToSpendTx(msg, address)
+	if err != nil {
+		return err
+	}
+
+	if err := checkFullToSign(toSpend, toSign); err != nil {
+		return err
+	}
+
+	return verifyToSign(toSpend, toSign)
+}
+
+// PubKeyToP2PKHAddress returns the P2PKH address of the compressed public key
+func PubKeyToP2PKHAddress(p *btcec.PublicKey, net *chaincfg.Params) (*btcutil.AddressPubKeyHash, error) {
+	return btcutil.NewAddressPubKeyHash(
+		btcutil.Hash160(p.SerializeCompressed()),
+		net,
+	)
+}
+
+// PubKeyToP2SHP2WPKHAddress returns the P2SH address nesting the P2WPKH
+// address of the public key
+func PubKeyToP2SHP2WPKHAddress(p *btcec.PublicKey, net *chaincfg.Params) (*btcutil.AddressScriptHash, error) {
+	redeemScript, err := p2shP2WPKHRedeemScript(p, net)
+	if err != nil {
+		return nil, err
+	}
+	return btcutil.NewAddressScriptHash(redeemScript, net)
+}
+
+func p2shP2WPKHRedeemScript(p *btcec.PublicKey, net *chaincfg.Params) ([]byte, error) {
+	witnessAddr, err := PubkeyToP2WPKHAddress(p, net)
+	if err != nil {
+		return nil, err
+	}
+	return txscript.PayToAddrScript(witnessAddr)
+}
+
+// PubKeysToP2WSHMultisigAddress returns the P2WSH address of the multisig
+// script requiring nRequired signatures of the given public keys, together
+// with the multisig script, i.e., the witness script
+func PubKeysToP2WSHMultisigAddress(
+	pubKeys []*btcec.PublicKey,
+	nRequired int,
+	net *chaincfg.Params,
+) (*btcutil.AddressWitnessScriptHash, []byte, error) {
+	witnessScript, err := multisigScript(pubKeys, nRequired, net)
+	if err != nil {
+		return nil, nil, err
+	}
+	scriptHash := sha256.Sum256(witnessScript)
+	address, err := btcutil.NewAddressWitnessScriptHash(scriptHash[:], net)
+	if err != nil {
+		return nil, nil, err
+	}
+	return address, witnessScript, nil
+}
+
+func multisigScript(pubKeys []*btcec.PublicKey, nRequired int, net *chaincfg.Params) ([]byte, error) {
+	addrs := make([]*btcutil.AddressPubKey, 0, len(pubKeys))
+	for _, pk := range pubKeys {
+		addr, err := btcutil.NewAddressPubKey(pk.SerializeCompressed(), net)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return txscript.MultiSigScript(addrs, nRequired)
+}
+
+// newToSign builds the toSpend and toSign transactions of the message and
+// address, together with the sighashes of the toSign transaction
+func newToSign(msg []byte, address btcutil.Address) (*wire.MsgTx, *wire.MsgTx, *txscript.TxSigHashes, error) {
+	toSpend, err := GetToSpendTx(msg, address)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	toSign := GetToSignTx(toSpend)
+
+	fetcher := txscript.NewCannedPrevOutputFetcher(
+		toSpend.TxOut[0].PkScript,
+		toSpend.TxOut[0].Value,
+	)
+
+	return toSpend, toSign, txscript.NewTxSigHashes(toSign, fetcher), nil
+}
+
+// SignFullWithP2PKHAddress signs the message with the P2PKH address of the
+// private key, and returns the address and the full signature
+func SignFullWithP2PKHAddress(
+	msg []byte,
+	privKey *btcec.PrivateKey,
+	net *chaincfg.Params,
+) (*btcutil.AddressPubKeyHash, []byte, error) {
+	address, err := PubKeyToP2PKHAddress(privKey.PubKey(), net)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toSpend, toSign, _, err := newToSign(msg, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// always use compressed pubkey
+	sigScript, err := txscript.SignatureScript(
+		toSign, 0, toSpend.TxOut[0].PkScript, txscript.SigHashAll, privKey, true,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	toSign.TxIn[0].SignatureScript = sigScript
+
+	fullSig, err := SerializeFullSig(toSign)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return address, fullSig, nil
+}
+
+// SignFullWithP2SHP2WPKHAddress signs the message with the P2SH-P2WPKH
+// address of the private key, and returns the address and the full signature
+func SignFullWithP2SHP2WPKHAddress(
+	msg []byte,
+	privKey *btcec.PrivateKey,
+	net *chaincfg.Params,
+) (*btcutil.AddressScriptHash, []byte, error) {
+	pubKey := privKey.PubKey()
+
+	address, err := PubKeyToP2SHP2WPKHAddress(pubKey, net)
+	if err != nil {
+		return nil, nil, err
+	}
+	redeemScript, err := p2shP2WPKHRedeemScript(pubKey, net)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toSpend, toSign, hashCache, err := newToSign(msg, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the witness signs the P2WPKH redeem script, and the signature script
+	// pushes the redeem script
+	witness, err := txscript.WitnessSignature(toSign, hashCache, 0,
+		toSpend.TxOut[0].Value, redeemScript, txscript.SigHashAll, privKey, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	sigScript, err := txscript.NewScriptBuilder().AddData(redeemScript).Script()
+	if err != nil {
+		return nil, nil, err
+	}
+	toSign.TxIn[0].Witness = witness
+	toSign.TxIn[0].SignatureScript = sigScript
+
+	fullSig, err := SerializeFullSig(toSign)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return address, fullSig, nil
+}
+
+// SignFullWithP2WPKHAddress signs the message with the P2WPKH address of the
+// private key, and returns the address and the full signature
+func SignFullWithP2WPKHAddress(
+	msg []byte,
+	privKey *btcec.PrivateKey,
+	net *chaincfg.Params,
+) (*btcutil.AddressWitnessPubKeyHash, []byte, error) {
+	address, simpleSig, err := SignWithP2WPKHAddress(msg, privKey, net)
+	if err != nil {
+		return nil, nil, err
+	}
+	fullSig, err := simpleSigToFullSig(msg, address, simpleSig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return address, fullSig, nil
+}
+
+// SignFullWithP2TrSpendAddress signs the message with the BIP-86 P2TR address
+// of the private key, and returns the address and the full signature
+func SignFullWithP2TrSpendAddress(
+	msg []byte,
+	privKey *btcec.PrivateKey,
+	net *chaincfg.Params,
+) (*btcutil.AddressTaproot, []byte, error) {
+	address, simpleSig, err := SignWithP2TrSpendAddress(msg, privKey, net)
+	if err != nil {
+		return nil, nil, err
+	}
+	fullSig, err := simpleSigToFullSig(msg, address, simpleSig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return address, fullSig, nil
+}
+
+// simpleSigToFullSig converts a simple signature over the message by the
+// address into the full signature
+func simpleSigToFullSig(msg []byte, address btcutil.Address, simpleSig []byte) ([]byte, error) {
+	witness, err := SimpleSigToWitness(simpleSig)
+	if err != nil {
+		return nil, err
+	}
+	toSpend, err := GetToSpendTx(msg, address)
+	if err != nil {
+		return nil, err
+	}
+	toSign := GetToSignTx(toSpend)
+	toSign.TxIn[0].Witness = witness
+	return SerializeFullSig(toSign)
+}
+
+// multisigWitness builds the witness spending the P2WSH multisig output of
+// the toSpend transaction. The private keys have to be the keys of nRequired
+// public keys of the multisig script, and the signatures are ordered as the
+// public keys in the multisig script.
+func multisigWitness(
+	toSpend *wire.MsgTx,
+	toSign *wire.MsgTx,
+	hashCache *txscript.TxSigHashes,
+	witnessScript []byte,
+	privKeys []*btcec.PrivateKey,
+	pubKeys []*btcec.PublicKey,
+	nRequired int,
+) (wire.TxWitness, error) {
+	if len(privKeys) != nRequired {
+		return nil, fmt.Errorf("expected %d private keys for the multisig, got %d", nRequired, len(privKeys))
+	}
+
+	// OP_CHECKMULTISIG pops an extra element off the stack, so the
+	// witness starts with an empty element
+	witness := wire.TxWitness{nil}
+	signed := 0
+	for _, pk := range pubKeys {
+		for _, sk := range privKeys {
+			if !sk.PubKey().IsEqual(pk) {
+				continue
+			}
+			sig, err := txscript.RawTxInWitnessSignature(toSign, hashCache, 0,
+				toSpend.TxOut[0].Value, witnessScript, txscript.SigHashAll, sk)
+			if err != nil {
+				return nil, err
+			}
+			witness = append(witness, sig)
+			signed++
+			break
+		}
+	}
+	if signed != nRequired {
+		return nil, fmt.Errorf("private keys do not correspond to %d distinct public keys of the multisig", nRequired)
+	}
+
+	return append(witness, witnessScript), nil
+}
+
+// SignWithP2WSHMultisigAddress signs the message with the P2WSH address of
+// the multisig script requiring nRequired signatures of the public keys, and
+// returns the address and the simple signature. The private keys have to be
+// the keys of nRequired of the public keys.
+func SignWithP2WSHMultisigAddress(
+	msg []byte,
+	privKeys []*btcec.PrivateKey,
+	pubKeys []*btcec.PublicKey,
+	nRequired int,
+	net *chaincfg.Params,
+) (*btcutil.AddressWitnessScriptHash, []byte, error) {
+	address, witnessScript, err := PubKeysToP2WSHMultisigAddress(pubKeys, nRequired, net)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toSpend, toSign, hashCache, err := newToSign(msg, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	witness, err := multisigWitness(toSpend, toSign, hashCache, witnessScript, privKeys, pubKeys, nRequired)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serializedWitness, err := SerializeWitness(witness)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return address, serializedWitness, nil
+}
+
+// SignFullWithP2WSHMultisigAddress signs the message with the P2WSH address
+// of the multisig script requiring nRequired signatures of the public keys,
+// and returns the address and the full signature. The private keys have to be
+// the keys of nRequired of the public keys.
+func SignFullWithP2WSHMultisigAddress(
+	msg []byte,
+	privKeys []*btcec.PrivateKey,
+	pubKeys []*btcec.PublicKey,
+	nRequired int,
+	net *chaincfg.Params,
+) (*btcutil.AddressWitnessScriptHash, []byte, error) {
+	address, simpleSig, err := SignWithP2WSHMultisigAddress(msg, privKeys, pubKeys, nRequired, net)
+	if err != nil {
+		return nil, nil, err
+	}
+	fullSig, err := simpleSigToFullSig(msg, address, simpleSig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return address, fullSig, nil
+}
@@ -0,0 +1,90 @@
+package bls12381
+
+import (
+	"github.com/pkg/errors"
+)
+
+// DecompressPubKey decompresses a BLS public key (compressed) into a point,
+// which can be added to a PubKeyAggregator without decompressing it again
+func DecompressPubKey(pk PublicKey) (*BlsPubKey, error) {
+	if len(pk) != PubKeySize {
+		return nil, errors.New("invalid bls public key size")
+	}
+	decompressed := new(BlsPubKey).Uncompress(pk)
+	if decompressed == nil {
+		return nil, errors.New("failed to decompress bls public key")
+	}
+	return decompressed, nil
+}
+
+// PubKeyAggregator aggregates BLS public keys in an incremental manner.
+// Unlike AggrPK, which decompresses the existing aggregated public key and
+// compresses the new one upon each aggregation, the aggregator keeps the
+// aggregated public key as a point, which is only compressed when requested.
+// Together with public keys decompressed in advance, e.g., the BLS public
+// keys of a validator set, aggregating n public keys takes n point additions
+// rather than 2n decompressions and n compressions.
+type PubKeyAggregator struct {
+	aggPk BlsMultiPubKey
+	n     int
+}
+
+// NewPubKeyAggregator returns a new aggregator without any public key
+func NewPubKeyAggregator() *PubKeyAggregator {
+	return &PubKeyAggregator{}
+}
+
+// Add decompresses the BLS public key (compressed) and adds it to the
+// aggregated public key
+func (a *PubKeyAggregator) Add(pk PublicKey) error {
+	decompressed, err := DecompressPubKey(pk)
+	if err != nil {
+		return err
+	}
+	a.AddDecompressed(decompressed)
+	return nil
+}
+
+// AddDecompressed adds the decompressed BLS public key to the aggregated
+// public key
+func (a *PubKeyAggregator) AddDecompressed(pk *BlsPubKey) {
+	// NOTE: consistent with AggrPKList, the public key is not group checked
+	a.aggPk.Add(pk, false)
+	a.n++
+}
+
+// Len returns the number of public keys that have been aggregated
+func (a *PubKeyAggregator) Len() int {
+	return a.n
+}
+
+// AggrPK returns the aggregated public key (compressed)
+func (a *PubKeyAggregator) AggrPK() (PublicKey, error) {
+	if a.n == 0 {
+		return nil, errors.New("no bls public key to aggregate")
+	}
+	return a.aggPk.ToAffine().Compress(), nil
+}
+
+// VerifyMultiSig verifies a BLS sig (compressed) over a message with the
+// aggregated public key, without compressing the aggregated public key
+func (a *PubKeyAggregator) VerifyMultiSig(sig Signature, msg []byte) (bool, error) {
+	if a.n == 0 {
+		return false, errors.New("no bls public key to aggregate")
+	}
+	decompressedSig := new(BlsSig).Uncompress(sig)
+	if decompressedSig == nil {
+		return false, nil
+	}
+	return decompressedSig.Verify(false, a.aggPk.ToAffine(), false, msg, DST), nil
+}
+
+// VerifyDecompressed verifies a BLS sig (compressed) over msg with a
+// decompressed BLS public key
+func VerifyDecompressed(sig Signature, pk *BlsPubKey, msg []byte) (bool, error) {
+	decompressedSig := new(BlsSig).Uncompress(sig)
+	if decompressedSig == nil {
+		return false, nil
+	}
+	return decompressedSig.Verify(false, pk, false, msg, DST), nil
+}
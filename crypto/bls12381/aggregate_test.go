@@ -0,0 +1,152 @@
+package bls12381
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPubKeyAggregator(t *testing.T) {
+	msga := []byte("aaaaaaaa")
+	msgb := []byte("bbbbbbbb")
+	n := 100
+	sks, pks := generateBatchTestKeyPairs(n)
+
+	aggr := NewPubKeyAggregator()
+	_, err := aggr.AggrPK()
+	require.Error(t, err)
+
+	var aggSig Signature
+	for i := 0; i < n; i++ {
+		aggSig, err = AggrSig(aggSig, Sign(sks[i], msga))
+		require.NoError(t, err)
+		// add compressed and decompressed public keys alternately
+		if i%2 == 0 {
+			require.NoError(t, aggr.Add(pks[i]))
+		} else {
+			decompressed, err := DecompressPubKey(pks[i])
+			require.NoError(t, err)
+			aggr.AddDecompressed(decompressed)
+		}
+		require.Equal(t, i+1, aggr.Len())
+
+		// the aggregated public key is the same as the one by AggrPKList
+		aggPK, err := aggr.AggrPK()
+		require.NoError(t, err)
+		expectedAggPK, err := AggrPKList(pks[:i+1])
+		require.NoError(t, err)
+		require.True(t, expectedAggPK.Equal(aggPK))
+
+		res, err := aggr.VerifyMultiSig(aggSig, msga)
+		require.NoError(t, err)
+		require.True(t, res)
+		res, err = aggr.VerifyMultiSig(aggSig, msgb)
+		require.NoError(t, err)
+		require.False(t, res)
+	}
+
+	// invalid public keys cannot be added
+	require.Error(t, aggr.Add(pks[0][:PubKeySize-1]))
+	require.Error(t, aggr.Add(make(PublicKey, PubKeySize)))
+	require.Equal(t, n, aggr.Len())
+}
+
+func TestVerifyDecompressed(t *testing.T) {
+	msga := []byte("aaaaaaaa")
+	msgb := []byte("bbbbbbbb")
+	sk, pk := GenKeyPair()
+	sig := Sign(sk, msga)
+	decompressed, err := DecompressPubKey(pk)
+	require.NoError(t, err)
+
+	res, err := VerifyDecompressed(sig, decompressed, msga)
+	require.NoError(t, err)
+	require.True(t, res)
+	res, err = VerifyDecompressed(sig, decompressed, msgb)
+	require.NoError(t, err)
+	require.False(t, res)
+	res, err = VerifyDecompressed(sig[:SignatureSize-1], decompressed, msga)
+	require.NoError(t, err)
+	require.False(t, res)
+}
+
+func benchmarkAggregation(b *testing.B, n int) {
+	msg := []byte("aaaaaaaa")
+	sks, pks := generateBatchTestKeyPairs(n)
+	sigs := make([]Signature, n)
+	for i := 0; i < n; i++ {
+		sigs[i] = Sign(sks[i], msg)
+	}
+	aggSig, err := AggrSigList(sigs)
+	require.NoError(b, err)
+	decompressed := make([]*BlsPubKey, n)
+	for i := 0; i < n; i++ {
+		decompressed[i], err = DecompressPubKey(pks[i])
+		require.NoError(b, err)
+	}
+
+	// aggregating public keys one by one via AggrPK, e.g., upon accumulating
+	// BLS signatures of a checkpoint
+	b.Run(fmt.Sprintf("AggrPK_%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var aggPK PublicKey
+			for _, pk := range pks {
+				aggPK, err = AggrPK(aggPK, pk)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	// aggregating public keys one by one via the aggregator
+	b.Run(fmt.Sprintf("PubKeyAggregator_%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			aggr := NewPubKeyAggregator()
+			for _, pk := range pks {
+				if err := aggr.Add(pk); err != nil {
+					b.Fatal(err)
+				}
+			}
+			if _, err := aggr.AggrPK(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	// aggregating public keys decompressed in advance via the aggregator
+	b.Run(fmt.Sprintf("PubKeyAggregatorDecompressed_%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			aggr := NewPubKeyAggregator()
+			for _, pk := range decompressed {
+				aggr.AddDecompressed(pk)
+			}
+			if _, err := aggr.AggrPK(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	// verifying a multi-sig with compressed public keys
+	b.Run(fmt.Sprintf("VerifyMultiSig_%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if ok, err := VerifyMultiSig(aggSig, pks, msg); err != nil || !ok {
+				b.Fatal("failed to verify multi-sig")
+			}
+		}
+	})
+	// verifying a multi-sig with public keys decompressed in advance
+	b.Run(fmt.Sprintf("VerifyMultiSigDecompressed_%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			aggr := NewPubKeyAggregator()
+			for _, pk := range decompressed {
+				aggr.AddDecompressed(pk)
+			}
+			if ok, err := aggr.VerifyMultiSig(aggSig, msg); err != nil || !ok {
+				b.Fatal("failed to verify multi-sig")
+			}
+		}
+	})
+}
+
+func BenchmarkAggregation_10(b *testing.B)  { benchmarkAggregation(b, 10) }
+func BenchmarkAggregation_100(b *testing.B) { benchmarkAggregation(b, 100) }
+func BenchmarkAggregation_500(b *testing.B) { benchmarkAggregation(b, 500) }
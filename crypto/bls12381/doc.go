@@ -3,4 +3,6 @@ package bls12381
 /*
 This package contains a wrapper around blst's go binding: https://github.com/supranational/blst/blob/master/bindings/go/blst.go.
 This package employs minimal signature size by default and can be changed to minimal public key in types.go.
+PubKeyAggregator in aggregate.go aggregates public keys incrementally, which avoids decompressing and compressing
+the aggregated public key upon each aggregation.
 */
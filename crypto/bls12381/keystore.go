@@ -0,0 +1,322 @@
+package bls12381
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	blst "github.com/supranational/blst/bindings/go"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Keystore follows the EIP-2335 keystore format for BLS private keys
+// (https://eips.ethereum.org/EIPS/eip-2335), where the private key is
+// encrypted with AES-128-CTR under a key derived from a passphrase via
+// scrypt or PBKDF2. Unlike EIP-2335, the public key is in the compressed
+// G2 form used by Babylon.
+type Keystore struct {
+	Crypto      KeystoreCrypto `json:"crypto"`
+	Description string         `json:"description"`
+	PubKey      string         `json:"pubkey"`
+	Path        string         `json:"path"`
+	UUID        string         `json:"uuid"`
+	Version     int            `json:"version"`
+}
+
+// KeystoreCrypto contains the KDF, checksum and cipher modules of a keystore
+type KeystoreCrypto struct {
+	KDF      KeystoreModule `json:"kdf"`
+	Checksum KeystoreModule `json:"checksum"`
+	Cipher   KeystoreModule `json:"cipher"`
+}
+
+// KeystoreModule is a module of a keystore, consisting of the function, its
+// parameters and its message
+type KeystoreModule struct {
+	Function string                     `json:"function"`
+	Params   map[string]json.RawMessage `json:"params"`
+	Message  string                     `json:"message"`
+}
+
+const (
+	// KeystoreVersion is the version of the EIP-2335 keystore format
+	KeystoreVersion = 4
+
+	// KDFScrypt is the scrypt key derivation function
+	KDFScrypt = "scrypt"
+	// KDFPBKDF2 is the PBKDF2 key derivation function with HMAC-SHA256
+	KDFPBKDF2 = "pbkdf2"
+
+	// StandardScryptN is the scrypt cost parameter specified in EIP-2335
+	StandardScryptN = 1 << 18
+	// LightScryptN is a scrypt cost parameter for testing only
+	LightScryptN = 1 << 12
+	// StandardPBKDF2C is the PBKDF2 iteration count specified in EIP-2335
+	StandardPBKDF2C = 1 << 18
+	// LightPBKDF2C is a PBKDF2 iteration count for testing only
+	LightPBKDF2C = 1 << 12
+
+	keystoreScryptR  = 8
+	keystoreScryptP  = 1
+	keystoreDKLen    = 32
+	keystoreSaltLen  = 32
+	keystorePRF      = "hmac-sha256"
+	keystoreChecksum = "sha256"
+	keystoreCipher   = "aes-128-ctr"
+)
+
+// ErrInvalidPassphrase is returned upon decrypting a keystore with a wrong
+// passphrase
+var ErrInvalidPassphrase = errors.New("invalid keystore passphrase")
+
+// EncryptKeystore encrypts the given private key under the given passphrase
+// with the given KDF (KDFScrypt or KDFPBKDF2). The cost is the scrypt cost
+// parameter N or the PBKDF2 iteration count, where 0 means the standard one.
+func EncryptKeystore(sk PrivateKey, passphrase string, kdf string, cost int) (*Keystore, error) {
+	if new(blst.SecretKey).Deserialize(sk) == nil {
+		return nil, errors.New("invalid BLS private key")
+	}
+
+	salt := make([]byte, keystoreSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	var kdfModule KeystoreModule
+	switch kdf {
+	case KDFScrypt:
+		if cost == 0 {
+			cost = StandardScryptN
+		}
+		kdfModule = newKeystoreModule(KDFScrypt, map[string]interface{}{
+			"dklen": keystoreDKLen,
+			"n":     cost,
+			"r":     keystoreScryptR,
+			"p":     keystoreScryptP,
+			"salt":  hex.EncodeToString(salt),
+		}, "")
+	case KDFPBKDF2:
+		if cost == 0 {
+			cost = StandardPBKDF2C
+		}
+		kdfModule = newKeystoreModule(KDFPBKDF2, map[string]interface{}{
+			"dklen": keystoreDKLen,
+			"c":     cost,
+			"prf":   keystorePRF,
+			"salt":  hex.EncodeToString(salt),
+		}, "")
+	default:
+		return nil, fmt.Errorf("unsupported KDF %q", kdf)
+	}
+
+	dk, err := deriveKeystoreKey(&kdfModule, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aes128CTR(dk[:16], iv, sk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Keystore{
+		Crypto: KeystoreCrypto{
+			KDF:      kdfModule,
+			Checksum: newKeystoreModule(keystoreChecksum, map[string]interface{}{}, hex.EncodeToString(keystoreChecksumOf(dk, cipherText))),
+			Cipher: newKeystoreModule(keystoreCipher, map[string]interface{}{
+				"iv": hex.EncodeToString(iv),
+			}, hex.EncodeToString(cipherText)),
+		},
+		PubKey:  hex.EncodeToString(sk.PubKey()),
+		UUID:    uuid.NewString(),
+		Version: KeystoreVersion,
+	}, nil
+}
+
+// Decrypt decrypts the private key in the keystore with the given passphrase.
+// It returns ErrInvalidPassphrase if the passphrase is wrong.
+func (ks *Keystore) Decrypt(passphrase string) (PrivateKey, error) {
+	if ks.Version != KeystoreVersion {
+		return nil, fmt.Errorf("unsupported keystore version %d", ks.Version)
+	}
+	if ks.Crypto.Checksum.Function != keystoreChecksum {
+		return nil, fmt.Errorf("unsupported checksum function %q", ks.Crypto.Checksum.Function)
+	}
+	if ks.Crypto.Cipher.Function != keystoreCipher {
+		return nil, fmt.Errorf("unsupported cipher function %q", ks.Crypto.Cipher.Function)
+	}
+
+	dk, err := deriveKeystoreKey(&ks.Crypto.KDF, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cipher message: %w", err)
+	}
+	checksum, err := hex.DecodeString(ks.Crypto.Checksum.Message)
+	if err != nil {
+		return nil, fmt.Errorf("invalid checksum message: %w", err)
+	}
+	if !bytes.Equal(checksum, keystoreChecksumOf(dk, cipherText)) {
+		return nil, ErrInvalidPassphrase
+	}
+
+	var ivHex string
+	if err := ks.Crypto.Cipher.param("iv", &ivHex); err != nil {
+		return nil, err
+	}
+	iv, err := hex.DecodeString(ivHex)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.New("invalid cipher IV")
+	}
+	sk, err := aes128CTR(dk[:16], iv, cipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	if new(blst.SecretKey).Deserialize(sk) == nil {
+		return nil, errors.New("invalid BLS private key in keystore")
+	}
+	if ks.PubKey != "" && ks.PubKey != hex.EncodeToString(PrivateKey(sk).PubKey()) {
+		return nil, errors.New("the BLS public key in keystore does not match the private key")
+	}
+	return sk, nil
+}
+
+// MarshalJSON encodes the keystore via the standard library, so that the
+// keystore remains in the EIP-2335 format when embedded in amino JSON
+func (ks Keystore) MarshalJSON() ([]byte, error) {
+	type keystore Keystore
+	return json.Marshal(keystore(ks))
+}
+
+// UnmarshalJSON decodes the keystore via the standard library
+func (ks *Keystore) UnmarshalJSON(bz []byte) error {
+	type keystore Keystore
+	return json.Unmarshal(bz, (*keystore)(ks))
+}
+
+func newKeystoreModule(function string, params map[string]interface{}, message string) KeystoreModule {
+	rawParams := make(map[string]json.RawMessage, len(params))
+	for k, v := range params {
+		bz, err := json.Marshal(v)
+		if err != nil {
+			panic(err)
+		}
+		rawParams[k] = bz
+	}
+	return KeystoreModule{Function: function, Params: rawParams, Message: message}
+}
+
+// param decodes the parameter with the given name of the module
+func (m *KeystoreModule) param(name string, v interface{}) error {
+	bz, ok := m.Params[name]
+	if !ok {
+		return fmt.Errorf("missing %s parameter %q", m.Function, name)
+	}
+	if err := json.Unmarshal(bz, v); err != nil {
+		return fmt.Errorf("invalid %s parameter %q: %w", m.Function, name, err)
+	}
+	return nil
+}
+
+// deriveKeystoreKey derives the decryption key from the passphrase with the
+// given KDF module
+func deriveKeystoreKey(kdf *KeystoreModule, passphrase string) ([]byte, error) {
+	var (
+		dkLen   int
+		saltHex string
+	)
+	if err := kdf.param("dklen", &dkLen); err != nil {
+		return nil, err
+	}
+	if dkLen < keystoreDKLen {
+		return nil, fmt.Errorf("derived key length %d is too short", dkLen)
+	}
+	if err := kdf.param("salt", &saltHex); err != nil {
+		return nil, err
+	}
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid KDF salt: %w", err)
+	}
+	password := []byte(normalizePassphrase(passphrase))
+
+	switch kdf.Function {
+	case KDFScrypt:
+		var n, r, p int
+		if err := kdf.param("n", &n); err != nil {
+			return nil, err
+		}
+		if err := kdf.param("r", &r); err != nil {
+			return nil, err
+		}
+		if err := kdf.param("p", &p); err != nil {
+			return nil, err
+		}
+		return scrypt.Key(password, salt, n, r, p, dkLen)
+	case KDFPBKDF2:
+		var (
+			c   int
+			prf string
+		)
+		if err := kdf.param("c", &c); err != nil {
+			return nil, err
+		}
+		if err := kdf.param("prf", &prf); err != nil {
+			return nil, err
+		}
+		if prf != keystorePRF {
+			return nil, fmt.Errorf("unsupported PBKDF2 PRF %q", prf)
+		}
+		if c <= 0 {
+			return nil, fmt.Errorf("invalid PBKDF2 iteration count %d", c)
+		}
+		return pbkdf2.Key(password, salt, c, dkLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("unsupported KDF %q", kdf.Function)
+	}
+}
+
+// normalizePassphrase normalizes the passphrase to NFKD and strips the
+// control codes, as specified in EIP-2335
+func normalizePassphrase(passphrase string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= 0x1F || (r >= 0x7F && r <= 0x9F) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(passphrase))
+}
+
+// keystoreChecksumOf returns SHA256(DK[16:32] || cipher_message)
+func keystoreChecksumOf(dk []byte, cipherText []byte) []byte {
+	h := sha256.New()
+	h.Write(dk[16:32])
+	h.Write(cipherText)
+	return h.Sum(nil)
+}
+
+func aes128CTR(key []byte, iv []byte, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
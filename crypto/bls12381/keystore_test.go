@@ -0,0 +1,95 @@
+package bls12381
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// eip2335PBKDF2Vector is the PBKDF2 test vector of EIP-2335, with the G1
+// public key removed as Babylon uses G2 public keys
+const eip2335PBKDF2Vector = `{
+	"crypto": {
+		"kdf": {
+			"function": "pbkdf2",
+			"params": {
+				"dklen": 32,
+				"c": 262144,
+				"prf": "hmac-sha256",
+				"salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+			},
+			"message": ""
+		},
+		"checksum": {
+			"function": "sha256",
+			"params": {},
+			"message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+		},
+		"cipher": {
+			"function": "aes-128-ctr",
+			"params": {
+				"iv": "264daa3f303d7259501c93d997d84fe6"
+			},
+			"message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+		}
+	},
+	"description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+	"pubkey": "",
+	"path": "m/12381/60/0/0",
+	"uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+	"version": 4
+}`
+
+// Tests decrypting the EIP-2335 test vector
+func TestKeystoreEIP2335Vector(t *testing.T) {
+	var ks Keystore
+	err := json.Unmarshal([]byte(eip2335PBKDF2Vector), &ks)
+	require.NoError(t, err)
+
+	// the passphrase is normalized to "testpassword🔑"
+	sk, err := ks.Decrypt("𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑")
+	require.NoError(t, err)
+	require.Equal(t, "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f", hex.EncodeToString(sk))
+
+	_, err = ks.Decrypt("testpassword")
+	require.ErrorIs(t, err, ErrInvalidPassphrase)
+}
+
+// Tests encrypting and decrypting a BLS private key with both KDFs
+func TestKeystoreEncryptDecrypt(t *testing.T) {
+	sk, pk := GenKeyPair()
+	for _, tc := range []struct {
+		kdf  string
+		cost int
+	}{
+		{KDFScrypt, LightScryptN},
+		{KDFPBKDF2, LightPBKDF2C},
+	} {
+		ks, err := EncryptKeystore(sk, "passphrase\x7f", tc.kdf, tc.cost)
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(pk), ks.PubKey)
+		require.NotContains(t, ks.Crypto.Cipher.Message, hex.EncodeToString(sk))
+
+		// the keystore survives a JSON round trip
+		bz, err := json.Marshal(ks)
+		require.NoError(t, err)
+		var decoded Keystore
+		err = json.Unmarshal(bz, &decoded)
+		require.NoError(t, err)
+
+		// control codes are stripped from the passphrase
+		decrypted, err := decoded.Decrypt("passphrase")
+		require.NoError(t, err)
+		require.Equal(t, sk, decrypted)
+
+		_, err = decoded.Decrypt("wrong passphrase")
+		require.ErrorIs(t, err, ErrInvalidPassphrase)
+	}
+
+	_, err := EncryptKeystore(sk, "passphrase", "argon2", 0)
+	require.Error(t, err)
+	_, err = EncryptKeystore(PrivateKey{}, "passphrase", KDFPBKDF2, LightPBKDF2C)
+	require.Error(t, err)
+}
@@ -7,12 +7,23 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 )
 
 const (
 	MAGIC_MESSAGE_PREFIX = "Bitcoin Signed Message:\n"
+
+	// header bytes of compact signatures, each followed by 4 values for the
+	// recovery ID
+	// ref: https://github.com/bitcoin/bips/blob/master/bip-0137.mediawiki
+	headerP2PKHUncompressed = 27
+	headerP2PKHCompressed   = 31
+	headerP2SHP2WPKH        = 35
+	headerP2WPKH            = 39
+	headerMax               = 43
 )
 
 // magicHash encodes the given msg into byte array, then calculates its sha256d hash
@@ -48,3 +59,115 @@ func Verify(pk *btcec.PublicKey, msg string, sigBytes []byte) error {
 	}
 	return nil
 }
+
+// SignWithAddress signs the msg with the given secret key, where the header
+// byte of the signature encodes the type of the given address, which has to
+// be derived from the compressed public key of the secret key
+// ref: https://github.com/bitcoin/bips/blob/master/bip-0137.mediawiki
+func SignWithAddress(sk *btcec.PrivateKey, msg string, address btcutil.Address) ([]byte, error) {
+	var headerOffset byte
+	switch address.(type) {
+	case *btcutil.AddressPubKeyHash:
+		headerOffset = 0
+	case *btcutil.AddressScriptHash:
+		headerOffset = headerP2SHP2WPKH - headerP2PKHCompressed
+	case *btcutil.AddressWitnessPubKeyHash:
+		headerOffset = headerP2WPKH - headerP2PKHCompressed
+	default:
+		return nil, fmt.Errorf("unsupported address type %T for signing message", address)
+	}
+
+	sig, err := Sign(sk, msg)
+	if err != nil {
+		return nil, err
+	}
+	sig[0] += headerOffset
+
+	// ensure the address is derived from the secret key
+	if err := VerifyWithAddress(address, msg, sig); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// VerifyWithAddress verifies the signature over the msg against the given
+// address rather than a public key. See RecoverWithAddress for the supported
+// header bytes and address types.
+func VerifyWithAddress(address btcutil.Address, msg string, sigBytes []byte) error {
+	_, err := RecoverWithAddress(address, msg, sigBytes)
+	return err
+}
+
+// RecoverWithAddress recovers the public key from the signature over the msg,
+// and checks whether the given address is derived from the public key as
+// indicated by the header byte of the signature. The header bytes
+// - 27-30 indicate a P2PKH address of the uncompressed public key,
+// - 31-34 indicate a P2PKH address of the compressed public key, and are also
+// used by Electrum for P2SH-P2WPKH and P2WPKH addresses,
+// - 35-38 indicate a P2SH-P2WPKH address, as used by Trezor, and
+// - 39-42 indicate a P2WPKH address, as used by Trezor.
+// ref: https://github.com/bitcoin/bips/blob/master/bip-0137.mediawiki
+func RecoverWithAddress(address btcutil.Address, msg string, sigBytes []byte) (*btcec.PublicKey, error) {
+	recoveredPK, header, err := recoverCompact(msg, sigBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// the hash of the uncompressed public key is only used by P2PKH addresses
+	if header < headerP2PKHCompressed {
+		addr, ok := address.(*btcutil.AddressPubKeyHash)
+		if !ok || !bytes.Equal(addr.ScriptAddress(), btcutil.Hash160(recoveredPK.SerializeUncompressed())) {
+			return nil, fmt.Errorf("the recovered PK does not match the given address")
+		}
+		return recoveredPK, nil
+	}
+
+	pkHash := btcutil.Hash160(recoveredPK.SerializeCompressed())
+	var matched bool
+	switch addr := address.(type) {
+	case *btcutil.AddressPubKeyHash:
+		matched = header < headerP2SHP2WPKH && bytes.Equal(addr.ScriptAddress(), pkHash)
+	case *btcutil.AddressScriptHash:
+		// the script is the P2WPKH script of the public key
+		redeemScript := append([]byte{txscript.OP_0, txscript.OP_DATA_20}, pkHash...)
+		matched = header < headerP2WPKH && bytes.Equal(addr.ScriptAddress(), btcutil.Hash160(redeemScript))
+	case *btcutil.AddressWitnessPubKeyHash:
+		matched = (header < headerP2SHP2WPKH || header >= headerP2WPKH) && bytes.Equal(addr.ScriptAddress(), pkHash)
+	default:
+		return nil, fmt.Errorf("unsupported address type %T for verifying message signature", address)
+	}
+	if !matched {
+		return nil, fmt.Errorf("the recovered PK does not match the given address")
+	}
+	return recoveredPK, nil
+}
+
+// recoverCompact recovers the public key from the compact signature over
+// the msg, and returns it together with the header byte of the signature.
+// Besides the header bytes 27-34 of the compact signature format, it accepts
+// the header bytes 35-42 of segwit addresses, which are for compressed
+// public keys. It is only used for verifying signatures against addresses,
+// as the header bytes 35-42 are meaningless without an address.
+func recoverCompact(msg string, sigBytes []byte) (*btcec.PublicKey, byte, error) {
+	if len(sigBytes) != 65 { // size of compact signature
+		return nil, 0, fmt.Errorf("invalid compact signature size %d", len(sigBytes))
+	}
+	header := sigBytes[0]
+	if header < headerP2PKHUncompressed || header >= headerMax {
+		return nil, 0, fmt.Errorf("invalid compact signature header byte %d", header)
+	}
+
+	sig := make([]byte, len(sigBytes))
+	copy(sig, sigBytes)
+	// convert the header of segwit addresses to the one of compressed public keys
+	if header >= headerP2SHP2WPKH {
+		sig[0] = headerP2PKHCompressed + (header-headerP2SHP2WPKH)%4
+	}
+
+	msgHash := magicHash(msg)
+	recoveredPK, _, err := ecdsa.RecoverCompact(sig, msgHash[:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return recoveredPK, header, nil
+}
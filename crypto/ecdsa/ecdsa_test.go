@@ -4,13 +4,23 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/hex"
+	"math/rand"
 	"testing"
 
 	"github.com/babylonchain/babylon/crypto/ecdsa"
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/stretchr/testify/require"
 )
 
+var net = &chaincfg.MainNetParams
+
 const (
 	// test vector from https://github.com/okx/js-wallet-sdk/blob/a57c2acbe6ce917c0aa4e951d96c4e562ad58444/packages/coin-bitcoin/tests/btc.test.ts#L113-L126
 	skHex         = "adce25dc25ef89f06a722abdc4b601d706c9efc6bc84075355e6b96ca3871621"
@@ -36,3 +46,104 @@ func TestECDSA(t *testing.T) {
 	err = ecdsa.Verify(pk, testMsg, sig)
 	require.NoError(t, err)
 }
+
+func FuzzECDSAWithAddress(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		sk, pk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		msg := hex.EncodeToString(datagen.GenRandomByteArray(r, 32))
+
+		pkHash := btcutil.Hash160(pk.SerializeCompressed())
+		p2pkhAddr, err := btcutil.NewAddressPubKeyHash(pkHash, net)
+		require.NoError(t, err)
+		p2pkhUncompressedAddr, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(pk.SerializeUncompressed()), net)
+		require.NoError(t, err)
+		p2wpkhAddr, err := btcutil.NewAddressWitnessPubKeyHash(pkHash, net)
+		require.NoError(t, err)
+		p2wpkhScript, err := txscript.PayToAddrScript(p2wpkhAddr)
+		require.NoError(t, err)
+		p2shP2WPKHAddr, err := btcutil.NewAddressScriptHash(p2wpkhScript, net)
+		require.NoError(t, err)
+
+		// Trezor style signatures, where the header byte indicates the
+		// address type
+		for _, addr := range []btcutil.Address{p2pkhAddr, p2shP2WPKHAddr, p2wpkhAddr} {
+			sig, err := ecdsa.SignWithAddress(sk, msg, addr)
+			require.NoError(t, err)
+			err = ecdsa.VerifyWithAddress(addr, msg, sig)
+			require.NoError(t, err)
+			recoveredPK, err := ecdsa.RecoverWithAddress(addr, msg, sig)
+			require.NoError(t, err)
+			require.True(t, pk.IsEqual(recoveredPK))
+			// only the signature with the header byte of compressed public
+			// keys can be verified against the public key, as the header
+			// bytes of segwit addresses are not accepted by Verify
+			err = ecdsa.Verify(pk, msg, sig)
+			if addr == p2pkhAddr {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+			// the signature is not valid for another msg
+			err = ecdsa.VerifyWithAddress(addr, msg+"0", sig)
+			require.Error(t, err)
+		}
+
+		// Electrum style signatures, where the header byte of compressed
+		// public keys is used for segwit addresses
+		sig, err := ecdsa.Sign(sk, msg)
+		require.NoError(t, err)
+		for _, addr := range []btcutil.Address{p2pkhAddr, p2shP2WPKHAddr, p2wpkhAddr} {
+			err = ecdsa.VerifyWithAddress(addr, msg, sig)
+			require.NoError(t, err)
+		}
+		// the header byte of compressed public keys does not match the
+		// address of the uncompressed public key
+		err = ecdsa.VerifyWithAddress(p2pkhUncompressedAddr, msg, sig)
+		require.Error(t, err)
+
+		// signatures with the header byte of uncompressed public keys
+		msgHash := magicHash(msg)
+		sigUncompressed, err := btcecdsa.SignCompact(sk, msgHash[:], false)
+		require.NoError(t, err)
+		err = ecdsa.VerifyWithAddress(p2pkhUncompressedAddr, msg, sigUncompressed)
+		require.NoError(t, err)
+		for _, addr := range []btcutil.Address{p2pkhAddr, p2shP2WPKHAddr, p2wpkhAddr} {
+			err = ecdsa.VerifyWithAddress(addr, msg, sigUncompressed)
+			require.Error(t, err)
+		}
+
+		// the header byte of an address type does not match other types
+		sig, err = ecdsa.SignWithAddress(sk, msg, p2wpkhAddr)
+		require.NoError(t, err)
+		err = ecdsa.VerifyWithAddress(p2shP2WPKHAddr, msg, sig)
+		require.Error(t, err)
+		err = ecdsa.VerifyWithAddress(p2pkhAddr, msg, sig)
+		require.Error(t, err)
+
+		// the signature is not valid for the address of another key
+		_, otherPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		otherAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(otherPK.SerializeCompressed()), net)
+		require.NoError(t, err)
+		err = ecdsa.VerifyWithAddress(otherAddr, msg, sig)
+		require.Error(t, err)
+
+		// invalid header bytes
+		invalidSig := append([]byte{}, sig...)
+		invalidSig[0] = 43
+		err = ecdsa.VerifyWithAddress(p2wpkhAddr, msg, invalidSig)
+		require.Error(t, err)
+	})
+}
+
+// magicHash is the hash of the msg signed by compact signatures
+func magicHash(msg string) chainhash.Hash {
+	buf := bytes.NewBuffer(nil)
+	wire.WriteVarString(buf, 0, ecdsa.MAGIC_MESSAGE_PREFIX) //nolint:errcheck
+	wire.WriteVarString(buf, 0, msg)                        //nolint:errcheck
+	return chainhash.DoubleHashH(buf.Bytes())
+}
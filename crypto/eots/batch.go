@@ -0,0 +1,265 @@
+package eots
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	ecdsa_schnorr "github.com/decred/dcrd/dcrec/secp256k1/v4/schnorr"
+)
+
+// ErrBatchVerifyFailed indicates that at least one signature in a batch is
+// invalid. The invalid signatures can be identified via Verify.
+const ErrBatchVerifyFailed = ErrorKind("ErrBatchVerifyFailed")
+
+// tagBatch is the tag of the hash used for deriving the random coefficients
+// of batch verification
+var tagBatch = []byte("BIP0340/batch")
+
+// wnafWidth is the window width of the wNAF representation of the scalars in
+// the multi-scalar multiplication
+const wnafWidth = 5
+
+// BatchVerifier verifies a batch of EOTS signatures at once. Following BIP-340
+// batch verification, it checks whether
+//
+//	(a_1*s_1 + ... + a_u*s_u)*G = a_1*R_1 + ... + a_u*R_u + a_1*e_1*P_1 + ... + a_u*e_u*P_u
+//
+// holds for coefficients a_1 = 1 and a_2, ..., a_u being 128-bit
+// pseudorandom values derived from all signatures in the batch, which is
+// considerably faster than verifying each signature individually.
+// The batch is valid if and only if each signature is valid, except for a
+// negligible probability.
+type BatchVerifier struct {
+	cache   *Cache
+	entries []batchEntry
+}
+
+type batchEntry struct {
+	pubKey *PublicKey
+	r      *PublicRand
+	hash   [32]byte
+	sig    *Signature
+}
+
+// NewBatchVerifier returns a new batch verifier. The cache is optional and is
+// used for lifting public randomness values to points on the curve.
+func NewBatchVerifier(cache *Cache) *BatchVerifier {
+	return &BatchVerifier{cache: cache}
+}
+
+// Add adds a signature over the message w.r.t. the public key and the public
+// randomness to the batch
+func (b *BatchVerifier) Add(pubKey *PublicKey, r *PublicRand, message []byte, sig *Signature) {
+	b.entries = append(b.entries, batchEntry{
+		pubKey: pubKey,
+		r:      r,
+		hash:   hash(message),
+		sig:    sig,
+	})
+}
+
+// Len returns the number of signatures in the batch
+func (b *BatchVerifier) Len() int {
+	return len(b.entries)
+}
+
+// Verify verifies all signatures in the batch. An empty batch is valid.
+func (b *BatchVerifier) Verify() error {
+	switch len(b.entries) {
+	case 0:
+		return nil
+	case 1:
+		e := b.entries[0]
+		return verifyHash(e.pubKey, e.r, e.hash, e.sig)
+	}
+
+	// derive the seed of the random coefficients from all signatures
+	seedInput := make([][]byte, 0, 4*len(b.entries))
+	for i := range b.entries {
+		e := &b.entries[i]
+		rBytes, sBytes := e.r.Bytes(), e.sig.Bytes()
+		seedInput = append(seedInput, e.pubKey.SerializeCompressed(), rBytes[:], e.hash[:], sBytes[:])
+	}
+	seed := chainhash.TaggedHash(tagBatch, seedInput...)
+
+	// the terms a_i*R_i and a_i*e_i*P_i, and the sum of a_i*s_i
+	scalars := make([]ModNScalar, 0, 2*len(b.entries))
+	points := make([]btcec.JacobianPoint, 0, 2*len(b.entries))
+	var sumS ModNScalar
+	for i := range b.entries {
+		e := &b.entries[i]
+
+		P, err := evenPoint(e.pubKey)
+		if err != nil {
+			return err
+		}
+		var R btcec.JacobianPoint
+		if b.cache != nil {
+			err = b.cache.liftPubRand(e.r, &R)
+		} else {
+			err = liftPubRand(e.r, &R)
+		}
+		if err != nil {
+			return err
+		}
+		c, err := challenge(e.r, e.pubKey, e.hash)
+		if err != nil {
+			return err
+		}
+
+		a := batchCoefficient(seed, i)
+		var ae, as ModNScalar
+		ae.Mul2(&a, c)
+		as.Mul2(&a, e.sig)
+		sumS.Add(&as)
+
+		scalars = append(scalars, a, ae)
+		points = append(points, R, *P)
+	}
+
+	// check sum(a_i*R_i + a_i*e_i*P_i) - sum(a_i*s_i)*G is the point at infinity
+	var rhs, sG btcec.JacobianPoint
+	multiScalarMult(scalars, points, &rhs)
+	btcec.ScalarBaseMultNonConst(&sumS, &sG)
+	sG.Y.Negate(1).Normalize()
+	btcec.AddNonConst(&rhs, &sG, &rhs)
+	if !isInfinity(&rhs) {
+		str := "at least one signature in the batch is invalid"
+		return signatureError(ErrBatchVerifyFailed, str)
+	}
+
+	return nil
+}
+
+// batchCoefficient returns the i-th random coefficient derived from the seed,
+// where the first coefficient is 1 and the others are 128-bit values
+func batchCoefficient(seed *chainhash.Hash, i int) ModNScalar {
+	var a ModNScalar
+	if i == 0 {
+		a.SetInt(1)
+		return a
+	}
+	var indexBytes [4]byte
+	binary.BigEndian.PutUint32(indexBytes[:], uint32(i))
+	h := sha256.Sum256(append(seed[:], indexBytes[:]...))
+	a.SetByteSlice(h[:16])
+	return a
+}
+
+// challenge returns e = int(tagged_hash("BIP0340/challenge", bytes(r) || bytes(P) || m)) mod n
+func challenge(r *PublicRand, pubKey *PublicKey, hash [32]byte) (*ModNScalar, error) {
+	var rBytes [32]byte
+	r.PutBytesUnchecked(rBytes[:])
+	pBytes := pubKey.SerializeCompressed()[1:]
+
+	commitment := chainhash.TaggedHash(chainhash.TagBIP0340Challenge, rBytes[:], pBytes, hash[:])
+
+	var e ModNScalar
+	if overflow := e.SetBytes((*[32]byte)(commitment)); overflow != 0 {
+		str := "hash of (r || P || m) too big"
+		return nil, signatureError(ecdsa_schnorr.ErrSchnorrHashValue, str)
+	}
+	return &e, nil
+}
+
+// evenPoint returns the point with the same x coordinate as the given public
+// key and an even y coordinate, i.e., lift_x(x(P)) in BIP-340
+func evenPoint(pubKey *PublicKey) (*btcec.JacobianPoint, error) {
+	if !pubKey.IsOnCurve() {
+		str := "pubkey point is not on curve"
+		return nil, signatureError(ecdsa_schnorr.ErrPubKeyNotOnCurve, str)
+	}
+	var P btcec.JacobianPoint
+	pubKey.AsJacobian(&P)
+	if P.Y.IsOdd() {
+		P.Y.Negate(1).Normalize()
+	}
+	return &P, nil
+}
+
+// liftPubRand returns the point with the public randomness as the x
+// coordinate and an even y coordinate, i.e., lift_x(r) in BIP-340
+func liftPubRand(r *PublicRand, result *btcec.JacobianPoint) error {
+	result.X.Set(r).Normalize()
+	if !secp256k1.DecompressY(&result.X, false, &result.Y) {
+		str := "public randomness is not the x coordinate of a point on curve"
+		return signatureError(ecdsa_schnorr.ErrSigRNotOnCurve, str)
+	}
+	result.Y.Normalize()
+	result.Z.SetInt(1)
+	return nil
+}
+
+func isInfinity(p *btcec.JacobianPoint) bool {
+	return (p.X.IsZero() && p.Y.IsZero()) || p.Z.IsZero()
+}
+
+// multiScalarMult computes sum(scalars[i]*points[i]) via Straus' method with
+// wNAF representations of the scalars, such that all terms share the same
+// chain of point doublings
+func multiScalarMult(scalars []ModNScalar, points []btcec.JacobianPoint, result *btcec.JacobianPoint) {
+	// precompute the odd multiples P, 3P, ..., (2^(w-1)-1)P of each point
+	tables := make([][1 << (wnafWidth - 2)]btcec.JacobianPoint, len(points))
+	nafs := make([][]int8, len(points))
+	maxLen := 0
+	for i := range points {
+		var double btcec.JacobianPoint
+		btcec.DoubleNonConst(&points[i], &double)
+		tables[i][0].Set(&points[i])
+		for j := 1; j < len(tables[i]); j++ {
+			btcec.AddNonConst(&tables[i][j-1], &double, &tables[i][j])
+		}
+
+		nafs[i] = wnaf(&scalars[i])
+		if len(nafs[i]) > maxLen {
+			maxLen = len(nafs[i])
+		}
+	}
+
+	var q, neg btcec.JacobianPoint
+	for bit := maxLen - 1; bit >= 0; bit-- {
+		btcec.DoubleNonConst(&q, &q)
+		for i := range points {
+			if bit >= len(nafs[i]) || nafs[i][bit] == 0 {
+				continue
+			}
+			digit := nafs[i][bit]
+			if digit > 0 {
+				btcec.AddNonConst(&q, &tables[i][digit/2], &q)
+			} else {
+				neg.Set(&tables[i][-digit/2])
+				neg.Y.Negate(1).Normalize()
+				btcec.AddNonConst(&q, &neg, &q)
+			}
+		}
+	}
+
+	result.Set(&q)
+}
+
+// wnaf returns the width-w non-adjacent form of the scalar, from the least
+// significant digit to the most significant one, where each non-zero digit
+// is odd and in (-2^(w-1), 2^(w-1))
+func wnaf(k *ModNScalar) []int8 {
+	kBytes := k.Bytes()
+	n := new(big.Int).SetBytes(kBytes[:])
+	window := big.Word(1<<wnafWidth - 1)
+	naf := make([]int8, 0, 257)
+	for n.Sign() > 0 {
+		digit := int64(0)
+		if n.Bit(0) == 1 {
+			digit = int64(n.Bits()[0] & window)
+			if digit >= 1<<(wnafWidth-1) {
+				digit -= 1 << wnafWidth
+			}
+			n.Sub(n, big.NewInt(digit))
+		}
+		naf = append(naf, int8(digit))
+		n.Rsh(n, 1)
+	}
+	return naf
+}
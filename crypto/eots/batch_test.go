@@ -0,0 +1,139 @@
+package eots_test
+
+import (
+	"fmt"
+	mathrand "math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/babylonchain/babylon/testutil/datagen"
+)
+
+type signedMsg struct {
+	pk  *eots.PublicKey
+	pr  *eots.PublicRand
+	msg []byte
+	sig *eots.Signature
+}
+
+func genSignedMsgs(t testing.TB, r *mathrand.Rand, numMsgs int) []*signedMsg {
+	msgs := make([]*signedMsg, 0, numMsgs)
+	for i := 0; i < numMsgs; i++ {
+		sk, err := eots.KeyGen(r)
+		require.NoError(t, err)
+		sr, pr, err := eots.RandGen(r)
+		require.NoError(t, err)
+		msg := datagen.GenRandomByteArray(r, 40)
+		sig, err := eots.Sign(sk, sr, msg)
+		require.NoError(t, err)
+		msgs = append(msgs, &signedMsg{eots.PubGen(sk), pr, msg, sig})
+	}
+	return msgs
+}
+
+func batchVerify(cache *eots.Cache, msgs []*signedMsg) error {
+	bv := eots.NewBatchVerifier(cache)
+	for _, m := range msgs {
+		bv.Add(m.pk, m.pr, m.msg, m.sig)
+	}
+	return bv.Verify()
+}
+
+func FuzzBatchVerify(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := mathrand.New(mathrand.NewSource(seed))
+		cache := eots.NewCache(10)
+
+		// a batch of valid signatures is valid, with or without cache
+		numMsgs := int(datagen.RandomInt(r, 20) + 1)
+		msgs := genSignedMsgs(t, r, numMsgs)
+		require.NoError(t, batchVerify(nil, msgs))
+		require.NoError(t, batchVerify(cache, msgs))
+		require.NoError(t, batchVerify(cache, msgs))
+		// an empty batch is valid
+		require.NoError(t, batchVerify(nil, nil))
+
+		// a batch with an invalid signature is invalid
+		invalidIdx := r.Intn(numMsgs)
+		invalidMsg := *msgs[invalidIdx]
+		switch r.Intn(3) {
+		case 0:
+			// signature over another message
+			invalidMsg.msg = datagen.GenRandomByteArray(r, 40)
+		case 1:
+			// signature with another public randomness
+			_, invalidMsg.pr, _ = eots.RandGen(r)
+		case 2:
+			// tampered signature
+			invalidMsg.sig = new(eots.Signature).Set(invalidMsg.sig).Add(new(eots.Signature).SetInt(1))
+		}
+		require.Error(t, eots.Verify(invalidMsg.pk, invalidMsg.pr, invalidMsg.msg, invalidMsg.sig))
+		msgs[invalidIdx] = &invalidMsg
+		require.Error(t, batchVerify(nil, msgs))
+		require.Error(t, batchVerify(cache, msgs))
+	})
+}
+
+func FuzzCacheParsePubKey(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := mathrand.New(mathrand.NewSource(seed))
+		cache := eots.NewCache(2)
+
+		for i := 0; i < 5; i++ {
+			sk, err := eots.KeyGen(r)
+			require.NoError(t, err)
+			pkBytes := schnorr.SerializePubKey(eots.PubGen(sk))
+			expectedPK, err := schnorr.ParsePubKey(pkBytes)
+			require.NoError(t, err)
+
+			// the cached public key is the same as the parsed one
+			for j := 0; j < 2; j++ {
+				pk, err := cache.ParsePubKey(pkBytes)
+				require.NoError(t, err)
+				require.True(t, expectedPK.IsEqual(pk))
+			}
+		}
+
+		// invalid public keys are rejected
+		_, err := cache.ParsePubKey(datagen.GenRandomByteArray(r, 31))
+		require.Error(t, err)
+	})
+}
+
+func BenchmarkVerify(b *testing.B) {
+	r := mathrand.New(mathrand.NewSource(1))
+	for _, numMsgs := range []int{1, 10, 100} {
+		msgs := genSignedMsgs(b, r, numMsgs)
+		b.Run(fmt.Sprintf("individual-%d", numMsgs), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, m := range msgs {
+					if err := eots.Verify(m.pk, m.pr, m.msg, m.sig); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("batch-%d", numMsgs), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := batchVerify(nil, msgs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("batch-cached-%d", numMsgs), func(b *testing.B) {
+			cache := eots.NewCache(numMsgs)
+			for i := 0; i < b.N; i++ {
+				if err := batchVerify(cache, msgs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
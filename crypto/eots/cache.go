@@ -0,0 +1,85 @@
+package eots
+
+import (
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+)
+
+// Cache caches the parsed BIP-340 public keys and the public randomness
+// lifted to points on the curve, both of which otherwise take a square root
+// to compute upon each verification. When the number of cached values of a
+// kind reaches the capacity, all cached values of that kind are evicted.
+// It is safe for concurrent use.
+type Cache struct {
+	mu       sync.Mutex
+	capacity int
+	pubKeys  map[[32]byte]*PublicKey
+	pubRands map[PublicRand]btcec.JacobianPoint
+}
+
+// NewCache returns a new cache holding up to capacity public keys and
+// capacity public randomness
+func NewCache(capacity int) *Cache {
+	return &Cache{
+		capacity: capacity,
+		pubKeys:  make(map[[32]byte]*PublicKey),
+		pubRands: make(map[PublicRand]btcec.JacobianPoint),
+	}
+}
+
+// ParsePubKey parses the given 32-byte BIP-340 public key, i.e., the point
+// with the given x coordinate and an even y coordinate
+func (c *Cache) ParsePubKey(pkBytes []byte) (*PublicKey, error) {
+	if len(pkBytes) != schnorr.PubKeyBytesLen {
+		return schnorr.ParsePubKey(pkBytes)
+	}
+	key := [32]byte(pkBytes)
+
+	c.mu.Lock()
+	pk, ok := c.pubKeys[key]
+	c.mu.Unlock()
+	if ok {
+		return pk, nil
+	}
+
+	pk, err := schnorr.ParsePubKey(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.pubKeys) >= c.capacity {
+		clear(c.pubKeys)
+	}
+	c.pubKeys[key] = pk
+	return pk, nil
+}
+
+// liftPubRand returns lift_x(r) via the cache
+func (c *Cache) liftPubRand(r *PublicRand, result *btcec.JacobianPoint) error {
+	var key PublicRand
+	key.Set(r).Normalize()
+
+	c.mu.Lock()
+	point, ok := c.pubRands[key]
+	c.mu.Unlock()
+	if ok {
+		result.Set(&point)
+		return nil
+	}
+
+	if err := liftPubRand(&key, result); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.pubRands) >= c.capacity {
+		clear(c.pubRands)
+	}
+	c.pubRands[key] = *result
+	return nil
+}
@@ -6,7 +6,6 @@ import (
 	"io"
 
 	"github.com/btcsuite/btcd/btcec/v2"
-	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	ecdsa_schnorr "github.com/decred/dcrd/dcrec/secp256k1/v4/schnorr"
@@ -119,43 +118,32 @@ func signHash(sk *PrivateKey, privateRand *PrivateRand, hash [32]byte) (*Signatu
 // Verify verifies that the signature is valid for this message, public key and random value.
 func Verify(pubKey *PublicKey, r *PublicRand, message []byte, sig *Signature) error {
 	h := hash(message)
-	pubkeyBytes := schnorr.SerializePubKey(pubKey)
-	return verifyHash(pubkeyBytes, r, h, sig)
+	return verifyHash(pubKey, r, h, sig)
 }
 
 // Verify verifies that the signature is valid for this hashed message, public key and random value.
 // Based on unexported schnorrVerify of btcd.
-func verifyHash(pubKeyBytes []byte, r *PublicRand, hash [32]byte, sig *Signature) error {
+func verifyHash(pubKey *PublicKey, r *PublicRand, hash [32]byte, sig *Signature) error {
 	// Step 2.
 	//
 	// P = lift_x(int(pk))
 	//
 	// Fail if P is not a point on the curve
-	pubKey, err := schnorr.ParsePubKey(pubKeyBytes)
+	//
+	// Note that lift_x(int(pk)) is the given public key with its y
+	// coordinate negated if odd, which avoids computing a square root.
+	P, err := evenPoint(pubKey)
 	if err != nil {
 		return err
 	}
 
-	// Fail if P is not a point on the curve
-	if !pubKey.IsOnCurve() {
-		str := "pubkey point is not on curve"
-		return signatureError(ecdsa_schnorr.ErrPubKeyNotOnCurve, str)
-	}
-
 	// Fail if r >= p is already handled by the fact r is a field element.
 	// Fail if s >= n is already handled by the fact s is a mod n scalar.
 
 	// e = int(tagged_hash("BIP0340/challenge", bytes(r) || bytes(P) || M)) mod n.
-	var rBytes [32]byte
-	r.PutBytesUnchecked(rBytes[:])
-	pBytes := pubKey.SerializeCompressed()[1:]
-
-	commitment := chainhash.TaggedHash(chainhash.TagBIP0340Challenge, rBytes[:], pBytes, hash[:])
-
-	var e ModNScalar
-	if overflow := e.SetBytes((*[32]byte)(commitment)); overflow != 0 {
-		str := "hash of (r || P || m) too big"
-		return signatureError(ecdsa_schnorr.ErrSchnorrHashValue, str)
+	e, err := challenge(r, pubKey, hash)
+	if err != nil {
+		return err
 	}
 
 	// Negate e here so we can use AddNonConst below to subtract the s*G
@@ -163,14 +151,13 @@ func verifyHash(pubKeyBytes []byte, r *PublicRand, hash [32]byte, sig *Signature
 	e.Negate()
 
 	// R = s*G - e*P
-	var P, R, sG, eP btcec.JacobianPoint
-	pubKey.AsJacobian(&P)
+	var R, sG, eP btcec.JacobianPoint
 	btcec.ScalarBaseMultNonConst(sig, &sG)
-	btcec.ScalarMultNonConst(&e, &P, &eP)
+	btcec.ScalarMultNonConst(e, P, &eP)
 	btcec.AddNonConst(&sG, &eP, &R)
 
 	// Fail if R is the point at infinity
-	if (R.X.IsZero() && R.Y.IsZero()) || R.Z.IsZero() {
+	if isInfinity(&R) {
 		str := "calculated R point is the point at infinity"
 		return signatureError(ecdsa_schnorr.ErrSigRNotOnCurve, str)
 	}
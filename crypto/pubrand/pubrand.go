@@ -0,0 +1,145 @@
+package pubrand
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+)
+
+// randDerivationTag is the domain separation tag of the HMAC message used
+// for deriving EOTS randomness
+const randDerivationTag = "babylon/eots/rand"
+
+// DeriveRand deterministically derives the EOTS private/public randomness
+// pair for the given chain ID and height from the given seed, where
+//
+//	k = HMAC-SHA256(seed, tag || len(chainID) || chainID || height || counter) mod n
+//
+// and the counter starts from 0 and is incremented in the negligible case
+// that the HMAC output is not a valid non-zero scalar.
+// The seed MUST be kept secret as anyone knowing it can derive the private
+// randomness and extract the EOTS private key from a finality signature.
+func DeriveRand(seed []byte, chainID string, height uint64) (*eots.PrivateRand, *eots.PublicRand, error) {
+	if len(seed) == 0 {
+		return nil, nil, fmt.Errorf("empty seed")
+	}
+
+	for counter := uint32(0); ; counter++ {
+		mac := hmac.New(sha256.New, seed)
+		mac.Write([]byte(randDerivationTag))
+		mac.Write(binary.BigEndian.AppendUint64(nil, uint64(len(chainID))))
+		mac.Write([]byte(chainID))
+		mac.Write(binary.BigEndian.AppendUint64(nil, height))
+		mac.Write(binary.BigEndian.AppendUint32(nil, counter))
+
+		var k eots.PrivateRand
+		if overflow := k.SetByteSlice(mac.Sum(nil)); overflow || k.IsZero() {
+			continue
+		}
+
+		var j secp256k1.JacobianPoint
+		secp256k1.NewPrivateKey(&k).PubKey().AsJacobian(&j)
+		return &k, &j.X, nil
+	}
+}
+
+// DeriveRandList derives the EOTS randomness pairs for numPubRand
+// consecutive heights starting from startHeight
+func DeriveRandList(seed []byte, chainID string, startHeight uint64, numPubRand uint64) ([]*eots.PrivateRand, []*eots.PublicRand, error) {
+	srList := make([]*eots.PrivateRand, 0, numPubRand)
+	prList := make([]*eots.PublicRand, 0, numPubRand)
+	for i := uint64(0); i < numPubRand; i++ {
+		sr, pr, err := DeriveRand(seed, chainID, startHeight+i)
+		if err != nil {
+			return nil, nil, err
+		}
+		srList = append(srList, sr)
+		prList = append(prList, pr)
+	}
+	return srList, prList, nil
+}
+
+// PubRandBytes returns the 32-byte encoding of the given public randomness,
+// i.e., the encoding committed in the Merkle tree and used in finality votes
+func PubRandBytes(pr *eots.PublicRand) []byte {
+	prBytes := pr.Bytes()
+	return prBytes[:]
+}
+
+// Commit returns the Merkle root committing to the given list of public
+// randomness, together with the inclusion proof of each public randomness
+func Commit(prList []*eots.PublicRand) ([]byte, []*merkle.Proof) {
+	prByteList := make([][]byte, 0, len(prList))
+	for _, pr := range prList {
+		prByteList = append(prByteList, PubRandBytes(pr))
+	}
+	return merkle.ProofsFromByteSlices(prByteList)
+}
+
+// HashToSign returns the 32-byte hash of (start_height || num_pub_rand || commitment),
+// which is signed by the finality provider when committing public randomness
+func HashToSign(startHeight uint64, numPubRand uint64, commitment []byte) []byte {
+	hasher := tmhash.New()
+	hasher.Write(binary.BigEndian.AppendUint64(nil, startHeight))
+	hasher.Write(binary.BigEndian.AppendUint64(nil, numPubRand))
+	hasher.Write(commitment)
+	return hasher.Sum(nil)
+}
+
+// SignCommit signs the public randomness commitment with the given BTC
+// private key of the finality provider
+func SignCommit(sk *btcec.PrivateKey, startHeight uint64, numPubRand uint64, commitment []byte) (*schnorr.Signature, error) {
+	return schnorr.Sign(sk, HashToSign(startHeight, numPubRand, commitment))
+}
+
+// VerifyCommitSig verifies the signature over the public randomness commitment
+// w.r.t. the given BTC public key of the finality provider
+func VerifyCommitSig(pk *btcec.PublicKey, startHeight uint64, numPubRand uint64, commitment []byte, sig *schnorr.Signature) error {
+	if sig == nil {
+		return fmt.Errorf("empty signature")
+	}
+	if !sig.Verify(HashToSign(startHeight, numPubRand, commitment), pk) {
+		return fmt.Errorf("failed to verify signature")
+	}
+	return nil
+}
+
+// VerifyInclusion verifies that the given public randomness at the given
+// height is committed in the commitment over numPubRand public randomness
+// starting from startHeight
+func VerifyInclusion(commitment []byte, startHeight uint64, numPubRand uint64, height uint64, pubRand []byte, proof *cmtcrypto.Proof) error {
+	if pubRand == nil {
+		return fmt.Errorf("empty public randomness")
+	}
+	if proof == nil {
+		return fmt.Errorf("empty inclusion proof")
+	}
+	// verify the index of the public randomness
+	heightOfProof := startHeight + uint64(proof.Index)
+	if height != heightOfProof {
+		return fmt.Errorf("the inclusion proof (for height %d) does not correspond to the given height (%d) in the message", heightOfProof, height)
+	}
+	// verify the total number of randomness is same as in the commit
+	if uint64(proof.Total) != numPubRand {
+		return fmt.Errorf("the total number of public randomnesses in the proof (%d) does not match the number of public randomnesses committed (%d)", proof.Total, numPubRand)
+	}
+	// verify the proof of inclusion for this public randomness
+	unwrappedProof, err := merkle.ProofFromProto(proof)
+	if err != nil {
+		return fmt.Errorf("failed to unwrap proof: %w", err)
+	}
+	if err := unwrappedProof.Verify(commitment, pubRand); err != nil {
+		return fmt.Errorf("the inclusion proof of the public randomness is invalid: %w", err)
+	}
+	return nil
+}
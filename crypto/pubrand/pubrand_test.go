@@ -0,0 +1,172 @@
+package pubrand_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/babylonchain/babylon/crypto/pubrand"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	ftypes "github.com/babylonchain/babylon/x/finality/types"
+)
+
+// testVector is a test vector shared with finality provider implementations
+type testVector struct {
+	Seed        string   `json:"seed"`
+	ChainID     string   `json:"chain_id"`
+	StartHeight uint64   `json:"start_height"`
+	NumPubRand  uint64   `json:"num_pub_rand"`
+	PrivateRand []string `json:"private_rand"`
+	PubRand     []string `json:"pub_rand"`
+	Commitment  string   `json:"commitment"`
+	SecretKey   string   `json:"secret_key"`
+	HashToSign  string   `json:"hash_to_sign"`
+	CommitSig   string   `json:"commit_sig"`
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestVectors(t *testing.T) {
+	bz, err := os.ReadFile("testdata/vectors.json")
+	require.NoError(t, err)
+	var vectors []testVector
+	err = json.Unmarshal(bz, &vectors)
+	require.NoError(t, err)
+	require.NotEmpty(t, vectors)
+
+	for _, v := range vectors {
+		// derive the randomness pairs
+		srList, prList, err := pubrand.DeriveRandList(decodeHex(t, v.Seed), v.ChainID, v.StartHeight, v.NumPubRand)
+		require.NoError(t, err)
+		require.Len(t, srList, int(v.NumPubRand))
+		for i := range srList {
+			srBytes := srList[i].Bytes()
+			require.Equal(t, v.PrivateRand[i], hex.EncodeToString(srBytes[:]))
+			require.Equal(t, v.PubRand[i], hex.EncodeToString(pubrand.PubRandBytes(prList[i])))
+		}
+
+		// build the commitment and verify the inclusion proofs
+		commitment, proofs := pubrand.Commit(prList)
+		require.Equal(t, v.Commitment, hex.EncodeToString(commitment))
+		for i, proof := range proofs {
+			err := pubrand.VerifyInclusion(commitment, v.StartHeight, v.NumPubRand, v.StartHeight+uint64(i), decodeHex(t, v.PubRand[i]), proof.ToProto())
+			require.NoError(t, err)
+		}
+
+		// sign the commitment and verify the signature
+		sk, pk := btcec.PrivKeyFromBytes(decodeHex(t, v.SecretKey))
+		require.Equal(t, v.HashToSign, hex.EncodeToString(pubrand.HashToSign(v.StartHeight, v.NumPubRand, commitment)))
+		sig, err := pubrand.SignCommit(sk, v.StartHeight, v.NumPubRand, commitment)
+		require.NoError(t, err)
+		require.Equal(t, v.CommitSig, hex.EncodeToString(sig.Serialize()))
+		expectedSig, err := schnorr.ParseSignature(decodeHex(t, v.CommitSig))
+		require.NoError(t, err)
+		err = pubrand.VerifyCommitSig(pk, v.StartHeight, v.NumPubRand, commitment, expectedSig)
+		require.NoError(t, err)
+	}
+}
+
+func FuzzDeriveRand(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		randSeed := datagen.GenRandomByteArray(r, 32)
+		chainID := datagen.GenRandomHexStr(r, 10)
+		height := datagen.RandomInt(r, 100000)
+
+		// the derivation is deterministic
+		sr, pr, err := pubrand.DeriveRand(randSeed, chainID, height)
+		require.NoError(t, err)
+		sr2, pr2, err := pubrand.DeriveRand(randSeed, chainID, height)
+		require.NoError(t, err)
+		require.True(t, sr.Equals(sr2))
+		require.True(t, pr.Equals(pr2))
+		// the public randomness corresponds to the private randomness
+		require.Equal(t, []byte(*bbn.NewPubRandFromPrivRand(sr)), pubrand.PubRandBytes(pr))
+
+		// the derivation is bound to the chain ID and height
+		_, prOtherHeight, err := pubrand.DeriveRand(randSeed, chainID, height+1)
+		require.NoError(t, err)
+		require.False(t, pr.Equals(prOtherHeight))
+		_, prOtherChain, err := pubrand.DeriveRand(randSeed, chainID+"0", height)
+		require.NoError(t, err)
+		require.False(t, pr.Equals(prOtherChain))
+
+		// empty seeds are rejected
+		_, _, err = pubrand.DeriveRand(nil, chainID, height)
+		require.Error(t, err)
+	})
+}
+
+// FuzzVerifyAsKeeper ensures the commitments, proofs and signatures produced
+// by this package are accepted by the finality module
+func FuzzVerifyAsKeeper(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		sk, err := eots.KeyGen(r)
+		require.NoError(t, err)
+		randSeed := datagen.GenRandomByteArray(r, 32)
+		chainID := datagen.GenRandomHexStr(r, 10)
+		startHeight := datagen.RandomInt(r, 100) + 1
+		numPubRand := datagen.RandomInt(r, 100) + 1
+
+		// commit a list of public randomness
+		srList, prList, err := pubrand.DeriveRandList(randSeed, chainID, startHeight, numPubRand)
+		require.NoError(t, err)
+		commitment, proofs := pubrand.Commit(prList)
+		sig, err := pubrand.SignCommit(sk, startHeight, numPubRand, commitment)
+		require.NoError(t, err)
+		msgCommit := &ftypes.MsgCommitPubRandList{
+			FpBtcPk:     bbn.NewBIP340PubKeyFromBTCPK(sk.PubKey()),
+			StartHeight: startHeight,
+			NumPubRand:  numPubRand,
+			Commitment:  commitment,
+			Sig:         bbn.NewBIP340SignatureFromBTCSig(sig),
+		}
+		require.NoError(t, msgCommit.VerifySig())
+		prCommit := &ftypes.PubRandCommit{
+			StartHeight: startHeight,
+			NumPubRand:  numPubRand,
+			Commitment:  commitment,
+		}
+
+		// vote for a block with the derived randomness
+		idx := datagen.RandomInt(r, int(numPubRand))
+		height := startHeight + idx
+		msgVote := &ftypes.MsgAddFinalitySig{
+			FpBtcPk:      bbn.NewBIP340PubKeyFromBTCPK(sk.PubKey()),
+			BlockHeight:  height,
+			PubRand:      bbn.NewSchnorrPubRandFromFieldVal(prList[idx]),
+			Proof:        proofs[idx].ToProto(),
+			BlockAppHash: datagen.GenRandomByteArray(r, 32),
+		}
+		eotsSig, err := eots.Sign(sk, srList[idx], msgVote.MsgToSign())
+		require.NoError(t, err)
+		msgVote.FinalitySig = bbn.NewSchnorrEOTSSigFromModNScalar(eotsSig)
+		require.NoError(t, ftypes.VerifyFinalitySig(msgVote, prCommit, nil))
+
+		// the proof does not verify at another height
+		err = pubrand.VerifyInclusion(commitment, startHeight, numPubRand, height+1, pubrand.PubRandBytes(prList[idx]), proofs[idx].ToProto())
+		require.Error(t, err)
+		// the commitment signature does not verify over another commitment
+		err = pubrand.VerifyCommitSig(sk.PubKey(), startHeight, numPubRand, datagen.GenRandomByteArray(r, 32), sig)
+		require.Error(t, err)
+	})
+}
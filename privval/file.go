@@ -1,6 +1,7 @@
 package privval
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -21,6 +22,11 @@ import (
 	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
 )
 
+// NextKeyFileSuffix is the suffix of the file of the validator keys with the
+// new BLS key upon rotating the BLS key, which replaces the key file once the
+// new BLS key takes effect
+const NextKeyFileSuffix = ".next"
+
 // copied from github.com/cometbft/cometbft/privval/file.go"
 //
 //nolint:unused
@@ -52,7 +58,10 @@ type WrappedFilePVKey struct {
 	PubKey           cmtcrypto.PubKey    `json:"pub_key"`
 	PrivKey          cmtcrypto.PrivKey   `json:"priv_key"`
 	BlsPubKey        bls12381.PublicKey  `json:"bls_pub_key"`
-	BlsPrivKey       bls12381.PrivateKey `json:"bls_priv_key"`
+	BlsPrivKey       bls12381.PrivateKey `json:"bls_priv_key,omitempty"`
+	// BlsKeystore is the encrypted BLS private key. If it is set, the BLS
+	// private key is not persisted in plaintext.
+	BlsKeystore *bls12381.Keystore `json:"bls_keystore,omitempty"`
 
 	filePath string
 }
@@ -64,6 +73,14 @@ func (pvKey WrappedFilePVKey) Save() {
 		panic("cannot save PrivValidator key: filePath not set")
 	}
 
+	if pvKey.BlsKeystore != nil {
+		if pvKey.BlsKeystore.PubKey != hex.EncodeToString(pvKey.BlsPubKey) {
+			panic("cannot save PrivValidator key: BLS keystore does not match BLS key")
+		}
+		// never persist the encrypted BLS private key in plaintext
+		pvKey.BlsPrivKey = nil
+	}
+
 	jsonBytes, err := cmtjson.MarshalIndent(pvKey, "", "  ")
 	if err != nil {
 		panic(err)
@@ -106,28 +123,59 @@ func GenWrappedFilePV(keyFilePath, stateFilePath string) *WrappedFilePV {
 
 // LoadWrappedFilePV loads a FilePV from the filePaths.  The FilePV handles double
 // signing prevention by persisting data to the stateFilePath.  If either file path
-// does not exist, the program will exit.
+// does not exist, the program will exit. If the BLS key is encrypted, the
+// passphrase is loaded via LoadBlsPassphrase.
 func LoadWrappedFilePV(keyFilePath, stateFilePath string) *WrappedFilePV {
-	return loadWrappedFilePV(keyFilePath, stateFilePath, true)
+	pv, err := loadWrappedFilePV(keyFilePath, stateFilePath, true, LoadBlsPassphrase)
+	if err != nil {
+		cmtos.Exit(err.Error())
+	}
+	return pv
 }
 
 // LoadWrappedFilePVEmptyState loads a FilePV from the given keyFilePath, with an empty LastSignState.
 // If the keyFilePath does not exist, the program will exit.
 func LoadWrappedFilePVEmptyState(keyFilePath, stateFilePath string) *WrappedFilePV {
-	return loadWrappedFilePV(keyFilePath, stateFilePath, false)
+	pv, err := loadWrappedFilePV(keyFilePath, stateFilePath, false, LoadBlsPassphrase)
+	if err != nil {
+		cmtos.Exit(err.Error())
+	}
+	return pv
+}
+
+// LoadWrappedFilePVWithBlsPassphrase loads a FilePV from the filePaths, where the
+// BLS key is decrypted with the given passphrase if it is encrypted. Unlike
+// LoadWrappedFilePV, it returns an error rather than exiting the program.
+func LoadWrappedFilePVWithBlsPassphrase(keyFilePath, stateFilePath, passphrase string) (*WrappedFilePV, error) {
+	return loadWrappedFilePV(keyFilePath, stateFilePath, true, func() (string, error) {
+		return passphrase, nil
+	})
 }
 
 // If loadState is true, we load from the stateFilePath. Otherwise, we use an empty LastSignState.
-func loadWrappedFilePV(keyFilePath, stateFilePath string, loadState bool) *WrappedFilePV {
+// The blsPassphrase function is only invoked if the BLS key is encrypted.
+func loadWrappedFilePV(keyFilePath, stateFilePath string, loadState bool, blsPassphrase func() (string, error)) (*WrappedFilePV, error) {
 	keyFilePath = filepath.Clean(keyFilePath)
 	keyJSONBytes, err := os.ReadFile(keyFilePath)
 	if err != nil {
-		cmtos.Exit(err.Error())
+		return nil, err
 	}
 	pvKey := WrappedFilePVKey{}
 	err = cmtjson.Unmarshal(keyJSONBytes, &pvKey)
 	if err != nil {
-		cmtos.Exit(fmt.Sprintf("Error reading PrivValidator key from %v: %v\n", keyFilePath, err))
+		return nil, fmt.Errorf("error reading PrivValidator key from %v: %w", keyFilePath, err)
+	}
+
+	// decrypt the BLS key if it is encrypted
+	if pvKey.BlsKeystore != nil {
+		passphrase, err := blsPassphrase()
+		if err != nil {
+			return nil, err
+		}
+		pvKey.BlsPrivKey, err = pvKey.BlsKeystore.Decrypt(passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting BLS key from %v: %w", keyFilePath, err)
+		}
 	}
 
 	// overwrite pubkey and address for convenience
@@ -142,11 +190,11 @@ func loadWrappedFilePV(keyFilePath, stateFilePath string, loadState bool) *Wrapp
 		stateFilePath := filepath.Clean(stateFilePath)
 		stateJSONBytes, err := os.ReadFile(stateFilePath)
 		if err != nil {
-			cmtos.Exit(err.Error())
+			return nil, err
 		}
 		err = cmtjson.Unmarshal(stateJSONBytes, &pvState)
 		if err != nil {
-			cmtos.Exit(fmt.Sprintf("Error reading PrivValidator state from %v: %v\n", stateFilePath, err))
+			return nil, fmt.Errorf("error reading PrivValidator state from %v: %w", stateFilePath, err)
 		}
 	}
 
@@ -156,7 +204,7 @@ func loadWrappedFilePV(keyFilePath, stateFilePath string, loadState bool) *Wrapp
 	return &WrappedFilePV{
 		Key:           pvKey,
 		LastSignState: pvState,
-	}
+	}, nil
 }
 
 // LoadOrGenWrappedFilePV loads a FilePV from the given filePaths
@@ -248,6 +296,12 @@ func (pv *WrappedFilePV) SignMsgWithBls(msg []byte) (bls12381.Signature, error)
 	return bls12381.Sign(blsPrivKey, msg), nil
 }
 
+// SignBlsCheckpoint signs a BLS signature over the checkpoint of the given
+// epoch number and block hash
+func (pv *WrappedFilePV) SignBlsCheckpoint(epochNum uint64, blockHash checkpointingtypes.BlockHash) (bls12381.Signature, error) {
+	return pv.SignMsgWithBls(checkpointingtypes.GetSignBytes(epochNum, blockHash))
+}
+
 func (pv *WrappedFilePV) GetBlsPubkey() (bls12381.PublicKey, error) {
 	blsPrivKey := pv.GetBlsPrivKey()
 	if blsPrivKey == nil {
@@ -260,6 +314,63 @@ func (pv *WrappedFilePV) GetValidatorPubkey() (cmtcrypto.PubKey, error) {
 	return pv.GetPubKey()
 }
 
+// SwitchBlsKey switches to the given BLS public key once a BLS key rotation
+// of the validator takes effect. The new BLS key is loaded from the next key
+// file, i.e., the key file with NextKeyFileSuffix, which then replaces the key
+// file so that the switch persists across restarts. It does nothing if the
+// given BLS public key is already in use.
+func (pv *WrappedFilePV) SwitchBlsKey(blsPubKey bls12381.PublicKey) error {
+	if pv.Key.BlsPubKey.Equal(blsPubKey) {
+		return nil
+	}
+	nextPV, err := pv.loadNextKey(blsPubKey)
+	if err != nil {
+		return err
+	}
+
+	keyFilePath := pv.Key.filePath
+	nextKeyFilePath := keyFilePath + NextKeyFileSuffix
+	if err := os.Rename(nextKeyFilePath, keyFilePath); err != nil {
+		return fmt.Errorf("failed to replace %s with %s: %w", keyFilePath, nextKeyFilePath, err)
+	}
+	nextPV.Key.filePath = keyFilePath
+	pv.Key = nextPV.Key
+	return nil
+}
+
+// CheckNextBlsKey checks that SwitchBlsKey is able to switch to the given BLS
+// public key, i.e., the BLS public key is already in use or the next key file
+// contains its BLS key. It is used to detect a missing next key file before
+// the BLS key rotation takes effect.
+func (pv *WrappedFilePV) CheckNextBlsKey(blsPubKey bls12381.PublicKey) error {
+	if pv.Key.BlsPubKey.Equal(blsPubKey) {
+		return nil
+	}
+	_, err := pv.loadNextKey(blsPubKey)
+	return err
+}
+
+// loadNextKey loads the validator keys in the next key file, which have to
+// contain the same validator key and the given BLS public key
+func (pv *WrappedFilePV) loadNextKey(blsPubKey bls12381.PublicKey) (*WrappedFilePV, error) {
+	keyFilePath := pv.Key.filePath
+	nextKeyFilePath := keyFilePath + NextKeyFileSuffix
+	if !cmtos.FileExists(nextKeyFilePath) {
+		return nil, fmt.Errorf("the BLS key %X is not in use and %s does not exist", blsPubKey, nextKeyFilePath)
+	}
+	nextPV, err := loadWrappedFilePV(nextKeyFilePath, "", false, LoadBlsPassphrase)
+	if err != nil {
+		return nil, err
+	}
+	if !nextPV.Key.PubKey.Equals(pv.Key.PubKey) {
+		return nil, fmt.Errorf("the validator key in %s does not match the one in %s", nextKeyFilePath, keyFilePath)
+	}
+	if !nextPV.Key.BlsPubKey.Equal(blsPubKey) {
+		return nil, fmt.Errorf("the BLS key in %s is %X rather than %X", nextKeyFilePath, nextPV.Key.BlsPubKey, blsPubKey)
+	}
+	return nextPV, nil
+}
+
 // Save persists the FilePV to disk.
 func (pv *WrappedFilePV) Save() {
 	pv.Key.Save()
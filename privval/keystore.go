@@ -0,0 +1,68 @@
+package privval
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+)
+
+const (
+	// BlsPassphraseEnvVar is the environment variable holding the passphrase
+	// of the encrypted BLS key
+	BlsPassphraseEnvVar = "BABYLON_BLS_PASSPHRASE"
+	// BlsPassphraseFileEnvVar is the environment variable holding the path to
+	// the file containing the passphrase of the encrypted BLS key
+	BlsPassphraseFileEnvVar = "BABYLON_BLS_PASSPHRASE_FILE"
+)
+
+// LoadBlsPassphrase loads the passphrase of the encrypted BLS key from the
+// BABYLON_BLS_PASSPHRASE environment variable, or else from the file given by
+// the BABYLON_BLS_PASSPHRASE_FILE environment variable
+func LoadBlsPassphrase() (string, error) {
+	if passphrase, ok := os.LookupEnv(BlsPassphraseEnvVar); ok {
+		return passphrase, nil
+	}
+	if passphraseFile := os.Getenv(BlsPassphraseFileEnvVar); passphraseFile != "" {
+		return ReadPassphraseFile(passphraseFile)
+	}
+	return "", fmt.Errorf("the BLS key is encrypted but neither %s nor %s is set", BlsPassphraseEnvVar, BlsPassphraseFileEnvVar)
+}
+
+// ReadPassphraseFile reads the passphrase from the given file, ignoring the
+// trailing line break
+func ReadPassphraseFile(filePath string) (string, error) {
+	bz, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase file: %w", err)
+	}
+	return strings.TrimRight(string(bz), "\r\n"), nil
+}
+
+// IsBlsKeyEncrypted returns whether the BLS private key is stored encrypted
+func (pv *WrappedFilePV) IsBlsKeyEncrypted() bool {
+	return pv.Key.BlsKeystore != nil
+}
+
+// EncryptBlsKey encrypts the BLS private key under the given passphrase with
+// the given KDF and cost (see bls12381.EncryptKeystore), such that the BLS
+// private key is no longer stored in plaintext. Encrypting an encrypted BLS
+// private key changes its passphrase. The key file needs to be saved
+// afterwards.
+func (pv *WrappedFilePV) EncryptBlsKey(passphrase string, kdf string, cost int) error {
+	ks, err := bls12381.EncryptKeystore(pv.Key.BlsPrivKey, passphrase, kdf, cost)
+	if err != nil {
+		return err
+	}
+	pv.Key.BlsKeystore = ks
+	return nil
+}
+
+// DecryptBlsKey removes the encryption of the BLS private key, such that the
+// BLS private key is stored in plaintext. The key file needs to be saved
+// afterwards.
+func (pv *WrappedFilePV) DecryptBlsKey() {
+	pv.Key.BlsKeystore = nil
+}
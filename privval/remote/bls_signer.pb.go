@@ -0,0 +1,1085 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/privval/v1/bls_signer.proto
+
+package remote
+
+import (
+	context "context"
+	fmt "fmt"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GetPubKeysRequest is the request of the GetPubKeys RPC
+type GetPubKeysRequest struct {
+}
+
+func (m *GetPubKeysRequest) Reset()         { *m = GetPubKeysRequest{} }
+func (m *GetPubKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPubKeysRequest) ProtoMessage()    {}
+func (*GetPubKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b6216f275a635b9, []int{0}
+}
+func (m *GetPubKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPubKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPubKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPubKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPubKeysRequest.Merge(m, src)
+}
+func (m *GetPubKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPubKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPubKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPubKeysRequest proto.InternalMessageInfo
+
+// GetPubKeysResponse is the response of the GetPubKeys RPC
+type GetPubKeysResponse struct {
+	// validator_address is the address of the validator in bytes
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// bls_pub_key is the BLS public key of the validator
+	BlsPubKey []byte `protobuf:"bytes,2,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`
+	// validator_pub_key is the consensus public key of the validator
+	ValidatorPubKey *crypto.PublicKey `protobuf:"bytes,3,opt,name=validator_pub_key,json=validatorPubKey,proto3" json:"validator_pub_key,omitempty"`
+}
+
+func (m *GetPubKeysResponse) Reset()         { *m = GetPubKeysResponse{} }
+func (m *GetPubKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPubKeysResponse) ProtoMessage()    {}
+func (*GetPubKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b6216f275a635b9, []int{1}
+}
+func (m *GetPubKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPubKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPubKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPubKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPubKeysResponse.Merge(m, src)
+}
+func (m *GetPubKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPubKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPubKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPubKeysResponse proto.InternalMessageInfo
+
+func (m *GetPubKeysResponse) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *GetPubKeysResponse) GetBlsPubKey() []byte {
+	if m != nil {
+		return m.BlsPubKey
+	}
+	return nil
+}
+
+func (m *GetPubKeysResponse) GetValidatorPubKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ValidatorPubKey
+	}
+	return nil
+}
+
+// SignCheckpointRequest is the request of the SignCheckpoint RPC
+type SignCheckpointRequest struct {
+	// epoch_num is the number of the epoch to sign
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// block_hash is the hash of the last block of the epoch
+	BlockHash []byte `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (m *SignCheckpointRequest) Reset()         { *m = SignCheckpointRequest{} }
+func (m *SignCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*SignCheckpointRequest) ProtoMessage()    {}
+func (*SignCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b6216f275a635b9, []int{2}
+}
+func (m *SignCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignCheckpointRequest.Merge(m, src)
+}
+func (m *SignCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignCheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignCheckpointRequest proto.InternalMessageInfo
+
+func (m *SignCheckpointRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *SignCheckpointRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+// SignCheckpointResponse is the response of the SignCheckpoint RPC
+type SignCheckpointResponse struct {
+	// bls_sig is the BLS signature over the epoch number and the block hash
+	BlsSig []byte `protobuf:"bytes,1,opt,name=bls_sig,json=blsSig,proto3" json:"bls_sig,omitempty"`
+}
+
+func (m *SignCheckpointResponse) Reset()         { *m = SignCheckpointResponse{} }
+func (m *SignCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*SignCheckpointResponse) ProtoMessage()    {}
+func (*SignCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b6216f275a635b9, []int{3}
+}
+func (m *SignCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignCheckpointResponse.Merge(m, src)
+}
+func (m *SignCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignCheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignCheckpointResponse proto.InternalMessageInfo
+
+func (m *SignCheckpointResponse) GetBlsSig() []byte {
+	if m != nil {
+		return m.BlsSig
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetPubKeysRequest)(nil), "babylon.privval.v1.GetPubKeysRequest")
+	proto.RegisterType((*GetPubKeysResponse)(nil), "babylon.privval.v1.GetPubKeysResponse")
+	proto.RegisterType((*SignCheckpointRequest)(nil), "babylon.privval.v1.SignCheckpointRequest")
+	proto.RegisterType((*SignCheckpointResponse)(nil), "babylon.privval.v1.SignCheckpointResponse")
+}
+
+func init() {
+	proto.RegisterFile("babylon/privval/v1/bls_signer.proto", fileDescriptor_4b6216f275a635b9)
+}
+
+var fileDescriptor_4b6216f275a635b9 = []byte{
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6e, 0xd4, 0x30,
+	0x14, 0x86, 0xc7, 0x80, 0x0a, 0xe3, 0x22, 0xa0, 0x46, 0x40, 0x35, 0x94, 0xa8, 0x0a, 0x02, 0x15,
+	0x90, 0x1c, 0x4d, 0x39, 0x01, 0x65, 0xc1, 0x48, 0x95, 0x50, 0x95, 0xd9, 0xc1, 0x22, 0xb2, 0x9d,
+	0xa7, 0xc4, 0x8a, 0x63, 0x07, 0xdb, 0x89, 0x94, 0x5b, 0x70, 0x0f, 0x2e, 0xc2, 0xb2, 0xec, 0x58,
+	0xa2, 0x99, 0x8b, 0x20, 0x12, 0xd3, 0x29, 0x6d, 0x25, 0xba, 0xb3, 0x9e, 0xff, 0xf7, 0xfb, 0x7b,
+	0xbf, 0x1f, 0x7e, 0xce, 0x19, 0xef, 0x95, 0xd1, 0x49, 0x63, 0x65, 0xd7, 0x31, 0x95, 0x74, 0xf3,
+	0x84, 0x2b, 0x97, 0x39, 0x59, 0x68, 0xb0, 0xb4, 0xb1, 0xc6, 0x1b, 0x42, 0x82, 0x88, 0x06, 0x11,
+	0xed, 0xe6, 0xb3, 0x3d, 0x0f, 0x3a, 0x07, 0x5b, 0x4b, 0xed, 0x13, 0x61, 0xfb, 0xc6, 0x9b, 0xa4,
+	0x82, 0xde, 0x8d, 0x1d, 0xf1, 0x43, 0xbc, 0xf3, 0x01, 0xfc, 0x49, 0xcb, 0x8f, 0xa1, 0x77, 0x29,
+	0x7c, 0x69, 0xc1, 0xf9, 0xf8, 0x1b, 0xc2, 0xe4, 0x7c, 0xd5, 0x35, 0x46, 0x3b, 0x20, 0x6f, 0xf0,
+	0x4e, 0xc7, 0x94, 0xcc, 0x99, 0x37, 0x36, 0x63, 0x79, 0x6e, 0xc1, 0xb9, 0x5d, 0xb4, 0x8f, 0x0e,
+	0xee, 0xa6, 0x0f, 0xce, 0x2e, 0xde, 0x8d, 0x75, 0x12, 0xe1, 0xed, 0x3f, 0x78, 0x4d, 0xcb, 0xb3,
+	0x0a, 0xfa, 0xdd, 0x1b, 0x83, 0x6c, 0xca, 0x95, 0x1b, 0x5d, 0xc9, 0xe2, 0xbc, 0xd9, 0x5f, 0xd5,
+	0xcd, 0x7d, 0x74, 0xb0, 0x7d, 0xb8, 0x47, 0x37, 0xc8, 0x74, 0x44, 0xa6, 0x27, 0x2d, 0x57, 0x52,
+	0x1c, 0x43, 0x9f, 0xde, 0x3f, 0x6b, 0x1b, 0x9d, 0xe2, 0x25, 0x7e, 0xb4, 0x94, 0x85, 0x7e, 0x5f,
+	0x82, 0xa8, 0x1a, 0x23, 0xb5, 0x0f, 0x63, 0x90, 0xa7, 0x78, 0x0a, 0x8d, 0x11, 0x65, 0xa6, 0xdb,
+	0x7a, 0xe0, 0xbc, 0x95, 0xde, 0x19, 0x0a, 0x1f, 0xdb, 0x9a, 0x3c, 0xc3, 0x98, 0x2b, 0x23, 0xaa,
+	0xac, 0x64, 0xae, 0xdc, 0xe0, 0x19, 0x51, 0x2d, 0x98, 0x2b, 0xe3, 0x39, 0x7e, 0x7c, 0xd1, 0x34,
+	0xa4, 0xf0, 0x04, 0xdf, 0x0e, 0xb9, 0x87, 0xd9, 0xb7, 0xb8, 0x72, 0x4b, 0x59, 0x1c, 0xfe, 0x40,
+	0x78, 0x7a, 0x34, 0x1c, 0x35, 0x58, 0xf2, 0x19, 0xe3, 0x4d, 0x84, 0xe4, 0x05, 0xbd, 0xfc, 0x33,
+	0xf4, 0x52, 0xf0, 0xb3, 0x97, 0xff, 0x93, 0x05, 0x86, 0x02, 0xdf, 0xfb, 0x97, 0x8e, 0xbc, 0xba,
+	0xaa, 0xf3, 0xca, 0x58, 0x66, 0xaf, 0xaf, 0x23, 0x1d, 0x1f, 0x3a, 0x5a, 0x7c, 0x5f, 0x45, 0xe8,
+	0x74, 0x15, 0xa1, 0x5f, 0xab, 0x08, 0x7d, 0x5d, 0x47, 0x93, 0xd3, 0x75, 0x34, 0xf9, 0xb9, 0x8e,
+	0x26, 0x9f, 0x68, 0x21, 0x7d, 0xd9, 0x72, 0x2a, 0x4c, 0x9d, 0x04, 0x3f, 0x51, 0x32, 0xa9, 0x93,
+	0x8b, 0x7b, 0x6a, 0xa1, 0x36, 0x1e, 0xf8, 0xd6, 0xb0, 0x6f, 0x6f, 0x7f, 0x07, 0x00, 0x00, 0xff,
+	0xff, 0xbf, 0x76, 0xa1, 0xe8, 0xc8, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlsSignerClient is the client API for BlsSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlsSignerClient interface {
+	// GetPubKeys returns the address and the public keys of the validator
+	GetPubKeys(ctx context.Context, in *GetPubKeysRequest, opts ...grpc.CallOption) (*GetPubKeysResponse, error)
+	// SignCheckpoint signs a BLS signature over the last block hash of the
+	// given epoch. The signer refuses to sign another block hash for an epoch
+	// that it has signed, or for an epoch before it.
+	SignCheckpoint(ctx context.Context, in *SignCheckpointRequest, opts ...grpc.CallOption) (*SignCheckpointResponse, error)
+}
+
+type blsSignerClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlsSignerClient(cc grpc1.ClientConn) BlsSignerClient {
+	return &blsSignerClient{cc}
+}
+
+func (c *blsSignerClient) GetPubKeys(ctx context.Context, in *GetPubKeysRequest, opts ...grpc.CallOption) (*GetPubKeysResponse, error) {
+	out := new(GetPubKeysResponse)
+	err := c.cc.Invoke(ctx, "/babylon.privval.v1.BlsSigner/GetPubKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blsSignerClient) SignCheckpoint(ctx context.Context, in *SignCheckpointRequest, opts ...grpc.CallOption) (*SignCheckpointResponse, error) {
+	out := new(SignCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/babylon.privval.v1.BlsSigner/SignCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlsSignerServer is the server API for BlsSigner service.
+type BlsSignerServer interface {
+	// GetPubKeys returns the address and the public keys of the validator
+	GetPubKeys(context.Context, *GetPubKeysRequest) (*GetPubKeysResponse, error)
+	// SignCheckpoint signs a BLS signature over the last block hash of the
+	// given epoch. The signer refuses to sign another block hash for an epoch
+	// that it has signed, or for an epoch before it.
+	SignCheckpoint(context.Context, *SignCheckpointRequest) (*SignCheckpointResponse, error)
+}
+
+// UnimplementedBlsSignerServer can be embedded to have forward compatible implementations.
+type UnimplementedBlsSignerServer struct {
+}
+
+func (*UnimplementedBlsSignerServer) GetPubKeys(ctx context.Context, req *GetPubKeysRequest) (*GetPubKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubKeys not implemented")
+}
+func (*UnimplementedBlsSignerServer) SignCheckpoint(ctx context.Context, req *SignCheckpointRequest) (*SignCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignCheckpoint not implemented")
+}
+
+func RegisterBlsSignerServer(s grpc1.Server, srv BlsSignerServer) {
+	s.RegisterService(&_BlsSigner_serviceDesc, srv)
+}
+
+func _BlsSigner_GetPubKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPubKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlsSignerServer).GetPubKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.privval.v1.BlsSigner/GetPubKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlsSignerServer).GetPubKeys(ctx, req.(*GetPubKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlsSigner_SignCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlsSignerServer).SignCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.privval.v1.BlsSigner/SignCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlsSignerServer).SignCheckpoint(ctx, req.(*SignCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BlsSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.privval.v1.BlsSigner",
+	HandlerType: (*BlsSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPubKeys",
+			Handler:    _BlsSigner_GetPubKeys_Handler,
+		},
+		{
+			MethodName: "SignCheckpoint",
+			Handler:    _BlsSigner_SignCheckpoint_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/privval/v1/bls_signer.proto",
+}
+
+func (m *GetPubKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPubKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPubKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPubKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPubKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPubKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorPubKey != nil {
+		{
+			size, err := m.ValidatorPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlsSigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintBlsSigner(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintBlsSigner(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintBlsSigner(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintBlsSigner(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlsSig) > 0 {
+		i -= len(m.BlsSig)
+		copy(dAtA[i:], m.BlsSig)
+		i = encodeVarintBlsSigner(dAtA, i, uint64(len(m.BlsSig)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlsSigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlsSigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetPubKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetPubKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovBlsSigner(uint64(l))
+	}
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovBlsSigner(uint64(l))
+	}
+	if m.ValidatorPubKey != nil {
+		l = m.ValidatorPubKey.Size()
+		n += 1 + l + sovBlsSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovBlsSigner(uint64(m.EpochNum))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovBlsSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlsSig)
+	if l > 0 {
+		n += 1 + l + sovBlsSigner(uint64(l))
+	}
+	return n
+}
+
+func sovBlsSigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlsSigner(x uint64) (n int) {
+	return sovBlsSigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetPubKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlsSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPubKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPubKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlsSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPubKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlsSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPubKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPubKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = append(m.BlsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsPubKey == nil {
+				m.BlsPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorPubKey == nil {
+				m.ValidatorPubKey = &crypto.PublicKey{}
+			}
+			if err := m.ValidatorPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlsSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignCheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlsSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlsSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlsSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsSig = append(m.BlsSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsSig == nil {
+				m.BlsSig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlsSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlsSigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlsSigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlsSigner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlsSigner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlsSigner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlsSigner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlsSigner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlsSigner = fmt.Errorf("proto: unexpected end of group")
+)
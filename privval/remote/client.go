@@ -0,0 +1,174 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtnet "github.com/cometbft/cometbft/libs/net"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// DefaultTimeout is the default timeout of requests to a remote BLS signer
+const DefaultTimeout = 3 * time.Second
+
+// RemoteBlsSigner signs BLS signatures via a remote BLS signer serving the
+// BlsSigner service. It implements the BlsSigner interface of the
+// checkpointing module.
+type RemoteBlsSigner struct {
+	conn    *grpc.ClientConn
+	client  BlsSignerClient
+	timeout time.Duration
+
+	valAddr   sdk.ValAddress
+	blsPubKey bls12381.PublicKey
+	valPubKey cmtcrypto.PubKey
+}
+
+// NewRemoteBlsSigner connects to the remote BLS signer at the given address,
+// which is either unix://<path> or tcp://<host>:<port>, and retrieves the
+// keys of the validator from it. The connection uses mutual TLS with the
+// given config unless it is nil, which is only allowed for unix sockets.
+func NewRemoteBlsSigner(addr string, timeout time.Duration, tlsConfig *tls.Config) (*RemoteBlsSigner, error) {
+	protocol, address := cmtnet.ProtocolAndAddress(addr)
+	if protocol != "unix" && protocol != "tcp" {
+		return nil, fmt.Errorf("unsupported protocol %q of BLS signer address", protocol)
+	}
+	if protocol == "tcp" && tlsConfig == nil {
+		return nil, ErrInsecureTCP
+	}
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	// dial the address directly rather than letting gRPC resolve it, as
+	// gRPC parses targets as URLs which mangles some unix socket paths
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, protocol, address)
+	}
+	conn, err := grpc.Dial(
+		"passthrough:///"+address,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithContextDialer(dialer),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to BLS signer at %s: %w", addr, err)
+	}
+	return newRemoteBlsSigner(conn, timeout)
+}
+
+func newRemoteBlsSigner(conn *grpc.ClientConn, timeout time.Duration) (*RemoteBlsSigner, error) {
+	s := &RemoteBlsSigner{
+		conn:    conn,
+		client:  NewBlsSignerClient(conn),
+		timeout: timeout,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	res, err := s.client.GetPubKeys(ctx, &GetPubKeysRequest{}, grpc.WaitForReady(true))
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to get keys from BLS signer: %w", err)
+	}
+	if len(res.BlsPubKey) != bls12381.PubKeySize {
+		_ = conn.Close()
+		return nil, fmt.Errorf("invalid BLS public key from BLS signer")
+	}
+	if res.ValidatorPubKey == nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("empty validator public key from BLS signer")
+	}
+	valPubKey, err := cryptoenc.PubKeyFromProto(*res.ValidatorPubKey)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("invalid validator public key from BLS signer: %w", err)
+	}
+
+	s.valAddr = res.ValidatorAddress
+	s.blsPubKey = res.BlsPubKey
+	s.valPubKey = valPubKey
+	return s, nil
+}
+
+// GetAddress returns the address of the validator
+func (s *RemoteBlsSigner) GetAddress() sdk.ValAddress {
+	return s.valAddr
+}
+
+// GetBlsPubkey returns the BLS public key of the validator
+func (s *RemoteBlsSigner) GetBlsPubkey() (bls12381.PublicKey, error) {
+	return s.blsPubKey, nil
+}
+
+// GetValidatorPubkey returns the consensus public key of the validator
+func (s *RemoteBlsSigner) GetValidatorPubkey() (cmtcrypto.PubKey, error) {
+	return s.valPubKey, nil
+}
+
+// SignBlsCheckpoint requests the remote BLS signer to sign a BLS signature
+// over the given epoch number and block hash, and verifies the returned
+// signature. A refusal of the signer due to its double-sign protection is
+// returned as ErrBlsSignRefused.
+func (s *RemoteBlsSigner) SignBlsCheckpoint(epochNum uint64, blockHash checkpointingtypes.BlockHash) (bls12381.Signature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	res, err := s.client.SignCheckpoint(ctx, &SignCheckpointRequest{
+		EpochNum:  epochNum,
+		BlockHash: blockHash,
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil, checkpointingtypes.ErrBlsSignRefused.Wrap(status.Convert(err).Message())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign BLS signature via BLS signer: %w", err)
+	}
+
+	blsSig := bls12381.Signature(res.BlsSig)
+	if err := blsSig.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	valid, err := bls12381.Verify(blsSig, s.blsPubKey, checkpointingtypes.GetSignBytes(epochNum, blockHash))
+	if err != nil || !valid {
+		return nil, fmt.Errorf("invalid BLS signature from BLS signer")
+	}
+	return blsSig, nil
+}
+
+// SwitchBlsKey switches to the given BLS public key once a BLS key rotation
+// of the validator takes effect. The BLS key of the remote BLS signer has to
+// be switched on the signer host, after which the keys are retrieved again.
+func (s *RemoteBlsSigner) SwitchBlsKey(blsPubKey bls12381.PublicKey) error {
+	if s.blsPubKey.Equal(blsPubKey) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	res, err := s.client.GetPubKeys(ctx, &GetPubKeysRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to get keys from BLS signer: %w", err)
+	}
+	if !bls12381.PublicKey(res.BlsPubKey).Equal(blsPubKey) {
+		return fmt.Errorf("the BLS signer serves the BLS key %X rather than %X", res.BlsPubKey, blsPubKey)
+	}
+	s.blsPubKey = res.BlsPubKey
+	return nil
+}
+
+// Close closes the connection to the remote BLS signer
+func (s *RemoteBlsSigner) Close() error {
+	return s.conn.Close()
+}
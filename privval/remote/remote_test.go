@@ -0,0 +1,118 @@
+package remote_test
+
+import (
+	"math/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/privval"
+	"github.com/babylonchain/babylon/privval/remote"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// startSigner starts a BLS signer serving the given private validator at a
+// unix socket in the given directory, and returns its address and a function
+// stopping it. The sign state of the signer is persisted in the directory.
+func startSigner(t *testing.T, dir string, pv *privval.WrappedFilePV) (string, func()) {
+	signState, err := remote.LoadOrGenSignState(filepath.Join(dir, "bls_signer_state.json"))
+	require.NoError(t, err)
+
+	addr := "unix://" + filepath.Join(dir, "bls_signer.sock")
+	lis, err := remote.Listen(addr, nil)
+	require.NoError(t, err)
+	grpcServer := remote.NewSignerServer(pv, signState).NewGRPCServer(nil)
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+	return addr, grpcServer.Stop
+}
+
+func newSigner(t *testing.T, addr string) *remote.RemoteBlsSigner {
+	signer, err := remote.NewRemoteBlsSigner(addr, 5*time.Second, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = signer.Close()
+	})
+	return signer
+}
+
+func FuzzRemoteBlsSigner(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		dir := t.TempDir()
+		require.NoError(t, cmtos.EnsureDir(filepath.Join(dir, "config"), 0777))
+		pv := privval.GenWrappedFilePV(filepath.Join(dir, "config", "priv_validator_key.json"), filepath.Join(dir, "priv_validator_state.json"))
+		pv.SetAccAddress(sdk.MustAccAddressFromBech32(datagen.GenRandomAccount().Address))
+
+		addr, stop := startSigner(t, dir, pv)
+		signer := newSigner(t, addr)
+
+		// the remote signer returns the keys of the validator
+		require.Equal(t, pv.GetAddress(), signer.GetAddress())
+		blsPubKey, err := signer.GetBlsPubkey()
+		require.NoError(t, err)
+		require.True(t, pv.Key.BlsPubKey.Equal(blsPubKey))
+		valPubKey, err := signer.GetValidatorPubkey()
+		require.NoError(t, err)
+		require.True(t, pv.Key.PubKey.Equals(valPubKey))
+
+		// the checkpointing keeper signs via the remote signer
+		k, _, _ := testkeeper.CheckpointingKeeper(t, nil, signer)
+		epochNum := datagen.RandomInt(r, 100) + 1
+		blockHash := checkpointingtypes.BlockHash(datagen.GenRandomByteArray(r, 32))
+		blsSig, err := k.SignBLS(epochNum, blockHash)
+		require.NoError(t, err)
+		valid, err := bls12381.Verify(blsSig, blsPubKey, checkpointingtypes.GetSignBytes(epochNum, blockHash))
+		require.NoError(t, err)
+		require.True(t, valid)
+
+		// signing the same checkpoint again returns the same signature
+		blsSig2, err := signer.SignBlsCheckpoint(epochNum, blockHash)
+		require.NoError(t, err)
+		require.Equal(t, blsSig, blsSig2)
+
+		// signing another block hash for the same epoch or a previous epoch
+		// is refused
+		_, err = signer.SignBlsCheckpoint(epochNum, datagen.GenRandomByteArray(r, 32))
+		require.ErrorIs(t, err, checkpointingtypes.ErrBlsSignRefused)
+		require.ErrorContains(t, err, remote.ErrDoubleSign.Error())
+		_, err = signer.SignBlsCheckpoint(epochNum-1, datagen.GenRandomByteArray(r, 32))
+		require.ErrorIs(t, err, checkpointingtypes.ErrBlsSignRefused)
+		require.ErrorContains(t, err, remote.ErrEpochRegression.Error())
+		// an invalid block hash is refused
+		_, err = signer.SignBlsCheckpoint(epochNum+1, datagen.GenRandomByteArray(r, 31))
+		require.Error(t, err)
+
+		// the double-sign protection survives restarting the signer
+		stop()
+		addr, _ = startSigner(t, dir, pv)
+		signer = newSigner(t, addr)
+		_, err = signer.SignBlsCheckpoint(epochNum, datagen.GenRandomByteArray(r, 32))
+		require.ErrorContains(t, err, remote.ErrDoubleSign.Error())
+		blsSig2, err = signer.SignBlsCheckpoint(epochNum, blockHash)
+		require.NoError(t, err)
+		require.Equal(t, blsSig, blsSig2)
+
+		// a new epoch can be signed
+		_, err = signer.SignBlsCheckpoint(epochNum+1, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+	})
+}
+
+func TestRemoteBlsSignerUnavailable(t *testing.T) {
+	_, err := remote.NewRemoteBlsSigner("unix://"+filepath.Join(t.TempDir(), "missing.sock"), 100*time.Millisecond, nil)
+	require.Error(t, err)
+	_, err = remote.NewRemoteBlsSigner("udp://127.0.0.1:26659", 100*time.Millisecond, nil)
+	require.Error(t, err)
+}
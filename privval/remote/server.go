@@ -0,0 +1,131 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtnet "github.com/cometbft/cometbft/libs/net"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonchain/babylon/privval"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// SignerServer serves the BlsSigner service with the BLS key of a local
+// wrapped file private validator, with double-sign protection by the sign
+// state
+type SignerServer struct {
+	mu        sync.Mutex
+	pv        *privval.WrappedFilePV
+	signState *SignState
+}
+
+var _ BlsSignerServer = &SignerServer{}
+
+// NewSignerServer returns a new signer server signing with the given wrapped
+// file private validator and protected by the given sign state
+func NewSignerServer(pv *privval.WrappedFilePV, signState *SignState) *SignerServer {
+	return &SignerServer{
+		pv:        pv,
+		signState: signState,
+	}
+}
+
+// GetPubKeys returns the address and the public keys of the validator
+func (s *SignerServer) GetPubKeys(_ context.Context, _ *GetPubKeysRequest) (*GetPubKeysResponse, error) {
+	blsPubKey, err := s.pv.GetBlsPubkey()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	valPubKey, err := s.pv.GetValidatorPubkey()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	valPubKeyProto, err := cryptoenc.PubKeyToProto(valPubKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &GetPubKeysResponse{
+		ValidatorAddress: s.pv.GetAddress(),
+		BlsPubKey:        blsPubKey,
+		ValidatorPubKey:  &valPubKeyProto,
+	}, nil
+}
+
+// SignCheckpoint signs a BLS signature over the given epoch and block hash,
+// unless it conflicts with the last signed checkpoint
+func (s *SignerServer) SignCheckpoint(_ context.Context, req *SignCheckpointRequest) (*SignCheckpointResponse, error) {
+	var blockHash checkpointingtypes.BlockHash
+	if err := blockHash.Unmarshal(req.BlockHash); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	signed, err := s.signState.CheckCheckpoint(req.EpochNum, blockHash)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if signed {
+		// the same checkpoint has been signed, return the same signature
+		return &SignCheckpointResponse{BlsSig: s.signState.BlsSig}, nil
+	}
+
+	blsSig, err := s.pv.SignBlsCheckpoint(req.EpochNum, blockHash)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	// persist the sign state before releasing the signature
+	if err := s.signState.Update(req.EpochNum, blockHash, blsSig); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to persist BLS sign state: %v", err))
+	}
+	return &SignCheckpointResponse{BlsSig: blsSig}, nil
+}
+
+// Listen listens at the given address, which is either unix://<path> or
+// tcp://<host>:<port>. A unix socket is only accessible to the owner of the
+// signer process. A TCP address is only allowed if the gRPC server requires
+// mutual TLS, i.e., tlsConfig is not nil.
+func Listen(addr string, tlsConfig *tls.Config) (net.Listener, error) {
+	protocol, address := cmtnet.ProtocolAndAddress(addr)
+	switch protocol {
+	case "unix":
+		lis, err := net.Listen(protocol, address)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.Chmod(address, 0600); err != nil {
+			_ = lis.Close()
+			return nil, fmt.Errorf("failed to restrict access to BLS signer socket: %w", err)
+		}
+		return lis, nil
+	case "tcp":
+		if tlsConfig == nil {
+			return nil, ErrInsecureTCP
+		}
+		return net.Listen(protocol, address)
+	default:
+		return nil, fmt.Errorf("unsupported protocol %q of BLS signer address", protocol)
+	}
+}
+
+// NewGRPCServer returns a new gRPC server serving the signer server, which
+// requires mutual TLS with the given config unless it is nil
+func (s *SignerServer) NewGRPCServer(tlsConfig *tls.Config) *grpc.Server {
+	var opts []grpc.ServerOption
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	grpcServer := grpc.NewServer(opts...)
+	RegisterBlsSignerServer(grpcServer, s)
+	return grpcServer
+}
@@ -0,0 +1,102 @@
+package remote
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/libs/tempfile"
+)
+
+var (
+	// ErrDoubleSign is returned upon a request to sign another block hash for
+	// an epoch that has been signed
+	ErrDoubleSign = errors.New("refusing to sign another block hash for a signed epoch")
+	// ErrEpochRegression is returned upon a request to sign an epoch before
+	// the last signed epoch
+	ErrEpochRegression = errors.New("refusing to sign an epoch before the last signed epoch")
+)
+
+// SignState is the last checkpoint signed by a BLS signer. It is persisted
+// before the signature is released, so that the signer never signs two
+// different block hashes for the same epoch, even across restarts.
+type SignState struct {
+	EpochNum  uint64            `json:"epoch_num"`
+	BlockHash cmtbytes.HexBytes `json:"block_hash,omitempty"`
+	BlsSig    cmtbytes.HexBytes `json:"bls_sig,omitempty"`
+
+	filePath string
+}
+
+// LoadOrGenSignState loads the sign state from the given file path, or
+// creates an empty sign state and saves it to the file path if the file
+// does not exist
+func LoadOrGenSignState(filePath string) (*SignState, error) {
+	filePath = filepath.Clean(filePath)
+	if !cmtos.FileExists(filePath) {
+		ss := &SignState{filePath: filePath}
+		if err := ss.Save(); err != nil {
+			return nil, err
+		}
+		return ss, nil
+	}
+
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	ss := &SignState{}
+	if err := cmtjson.Unmarshal(bz, ss); err != nil {
+		return nil, fmt.Errorf("error reading BLS sign state from %v: %w", filePath, err)
+	}
+	ss.filePath = filePath
+	return ss, nil
+}
+
+// CheckCheckpoint returns whether the checkpoint of the given epoch and
+// block hash is the last signed one, in which case the signature can be
+// returned again. It returns an error if signing the checkpoint would
+// conflict with the last signed one.
+func (ss *SignState) CheckCheckpoint(epochNum uint64, blockHash []byte) (bool, error) {
+	if len(ss.BlsSig) == 0 {
+		// nothing has been signed yet
+		return false, nil
+	}
+	switch {
+	case epochNum < ss.EpochNum:
+		return false, fmt.Errorf("%w: epoch %d, last signed epoch %d", ErrEpochRegression, epochNum, ss.EpochNum)
+	case epochNum == ss.EpochNum:
+		if !bytes.Equal(blockHash, ss.BlockHash) {
+			return false, fmt.Errorf("%w: epoch %d, block hash %X, signed block hash %X", ErrDoubleSign, epochNum, blockHash, ss.BlockHash)
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// Update records the given signature over the checkpoint of the given
+// epoch and block hash as the last signed one and persists it
+func (ss *SignState) Update(epochNum uint64, blockHash []byte, blsSig []byte) error {
+	ss.EpochNum = epochNum
+	ss.BlockHash = blockHash
+	ss.BlsSig = blsSig
+	return ss.Save()
+}
+
+// Save persists the sign state to its file path
+func (ss *SignState) Save() error {
+	if ss.filePath == "" {
+		return errors.New("cannot save BLS sign state: filePath not set")
+	}
+	bz, err := cmtjson.MarshalIndent(ss, "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(ss.filePath, bz, 0600)
+}
@@ -0,0 +1,87 @@
+package remote
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrInsecureTCP is returned upon a TCP address of a BLS signer without
+// mutual TLS, as anyone able to reach the address could otherwise request
+// signatures and advance the sign state of the signer
+var ErrInsecureTCP = errors.New("a TCP address of the BLS signer requires mutual TLS")
+
+// TLSFiles are the PEM files of the mutual TLS between a node and its remote
+// BLS signer. Each side presents the certificate in CertFile with the key in
+// KeyFile, and only accepts certificates of the other side signed by the CA
+// in CAFile.
+type TLSFiles struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+}
+
+// IsEmpty returns whether none of the files is set, i.e., mutual TLS is
+// disabled
+func (f TLSFiles) IsEmpty() bool {
+	return f.CertFile == "" && f.KeyFile == "" && f.CAFile == ""
+}
+
+// load loads the certificate and the CA pool from the files
+func (f TLSFiles) load() (tls.Certificate, *x509.CertPool, error) {
+	if f.CertFile == "" || f.KeyFile == "" || f.CAFile == "" {
+		return tls.Certificate{}, nil, errors.New("the certificate, the key and the CA files of mutual TLS have to be set together")
+	}
+	cert, err := tls.LoadX509KeyPair(f.CertFile, f.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	caPEM, err := os.ReadFile(f.CAFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to read TLS CA: %w", err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPEM) {
+		return tls.Certificate{}, nil, fmt.Errorf("no valid certificate in TLS CA file %s", f.CAFile)
+	}
+	return cert, caPool, nil
+}
+
+// NewServerTLSConfig returns the TLS config of a BLS signer, which requires
+// clients to present a certificate signed by the CA. It returns nil if the
+// files are empty.
+func NewServerTLSConfig(files TLSFiles) (*tls.Config, error) {
+	if files.IsEmpty() {
+		return nil, nil
+	}
+	cert, caPool, err := files.load()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    caPool,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// NewClientTLSConfig returns the TLS config of a node connecting to a BLS
+// signer, which presents the certificate of the node and only accepts a
+// signer certificate signed by the CA. It returns nil if the files are empty.
+func NewClientTLSConfig(files TLSFiles) (*tls.Config, error) {
+	if files.IsEmpty() {
+		return nil, nil
+	}
+	cert, caPool, err := files.load()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package remote_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/privval"
+	"github.com/babylonchain/babylon/privval/remote"
+)
+
+// testCA is a CA issuing certificates for mutual TLS in tests
+type testCA struct {
+	dir  string
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	// caFile is the PEM file of the CA certificate
+	caFile string
+}
+
+func newTestCA(t *testing.T, dir string, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	caFile := filepath.Join(dir, name+".crt")
+	writePEM(t, caFile, "CERTIFICATE", der)
+	return &testCA{dir: dir, cert: cert, key: key, caFile: caFile}
+}
+
+// issue issues a certificate for 127.0.0.1 of the given name, and returns
+// the PEM files of mutual TLS with the certificate and the given CA file
+func (ca *testCA) issue(t *testing.T, name string, caFile string) remote.TLSFiles {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	files := remote.TLSFiles{
+		CertFile: filepath.Join(ca.dir, name+".crt"),
+		KeyFile:  filepath.Join(ca.dir, name+".key"),
+		CAFile:   caFile,
+	}
+	writePEM(t, files.CertFile, "CERTIFICATE", der)
+	writePEM(t, files.KeyFile, "EC PRIVATE KEY", keyDER)
+	return files
+}
+
+func writePEM(t *testing.T, path string, blockType string, der []byte) {
+	err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600)
+	require.NoError(t, err)
+}
+
+func TestRemoteBlsSignerMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, cmtos.EnsureDir(filepath.Join(dir, "config"), 0777))
+	pv := privval.GenWrappedFilePV(filepath.Join(dir, "config", "priv_validator_key.json"), filepath.Join(dir, "priv_validator_state.json"))
+	signState, err := remote.LoadOrGenSignState(filepath.Join(dir, "bls_signer_state.json"))
+	require.NoError(t, err)
+
+	ca := newTestCA(t, dir, "ca")
+	signerFiles := ca.issue(t, "signer", ca.caFile)
+	nodeFiles := ca.issue(t, "node", ca.caFile)
+	otherCA := newTestCA(t, dir, "other-ca")
+	otherNodeFiles := otherCA.issue(t, "other-node", ca.caFile)
+
+	// a TCP address requires mutual TLS
+	_, err = remote.Listen("tcp://127.0.0.1:0", nil)
+	require.ErrorIs(t, err, remote.ErrInsecureTCP)
+	_, err = remote.NewRemoteBlsSigner("tcp://127.0.0.1:26659", 100*time.Millisecond, nil)
+	require.ErrorIs(t, err, remote.ErrInsecureTCP)
+	// the files of mutual TLS have to be set together
+	_, err = remote.NewServerTLSConfig(remote.TLSFiles{CertFile: signerFiles.CertFile})
+	require.Error(t, err)
+
+	serverTLSConfig, err := remote.NewServerTLSConfig(signerFiles)
+	require.NoError(t, err)
+	lis, err := remote.Listen("tcp://127.0.0.1:0", serverTLSConfig)
+	require.NoError(t, err)
+	grpcServer := remote.NewSignerServer(pv, signState).NewGRPCServer(serverTLSConfig)
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+	addr := "tcp://" + lis.Addr().String()
+
+	// a node with a certificate signed by the CA can connect
+	clientTLSConfig, err := remote.NewClientTLSConfig(nodeFiles)
+	require.NoError(t, err)
+	signer, err := remote.NewRemoteBlsSigner(addr, 5*time.Second, clientTLSConfig)
+	require.NoError(t, err)
+	require.NoError(t, signer.Close())
+
+	// a node with a certificate signed by another CA is rejected
+	otherTLSConfig, err := remote.NewClientTLSConfig(otherNodeFiles)
+	require.NoError(t, err)
+	_, err = remote.NewRemoteBlsSigner(addr, time.Second, otherTLSConfig)
+	require.Error(t, err)
+}
+
+func TestListenUnixSocketPermissions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bls_signer.sock")
+	lis, err := remote.Listen("unix://"+path, nil)
+	require.NoError(t, err)
+	defer lis.Close()
+
+	// the unix socket is only accessible to its owner
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
@@ -4,6 +4,7 @@ import (
 	"math/rand"
 
 	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/babylonchain/babylon/crypto/pubrand"
 	bbn "github.com/babylonchain/babylon/types"
 	ftypes "github.com/babylonchain/babylon/x/finality/types"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -22,6 +23,7 @@ type RandListInfo struct {
 func GenRandomPubRandList(r *rand.Rand, numPubRand uint64) (*RandListInfo, error) {
 	// generate a list of secret/public randomness
 	srList := []*eots.PrivateRand{}
+	eotsPRList := []*eots.PublicRand{}
 	prList := []bbn.SchnorrPubRand{}
 	for i := uint64(0); i < numPubRand; i++ {
 		eotsSR, eotsPR, err := eots.RandGen(r)
@@ -30,16 +32,12 @@ func GenRandomPubRandList(r *rand.Rand, numPubRand uint64) (*RandListInfo, error
 		}
 		pr := bbn.NewSchnorrPubRandFromFieldVal(eotsPR)
 		srList = append(srList, eotsSR)
+		eotsPRList = append(eotsPRList, eotsPR)
 		prList = append(prList, *pr)
 	}
 
-	prByteList := [][]byte{}
-	for i := range prList {
-		prByteList = append(prByteList, prList[i])
-	}
-
 	// generate the commitment to these public randomness
-	commitment, proofList := merkle.ProofsFromByteSlices(prByteList)
+	commitment, proofList := pubrand.Commit(eotsPRList)
 
 	return &RandListInfo{srList, prList, commitment, proofList}, nil
 }
@@ -22,7 +22,7 @@ import (
 	"github.com/babylonchain/babylon/x/finality/types"
 )
 
-func FinalityKeeper(t testing.TB, bsKeeper types.BTCStakingKeeper, iKeeper types.IncentiveKeeper) (*keeper.Keeper, sdk.Context) {
+func FinalityKeeper(t testing.TB, bsKeeper types.BTCStakingKeeper, iKeeper types.IncentiveKeeper, eKeeper types.EpochingKeeper) (*keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -38,6 +38,8 @@ func FinalityKeeper(t testing.TB, bsKeeper types.BTCStakingKeeper, iKeeper types
 		runtime.NewKVStoreService(storeKey),
 		bsKeeper,
 		iKeeper,
+		eKeeper,
+		stateStore.(storetypes.Queryable),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -8,8 +8,9 @@ import (
 	reflect "reflect"
 
 	bls12381 "github.com/babylonchain/babylon/crypto/bls12381"
+	types "github.com/babylonchain/babylon/x/checkpointing/types"
 	crypto "github.com/cometbft/cometbft/crypto"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types0 "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -37,10 +38,10 @@ func (m *MockBlsSigner) EXPECT() *MockBlsSignerMockRecorder {
 }
 
 // GetAddress mocks base method.
-func (m *MockBlsSigner) GetAddress() types.ValAddress {
+func (m *MockBlsSigner) GetAddress() types0.ValAddress {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAddress")
-	ret0, _ := ret[0].(types.ValAddress)
+	ret0, _ := ret[0].(types0.ValAddress)
 	return ret0
 }
 
@@ -80,17 +81,17 @@ func (mr *MockBlsSignerMockRecorder) GetValidatorPubkey() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorPubkey", reflect.TypeOf((*MockBlsSigner)(nil).GetValidatorPubkey))
 }
 
-// SignMsgWithBls mocks base method.
-func (m *MockBlsSigner) SignMsgWithBls(msg []byte) (bls12381.Signature, error) {
+// SignBlsCheckpoint mocks base method.
+func (m *MockBlsSigner) SignBlsCheckpoint(epochNum uint64, blockHash types.BlockHash) (bls12381.Signature, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SignMsgWithBls", msg)
+	ret := m.ctrl.Call(m, "SignBlsCheckpoint", epochNum, blockHash)
 	ret0, _ := ret[0].(bls12381.Signature)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SignMsgWithBls indicates an expected call of SignMsgWithBls.
-func (mr *MockBlsSignerMockRecorder) SignMsgWithBls(msg interface{}) *gomock.Call {
+// SignBlsCheckpoint indicates an expected call of SignBlsCheckpoint.
+func (mr *MockBlsSignerMockRecorder) SignBlsCheckpoint(epochNum, blockHash interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMsgWithBls", reflect.TypeOf((*MockBlsSigner)(nil).SignMsgWithBls), msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignBlsCheckpoint", reflect.TypeOf((*MockBlsSigner)(nil).SignBlsCheckpoint), epochNum, blockHash)
 }
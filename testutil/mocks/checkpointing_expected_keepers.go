@@ -11,8 +11,9 @@ import (
 	types "github.com/babylonchain/babylon/x/checkpointing/types"
 	types0 "github.com/babylonchain/babylon/x/epoching/types"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
-	types1 "github.com/cosmos/cosmos-sdk/types"
-	types2 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types1 "github.com/cosmos/cosmos-sdk/crypto/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
+	types3 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -40,7 +41,7 @@ func (m *MockEpochingKeeper) EXPECT() *MockEpochingKeeperMockRecorder {
 }
 
 // CheckMsgCreateValidator mocks base method.
-func (m *MockEpochingKeeper) CheckMsgCreateValidator(ctx context.Context, msg *types2.MsgCreateValidator) error {
+func (m *MockEpochingKeeper) CheckMsgCreateValidator(ctx context.Context, msg *types3.MsgCreateValidator) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CheckMsgCreateValidator", ctx, msg)
 	ret0, _ := ret[0].(error)
@@ -80,7 +81,7 @@ func (mr *MockEpochingKeeperMockRecorder) GetEpoch(ctx interface{}) *gomock.Call
 }
 
 // GetPubKeyByConsAddr mocks base method.
-func (m *MockEpochingKeeper) GetPubKeyByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (crypto.PublicKey, error) {
+func (m *MockEpochingKeeper) GetPubKeyByConsAddr(ctx context.Context, consAddr types2.ConsAddress) (crypto.PublicKey, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPubKeyByConsAddr", ctx, consAddr)
 	ret0, _ := ret[0].(crypto.PublicKey)
@@ -108,6 +109,21 @@ func (mr *MockEpochingKeeperMockRecorder) GetTotalVotingPower(ctx, epochNumber i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalVotingPower", reflect.TypeOf((*MockEpochingKeeper)(nil).GetTotalVotingPower), ctx, epochNumber)
 }
 
+// GetValidatorConsPubKey mocks base method.
+func (m *MockEpochingKeeper) GetValidatorConsPubKey(ctx context.Context, valAddr types2.ValAddress) (types1.PubKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorConsPubKey", ctx, valAddr)
+	ret0, _ := ret[0].(types1.PubKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorConsPubKey indicates an expected call of GetValidatorConsPubKey.
+func (mr *MockEpochingKeeperMockRecorder) GetValidatorConsPubKey(ctx, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorConsPubKey", reflect.TypeOf((*MockEpochingKeeper)(nil).GetValidatorConsPubKey), ctx, valAddr)
+}
+
 // GetValidatorSet mocks base method.
 func (m *MockEpochingKeeper) GetValidatorSet(ctx context.Context, epochNumer uint64) types0.ValidatorSet {
 	m.ctrl.T.Helper()
@@ -146,7 +162,7 @@ func (m *MockCheckpointingHooks) EXPECT() *MockCheckpointingHooksMockRecorder {
 }
 
 // AfterBlsKeyRegistered mocks base method.
-func (m *MockCheckpointingHooks) AfterBlsKeyRegistered(ctx context.Context, valAddr types1.ValAddress) error {
+func (m *MockCheckpointingHooks) AfterBlsKeyRegistered(ctx context.Context, valAddr types2.ValAddress) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AfterBlsKeyRegistered", ctx, valAddr)
 	ret0, _ := ret[0].(error)
@@ -159,6 +175,20 @@ func (mr *MockCheckpointingHooksMockRecorder) AfterBlsKeyRegistered(ctx, valAddr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBlsKeyRegistered", reflect.TypeOf((*MockCheckpointingHooks)(nil).AfterBlsKeyRegistered), ctx, valAddr)
 }
 
+// AfterBlsKeyRotated mocks base method.
+func (m *MockCheckpointingHooks) AfterBlsKeyRotated(ctx context.Context, valAddr types2.ValAddress, epoch uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterBlsKeyRotated", ctx, valAddr, epoch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterBlsKeyRotated indicates an expected call of AfterBlsKeyRotated.
+func (mr *MockCheckpointingHooksMockRecorder) AfterBlsKeyRotated(ctx, valAddr, epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBlsKeyRotated", reflect.TypeOf((*MockCheckpointingHooks)(nil).AfterBlsKeyRotated), ctx, valAddr, epoch)
+}
+
 // AfterRawCheckpointBlsSigVerified mocks base method.
 func (m *MockCheckpointingHooks) AfterRawCheckpointBlsSigVerified(ctx context.Context, ckpt *types.RawCheckpoint) error {
 	m.ctrl.T.Helper()
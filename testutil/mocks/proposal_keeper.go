@@ -39,19 +39,19 @@ func (m *MockCheckpointingKeeper) EXPECT() *MockCheckpointingKeeperMockRecorder
 	return m.recorder
 }
 
-// GetBlsPubKey mocks base method.
-func (m *MockCheckpointingKeeper) GetBlsPubKey(ctx context.Context, address types1.ValAddress) (bls12381.PublicKey, error) {
+// GetDecompressedBlsPubKeyAtEpoch mocks base method.
+func (m *MockCheckpointingKeeper) GetDecompressedBlsPubKeyAtEpoch(ctx context.Context, address types1.ValAddress, epochNumber uint64) (*bls12381.BlsPubKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlsPubKey", ctx, address)
-	ret0, _ := ret[0].(bls12381.PublicKey)
+	ret := m.ctrl.Call(m, "GetDecompressedBlsPubKeyAtEpoch", ctx, address, epochNumber)
+	ret0, _ := ret[0].(*bls12381.BlsPubKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBlsPubKey indicates an expected call of GetBlsPubKey.
-func (mr *MockCheckpointingKeeperMockRecorder) GetBlsPubKey(ctx, address interface{}) *gomock.Call {
+// GetDecompressedBlsPubKeyAtEpoch indicates an expected call of GetDecompressedBlsPubKeyAtEpoch.
+func (mr *MockCheckpointingKeeperMockRecorder) GetDecompressedBlsPubKeyAtEpoch(ctx, address, epochNumber interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlsPubKey", reflect.TypeOf((*MockCheckpointingKeeper)(nil).GetBlsPubKey), ctx, address)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecompressedBlsPubKeyAtEpoch", reflect.TypeOf((*MockCheckpointingKeeper)(nil).GetDecompressedBlsPubKeyAtEpoch), ctx, address, epochNumber)
 }
 
 // GetEpoch mocks base method.
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"math/big"
 
 	"github.com/btcsuite/btcd/chaincfg"
@@ -35,18 +36,28 @@ func getParams(opts servertypes.AppOptions) *chaincfg.Params {
 		panic("Bitcoin network config should be valid string")
 	}
 
-	if network == string(BtcMainnet) {
-		return &chaincfg.MainNetParams
-	} else if network == string(BtcTestnet) {
-		return &chaincfg.TestNet3Params
-	} else if network == string(BtcSimnet) {
-		return &chaincfg.SimNetParams
-	} else if network == string(BtcRegtest) {
-		return &chaincfg.RegressionNetParams
-	} else if network == string(BtcSignet) {
-		return &chaincfg.SigNetParams
-	} else {
-		panic("Bitcoin network should be one of [mainet, testnet, simnet, regtest, signet]")
+	btcNetParams, err := NetParamsFromString(network)
+	if err != nil {
+		panic(err.Error())
+	}
+	return btcNetParams
+}
+
+// NetParamsFromString returns the parameters of the given Bitcoin network
+func NetParamsFromString(network string) (*chaincfg.Params, error) {
+	switch SupportedBtcNetwork(network) {
+	case BtcMainnet:
+		return &chaincfg.MainNetParams, nil
+	case BtcTestnet:
+		return &chaincfg.TestNet3Params, nil
+	case BtcSimnet:
+		return &chaincfg.SimNetParams, nil
+	case BtcRegtest:
+		return &chaincfg.RegressionNetParams, nil
+	case BtcSignet:
+		return &chaincfg.SigNetParams, nil
+	default:
+		return nil, errors.New("Bitcoin network should be one of [mainet, testnet, simnet, regtest, signet]")
 	}
 }
 
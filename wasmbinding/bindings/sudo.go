@@ -0,0 +1,11 @@
+package bindings
+
+// BabylonSudoMsg is the sudo message sent to the CosmWasm contracts registered
+// for finality hooks
+type BabylonSudoMsg struct {
+	BlockFinalized *BlockFinalized `json:"block_finalized,omitempty"`
+}
+
+type BlockFinalized struct {
+	Height uint64 `json:"height"`
+}
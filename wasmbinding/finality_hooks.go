@@ -0,0 +1,112 @@
+package wasmbinding
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/wasmbinding/bindings"
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
+)
+
+// FinalityHookGasLimit is the gas limit of each sudo call to a contract
+// registered for finality hooks
+const FinalityHookGasLimit = uint64(500_000)
+
+var _ finalitytypes.FinalityHooks = &FinalityHooks{}
+
+// ContractSudoer is the subset of the wasm keeper that calls the sudo entry
+// point of a contract
+type ContractSudoer interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// FinalityParamsKeeper is the subset of the finality keeper that provides
+// the contracts registered for finality hooks
+type FinalityParamsKeeper interface {
+	GetParams(ctx context.Context) finalitytypes.Params
+}
+
+// FinalityHooks notifies the CosmWasm contracts registered in the finality
+// module parameters about finality events via sudo calls
+type FinalityHooks struct {
+	sudoer         ContractSudoer
+	finalityKeeper FinalityParamsKeeper
+}
+
+// NewFinalityHooks returns a reference to a new FinalityHooks.
+func NewFinalityHooks(sudoer ContractSudoer, finalityKeeper FinalityParamsKeeper) *FinalityHooks {
+	return &FinalityHooks{
+		sudoer:         sudoer,
+		finalityKeeper: finalityKeeper,
+	}
+}
+
+// AfterSluggishFinalityProviderDetected does nothing as contracts are not
+// notified about sluggish finality providers
+func (h *FinalityHooks) AfterSluggishFinalityProviderDetected(ctx context.Context, btcPk *bbn.BIP340PubKey) error {
+	return nil
+}
+
+// AfterBlockFinalized notifies each registered contract that the block at the
+// given height is finalized. A failing contract does not affect the other
+// contracts nor the finalization of the block.
+func (h *FinalityHooks) AfterBlockFinalized(ctx context.Context, height uint64) error {
+	contracts := h.finalityKeeper.GetParams(ctx).FinalityHookContracts
+	if len(contracts) == 0 {
+		return nil
+	}
+
+	msg, err := json.Marshal(bindings.BabylonSudoMsg{
+		BlockFinalized: &bindings.BlockFinalized{Height: height},
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "failed marshaling")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, contract := range contracts {
+		// the addresses are validated in the finality module parameters
+		contractAddr := sdk.MustAccAddressFromBech32(contract)
+		if err := h.sudo(sdkCtx, contractAddr, msg); err != nil {
+			sdkCtx.Logger().Error(
+				"failed to notify contract about finalized block",
+				"contract", contract,
+				"height", height,
+				"error", err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// sudo calls the sudo entry point of the given contract in a cached context
+// with a limited gas meter, and commits the state changes only if the call
+// succeeds
+func (h *FinalityHooks) sudo(ctx sdk.Context, contractAddr sdk.AccAddress, msg []byte) (err error) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(FinalityHookGasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", outOfGas.Descriptor)
+		}
+	}()
+
+	if _, err := h.sudoer.Sudo(cacheCtx, contractAddr, msg); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
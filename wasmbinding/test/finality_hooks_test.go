@@ -0,0 +1,69 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/stretchr/testify/require"
+
+	owasm "github.com/babylonchain/babylon/wasmbinding"
+	"github.com/babylonchain/babylon/wasmbinding/bindings"
+)
+
+// pathToRecorderContract is the path to a contract that records the last
+// sudo message it receives under the key "sudo" of its storage
+const pathToRecorderContract = "../testdata/finality_hooks/recorder.wasm"
+
+func TestFinalityHooksWithFailingContract(t *testing.T) {
+	acc := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+	fundAccount(t, ctx, babylonApp, acc)
+
+	// the test contract does not implement the sudo entry point, so that
+	// notifying it about a finalized block fails
+	contractAddress := deployTestContract(t, ctx, babylonApp, acc, pathToContract)
+	params := babylonApp.FinalityKeeper.GetParams(ctx)
+	params.FinalityHookContracts = []string{contractAddress.String()}
+	err := babylonApp.FinalityKeeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	hooks := owasm.NewFinalityHooks(keeper.NewDefaultPermissionKeeper(babylonApp.WasmKeeper), &babylonApp.FinalityKeeper)
+	err = hooks.AfterBlockFinalized(ctx, 1)
+	require.NoError(t, err)
+}
+
+func TestFinalityHooksWithRecorderContract(t *testing.T) {
+	acc := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+	fundAccount(t, ctx, babylonApp, acc)
+
+	// a failing contract is notified before the recorder contract, and does
+	// not prevent the recorder contract from being notified
+	failingContractAddress := deployTestContract(t, ctx, babylonApp, acc, pathToContract)
+	recorderContractAddress := deployTestContract(t, ctx, babylonApp, acc, pathToRecorderContract)
+	params := babylonApp.FinalityKeeper.GetParams(ctx)
+	params.FinalityHookContracts = []string{failingContractAddress.String(), recorderContractAddress.String()}
+	err := babylonApp.FinalityKeeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// the recorder contract has not received any sudo message yet
+	require.Nil(t, babylonApp.WasmKeeper.QueryRaw(ctx, recorderContractAddress, []byte("sudo")))
+
+	hooks := owasm.NewFinalityHooks(keeper.NewDefaultPermissionKeeper(babylonApp.WasmKeeper), &babylonApp.FinalityKeeper)
+	for _, height := range []uint64{1, 2} {
+		err = hooks.AfterBlockFinalized(ctx, height)
+		require.NoError(t, err)
+
+		// the sudo message is recorded in the committed state of the contract
+		recorded := babylonApp.WasmKeeper.QueryRaw(ctx, recorderContractAddress, []byte("sudo"))
+		require.NotNil(t, recorded)
+		var msg bindings.BabylonSudoMsg
+		err = json.Unmarshal(recorded, &msg)
+		require.NoError(t, err)
+		require.NotNil(t, msg.BlockFinalized)
+		require.Equal(t, height, msg.BlockFinalized.Height)
+		require.JSONEq(t, fmt.Sprintf(`{"block_finalized":{"height":%d}}`, height), string(recorded))
+	}
+}
@@ -0,0 +1,59 @@
+;; A minimal CosmWasm contract that records the last sudo message it receives
+;; under the key "sudo" of its storage, so that tests can check the sudo
+;; messages sent by the finality hooks.
+;;
+;; Build the artifact with `wat2wasm recorder.wat -o recorder.wasm`.
+(module
+  (type $db_write_t (func (param i32 i32)))
+  (type $allocate_t (func (param i32) (result i32)))
+  (type $deallocate_t (func (param i32)))
+  (type $instantiate_t (func (param i32 i32 i32) (result i32)))
+  (type $sudo_t (func (param i32 i32) (result i32)))
+  (type $interface_version_t (func))
+
+  (import "env" "db_write" (func $db_write (type $db_write_t)))
+
+  (memory (export "memory") 1)
+
+  ;; bump allocator that never frees memory
+  (global $heap (mut i32) (i32.const 1024))
+
+  (func (export "interface_version_8") (type $interface_version_t))
+
+  ;; allocate returns a region {offset, capacity, length} followed by
+  ;; capacity bytes of memory
+  (func (export "allocate") (type $allocate_t) (param $size i32) (result i32)
+    (local $region i32)
+    (local $end i32)
+    (local.set $region (global.get $heap))
+    (global.set $heap
+      (local.tee $end
+        (i32.add (i32.add (local.get $region) (i32.const 12)) (local.get $size))))
+    (if (i32.gt_u (local.get $end) (i32.shl (memory.size) (i32.const 16)))
+      (then
+        (drop (memory.grow
+          (i32.sub
+            (i32.add (i32.shr_u (local.get $end) (i32.const 16)) (i32.const 1))
+            (memory.size))))))
+    (i32.store offset=0 (local.get $region) (i32.add (local.get $region) (i32.const 12)))
+    (i32.store offset=4 (local.get $region) (local.get $size))
+    (i32.store offset=8 (local.get $region) (i32.const 0))
+    (local.get $region))
+
+  (func (export "deallocate") (type $deallocate_t) (param $region i32))
+
+  (func (export "instantiate") (type $instantiate_t)
+    (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    (i32.const 12))
+
+  (func (export "sudo") (type $sudo_t) (param $env i32) (param $msg i32) (result i32)
+    (call $db_write (i32.const 0) (local.get $msg))
+    (i32.const 12))
+
+  ;; region of the storage key
+  (data (i32.const 0) "\20\00\00\00\04\00\00\00\04\00\00\00")
+  ;; region of the empty response
+  (data (i32.const 12) "\40\00\00\00\32\00\00\00\32\00\00\00")
+  (data (i32.const 32) "sudo")
+  (data (i32.const 64) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[]}}")
+)
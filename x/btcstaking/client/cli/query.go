@@ -31,6 +31,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdActivatedHeight())
 	cmd.AddCommand(CmdFinalityProviderDelegations())
 	cmd.AddCommand(CmdDelegation())
+	cmd.AddCommand(CmdCovenantRotation())
+	cmd.AddCommand(CmdRetiringCovenantDelegations())
+	cmd.AddCommand(CmdSlashingTxs())
+	cmd.AddCommand(CmdSlashingRecord())
+	cmd.AddCommand(CmdSlashingRecords())
 
 	return cmd
 }
@@ -285,3 +290,145 @@ func CmdFinalityProviderDelegations() *cobra.Command {
 
 	return cmd
 }
+
+func CmdCovenantRotation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-rotation",
+		Short: "retrieve the scheduled covenant committee rotation",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantRotation(cmd.Context(), &types.QueryCovenantRotationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRetiringCovenantDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retiring-covenant-delegations",
+		Short: "retrieve all pending BTC delegations waiting for signatures from a retiring covenant committee",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RetiringCovenantDelegations(cmd.Context(), &types.QueryRetiringCovenantDelegationsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "retiring-covenant-delegations")
+
+	return cmd
+}
+
+func CmdSlashingTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-txs [fp_btc_pk_hex]",
+		Short: "retrieve the fully signed slashing txs of all BTC delegations under a slashed finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SlashingTxs(cmd.Context(), &types.QuerySlashingTxsRequest{
+				FpBtcPkHex: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slashing-txs")
+
+	return cmd
+}
+
+func CmdSlashingRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-record [staking_tx_hash_hex]",
+		Short: "retrieve the slashing record of a slashed BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashingRecord(cmd.Context(), &types.QuerySlashingRecordRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSlashingRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-records",
+		Short: "retrieve the slashing records of all slashed BTC delegations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SlashingRecords(cmd.Context(), &types.QuerySlashingRecordsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slashing-records")
+
+	return cmd
+}
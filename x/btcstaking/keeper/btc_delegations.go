@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/btcstaking"
 	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
@@ -117,7 +118,9 @@ func (k Keeper) btcUndelegate(
 	btcDel *types.BTCDelegation,
 	unbondingTxSig *bbn.BIP340Signature,
 ) {
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
 	btcDel.BtcUndelegation.DelegatorUnbondingSig = unbondingTxSig
+	btcDel.BtcUndelegation.UnbondingBtcHeight = btcTip.Height
 	k.setBTCDelegation(ctx, btcDel)
 
 	// notify subscriber about this unbonded BTC delegation
@@ -130,9 +133,12 @@ func (k Keeper) btcUndelegate(
 		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new unbonded BTC delegation: %w", err))
 	}
 
+	if err := k.hooks.AfterBTCDelegationUnbonded(ctx, btcDel); err != nil {
+		panic(fmt.Errorf("failed to execute after BTC delegation %s unbonded: %w", event.StakingTxHash, err))
+	}
+
 	// record event that the BTC delegation becomes unbonded at this height
 	unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
-	btcTip := k.btclcKeeper.GetTipInfo(ctx)
 	k.addPowerDistUpdateEvent(ctx, btcTip.Height, unbondedEvent)
 }
 
@@ -158,6 +164,28 @@ func (k Keeper) GetBTCDelegation(ctx context.Context, stakingTxHashStr string) (
 	return btcDel, nil
 }
 
+// VerifyCovenantAdaptorSigEnvelope verifies the given adaptor signature
+// envelope against the BTC delegation it specifies, i.e., the envelope must be
+// for the Bitcoin network Babylon operates on, be signed by a covenant member
+// of the BTC delegation's params, be encrypted by a finality provider the BTC
+// delegation restakes to, and commit to the BTC delegation's slashing tx or
+// unbonding slashing tx as specified by the envelope
+func (k Keeper) VerifyCovenantAdaptorSigEnvelope(ctx context.Context, env *btcstaking.AdaptorSigEnvelope) error {
+	if env == nil {
+		return types.ErrInvalidAdaptorSigEnvelope.Wrap("envelope is nil")
+	}
+	btcDel := k.getBTCDelegation(ctx, env.StakingTxHash)
+	if btcDel == nil {
+		return types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", env.StakingTxHash)
+	}
+	bsParams := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if bsParams == nil {
+		panic("params version in BTC delegation is not found")
+	}
+
+	return btcDel.VerifyCovenantAdaptorSigEnvelope(bsParams, k.btcNet, env)
+}
+
 func (k Keeper) getBTCDelegation(ctx context.Context, stakingTxHash chainhash.Hash) *types.BTCDelegation {
 	store := k.btcDelegationStore(ctx)
 	btcDelBytes := store.Get(stakingTxHash[:])
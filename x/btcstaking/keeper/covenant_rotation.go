@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// SetCovenantRotation schedules the given covenant committee rotation,
+// replacing any rotation that is not in effect yet
+func (k Keeper) SetCovenantRotation(ctx context.Context, rotation *types.CovenantRotation) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(rotation)
+	return store.Set(types.CovenantRotationKey, bz)
+}
+
+// GetCovenantRotation returns the scheduled covenant committee rotation, or
+// nil if there is none
+func (k Keeper) GetCovenantRotation(ctx context.Context) *types.CovenantRotation {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.CovenantRotationKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var rotation types.CovenantRotation
+	k.cdc.MustUnmarshal(bz, &rotation)
+	return &rotation
+}
+
+func (k Keeper) removeCovenantRotation(ctx context.Context) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.CovenantRotationKey); err != nil {
+		panic(err)
+	}
+}
+
+// ApplyCovenantRotation stores a new version of parameters carrying the new
+// covenant committee if the BTC tip has reached the activation height of the
+// scheduled rotation. BTC delegations created under past parameter versions
+// are still verified against the committee of their own version, so that the
+// retiring committee can keep signing them until they become active or expire.
+// This is triggered upon each `BeginBlock`
+func (k Keeper) ApplyCovenantRotation(ctx context.Context) {
+	rotation := k.GetCovenantRotation(ctx)
+	if rotation == nil {
+		return
+	}
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil || btcTip.Height < rotation.ActivationBtcHeight {
+		return
+	}
+
+	params := k.GetParams(ctx).ApplyCovenantRotation(rotation)
+	if err := k.SetParams(ctx, params); err != nil {
+		// the rotation is validated against the params upon being scheduled
+		panic(fmt.Errorf("failed to apply covenant committee rotation: %w", err))
+	}
+	k.removeCovenantRotation(ctx)
+
+	event := &types.EventCovenantCommitteeRotated{
+		ParamsVersion:       k.GetParamsWithVersion(ctx).Version,
+		ActivationBtcHeight: rotation.ActivationBtcHeight,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventCovenantCommitteeRotated: %w", err))
+	}
+}
+
+// IsRetiringCovenantDelegation returns whether the given BTC delegation is
+// pending and waits for signatures from a covenant committee that is no longer
+// the current one
+func (k Keeper) IsRetiringCovenantDelegation(
+	ctx context.Context,
+	btcDel *types.BTCDelegation,
+	btcTipHeight uint64,
+	wValue uint64,
+) bool {
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic(fmt.Errorf("params version %d of BTC delegation is not found", btcDel.ParamsVersion))
+	}
+	if btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum) != types.BTCDelegationStatus_PENDING {
+		return false
+	}
+	curParams := k.GetParams(ctx)
+	return !curParams.HasSameCovenantCommittee(params)
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzCovenantRotation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		oldCovenantSKs, _ := h.GenAndApplyParams(r)
+		oldParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert a pending BTC delegation under the old committee
+		stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+		)
+		require.Equal(t, oldParams.Version, actualDel.ParamsVersion)
+		// the old committee signs the BTC delegation before the rotation is applied
+		msgs := h.GenerateCovenantSignaturesMessages(r, oldCovenantSKs, msgCreateBTCDel, actualDel)
+
+		// schedule a covenant committee rotation
+		_, newCovenantPKs, err := datagen.GenRandomBTCKeyPairs(r, 5)
+		require.NoError(t, err)
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		rotation := types.CovenantRotation{
+			CovenantPks:         bbn.NewBIP340PKsFromBTCPKs(newCovenantPKs),
+			CovenantQuorum:      3,
+			ActivationBtcHeight: btcTip.Height + datagen.RandomInt(r, 10) + 1,
+		}
+		authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+		// rotation must be issued by the authority
+		_, err = h.MsgServer.RotateCovenantCommittee(h.Ctx, &types.MsgRotateCovenantCommittee{
+			Authority: datagen.GenRandomAccount().Address,
+			Rotation:  rotation,
+		})
+		require.Error(t, err)
+		// rotation must take effect in the future
+		pastRotation := rotation
+		pastRotation.ActivationBtcHeight = btcTip.Height
+		_, err = h.MsgServer.RotateCovenantCommittee(h.Ctx, &types.MsgRotateCovenantCommittee{
+			Authority: authority,
+			Rotation:  pastRotation,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidCovenantRotation)
+		_, err = h.MsgServer.RotateCovenantCommittee(h.Ctx, &types.MsgRotateCovenantCommittee{
+			Authority: authority,
+			Rotation:  rotation,
+		})
+		require.NoError(t, err)
+		resp, err := h.BTCStakingKeeper.CovenantRotation(h.Ctx, &types.QueryCovenantRotationRequest{})
+		require.NoError(t, err)
+		require.Equal(t, rotation, *resp.Rotation)
+
+		// the rotation does not take effect before the activation height
+		h.SetCtxHeight(1)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, oldParams, h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx))
+
+		// the rotation takes effect at the activation height
+		h.SetCtxHeight(2)
+		newTip := &btclctypes.BTCHeaderInfo{Height: rotation.ActivationBtcHeight}
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(newTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		newParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+		require.Equal(t, oldParams.Version+1, newParams.Version)
+		require.Equal(t, rotation.CovenantPks, newParams.Params.CovenantPks)
+		require.Equal(t, oldParams.Params.SlashingAddress, newParams.Params.SlashingAddress)
+		_, err = h.BTCStakingKeeper.CovenantRotation(h.Ctx, &types.QueryCovenantRotationRequest{})
+		require.ErrorIs(t, err, types.ErrCovenantRotationNotFound)
+
+		// the BTC delegation waits for signatures from the retiring committee
+		retiringResp, err := h.BTCStakingKeeper.RetiringCovenantDelegations(h.Ctx, &types.QueryRetiringCovenantDelegationsRequest{})
+		require.NoError(t, err)
+		require.Len(t, retiringResp.BtcDelegations, 1)
+
+		// the retiring committee can still activate the BTC delegation
+		for i := 0; i < int(oldParams.Params.CovenantQuorum); i++ {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+			h.NoError(err)
+		}
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, actualDel.HasCovenantQuorums(oldParams.Params.CovenantQuorum))
+		retiringResp, err = h.BTCStakingKeeper.RetiringCovenantDelegations(h.Ctx, &types.QueryRetiringCovenantDelegationsRequest{})
+		require.NoError(t, err)
+		require.Empty(t, retiringResp.BtcDelegations)
+	})
+}
@@ -67,24 +67,68 @@ func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	return nil
 }
 
-// RevertSluggishFinalityProvider sets the Sluggish flag of the given finality provider
-// to false
-func (k Keeper) RevertSluggishFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+// JailFinalityProvider jails a finality provider with the given PK
+// A jailed finality provider will not have voting power until it is unjailed
+func (k Keeper) JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
 	// ensure finality provider exists
 	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
 	if err != nil {
 		return err
 	}
 
-	// ignore the finality provider is already slashed
-	// or detected as sluggish
-	if fp.IsSlashed() || fp.IsSluggish() {
-		return nil
+	// ensure finality provider is not slashed or jailed yet
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+	if fp.IsJailed() {
+		return types.ErrFpAlreadyJailed
 	}
 
+	fp.Jailed = true
+	k.SetFinalityProvider(ctx, fp)
+
+	// record jailed event. The next `BeginBlock` will consume this
+	// event for updating the finality provider set
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithJailedFP(fp.BtcPk)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
+	return nil
+}
+
+// UnjailFinalityProvider reverts the jailing of a finality provider with the given PK
+func (k Keeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	// ensure finality provider exists
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	// ensure finality provider is jailed and not slashed
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+	if !fp.IsJailed() {
+		return types.ErrFpNotJailed
+	}
+
+	fp.Jailed = false
+	// the finality provider starts from a clean liveness record
 	fp.Sluggish = false
 	k.SetFinalityProvider(ctx, fp)
 
+	// record unjailed event. The next `BeginBlock` will consume this
+	// event for updating the finality provider set
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithUnjailedFP(fp.BtcPk)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
 	return nil
 }
 
@@ -55,6 +55,24 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.setVotingPowerDistCache(ctx, vpCache.BlockHeight, vpCache.VpDistribution)
 	}
 
+	if gs.CovenantRotation != nil {
+		if err := k.SetCovenantRotation(ctx, gs.CovenantRotation); err != nil {
+			return err
+		}
+	}
+
+	for _, signedSlashingTx := range gs.SignedSlashingTxs {
+		k.setSignedSlashingTx(ctx, signedSlashingTx)
+	}
+
+	for _, record := range gs.SlashingRecords {
+		k.setSlashingRecord(ctx, record)
+	}
+
+	for _, pendingTx := range gs.PendingSlashingTxs {
+		k.setPendingSlashingTx(ctx, pendingTx)
+	}
+
 	return nil
 }
 
@@ -90,15 +108,34 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	signedSlashingTxs, err := k.signedSlashingTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slashingRecords, err := k.slashingRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingSlashingTxs, err := k.pendingSlashingTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:            k.GetAllParams(ctx),
-		FinalityProviders: fps,
-		BtcDelegations:    dels,
-		VotingPowers:      vpFps,
-		BlockHeightChains: k.blockHeightChains(ctx),
-		BtcDelegators:     btcDels,
-		Events:            evts,
-		VpDstCache:        vpsCache,
+		Params:             k.GetAllParams(ctx),
+		FinalityProviders:  fps,
+		BtcDelegations:     dels,
+		VotingPowers:       vpFps,
+		BlockHeightChains:  k.blockHeightChains(ctx),
+		BtcDelegators:      btcDels,
+		Events:             evts,
+		VpDstCache:         vpsCache,
+		CovenantRotation:   k.GetCovenantRotation(ctx),
+		SignedSlashingTxs:  signedSlashingTxs,
+		SlashingRecords:    slashingRecords,
+		PendingSlashingTxs: pendingSlashingTxs,
 	}, nil
 }
 
@@ -246,6 +283,54 @@ func (k Keeper) votingPowersDistCacheBlkHeight(ctx context.Context) ([]*types.Vo
 	return vps, nil
 }
 
+func (k Keeper) signedSlashingTxs(ctx context.Context) ([]*types.SignedSlashingTx, error) {
+	txs := make([]*types.SignedSlashingTx, 0)
+	iter := k.signedSlashingTxStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var tx types.SignedSlashingTx
+		if err := tx.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		txs = append(txs, &tx)
+	}
+
+	return txs, nil
+}
+
+func (k Keeper) slashingRecords(ctx context.Context) ([]*types.SlashingRecord, error) {
+	records := make([]*types.SlashingRecord, 0)
+	iter := k.slashingRecordStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.SlashingRecord
+		if err := record.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		records = append(records, &record)
+	}
+
+	return records, nil
+}
+
+func (k Keeper) pendingSlashingTxs(ctx context.Context) ([]*types.PendingSlashingTx, error) {
+	pendingTxs := make([]*types.PendingSlashingTx, 0)
+	iter := k.pendingSlashingTxStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pendingTx types.PendingSlashingTx
+		if err := pendingTx.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		pendingTxs = append(pendingTxs, &pendingTx)
+	}
+
+	return pendingTxs, nil
+}
+
 func (k Keeper) setBlockHeightChains(ctx context.Context, blocks *types.BlockHeightBbnToBtc) {
 	store := k.btcHeightStore(ctx)
 	store.Set(sdk.Uint64ToBigEndian(blocks.BlockHeightBbn), sdk.Uint64ToBigEndian(blocks.BlockHeightBtc))
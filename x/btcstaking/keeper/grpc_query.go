@@ -191,6 +191,7 @@ func (k Keeper) ActiveFinalityProvidersAtHeight(ctx context.Context, req *types.
 				VotingPower:          votingPower,
 				SlashedBabylonHeight: finalityProvider.SlashedBabylonHeight,
 				SlashedBtcHeight:     finalityProvider.SlashedBtcHeight,
+				Jailed:               finalityProvider.Jailed,
 			}
 			finalityProvidersWithMeta = append(finalityProvidersWithMeta, &finalityProviderWithMeta)
 		}
@@ -302,3 +303,133 @@ func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegation
 		BtcDelegation: types.NewBTCDelegationResponse(btcDel, status),
 	}, nil
 }
+
+// SlashingRecord returns the slashing record of the BTC delegation with the
+// given staking tx hash
+func (k Keeper) SlashingRecord(ctx context.Context, req *types.QuerySlashingRecordRequest) (*types.QuerySlashingRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	record := k.GetSlashingRecord(ctx, *stakingTxHash)
+	if record == nil {
+		return nil, types.ErrSlashingRecordNotFound
+	}
+
+	return &types.QuerySlashingRecordResponse{Record: record.ToResponse()}, nil
+}
+
+// SlashingRecords returns a paginated list of slashing records of all slashed
+// BTC delegations
+func (k Keeper) SlashingRecords(ctx context.Context, req *types.QuerySlashingRecordsRequest) (*types.QuerySlashingRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	store := k.slashingRecordStore(ctx)
+	var records []*types.SlashingRecordResponse
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var record types.SlashingRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record.ToResponse())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashingRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// CovenantRotation returns the scheduled covenant committee rotation
+func (k Keeper) CovenantRotation(ctx context.Context, req *types.QueryCovenantRotationRequest) (*types.QueryCovenantRotationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	rotation := k.GetCovenantRotation(ctx)
+	if rotation == nil {
+		return nil, types.ErrCovenantRotationNotFound
+	}
+
+	return &types.QueryCovenantRotationResponse{Rotation: rotation}, nil
+}
+
+// RetiringCovenantDelegations returns a paginated list of pending BTC delegations
+// that still wait for signatures from a covenant committee that is no longer the
+// current one
+func (k Keeper) RetiringCovenantDelegations(ctx context.Context, req *types.QueryRetiringCovenantDelegationsRequest) (*types.QueryRetiringCovenantDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// get current BTC height
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	// get value of w
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	store := k.btcDelegationStore(ctx)
+	var btcDels []*types.BTCDelegationResponse
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(value, &btcDel)
+
+		if !k.IsRetiringCovenantDelegation(ctx, &btcDel, btcTipHeight, wValue) {
+			return false, nil
+		}
+		if accumulate {
+			resp := types.NewBTCDelegationResponse(&btcDel, types.BTCDelegationStatus_PENDING)
+			btcDels = append(btcDels, resp)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRetiringCovenantDelegationsResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// SlashingTxs returns a paginated list of fully witnessed slashing txs of all
+// BTC delegations under the given slashed finality provider
+func (k Keeper) SlashingTxs(ctx context.Context, req *types.QuerySlashingTxsRequest) (*types.QuerySlashingTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.FpBtcPkHex) == 0 {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "finality provider BTC public key cannot be empty")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, err
+	}
+
+	store := k.signedSlashingTxFpStore(ctx, fpPK)
+	var slashingTxs []*types.SignedSlashingTxResponse
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var signedSlashingTx types.SignedSlashingTx
+		if err := k.cdc.Unmarshal(value, &signedSlashingTx); err != nil {
+			return err
+		}
+		slashingTxs = append(slashingTxs, signedSlashingTx.ToResponse())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashingTxsResponse{SlashingTxs: slashingTxs, Pagination: pageRes}, nil
+}
@@ -37,3 +37,9 @@ func (h Hooks) AfterSluggishFinalityProviderDetected(ctx context.Context, fpPk *
 
 	return nil
 }
+
+// AfterBlockFinalized does nothing as the BTC staking module is not concerned
+// about finalized blocks
+func (h Hooks) AfterBlockFinalized(ctx context.Context, height uint64) error {
+	return nil
+}
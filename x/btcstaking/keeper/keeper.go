@@ -81,12 +81,19 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	// index BTC height at the current height
 	k.IndexBTCHeight(ctx)
+	// apply the scheduled covenant committee rotation if it takes effect
+	k.ApplyCovenantRotation(ctx)
 	// update voting power distribution
 	k.UpdatePowerDist(ctx)
 
 	return nil
 }
 
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	// assemble the signed slashing txs of slashed finality providers
+	return k.ProcessPendingSlashingTxs(ctx)
+}
+
 func (k Keeper) GetLastFinalizedEpoch(ctx context.Context) uint64 {
 	return k.ckptKeeper.GetLastFinalizedEpoch(ctx)
 }
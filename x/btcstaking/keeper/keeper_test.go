@@ -40,14 +40,28 @@ type Helper struct {
 }
 
 func NewHelper(t testing.TB, btclcKeeper *types.MockBTCLightClientKeeper, btccKeeper *types.MockBtcCheckpointKeeper, ckptKeeper *types.MockCheckpointingKeeper) *Helper {
-	k, ctx := keepertest.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
-	ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
-	msgSrvr := keeper.NewMsgServerImpl(*k)
-
 	ctrl := gomock.NewController(t)
 	mockedHooks := types.NewMockBtcStakingHooks(ctrl)
 	mockedHooks.EXPECT().AfterFinalityProviderActivated(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	k.SetHooks(mockedHooks)
+	mockedHooks.EXPECT().AfterBTCDelegationActivated(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockedHooks.EXPECT().AfterBTCDelegationUnbonded(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockedHooks.EXPECT().AfterBTCDelegationExpired(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockedHooks.EXPECT().AfterBTCDelegationSlashed(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return NewHelperWithHooks(t, btclcKeeper, btccKeeper, ckptKeeper, mockedHooks)
+}
+
+func NewHelperWithHooks(
+	t testing.TB,
+	btclcKeeper *types.MockBTCLightClientKeeper,
+	btccKeeper *types.MockBtcCheckpointKeeper,
+	ckptKeeper *types.MockCheckpointingKeeper,
+	hooks *types.MockBtcStakingHooks,
+) *Helper {
+	k, ctx := keepertest.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
+	k.SetHooks(hooks)
+	msgSrvr := keeper.NewMsgServerImpl(*k)
 
 	return &Helper{
 		t:                    t,
@@ -56,6 +70,7 @@ func NewHelper(t testing.TB, btclcKeeper *types.MockBTCLightClientKeeper, btccKe
 		BTCLightClientKeeper: btclcKeeper,
 		BTCCheckpointKeeper:  btccKeeper,
 		CheckpointingKeeper:  ckptKeeper,
+		BTCStakingHooks:      hooks,
 		MsgServer:            msgSrvr,
 		Net:                  &chaincfg.SimNetParams,
 	}
@@ -50,6 +50,38 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// RotateCovenantCommittee schedules a rotation of the covenant committee
+func (ms msgServer) RotateCovenantCommittee(goCtx context.Context, req *types.MsgRotateCovenantCommittee) (*types.MsgRotateCovenantCommitteeResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	if err := req.Rotation.Validate(); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid covenant committee rotation: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// ensure the params with the new covenant committee are valid
+	params := ms.GetParams(ctx).ApplyCovenantRotation(&req.Rotation)
+	if err := params.Validate(); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid parameter after covenant committee rotation: %v", err)
+	}
+
+	// ensure the rotation takes effect in the future
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	if req.Rotation.ActivationBtcHeight <= btcTip.Height {
+		return nil, types.ErrInvalidCovenantRotation.Wrapf(
+			"activation BTC height %d is not after the current BTC tip height %d",
+			req.Rotation.ActivationBtcHeight, btcTip.Height)
+	}
+
+	if err := ms.SetCovenantRotation(ctx, &req.Rotation); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateCovenantCommitteeResponse{}, nil
+}
+
 // CreateFinalityProvider creates a finality provider
 func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.MsgCreateFinalityProvider) (*types.MsgCreateFinalityProviderResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyCreateFinalityProvider)
@@ -726,6 +758,11 @@ func (ms msgServer) SelectiveSlashingEvidence(goCtx context.Context, req *types.
 	if err := ms.SlashFinalityProvider(ctx, fpBTCPK.MustMarshal()); err != nil {
 		panic(err) // failed to slash the finality provider, must be programming error
 	}
+	// queue the assembly of the slashing txs of all BTC delegations under
+	// this finality provider
+	if err := ms.RecordSignedSlashingTxs(ctx, fpSK); err != nil {
+		panic(err) // the finality provider exists, must be programming error
+	}
 
 	// emit selective slashing event
 	evidence := &types.SelectiveSlashingEvidence{
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/babylonchain/babylon/btcstaking"
 	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
@@ -308,6 +309,30 @@ func FuzzAddCovenantSigs(f *testing.F) {
 		require.True(h.t, actualDel.BtcUndelegation.HasCovenantQuorums(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
 		votingPower := actualDel.VotingPower(h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height, h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout, h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum)
 		require.Equal(t, uint64(stakingValue), votingPower)
+
+		// the covenant signatures wrapped into envelopes verify against the
+		// BTC delegation, and are rejected when claimed to be on the other
+		// slashing tx
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		for _, txType := range []btcstaking.SlashingTxType{btcstaking.StakingSlashingTx, btcstaking.UnbondingSlashingTx} {
+			envs, err := actualDel.CovenantAdaptorSigEnvelopes(&bsParams, h.Net, txType, msgs[0].Pk)
+			h.NoError(err)
+			for _, env := range envs {
+				h.NoError(h.BTCStakingKeeper.VerifyCovenantAdaptorSigEnvelope(h.Ctx, env))
+
+				mixedEnv := *env
+				if txType == btcstaking.StakingSlashingTx {
+					mixedEnv.TxType = btcstaking.UnbondingSlashingTx
+				} else {
+					mixedEnv.TxType = btcstaking.StakingSlashingTx
+				}
+				require.ErrorIs(t, h.BTCStakingKeeper.VerifyCovenantAdaptorSigEnvelope(h.Ctx, &mixedEnv), types.ErrInvalidAdaptorSigEnvelope)
+
+				unknownEnv := *env
+				unknownEnv.StakingTxHash = datagen.GenRandomBtcdHash(r)
+				require.ErrorIs(t, h.BTCStakingKeeper.VerifyCovenantAdaptorSigEnvelope(h.Ctx, &unknownEnv), types.ErrBTCDelegationNotFound)
+			}
+		}
 	})
 }
 
@@ -448,6 +473,47 @@ func FuzzSelectiveSlashing(f *testing.F) {
 		slashedFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, fpBtcPk.MustMarshal())
 		h.NoError(err)
 		require.True(t, slashedFp.IsSlashed())
+
+		// the slashing txs of the BTC delegation are assembled at the end of
+		// the block
+		actualStakingTxHash := actualDel.MustGetStakingTxHash()
+		require.True(t, h.BTCStakingKeeper.HasPendingSlashingTx(h.Ctx, fpBtcPk, actualStakingTxHash))
+		require.Nil(t, h.BTCStakingKeeper.GetSignedSlashingTx(h.Ctx, fpBtcPk, actualStakingTxHash))
+		err = h.BTCStakingKeeper.EndBlocker(h.Ctx)
+		h.NoError(err)
+		require.False(t, h.BTCStakingKeeper.HasPendingSlashingTx(h.Ctx, fpBtcPk, actualStakingTxHash))
+
+		// ensure the fully signed slashing txs of the BTC delegation are served
+		resp, err := h.BTCStakingKeeper.SlashingTxs(h.Ctx, &types.QuerySlashingTxsRequest{
+			FpBtcPkHex: fpBtcPk.MarshalHex(),
+		})
+		h.NoError(err)
+		require.Len(t, resp.SlashingTxs, 1)
+		require.Equal(t, stakingTxHash, resp.SlashingTxs[0].StakingTxHashHex)
+		slashingTx, _, err := bbn.NewBTCTxFromHex(resp.SlashingTxs[0].SlashingTxHex)
+		h.NoError(err)
+		require.Equal(t, actualDel.SlashingTx.MustGetTxHash().String(), slashingTx.TxHash().String())
+		require.NotEmpty(t, slashingTx.TxIn[0].Witness)
+		unbondingSlashingTx, _, err := bbn.NewBTCTxFromHex(resp.SlashingTxs[0].UnbondingSlashingTxHex)
+		h.NoError(err)
+		require.NotEmpty(t, unbondingSlashingTx.TxIn[0].Witness)
+
+		// ensure the loss of the BTC delegation is recorded
+		recordResp, err := h.BTCStakingKeeper.SlashingRecord(h.Ctx, &types.QuerySlashingRecordRequest{
+			StakingTxHashHex: stakingTxHash,
+		})
+		h.NoError(err)
+		record := recordResp.Record
+		require.Equal(t, fpBtcPk.MarshalHex(), record.FpBtcPkHex)
+		require.Equal(t, bsParams.SlashingRate, record.SlashingRate)
+		require.False(t, record.UnbondedEarly)
+		require.Equal(t, uint64(stakingValue), record.TotalAmount)
+		require.Equal(t, uint64(slashingTx.TxOut[0].Value), record.SlashedAmount)
+		require.Equal(t, uint64(slashingTx.TxOut[1].Value), record.ReturnedAmount)
+		require.Equal(t, record.TotalAmount, record.SlashedAmount+record.ReturnedAmount+record.Fee)
+		recordsResp, err := h.BTCStakingKeeper.SlashingRecords(h.Ctx, &types.QuerySlashingRecordsRequest{})
+		h.NoError(err)
+		require.Len(t, recordsResp.Records, 1)
 	})
 }
 
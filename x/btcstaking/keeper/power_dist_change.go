@@ -107,6 +107,7 @@ func (k Keeper) recordMetrics(dc *types.VotingPowerDistCache, maxActiveFps uint3
 // - newly active BTC delegations
 // - newly unbonded BTC delegations
 // - slashed finality providers
+// - jailed/unjailed finality providers
 func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	ctx context.Context,
 	dc *types.VotingPowerDistCache,
@@ -120,9 +121,14 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	unbondedBTCDels := map[string]struct{}{}
 	// a map where key is slashed finality providers' BTC PK
 	slashedFPs := map[string]struct{}{}
+	// a map where key is jailed finality providers' BTC PK
+	jailedFPs := map[string]struct{}{}
+	// a map where key is unjailed finality providers' BTC PK
+	unjailedFPs := map[string]struct{}{}
 
 	/*
-		filter and classify all events into new/expired BTC delegations and slashed FPs
+		filter and classify all events into new/expired BTC delegations and
+		slashed/jailed/unjailed FPs
 	*/
 	for _, event := range events {
 		switch typedEvent := event.Ev.(type) {
@@ -139,13 +145,28 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 					fpBTCPKHex := fpBTCPK.MarshalHex()
 					activeBTCDels[fpBTCPKHex] = append(activeBTCDels[fpBTCPKHex], btcDel)
 				}
+				if err := k.hooks.AfterBTCDelegationActivated(ctx, btcDel); err != nil {
+					panic(fmt.Errorf("failed to execute after BTC delegation %s activated: %w", delEvent.StakingTxHash, err))
+				}
 			} else if delEvent.NewState == types.BTCDelegationStatus_UNBONDED {
 				// add the expired BTC delegation to the map
 				unbondedBTCDels[delEvent.StakingTxHash] = struct{}{}
+				k.processExpiredBTCDelegation(ctx, delEvent.StakingTxHash)
 			}
 		case *types.EventPowerDistUpdate_SlashedFp:
 			// slashed finality providers
 			slashedFPs[typedEvent.SlashedFp.Pk.MarshalHex()] = struct{}{}
+			k.processSlashedBTCDelegations(ctx, dc, typedEvent.SlashedFp.Pk)
+		case *types.EventPowerDistUpdate_JailedFp:
+			// jailed finality providers
+			fpBTCPKHex := typedEvent.JailedFp.Pk.MarshalHex()
+			jailedFPs[fpBTCPKHex] = struct{}{}
+			delete(unjailedFPs, fpBTCPKHex)
+		case *types.EventPowerDistUpdate_UnjailedFp:
+			// unjailed finality providers
+			fpBTCPKHex := typedEvent.UnjailedFp.Pk.MarshalHex()
+			unjailedFPs[fpBTCPKHex] = struct{}{}
+			delete(jailedFPs, fpBTCPKHex)
 		}
 	}
 
@@ -174,6 +195,14 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 			continue
 		}
 
+		// set the jailing status of this finality provider
+		if _, ok := jailedFPs[fpBTCPKHex]; ok {
+			fp.IsJailed = true
+		}
+		if _, ok := unjailedFPs[fpBTCPKHex]; ok {
+			fp.IsJailed = false
+		}
+
 		// add all BTC delegations that are not unbonded to the new finality provider
 		for j := range dc.FinalityProviders[i].BtcDels {
 			btcDel := *dc.FinalityProviders[i].BtcDels[j]
@@ -243,6 +272,64 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	return newDc
 }
 
+// processExpiredBTCDelegation executes the hooks for a BTC delegation whose
+// unbonded event is consumed. Unbonded events are recorded both for early
+// unbonding and for the timelock expiry, so the hooks are only executed if
+// the BTC delegation was active and is not unbonded early, in which case
+// `AfterBTCDelegationUnbonded` is already executed upon `BTCUndelegate`. The
+// hooks are not executed either if any finality provider of the BTC
+// delegation is slashed, in which case `AfterBTCDelegationSlashed` is already
+// executed for the BTC delegation
+func (k Keeper) processExpiredBTCDelegation(ctx context.Context, stakingTxHash string) {
+	btcDel, err := k.GetBTCDelegation(ctx, stakingTxHash)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	if btcDel.IsUnbondedEarly() {
+		return
+	}
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic(fmt.Errorf("params version %d of BTC delegation %s is not found", btcDel.ParamsVersion, stakingTxHash))
+	}
+	if !btcDel.HasCovenantQuorums(params.CovenantQuorum) {
+		// the BTC delegation never became active
+		return
+	}
+	for _, fpBTCPK := range btcDel.FpBtcPkList {
+		fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+		if err != nil {
+			panic(err) // only programming error
+		}
+		if fp.IsSlashed() {
+			return
+		}
+	}
+	if err := k.hooks.AfterBTCDelegationExpired(ctx, btcDel); err != nil {
+		panic(fmt.Errorf("failed to execute after BTC delegation %s expired: %w", stakingTxHash, err))
+	}
+}
+
+// processSlashedBTCDelegations executes the hooks for each BTC delegation
+// under the given slashed finality provider in the given distribution cache
+func (k Keeper) processSlashedBTCDelegations(ctx context.Context, dc *types.VotingPowerDistCache, fpBTCPK *bbn.BIP340PubKey) {
+	for _, fp := range dc.FinalityProviders {
+		if !fp.BtcPk.Equals(fpBTCPK) {
+			continue
+		}
+		for _, d := range fp.BtcDels {
+			btcDel, err := k.GetBTCDelegation(ctx, d.StakingTxHash)
+			if err != nil {
+				panic(err) // only programming error
+			}
+			if err := k.hooks.AfterBTCDelegationSlashed(ctx, fpBTCPK, btcDel); err != nil {
+				panic(fmt.Errorf("failed to execute after BTC delegation %s slashed: %w", d.StakingTxHash, err))
+			}
+		}
+		return
+	}
+}
+
 /* voting power distribution update event store */
 
 // addPowerDistUpdateEvent appends an event that affect voting power distribution
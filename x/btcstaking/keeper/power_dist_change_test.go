@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -245,3 +247,210 @@ func FuzzBTCDelegationEvents(f *testing.F) {
 		require.Len(t, events, 0)
 	})
 }
+
+func FuzzBTCDelegationHooks(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+
+		// record the staking tx hash of BTC delegations for each hook
+		activated, unbonded, expired := []string{}, []string{}, []string{}
+		record := func(l *[]string) func(_ context.Context, btcDel *types.BTCDelegation) error {
+			return func(_ context.Context, btcDel *types.BTCDelegation) error {
+				*l = append(*l, btcDel.MustGetStakingTxHash().String())
+				return nil
+			}
+		}
+		hooks := types.NewMockBtcStakingHooks(ctrl)
+		hooks.EXPECT().AfterFinalityProviderActivated(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		hooks.EXPECT().AfterBTCDelegationActivated(gomock.Any(), gomock.Any()).DoAndReturn(record(&activated)).AnyTimes()
+		hooks.EXPECT().AfterBTCDelegationUnbonded(gomock.Any(), gomock.Any()).DoAndReturn(record(&unbonded)).AnyTimes()
+		hooks.EXPECT().AfterBTCDelegationExpired(gomock.Any(), gomock.Any()).DoAndReturn(record(&expired)).AnyTimes()
+		h := NewHelperWithHooks(t, btclcKeeper, btccKeeper, ckptKeeper, hooks)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert two active BTC delegations, where the first one
+		// will be unbonded early and the second one will expire
+		stakingValue := int64(2 * 10e8)
+		unbondedTxHash, delSK, _, msgCreateBTCDel, unbondedDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, unbondedDel)
+		expiredTxHash, _, _, msgCreateBTCDel, expiredDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, expiredDel)
+		// no hook is executed before the events are processed
+		require.Empty(t, activated)
+
+		// process the activation events
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.ElementsMatch(t, []string{unbondedTxHash, expiredTxHash}, activated)
+
+		// unbond the first BTC delegation early
+		unbondedDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, unbondedTxHash)
+		h.NoError(err)
+		delUnbondingSig, err := unbondedDel.SignUnbondingTx(&bsParams, h.Net, delSK)
+		h.NoError(err)
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+			Signer:         datagen.GenRandomAccount().Address,
+			StakingTxHash:  unbondedTxHash,
+			UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(delUnbondingSig),
+		})
+		h.NoError(err)
+		require.Equal(t, []string{unbondedTxHash}, unbonded)
+
+		// BTC height reaches end height - w, such that both BTC delegations have
+		// their unbonded events processed, but only the second one expires
+		unbondedHeight := expiredDel.EndHeight - btccKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: unbondedHeight}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, []string{expiredTxHash}, expired)
+		require.Equal(t, []string{unbondedTxHash}, unbonded)
+	})
+}
+
+func FuzzBTCDelegationSlashedHook(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+
+		// record the finality provider and the staking tx hash of BTC
+		// delegations for the slashed and expired hooks
+		slashed := []string{}
+		slashedFPs := []string{}
+		expired := []string{}
+		hooks := types.NewMockBtcStakingHooks(ctrl)
+		hooks.EXPECT().AfterFinalityProviderActivated(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		hooks.EXPECT().AfterBTCDelegationActivated(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		hooks.EXPECT().AfterBTCDelegationExpired(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, btcDel *types.BTCDelegation) error {
+				expired = append(expired, btcDel.MustGetStakingTxHash().String())
+				return nil
+			},
+		).AnyTimes()
+		hooks.EXPECT().AfterBTCDelegationSlashed(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, fpPk *bbn.BIP340PubKey, btcDel *types.BTCDelegation) error {
+				slashedFPs = append(slashedFPs, fpPk.MarshalHex())
+				slashed = append(slashed, btcDel.MustGetStakingTxHash().String())
+				return nil
+			},
+		).AnyTimes()
+		h := NewHelperWithHooks(t, btclcKeeper, btccKeeper, ckptKeeper, hooks)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert two finality providers, where the first one
+		// will be slashed
+		_, slashedFPPK, slashedFP := h.CreateFinalityProvider(r)
+		_, otherFPPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert active BTC delegations under both finality
+		// providers
+		stakingValue := int64(2 * 10e8)
+		numSlashedDels := int(datagen.RandomInt(r, 3)) + 1
+		slashedTxHashes := make([]string, 0, numSlashedDels)
+		for i := 0; i < numSlashedDels; i++ {
+			stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+				r,
+				slashedFPPK,
+				changeAddress.EncodeAddress(),
+				stakingValue,
+				1000,
+			)
+			h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+			slashedTxHashes = append(slashedTxHashes, stakingTxHash)
+		}
+		otherTxHash, _, _, msgCreateBTCDel, otherDel := h.CreateDelegation(
+			r,
+			otherFPPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, otherDel)
+
+		// process the activation events
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Empty(t, slashed)
+
+		// slash the first finality provider, and no hook is executed before
+		// the slashing event is processed
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, slashedFP.BtcPk.MustMarshal())
+		h.NoError(err)
+		require.Empty(t, slashed)
+
+		// the slashed hook is executed for each BTC delegation under the
+		// slashed finality provider only
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.ElementsMatch(t, slashedTxHashes, slashed)
+		for _, fpPKHex := range slashedFPs {
+			require.Equal(t, slashedFP.BtcPk.MarshalHex(), fpPKHex)
+		}
+		require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *slashedFP.BtcPk, babylonHeight))
+
+		// BTC height reaches end height - w, such that all BTC delegations
+		// have their unbonded events processed, but only the one under the
+		// finality provider that is not slashed expires
+		unbondedHeight := otherDel.EndHeight - btccKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: unbondedHeight}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, []string{otherTxHash}, expired)
+		require.ElementsMatch(t, slashedTxHashes, slashed)
+	})
+}
@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// RecordSignedSlashingTxs queues all BTC delegations under the finality
+// provider with the given secret key, such that the fully witnessed slashing
+// txs of them are assembled at the end of this and the following blocks (see
+// ProcessPendingSlashingTxs). This is called after the finality provider is
+// slashed and its secret key is extracted.
+func (k Keeper) RecordSignedSlashingTxs(ctx context.Context, fpSK *btcec.PrivateKey) error {
+	fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpSK.PubKey())
+	if !k.HasFinalityProvider(ctx, fpBTCPK.MustMarshal()) {
+		return types.ErrFpNotFound
+	}
+
+	// collect all staking tx hashes under this finality provider before
+	// writing to the store
+	stakingTxHashes := []chainhash.Hash{}
+	func() {
+		iter := k.btcDelegatorFpStore(ctx, fpBTCPK).Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			var btcDelIndex types.BTCDelegatorDelegationIndex
+			k.cdc.MustUnmarshal(iter.Value(), &btcDelIndex)
+			for _, stakingTxHashBytes := range btcDelIndex.StakingTxHashList {
+				stakingTxHash, err := chainhash.NewHash(stakingTxHashBytes)
+				if err != nil {
+					// failing to unmarshal hash bytes in DB's BTC delegation index is a programming error
+					panic(err)
+				}
+				stakingTxHashes = append(stakingTxHashes, *stakingTxHash)
+			}
+		}
+	}()
+
+	for _, stakingTxHash := range stakingTxHashes {
+		k.setPendingSlashingTx(ctx, &types.PendingSlashingTx{
+			FpBtcPk:       fpBTCPK,
+			StakingTxHash: stakingTxHash.String(),
+			FpBtcSk:       fpSK.Serialize(),
+		})
+	}
+
+	return nil
+}
+
+// ProcessPendingSlashingTxs assembles the fully witnessed slashing txs of at
+// most MaxPendingSlashingTxsPerBlock pending BTC delegations, and saves them
+// to the store, such that anyone can submit them to Bitcoin. It also records
+// the loss of each BTC delegation that is not slashed before.
+// Only BTC delegations that are active or unbonded early are slashable. For
+// the latter, only the unbonding slashing tx is assembled, as the staking
+// output is already spent, and they are skipped once the timelock of the
+// unbonding output has expired.
+func (k Keeper) ProcessPendingSlashingTxs(ctx context.Context) error {
+	// collect the pending slashing txs of this block before writing to the
+	// store
+	pendingTxs := []*types.PendingSlashingTx{}
+	func() {
+		iter := k.pendingSlashingTxStore(ctx).Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid() && len(pendingTxs) < types.MaxPendingSlashingTxsPerBlock; iter.Next() {
+			var pendingTx types.PendingSlashingTx
+			k.cdc.MustUnmarshal(iter.Value(), &pendingTx)
+			pendingTxs = append(pendingTxs, &pendingTx)
+		}
+	}()
+	if len(pendingTxs) == 0 {
+		return nil
+	}
+
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	for _, pendingTx := range pendingTxs {
+		k.deletePendingSlashingTx(ctx, pendingTx)
+
+		stakingTxHash, err := chainhash.NewHashFromStr(pendingTx.StakingTxHash)
+		if err != nil {
+			panic(err) // only programming error
+		}
+		fpSK, _ := btcec.PrivKeyFromBytes(pendingTx.FpBtcSk)
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		if params == nil {
+			panic(fmt.Errorf("params version %d of BTC delegation %s is not found", btcDel.ParamsVersion, stakingTxHash))
+		}
+		if btcDel.IsUnbondedEarly() {
+			if btcDel.BtcUndelegation.UnbondingBtcHeight+uint64(btcDel.UnbondingTime) <= btcTipHeight {
+				// the unbonding output can be withdrawn, so it is no
+				// longer slashable
+				continue
+			}
+		} else if btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum) != types.BTCDelegationStatus_ACTIVE {
+			continue
+		}
+
+		signedSlashingTx, err := k.buildSignedSlashingTx(btcDel, params, fpSK)
+		if err != nil {
+			// the BTC delegation was verified upon creation, so this should not
+			// happen. Do not prevent slashing other BTC delegations due to this
+			// single BTC delegation
+			k.Logger(sdk.UnwrapSDKContext(ctx)).Error(
+				"failed to build signed slashing tx",
+				"staking_tx_hash", pendingTx.StakingTxHash,
+				"fp_btc_pk", pendingTx.FpBtcPk.MarshalHex(),
+				"error", err,
+			)
+			continue
+		}
+		k.setSignedSlashingTx(ctx, signedSlashingTx)
+
+		// a BTC delegation under multiple finality providers can only be
+		// slashed once on Bitcoin, so keep the record of the first slashing
+		if k.GetSlashingRecord(ctx, *stakingTxHash) != nil {
+			continue
+		}
+		record, err := types.NewSlashingRecord(btcDel, signedSlashingTx, params.SlashingRate)
+		if err != nil {
+			// the slashing tx was verified upon creation, so this should not
+			// happen. Do not prevent slashing other BTC delegations due to this
+			// single BTC delegation
+			k.Logger(sdk.UnwrapSDKContext(ctx)).Error(
+				"failed to compute slashing record",
+				"staking_tx_hash", pendingTx.StakingTxHash,
+				"fp_btc_pk", pendingTx.FpBtcPk.MarshalHex(),
+				"error", err,
+			)
+			continue
+		}
+		k.setSlashingRecord(ctx, record)
+		event := &types.EventBTCDelegationSlashed{Record: record}
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) setPendingSlashingTx(ctx context.Context, pendingTx *types.PendingSlashingTx) {
+	stakingTxHash, err := chainhash.NewHashFromStr(pendingTx.StakingTxHash)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	key := append(pendingTx.FpBtcPk.MustMarshal(), stakingTxHash[:]...)
+	k.pendingSlashingTxStore(ctx).Set(key, k.cdc.MustMarshal(pendingTx))
+}
+
+func (k Keeper) deletePendingSlashingTx(ctx context.Context, pendingTx *types.PendingSlashingTx) {
+	stakingTxHash, err := chainhash.NewHashFromStr(pendingTx.StakingTxHash)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	key := append(pendingTx.FpBtcPk.MustMarshal(), stakingTxHash[:]...)
+	k.pendingSlashingTxStore(ctx).Delete(key)
+}
+
+// HasPendingSlashingTx checks whether the signed slashing txs of the BTC
+// delegation with the given staking tx hash under the given slashed finality
+// provider are yet to be assembled
+func (k Keeper) HasPendingSlashingTx(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, stakingTxHash chainhash.Hash) bool {
+	key := append(fpBTCPK.MustMarshal(), stakingTxHash[:]...)
+	return k.pendingSlashingTxStore(ctx).Has(key)
+}
+
+func (k Keeper) setSlashingRecord(ctx context.Context, record *types.SlashingRecord) {
+	stakingTxHash, err := chainhash.NewHashFromStr(record.StakingTxHash)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	k.slashingRecordStore(ctx).Set(stakingTxHash[:], k.cdc.MustMarshal(record))
+}
+
+// GetSlashingRecord gets the slashing record of the BTC delegation with the
+// given staking tx hash, or nil if the BTC delegation is not slashed
+func (k Keeper) GetSlashingRecord(ctx context.Context, stakingTxHash chainhash.Hash) *types.SlashingRecord {
+	bz := k.slashingRecordStore(ctx).Get(stakingTxHash[:])
+	if len(bz) == 0 {
+		return nil
+	}
+	var record types.SlashingRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record
+}
+
+func (k Keeper) buildSignedSlashingTx(
+	btcDel *types.BTCDelegation,
+	params *types.Params,
+	fpSK *btcec.PrivateKey,
+) (*types.SignedSlashingTx, error) {
+	// the staking output of a BTC delegation that has unbonded early is
+	// already spent, so only its unbonding output is slashable
+	var slashingTxBytes []byte
+	if !btcDel.IsUnbondedEarly() {
+		slashingTx, err := btcDel.BuildSlashingTxWithWitness(params, k.btcNet, fpSK)
+		if err != nil {
+			return nil, err
+		}
+		slashingTxBytes, err = bbn.SerializeBTCTx(slashingTx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	unbondingSlashingTx, err := btcDel.BuildUnbondingSlashingTxWithWitness(params, k.btcNet, fpSK)
+	if err != nil {
+		return nil, err
+	}
+	unbondingSlashingTxBytes, err := bbn.SerializeBTCTx(unbondingSlashingTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SignedSlashingTx{
+		FpBtcPk:             bbn.NewBIP340PubKeyFromBTCPK(fpSK.PubKey()),
+		StakingTxHash:       btcDel.MustGetStakingTxHash().String(),
+		SlashingTx:          slashingTxBytes,
+		UnbondingSlashingTx: unbondingSlashingTxBytes,
+	}, nil
+}
+
+func (k Keeper) setSignedSlashingTx(ctx context.Context, signedSlashingTx *types.SignedSlashingTx) {
+	stakingTxHash, err := chainhash.NewHashFromStr(signedSlashingTx.StakingTxHash)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	store := k.signedSlashingTxFpStore(ctx, signedSlashingTx.FpBtcPk)
+	store.Set(stakingTxHash[:], k.cdc.MustMarshal(signedSlashingTx))
+}
+
+// GetSignedSlashingTx gets the fully witnessed slashing txs of the BTC delegation
+// with the given staking tx hash under the given slashed finality provider
+func (k Keeper) GetSignedSlashingTx(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, stakingTxHash chainhash.Hash) *types.SignedSlashingTx {
+	store := k.signedSlashingTxFpStore(ctx, fpBTCPK)
+	bz := store.Get(stakingTxHash[:])
+	if len(bz) == 0 {
+		return nil
+	}
+	var signedSlashingTx types.SignedSlashingTx
+	k.cdc.MustUnmarshal(bz, &signedSlashingTx)
+	return &signedSlashingTx
+}
+
+// signedSlashingTxFpStore returns the KVStore of the signed slashing txs
+// under a given slashed finality provider
+// prefix: SignedSlashingTxKey || finality provider's Bitcoin secp256k1 PK
+// key: staking tx hash
+// value: SignedSlashingTx
+func (k Keeper) signedSlashingTxFpStore(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) prefix.Store {
+	store := k.signedSlashingTxStore(ctx)
+	return prefix.NewStore(store, fpBTCPK.MustMarshal())
+}
+
+// signedSlashingTxStore returns the KVStore of the signed slashing txs
+// prefix: SignedSlashingTxKey
+// key: (finality provider's Bitcoin secp256k1 PK || staking tx hash)
+// value: SignedSlashingTx
+func (k Keeper) signedSlashingTxStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.SignedSlashingTxKey)
+}
+
+// pendingSlashingTxStore returns the KVStore of the BTC delegations whose
+// signed slashing txs are yet to be assembled
+// prefix: PendingSlashingTxKey
+// key: (finality provider's Bitcoin secp256k1 PK || staking tx hash)
+// value: PendingSlashingTx
+func (k Keeper) pendingSlashingTxStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.PendingSlashingTxKey)
+}
+
+// slashingRecordStore returns the KVStore of the slashing records
+// prefix: SlashingRecordKey
+// key: staking tx hash
+// value: SlashingRecord
+func (k Keeper) slashingRecordStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.SlashingRecordKey)
+}
@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzRecordSignedSlashingTxs_InvalidSlashingRecord(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, covenantPKs := h.GenAndApplyParams(r)
+		storedParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+		bsParams := storedParams.Params
+
+		// generate and insert new finality provider
+		fpSK, _, fp := h.CreateFinalityProvider(r)
+
+		// generate and insert two active BTC delegations, where the loss of the
+		// second one cannot be computed as its slashing tx spends more than the
+		// staking output
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+		stakingValue := uint64(2 * 10e8)
+		btcDels := make([]*types.BTCDelegation, 2)
+		for i := range btcDels {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			h.NoError(err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				h.Net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				bsParams.CovenantQuorum,
+				bsParams.SlashingAddress,
+				1,
+				1000,
+				stakingValue,
+				bsParams.SlashingRate,
+				uint16(bsParams.MinUnbondingTime)+1,
+			)
+			h.NoError(err)
+			btcDel.ParamsVersion = storedParams.Version
+			btcDels[i] = btcDel
+		}
+		invalidDel := btcDels[1]
+		slashingMsgTx, err := invalidDel.SlashingTx.ToMsgTx()
+		h.NoError(err)
+		slashingMsgTx.TxOut[1].Value += int64(stakingValue)
+		invalidDel.SlashingTx, err = types.NewBTCSlashingTxFromMsgTx(slashingMsgTx)
+		h.NoError(err)
+		for _, btcDel := range btcDels {
+			h.NoError(h.BTCStakingKeeper.AddBTCDelegation(h.Ctx, btcDel))
+		}
+
+		// the failure of computing the slashing record of a single BTC
+		// delegation does not prevent slashing
+		err = h.BTCStakingKeeper.RecordSignedSlashingTxs(h.Ctx, fpSK)
+		h.NoError(err)
+		err = h.BTCStakingKeeper.ProcessPendingSlashingTxs(h.Ctx)
+		h.NoError(err)
+
+		// both BTC delegations have signed slashing txs, but only the valid
+		// one has its loss recorded
+		for _, btcDel := range btcDels {
+			stakingTxHash := btcDel.MustGetStakingTxHash()
+			require.NotNil(t, h.BTCStakingKeeper.GetSignedSlashingTx(h.Ctx, fp.BtcPk, stakingTxHash))
+		}
+		require.NotNil(t, h.BTCStakingKeeper.GetSlashingRecord(h.Ctx, btcDels[0].MustGetStakingTxHash()))
+		require.Nil(t, h.BTCStakingKeeper.GetSlashingRecord(h.Ctx, invalidDel.MustGetStakingTxHash()))
+	})
+}
+
+func FuzzProcessPendingSlashingTxs_UnbondedEarly(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, covenantPKs := h.GenAndApplyParams(r)
+		storedParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+		bsParams := storedParams.Params
+
+		// generate and insert new finality provider
+		fpSK, _, fp := h.CreateFinalityProvider(r)
+
+		// generate and insert an active BTC delegation, a BTC delegation whose
+		// unbonding output is still timelocked, and a BTC delegation whose
+		// unbonding output can be withdrawn
+		btcTipHeight := uint64(200)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: btcTipHeight}).AnyTimes()
+		btcDels := make([]*types.BTCDelegation, 3)
+		for i := range btcDels {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			h.NoError(err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				h.Net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				bsParams.CovenantQuorum,
+				bsParams.SlashingAddress,
+				1,
+				1000,
+				uint64(2*10e8),
+				bsParams.SlashingRate,
+				uint16(bsParams.MinUnbondingTime)+1,
+			)
+			h.NoError(err)
+			btcDel.ParamsVersion = storedParams.Version
+			if i > 0 {
+				unbondingSig, err := btcDel.SignUnbondingTx(&bsParams, h.Net, delSK)
+				h.NoError(err)
+				btcDel.BtcUndelegation.DelegatorUnbondingSig = bbn.NewBIP340SignatureFromBTCSig(unbondingSig)
+			}
+			btcDels[i] = btcDel
+		}
+		activeDel, unbondingDel, unbondedDel := btcDels[0], btcDels[1], btcDels[2]
+		unbondingDel.BtcUndelegation.UnbondingBtcHeight = btcTipHeight - uint64(unbondingDel.UnbondingTime) + 1
+		unbondedDel.BtcUndelegation.UnbondingBtcHeight = btcTipHeight - uint64(unbondedDel.UnbondingTime)
+		for _, btcDel := range btcDels {
+			h.NoError(h.BTCStakingKeeper.AddBTCDelegation(h.Ctx, btcDel))
+		}
+
+		err := h.BTCStakingKeeper.RecordSignedSlashingTxs(h.Ctx, fpSK)
+		h.NoError(err)
+		err = h.BTCStakingKeeper.ProcessPendingSlashingTxs(h.Ctx)
+		h.NoError(err)
+
+		// the active BTC delegation has both slashing txs
+		signedSlashingTx := h.BTCStakingKeeper.GetSignedSlashingTx(h.Ctx, fp.BtcPk, activeDel.MustGetStakingTxHash())
+		require.NotNil(t, signedSlashingTx)
+		require.NotEmpty(t, signedSlashingTx.SlashingTx)
+		require.NotEmpty(t, signedSlashingTx.UnbondingSlashingTx)
+		record := h.BTCStakingKeeper.GetSlashingRecord(h.Ctx, activeDel.MustGetStakingTxHash())
+		require.NotNil(t, record)
+		require.False(t, record.UnbondedEarly)
+
+		// the BTC delegation that has unbonded early only has the unbonding
+		// slashing tx, as its staking output is already spent
+		signedSlashingTx = h.BTCStakingKeeper.GetSignedSlashingTx(h.Ctx, fp.BtcPk, unbondingDel.MustGetStakingTxHash())
+		require.NotNil(t, signedSlashingTx)
+		require.Empty(t, signedSlashingTx.SlashingTx)
+		require.NotEmpty(t, signedSlashingTx.UnbondingSlashingTx)
+		record = h.BTCStakingKeeper.GetSlashingRecord(h.Ctx, unbondingDel.MustGetStakingTxHash())
+		require.NotNil(t, record)
+		require.True(t, record.UnbondedEarly)
+
+		// the BTC delegation whose unbonding output can be withdrawn is not
+		// slashable
+		require.Nil(t, h.BTCStakingKeeper.GetSignedSlashingTx(h.Ctx, fp.BtcPk, unbondedDel.MustGetStakingTxHash()))
+		require.Nil(t, h.BTCStakingKeeper.GetSlashingRecord(h.Ctx, unbondedDel.MustGetStakingTxHash()))
+
+		// no BTC delegation is pending anymore
+		for _, btcDel := range btcDels {
+			require.False(t, h.BTCStakingKeeper.HasPendingSlashingTx(h.Ctx, fp.BtcPk, btcDel.MustGetStakingTxHash()))
+		}
+	})
+}
+
+func FuzzProcessPendingSlashingTxs_Pagination(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 2)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, covenantPKs := h.GenAndApplyParams(r)
+		storedParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+		bsParams := storedParams.Params
+
+		// generate and insert new finality provider
+		fpSK, _, fp := h.CreateFinalityProvider(r)
+
+		// generate and insert more active BTC delegations than those whose
+		// slashing txs are assembled in a single block
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		btcDels := make([]*types.BTCDelegation, types.MaxPendingSlashingTxsPerBlock+1)
+		for i := range btcDels {
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				h.Net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				bsParams.CovenantQuorum,
+				bsParams.SlashingAddress,
+				1,
+				1000,
+				uint64(2*10e8),
+				bsParams.SlashingRate,
+				uint16(bsParams.MinUnbondingTime)+1,
+			)
+			h.NoError(err)
+			btcDel.ParamsVersion = storedParams.Version
+			h.NoError(h.BTCStakingKeeper.AddBTCDelegation(h.Ctx, btcDel))
+			btcDels[i] = btcDel
+		}
+
+		// recording the signed slashing txs only queues the BTC delegations
+		err = h.BTCStakingKeeper.RecordSignedSlashingTxs(h.Ctx, fpSK)
+		h.NoError(err)
+		countSignedSlashingTxs := func() int {
+			count := 0
+			for _, btcDel := range btcDels {
+				if h.BTCStakingKeeper.GetSignedSlashingTx(h.Ctx, fp.BtcPk, btcDel.MustGetStakingTxHash()) != nil {
+					count++
+				}
+			}
+			return count
+		}
+		require.Zero(t, countSignedSlashingTxs())
+
+		// the slashing txs are assembled across two blocks
+		err = h.BTCStakingKeeper.ProcessPendingSlashingTxs(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, types.MaxPendingSlashingTxsPerBlock, countSignedSlashingTxs())
+		err = h.BTCStakingKeeper.ProcessPendingSlashingTxs(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, len(btcDels), countSignedSlashingTxs())
+	})
+}
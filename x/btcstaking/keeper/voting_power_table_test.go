@@ -390,3 +390,99 @@ func FuzzVotingPowerTable_ActiveFinalityProviderRotation(f *testing.F) {
 		}
 	})
 }
+
+func FuzzVotingPowerTable_JailedFinalityProvider(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		h.NoError(err)
+
+		// generate a random batch of finality providers, each with a BTC delegation
+		fps := []*types.FinalityProvider{}
+		numFps := datagen.RandomInt(r, 10) + 2
+		stakingValue := datagen.RandomInt(r, 100000) + 100000
+		for i := uint64(0); i < numFps; i++ {
+			_, _, fp := h.CreateFinalityProvider(r)
+			fps = append(fps, fp)
+			_, _, _, delMsg, del := h.CreateDelegation(
+				r,
+				fp.BtcPk.MustToBTCPK(),
+				changeAddress.EncodeAddress(),
+				int64(stakingValue),
+				1000,
+			)
+			h.CreateCovenantSigs(r, covenantSKs, delMsg, del)
+		}
+
+		// all finality providers have voting power
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		require.NoError(t, err)
+		for _, fp := range fps {
+			power := h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight)
+			require.Equal(t, stakingValue, power)
+		}
+
+		/*
+			jail a random finality provider and assert it does not have voting power
+		*/
+		jailedIdx := datagen.RandomInt(r, int(numFps))
+		jailedFp := fps[jailedIdx]
+		err = h.BTCStakingKeeper.JailFinalityProvider(h.Ctx, jailedFp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		// jailing a jailed finality provider is not allowed
+		err = h.BTCStakingKeeper.JailFinalityProvider(h.Ctx, jailedFp.BtcPk.MustMarshal())
+		require.ErrorIs(t, err, types.ErrFpAlreadyJailed)
+
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		require.NoError(t, err)
+		for i, fp := range fps {
+			power := h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight)
+			if uint64(i) == jailedIdx {
+				require.Zero(t, power)
+			} else {
+				require.Equal(t, stakingValue, power)
+			}
+		}
+		// the jailed finality provider remains in the distribution cache
+		dc, err := h.BTCStakingKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		require.NoError(t, err)
+		require.Len(t, dc.FinalityProviders, int(numFps))
+		require.Equal(t, (numFps-1)*stakingValue, dc.TotalVotingPower)
+
+		/*
+			unjail the finality provider and assert it has voting power again
+		*/
+		err = h.BTCStakingKeeper.UnjailFinalityProvider(h.Ctx, jailedFp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		// unjailing a non-jailed finality provider is not allowed
+		err = h.BTCStakingKeeper.UnjailFinalityProvider(h.Ctx, jailedFp.BtcPk.MustMarshal())
+		require.ErrorIs(t, err, types.ErrFpNotJailed)
+
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		require.NoError(t, err)
+		for _, fp := range fps {
+			power := h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight)
+			require.Equal(t, stakingValue, power)
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// getSlashingTxWithSpendInfo returns the slashing tx of the given type of the
+// BTC delegation, together with the funding output it spends and the script
+// of the slashing path
+func (d *BTCDelegation) getSlashingTxWithSpendInfo(
+	bsParams *Params,
+	btcNet *chaincfg.Params,
+	txType btcstaking.SlashingTxType,
+) (*wire.MsgTx, *wire.TxOut, []byte, error) {
+	switch txType {
+	case btcstaking.StakingSlashingTx:
+		stakingInfo, err := d.GetStakingInfo(bsParams, btcNet)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("could not create BTC staking info: %v", err)
+		}
+		slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("could not get slashing spend info: %v", err)
+		}
+		slashingMsgTx, err := d.SlashingTx.ToMsgTx()
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to parse slashing tx: %v", err)
+		}
+		return slashingMsgTx, stakingInfo.StakingOutput, slashingSpendInfo.GetPkScriptPath(), nil
+	case btcstaking.UnbondingSlashingTx:
+		if d.BtcUndelegation == nil {
+			return nil, nil, nil, ErrInvalidDelegationState.Wrap("BTC delegation does not have an unbonding tx")
+		}
+		unbondingInfo, err := d.GetUnbondingInfo(bsParams, btcNet)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("could not create BTC unbonding info: %v", err)
+		}
+		slashingSpendInfo, err := unbondingInfo.SlashingPathSpendInfo()
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("could not get unbonding slashing spend info: %v", err)
+		}
+		slashingMsgTx, err := d.BtcUndelegation.SlashingTx.ToMsgTx()
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to parse unbonding slashing tx: %v", err)
+		}
+		return slashingMsgTx, unbondingInfo.UnbondingOutput, slashingSpendInfo.GetPkScriptPath(), nil
+	default:
+		return nil, nil, nil, fmt.Errorf("unknown slashing tx type %d", uint8(txType))
+	}
+}
+
+// CovenantAdaptorSigEnvelopes wraps the adaptor signatures of the given
+// covenant member on the slashing tx of the given type into envelopes, one for
+// each finality provider this BTC delegation restakes to, in the order of
+// FpBtcPkList
+func (d *BTCDelegation) CovenantAdaptorSigEnvelopes(
+	bsParams *Params,
+	btcNet *chaincfg.Params,
+	txType btcstaking.SlashingTxType,
+	covPK *bbn.BIP340PubKey,
+) ([]*btcstaking.AdaptorSigEnvelope, error) {
+	var covSigsList []*CovenantAdaptorSignatures
+	switch txType {
+	case btcstaking.StakingSlashingTx:
+		covSigsList = d.CovenantSigs
+	case btcstaking.UnbondingSlashingTx:
+		if d.BtcUndelegation == nil {
+			return nil, ErrInvalidDelegationState.Wrap("BTC delegation does not have an unbonding tx")
+		}
+		covSigsList = d.BtcUndelegation.CovenantSlashingSigs
+	default:
+		return nil, fmt.Errorf("unknown slashing tx type %d", uint8(txType))
+	}
+
+	var covSigs *CovenantAdaptorSignatures
+	for _, s := range covSigsList {
+		if s.CovPk.Equals(covPK) {
+			covSigs = s
+			break
+		}
+	}
+	if covSigs == nil {
+		return nil, ErrInvalidCovenantPK.Wrapf("covenant PK %s has not signed the %s", covPK.MarshalHex(), txType)
+	}
+	if len(covSigs.AdaptorSigs) != len(d.FpBtcPkList) {
+		return nil, ErrInvalidCovenantSig.Wrapf(
+			"number of adaptor signatures (%d) does not match number of finality providers (%d)",
+			len(covSigs.AdaptorSigs),
+			len(d.FpBtcPkList),
+		)
+	}
+
+	stakingTxHash, err := d.GetStakingTxHash()
+	if err != nil {
+		return nil, err
+	}
+	slashingMsgTx, fundingOut, script, err := d.getSlashingTxWithSpendInfo(bsParams, btcNet, txType)
+	if err != nil {
+		return nil, err
+	}
+	signerPK, err := covPK.ToBTCPK()
+	if err != nil {
+		return nil, err
+	}
+
+	envs := make([]*btcstaking.AdaptorSigEnvelope, len(d.FpBtcPkList))
+	for i := range d.FpBtcPkList {
+		encKey, err := asig.NewEncryptionKeyFromBTCPK(d.FpBtcPkList[i].MustToBTCPK())
+		if err != nil {
+			return nil, err
+		}
+		sig, err := asig.NewAdaptorSignatureFromBytes(covSigs.AdaptorSigs[i])
+		if err != nil {
+			return nil, err
+		}
+		envs[i], err = btcstaking.NewAdaptorSigEnvelope(
+			btcNet, txType, stakingTxHash, slashingMsgTx, fundingOut, script, signerPK, encKey, sig,
+		)
+		if err != nil {
+			return nil, ErrInvalidCovenantSig.Wrapf("err: %v", err)
+		}
+	}
+
+	return envs, nil
+}
+
+// VerifyCovenantAdaptorSigEnvelope verifies that the given envelope is an
+// adaptor signature of a covenant member on the slashing tx of this BTC
+// delegation that the envelope specifies, encrypted by the PK of a finality
+// provider this BTC delegation restakes to. Envelopes of other networks, other
+// BTC delegations or the other slashing tx of this BTC delegation are
+// rejected.
+func (d *BTCDelegation) VerifyCovenantAdaptorSigEnvelope(
+	bsParams *Params,
+	btcNet *chaincfg.Params,
+	env *btcstaking.AdaptorSigEnvelope,
+) error {
+	if env == nil || env.SignerPK == nil || env.EncKey == nil {
+		return ErrInvalidAdaptorSigEnvelope.Wrap("envelope is incomplete")
+	}
+	signerPK := bbn.NewBIP340PubKeyFromBTCPK(env.SignerPK)
+	if !bsParams.HasCovenantPK(signerPK) {
+		return ErrInvalidCovenantPK.Wrapf("signer %s is not a covenant member", signerPK.MarshalHex())
+	}
+	fpPK := bbn.NewBIP340PubKeyFromBTCPK(env.EncKey.ToBTCPK())
+	if d.GetFpIdx(fpPK) < 0 {
+		return ErrFpNotFound.Wrapf("encryption key %s is not a finality provider of the BTC delegation", fpPK.MarshalHex())
+	}
+
+	stakingTxHash, err := d.GetStakingTxHash()
+	if err != nil {
+		return err
+	}
+	slashingMsgTx, fundingOut, script, err := d.getSlashingTxWithSpendInfo(bsParams, btcNet, env.TxType)
+	if err != nil {
+		return ErrInvalidAdaptorSigEnvelope.Wrapf("err: %v", err)
+	}
+	if err := env.Verify(btcNet, env.TxType, stakingTxHash, slashingMsgTx, fundingOut, script); err != nil {
+		return ErrInvalidAdaptorSigEnvelope.Wrapf("err: %v", err)
+	}
+
+	return nil
+}
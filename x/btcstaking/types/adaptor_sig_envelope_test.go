@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzBTCDelegation_AdaptorSigEnvelope(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		net := &chaincfg.SimNetParams
+
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		numRestakedFPs := int(datagen.RandomInt(r, 5) + 1)
+		_, fpPKs, err := datagen.GenRandomBTCKeyPairs(r, numRestakedFPs)
+		require.NoError(t, err)
+		fpBTCPKs := bbn.NewBIP340PKsFromBTCPKs(fpPKs)
+
+		// (3, 5) covenant committee
+		covenantSKs, covenantPKs, err := datagen.GenRandomBTCKeyPairs(r, 5)
+		require.NoError(t, err)
+		covenantQuorum := uint32(3)
+		bsParams := &types.Params{
+			CovenantPks:    bbn.NewBIP340PKsFromBTCPKs(covenantPKs),
+			CovenantQuorum: covenantQuorum,
+		}
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			fpBTCPKs,
+			delSK,
+			covenantSKs[:covenantQuorum],
+			covenantPKs,
+			covenantQuorum,
+			slashingAddress.EncodeAddress(),
+			1000,
+			1005,
+			uint64(2*10e8),
+			slashingRate,
+			101,
+		)
+		require.NoError(t, err)
+		covPK := bbn.NewBIP340PubKeyFromBTCPK(covenantPKs[0])
+
+		slashingEnvs, err := btcDel.CovenantAdaptorSigEnvelopes(bsParams, net, btcstaking.StakingSlashingTx, covPK)
+		require.NoError(t, err)
+		require.Len(t, slashingEnvs, numRestakedFPs)
+		unbondingSlashingEnvs, err := btcDel.CovenantAdaptorSigEnvelopes(bsParams, net, btcstaking.UnbondingSlashingTx, covPK)
+		require.NoError(t, err)
+		require.Len(t, unbondingSlashingEnvs, numRestakedFPs)
+
+		for i := range slashingEnvs {
+			for _, env := range []*btcstaking.AdaptorSigEnvelope{slashingEnvs[i], unbondingSlashingEnvs[i]} {
+				// the parsed envelope verifies against the BTC delegation
+				envBytes, err := env.Marshal()
+				require.NoError(t, err)
+				parsedEnv, err := btcstaking.NewAdaptorSigEnvelopeFromBytes(envBytes)
+				require.NoError(t, err)
+				require.NoError(t, btcDel.VerifyCovenantAdaptorSigEnvelope(bsParams, net, parsedEnv))
+
+				// the envelope does not verify under another network
+				require.Error(t, btcDel.VerifyCovenantAdaptorSigEnvelope(bsParams, &chaincfg.MainNetParams, parsedEnv))
+			}
+
+			// the signature on the slashing tx does not verify when claimed
+			// to be on the unbonding slashing tx, and vice versa
+			mixedEnv := *slashingEnvs[i]
+			mixedEnv.TxType = btcstaking.UnbondingSlashingTx
+			require.ErrorIs(t, btcDel.VerifyCovenantAdaptorSigEnvelope(bsParams, net, &mixedEnv), types.ErrInvalidAdaptorSigEnvelope)
+			mixedEnv = *unbondingSlashingEnvs[i]
+			mixedEnv.TxType = btcstaking.StakingSlashingTx
+			require.ErrorIs(t, btcDel.VerifyCovenantAdaptorSigEnvelope(bsParams, net, &mixedEnv), types.ErrInvalidAdaptorSigEnvelope)
+		}
+
+		// the envelope of another BTC delegation is rejected
+		otherEnv := *slashingEnvs[0]
+		otherEnv.StakingTxHash[0] ^= 0xff
+		require.ErrorIs(t, btcDel.VerifyCovenantAdaptorSigEnvelope(bsParams, net, &otherEnv), types.ErrInvalidAdaptorSigEnvelope)
+
+		// the envelope of a signer that is not a covenant member is rejected
+		otherEnv = *slashingEnvs[0]
+		otherEnv.SignerPK = delSK.PubKey()
+		require.ErrorIs(t, btcDel.VerifyCovenantAdaptorSigEnvelope(bsParams, net, &otherEnv), types.ErrInvalidCovenantPK)
+
+		// a covenant member that did not sign has no envelopes
+		nonSignerPK := bbn.NewBIP340PubKeyFromBTCPK(covenantPKs[covenantQuorum])
+		_, err = btcDel.CovenantAdaptorSigEnvelopes(bsParams, net, btcstaking.StakingSlashingTx, nonSignerPK)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantPK)
+	})
+}
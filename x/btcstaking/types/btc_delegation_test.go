@@ -159,5 +159,13 @@ func FuzzBTCDelegation_SlashingTx(f *testing.F) {
 
 		// assert execution
 		btctest.AssertSlashingTxExecution(t, stakingInfo.StakingOutput, slashingTxWithWitness)
+
+		// the BTC delegation parsed from its query response builds the same
+		// slashing tx with witness
+		parsedBTCDel, err := types.NewBTCDelegationResponse(btcDel, types.BTCDelegationStatus_ACTIVE).ToBTCDelegation()
+		require.NoError(t, err)
+		parsedSlashingTxWithWitness, err := parsedBTCDel.BuildSlashingTxWithWitness(bsParams, net, fpSK)
+		require.NoError(t, err)
+		require.Equal(t, slashingTxWithWitness, parsedSlashingTxWithWitness)
 	})
 }
@@ -21,6 +21,10 @@ func (fp *FinalityProvider) IsSluggish() bool {
 	return fp.Sluggish
 }
 
+func (fp *FinalityProvider) IsJailed() bool {
+	return fp.Jailed
+}
+
 func (fp *FinalityProvider) ValidateBasic() error {
 	// ensure fields are non-empty and well-formatted
 	if _, err := sdk.AccAddressFromBech32(fp.Addr); err != nil {
@@ -43,9 +47,13 @@ func (fp *FinalityProvider) ValidateBasic() error {
 }
 
 // SortFinalityProviders sorts the finality providers slice,
-// from higher to lower voting power
+// from higher to lower voting power. Jailed finality providers
+// are placed after all non-jailed ones
 func SortFinalityProviders(fps []*FinalityProviderDistInfo) {
 	sort.SliceStable(fps, func(i, j int) bool {
+		if fps[i].IsJailed != fps[j].IsJailed {
+			return !fps[i].IsJailed
+		}
 		return fps[i].TotalVotingPower > fps[j].TotalVotingPower
 	})
 }
@@ -92,6 +92,10 @@ type FinalityProvider struct {
 	SlashedBtcHeight uint64 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
 	// sluggish defines whether the finality provider is detected sluggish
 	Sluggish bool `protobuf:"varint,8,opt,name=sluggish,proto3" json:"sluggish,omitempty"`
+	// jailed defines whether the finality provider is jailed due to being
+	// sluggish. A jailed finality provider is removed from the active set
+	// until it is unjailed
+	Jailed bool `protobuf:"varint,9,opt,name=jailed,proto3" json:"jailed,omitempty"`
 }
 
 func (m *FinalityProvider) Reset()         { *m = FinalityProvider{} }
@@ -169,6 +173,13 @@ func (m *FinalityProvider) GetSluggish() bool {
 	return false
 }
 
+func (m *FinalityProvider) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
 // FinalityProviderWithMeta wraps the FinalityProvider with metadata.
 type FinalityProviderWithMeta struct {
 	// btc_pk is the Bitcoin secp256k1 PK of thisfinality provider
@@ -188,6 +199,8 @@ type FinalityProviderWithMeta struct {
 	SlashedBtcHeight uint64 `protobuf:"varint,5,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
 	// sluggish defines whether the finality provider is detected sluggish
 	Sluggish bool `protobuf:"varint,6,opt,name=sluggish,proto3" json:"sluggish,omitempty"`
+	// jailed defines whether the finality provider is jailed
+	Jailed bool `protobuf:"varint,7,opt,name=jailed,proto3" json:"jailed,omitempty"`
 }
 
 func (m *FinalityProviderWithMeta) Reset()         { *m = FinalityProviderWithMeta{} }
@@ -258,6 +271,13 @@ func (m *FinalityProviderWithMeta) GetSluggish() bool {
 	return false
 }
 
+func (m *FinalityProviderWithMeta) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
 // BTCDelegation defines a BTC delegation
 type BTCDelegation struct {
 	// staker_addr is the address to receive rewards from BTC delegation.
@@ -444,6 +464,10 @@ type BTCUndelegation struct {
 	// by covenant members
 	// It must be provided after processing undelegate message by Babylon
 	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,6,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
+	// unbonding_btc_height is the BTC tip height when Babylon receives the
+	// delegator's signature on the unbonding tx. The unbonding output is
+	// considered slashable until unbonding_time BTC blocks after it
+	UnbondingBtcHeight uint64 `protobuf:"varint,7,opt,name=unbonding_btc_height,json=unbondingBtcHeight,proto3" json:"unbonding_btc_height,omitempty"`
 }
 
 func (m *BTCUndelegation) Reset()         { *m = BTCUndelegation{} }
@@ -500,6 +524,13 @@ func (m *BTCUndelegation) GetCovenantUnbondingSigList() []*SignatureInfo {
 	return nil
 }
 
+func (m *BTCUndelegation) GetUnbondingBtcHeight() uint64 {
+	if m != nil {
+		return m.UnbondingBtcHeight
+	}
+	return 0
+}
+
 // BTCDelegatorDelegations is a collection of BTC delegations from the same delegator.
 type BTCDelegatorDelegations struct {
 	Dels []*BTCDelegation `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
@@ -744,6 +775,241 @@ func (m *SelectiveSlashingEvidence) GetRecoveredFpBtcSk() []byte {
 	return nil
 }
 
+// PendingSlashingTx is a BTC delegation under a slashed finality provider
+// whose fully witnessed slashing txs are yet to be assembled. It is queued
+// once the finality provider's secret key is extracted, and processed at the
+// end of a later block.
+type PendingSlashingTx struct {
+	// fp_btc_pk is the BTC PK of the slashed finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// fp_btc_sk is the extracted BTC SK of the slashed finality provider
+	FpBtcSk []byte `protobuf:"bytes,3,opt,name=fp_btc_sk,json=fpBtcSk,proto3" json:"fp_btc_sk,omitempty"`
+}
+
+func (m *PendingSlashingTx) Reset()         { *m = PendingSlashingTx{} }
+func (m *PendingSlashingTx) String() string { return proto.CompactTextString(m) }
+func (*PendingSlashingTx) ProtoMessage()    {}
+func (*PendingSlashingTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{9}
+}
+func (m *PendingSlashingTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSlashingTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSlashingTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSlashingTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSlashingTx.Merge(m, src)
+}
+func (m *PendingSlashingTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSlashingTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSlashingTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSlashingTx proto.InternalMessageInfo
+
+func (m *PendingSlashingTx) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *PendingSlashingTx) GetFpBtcSk() []byte {
+	if m != nil {
+		return m.FpBtcSk
+	}
+	return nil
+}
+
+// SignedSlashingTx contains the fully witnessed slashing txs of a BTC delegation
+// under a slashed finality provider. They are assembled once the finality
+// provider's secret key is extracted, such that anyone can submit them to Bitcoin.
+type SignedSlashingTx struct {
+	// fp_btc_pk is the BTC PK of the slashed finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// slashing_tx is the fully witnessed slashing tx spending the staking output.
+	// It is empty if the BTC delegation has unbonded early, as the staking
+	// output is already spent by the unbonding tx
+	SlashingTx []byte `protobuf:"bytes,3,opt,name=slashing_tx,json=slashingTx,proto3" json:"slashing_tx,omitempty"`
+	// unbonding_slashing_tx is the fully witnessed slashing tx spending the
+	// unbonding output
+	UnbondingSlashingTx []byte `protobuf:"bytes,4,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3" json:"unbonding_slashing_tx,omitempty"`
+}
+
+func (m *SignedSlashingTx) Reset()         { *m = SignedSlashingTx{} }
+func (m *SignedSlashingTx) String() string { return proto.CompactTextString(m) }
+func (*SignedSlashingTx) ProtoMessage()    {}
+func (*SignedSlashingTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{10}
+}
+func (m *SignedSlashingTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedSlashingTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedSlashingTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedSlashingTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedSlashingTx.Merge(m, src)
+}
+func (m *SignedSlashingTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedSlashingTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedSlashingTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedSlashingTx proto.InternalMessageInfo
+
+func (m *SignedSlashingTx) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *SignedSlashingTx) GetSlashingTx() []byte {
+	if m != nil {
+		return m.SlashingTx
+	}
+	return nil
+}
+
+func (m *SignedSlashingTx) GetUnbondingSlashingTx() []byte {
+	if m != nil {
+		return m.UnbondingSlashingTx
+	}
+	return nil
+}
+
+// SlashingRecord records the loss of a BTC delegation that is slashed due to
+// a slashed finality provider. The amounts are computed from the slashing tx
+// that is effective for the BTC delegation, i.e., the unbonding slashing tx if
+// the BTC delegation has unbonded early, or the slashing tx otherwise.
+type SlashingRecord struct {
+	// fp_btc_pk is the BTC PK of the slashed finality provider that caused
+	// the slashing of the BTC delegation
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// slashing_rate is the slashing rate applied to the BTC delegation
+	SlashingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=slashing_rate,json=slashingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slashing_rate"`
+	// unbonded_early indicates whether the amounts are computed from the
+	// unbonding slashing tx
+	UnbondedEarly bool `protobuf:"varint,4,opt,name=unbonded_early,json=unbondedEarly,proto3" json:"unbonded_early,omitempty"`
+	// total_amount is the value (in Satoshis) of the output spent by the
+	// slashing tx
+	TotalAmount uint64 `protobuf:"varint,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// slashed_amount is the value (in Satoshis) sent to the slashing address
+	SlashedAmount uint64 `protobuf:"varint,6,opt,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount,omitempty"`
+	// returned_amount is the value (in Satoshis) returned to the staker
+	ReturnedAmount uint64 `protobuf:"varint,7,opt,name=returned_amount,json=returnedAmount,proto3" json:"returned_amount,omitempty"`
+	// fee is the fee (in Satoshis) paid by the slashing tx
+	Fee uint64 `protobuf:"varint,8,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (m *SlashingRecord) Reset()         { *m = SlashingRecord{} }
+func (m *SlashingRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashingRecord) ProtoMessage()    {}
+func (*SlashingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{11}
+}
+func (m *SlashingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingRecord.Merge(m, src)
+}
+func (m *SlashingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingRecord proto.InternalMessageInfo
+
+func (m *SlashingRecord) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *SlashingRecord) GetUnbondedEarly() bool {
+	if m != nil {
+		return m.UnbondedEarly
+	}
+	return false
+}
+
+func (m *SlashingRecord) GetTotalAmount() uint64 {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return 0
+}
+
+func (m *SlashingRecord) GetSlashedAmount() uint64 {
+	if m != nil {
+		return m.SlashedAmount
+	}
+	return 0
+}
+
+func (m *SlashingRecord) GetReturnedAmount() uint64 {
+	if m != nil {
+		return m.ReturnedAmount
+	}
+	return 0
+}
+
+func (m *SlashingRecord) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("babylon.btcstaking.v1.BTCDelegationStatus", BTCDelegationStatus_name, BTCDelegationStatus_value)
 	proto.RegisterType((*FinalityProvider)(nil), "babylon.btcstaking.v1.FinalityProvider")
@@ -755,6 +1021,9 @@ func init() {
 	proto.RegisterType((*SignatureInfo)(nil), "babylon.btcstaking.v1.SignatureInfo")
 	proto.RegisterType((*CovenantAdaptorSignatures)(nil), "babylon.btcstaking.v1.CovenantAdaptorSignatures")
 	proto.RegisterType((*SelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.SelectiveSlashingEvidence")
+	proto.RegisterType((*PendingSlashingTx)(nil), "babylon.btcstaking.v1.PendingSlashingTx")
+	proto.RegisterType((*SignedSlashingTx)(nil), "babylon.btcstaking.v1.SignedSlashingTx")
+	proto.RegisterType((*SlashingRecord)(nil), "babylon.btcstaking.v1.SlashingRecord")
 }
 
 func init() {
@@ -762,83 +1031,96 @@ func init() {
 }
 
 var fileDescriptor_3851ae95ccfaf7db = []byte{
-	// 1210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x13, 0xc7,
-	0x1b, 0xce, 0xda, 0x8e, 0x93, 0xbc, 0xb6, 0x89, 0x19, 0x42, 0x58, 0x12, 0xfd, 0x12, 0xff, 0x5c,
-	0x8a, 0xac, 0x96, 0xd8, 0x10, 0x68, 0x55, 0x0e, 0x3d, 0xc4, 0x71, 0x28, 0x11, 0x10, 0xdc, 0xb5,
-	0x43, 0xd5, 0x56, 0xea, 0x6a, 0xbc, 0x3b, 0x59, 0x8f, 0x6c, 0xef, 0x6c, 0x77, 0xc6, 0xae, 0xf3,
-	0x21, 0x2a, 0xf5, 0xda, 0x3b, 0x1f, 0x81, 0xcf, 0x50, 0xf5, 0x88, 0x38, 0x55, 0x39, 0x44, 0x15,
-	0xf4, 0xda, 0xef, 0x50, 0xcd, 0xec, 0x7a, 0x77, 0x9d, 0x12, 0x0a, 0x98, 0x9b, 0xe7, 0xfd, 0xf7,
-	0xbc, 0xf3, 0x3e, 0x8f, 0xdf, 0x59, 0xb8, 0xde, 0xc1, 0x9d, 0xe3, 0x3e, 0x73, 0x6b, 0x1d, 0x61,
-	0x71, 0x81, 0x7b, 0xd4, 0x75, 0x6a, 0xa3, 0x5b, 0x89, 0x53, 0xd5, 0xf3, 0x99, 0x60, 0xe8, 0x72,
-	0x18, 0x57, 0x4d, 0x78, 0x46, 0xb7, 0xd6, 0x56, 0x1c, 0xe6, 0x30, 0x15, 0x51, 0x93, 0xbf, 0x82,
-	0xe0, 0xb5, 0xab, 0x16, 0xe3, 0x03, 0xc6, 0xcd, 0xc0, 0x11, 0x1c, 0x42, 0xd7, 0xb5, 0xe0, 0x54,
-	0x8b, 0xb1, 0x3a, 0x44, 0xe0, 0x5b, 0xb5, 0x29, 0xb4, 0xb5, 0xcd, 0xd7, 0x77, 0xe5, 0x31, 0x2f,
-	0x08, 0x28, 0xff, 0x95, 0x86, 0xe2, 0x3d, 0xea, 0xe2, 0x3e, 0x15, 0xc7, 0x4d, 0x9f, 0x8d, 0xa8,
-	0x4d, 0x7c, 0x74, 0x03, 0x32, 0xd8, 0xb6, 0x7d, 0x5d, 0x2b, 0x69, 0x95, 0xa5, 0xba, 0xfe, 0xe2,
-	0xd9, 0xd6, 0x4a, 0x88, 0xbd, 0x63, 0xdb, 0x3e, 0xe1, 0xbc, 0x25, 0x7c, 0xea, 0x3a, 0x86, 0x8a,
-	0x42, 0x7b, 0x90, 0xb3, 0x09, 0xb7, 0x7c, 0xea, 0x09, 0xca, 0x5c, 0x3d, 0x55, 0xd2, 0x2a, 0xb9,
-	0xed, 0x8f, 0xaa, 0x61, 0x46, 0x7c, 0x47, 0xd5, 0x5f, 0xb5, 0x11, 0x87, 0x1a, 0xc9, 0x3c, 0xf4,
-	0x08, 0xc0, 0x62, 0x83, 0x01, 0xe5, 0x5c, 0x56, 0x49, 0x2b, 0xe8, 0xad, 0x93, 0xd3, 0xcd, 0xf5,
-	0xa0, 0x10, 0xb7, 0x7b, 0x55, 0xca, 0x6a, 0x03, 0x2c, 0xba, 0xd5, 0x87, 0xc4, 0xc1, 0xd6, 0x71,
-	0x83, 0x58, 0x2f, 0x9e, 0x6d, 0x41, 0x88, 0xd3, 0x20, 0x96, 0x91, 0x28, 0x80, 0x1e, 0x41, 0xb6,
-	0x23, 0x2c, 0xd3, 0xeb, 0xe9, 0x99, 0x92, 0x56, 0xc9, 0xd7, 0x3f, 0x3f, 0x39, 0xdd, 0xdc, 0x76,
-	0xa8, 0xe8, 0x0e, 0x3b, 0x55, 0x8b, 0x0d, 0x6a, 0xe1, 0x60, 0xac, 0x2e, 0xa6, 0xee, 0xe4, 0x50,
-	0x13, 0xc7, 0x1e, 0xe1, 0xd5, 0xfa, 0x7e, 0xf3, 0xf6, 0x9d, 0x9b, 0xcd, 0x61, 0xe7, 0x01, 0x39,
-	0x36, 0xe6, 0x3b, 0xc2, 0x6a, 0xf6, 0xd0, 0x97, 0x90, 0xf6, 0x98, 0xa7, 0xcf, 0xab, 0xcb, 0x7d,
-	0x5a, 0x7d, 0x2d, 0x89, 0xd5, 0xa6, 0xcf, 0xd8, 0xd1, 0xe3, 0xa3, 0x26, 0xe3, 0x9c, 0xa8, 0x2e,
-	0xea, 0xed, 0x5d, 0x43, 0xe6, 0xa1, 0x3b, 0xb0, 0xca, 0xfb, 0x98, 0x77, 0x89, 0x6d, 0x86, 0xa9,
-	0x66, 0x97, 0x50, 0xa7, 0x2b, 0xf4, 0x6c, 0x49, 0xab, 0x64, 0x8c, 0x95, 0xd0, 0x5b, 0x0f, 0x9c,
-	0xf7, 0x95, 0x0f, 0xdd, 0x00, 0x14, 0x65, 0x09, 0x6b, 0x92, 0xb1, 0xa0, 0x32, 0x8a, 0x93, 0x0c,
-	0x61, 0x85, 0xd1, 0x6b, 0xb0, 0xc8, 0xfb, 0x43, 0xc7, 0xa1, 0xbc, 0xab, 0x2f, 0x96, 0xb4, 0xca,
-	0xa2, 0x11, 0x9d, 0xcb, 0x4f, 0x53, 0xa0, 0x9f, 0xa5, 0xf9, 0x1b, 0x2a, 0xba, 0x8f, 0x88, 0xc0,
-	0x89, 0x51, 0x69, 0x1f, 0x62, 0x54, 0xab, 0x90, 0x0d, 0x3b, 0x4d, 0xa9, 0x4e, 0xc3, 0x13, 0xfa,
-	0x3f, 0xe4, 0x47, 0x4c, 0x50, 0xd7, 0x31, 0x3d, 0xf6, 0x13, 0xf1, 0x15, 0xc5, 0x19, 0x23, 0x17,
-	0xd8, 0x9a, 0xd2, 0xf4, 0x86, 0x31, 0x65, 0xde, 0x79, 0x4c, 0xf3, 0x6f, 0x31, 0xa6, 0xec, 0x99,
-	0x31, 0xfd, 0x9d, 0x85, 0x42, 0xbd, 0xbd, 0xdb, 0x20, 0x7d, 0xe2, 0x60, 0xa5, 0xca, 0xbb, 0x90,
-	0x93, 0x04, 0x13, 0xdf, 0x7c, 0xab, 0x7f, 0x04, 0x04, 0xc1, 0xd2, 0x98, 0x18, 0x6b, 0xea, 0x03,
-	0x2a, 0x30, 0xfd, 0x9e, 0x0a, 0xfc, 0x1e, 0x2e, 0x1c, 0x79, 0x66, 0xd0, 0x90, 0xd9, 0xa7, 0x5c,
-	0x8e, 0x34, 0x3d, 0x43, 0x57, 0xb9, 0x23, 0xaf, 0x2e, 0xfb, 0x7a, 0x48, 0xb9, 0xa2, 0x96, 0x0b,
-	0xec, 0x8b, 0xe9, 0xd9, 0xe7, 0x94, 0x2d, 0x1c, 0xfb, 0xff, 0x00, 0x88, 0x6b, 0x4f, 0xab, 0x7e,
-	0x89, 0xb8, 0x76, 0xe8, 0x5e, 0x87, 0x25, 0xc1, 0x04, 0xee, 0x9b, 0x1c, 0x4f, 0x14, 0xbe, 0xa8,
-	0x0c, 0x2d, 0xac, 0x72, 0xc3, 0x3b, 0x9a, 0x62, 0xac, 0xb4, 0x9d, 0x37, 0x96, 0x42, 0x4b, 0x7b,
-	0xac, 0xf8, 0x0f, 0xdd, 0x6c, 0x28, 0xbc, 0xa1, 0x30, 0xa9, 0x3d, 0xd6, 0x97, 0x4a, 0x5a, 0xa5,
-	0x60, 0x14, 0x43, 0xcf, 0x63, 0xe5, 0xd8, 0xb7, 0xc7, 0x68, 0x1b, 0x72, 0x4a, 0x13, 0x61, 0x35,
-	0x50, 0xdc, 0x5c, 0x3c, 0x39, 0xdd, 0x94, 0xcc, 0xb7, 0x42, 0x4f, 0x7b, 0x6c, 0x00, 0x8f, 0x7e,
-	0xa3, 0x1f, 0xa0, 0x60, 0x07, 0x9a, 0x60, 0xbe, 0xc9, 0xa9, 0xa3, 0xe7, 0x54, 0xd6, 0xdd, 0x93,
-	0xd3, 0xcd, 0xcf, 0xde, 0x65, 0x76, 0x2d, 0xea, 0xb8, 0x58, 0x0c, 0x7d, 0x62, 0xe4, 0xa3, 0x7a,
-	0x2d, 0xea, 0xa0, 0x43, 0x28, 0x58, 0x6c, 0x44, 0x5c, 0xec, 0x0a, 0x59, 0x9e, 0xeb, 0xf9, 0x52,
-	0xba, 0x92, 0xdb, 0xbe, 0x79, 0x0e, 0xcb, 0xbb, 0x61, 0xec, 0x8e, 0x8d, 0xbd, 0xa0, 0x42, 0x50,
-	0x95, 0x1b, 0xf9, 0x49, 0x99, 0x16, 0x75, 0x38, 0xfa, 0x18, 0x2e, 0x0c, 0xdd, 0x0e, 0x73, 0x6d,
-	0x75, 0x57, 0x3a, 0x20, 0x7a, 0x41, 0x0d, 0xa5, 0x10, 0x59, 0xdb, 0x74, 0x40, 0xd0, 0xd7, 0x50,
-	0x94, 0xba, 0x18, 0xba, 0x76, 0xa4, 0x7b, 0xfd, 0x82, 0x92, 0xd9, 0xf5, 0x73, 0x1a, 0xa8, 0xb7,
-	0x77, 0x0f, 0x13, 0xd1, 0xc6, 0x72, 0x47, 0x58, 0x49, 0x83, 0x44, 0xf6, 0xb0, 0x8f, 0x07, 0xdc,
-	0x1c, 0x11, 0x5f, 0x2d, 0xf4, 0xe5, 0x00, 0x39, 0xb0, 0x3e, 0x09, 0x8c, 0xe5, 0x5f, 0x33, 0xb0,
-	0x7c, 0xa6, 0x96, 0xd4, 0x52, 0xa2, 0xe9, 0x71, 0xb0, 0x93, 0x8c, 0x5c, 0xdc, 0xf2, 0xbf, 0x28,
-	0x4c, 0xbd, 0x0d, 0x85, 0x3f, 0xc2, 0x95, 0x98, 0xc2, 0x18, 0x40, 0x92, 0x99, 0x9e, 0x95, 0xcc,
-	0xcb, 0x51, 0xe5, 0xc3, 0x49, 0x61, 0xc9, 0x2a, 0x83, 0xd5, 0x84, 0x6a, 0x26, 0x0d, 0x4b, 0xc4,
-	0xcc, 0xac, 0x88, 0x2b, 0xb1, 0x7c, 0xc2, 0xba, 0x12, 0xf0, 0x08, 0x56, 0x63, 0x19, 0x25, 0xf0,
-	0xb8, 0x3e, 0xff, 0x9e, 0x7a, 0x5a, 0x89, 0xf4, 0x14, 0xc3, 0x70, 0x64, 0xc1, 0x7a, 0x84, 0x33,
-	0x35, 0xca, 0x60, 0xb1, 0x64, 0x15, 0xd8, 0xb5, 0x73, 0xc0, 0xa2, 0xea, 0xfb, 0xee, 0x11, 0x33,
-	0xf4, 0x49, 0xa1, 0xe4, 0xe4, 0xe4, 0x4e, 0x29, 0xb7, 0xe0, 0x4a, 0xbc, 0x8a, 0x99, 0x1f, 0xef,
-	0x64, 0x8e, 0xbe, 0x80, 0x8c, 0x4d, 0xfa, 0x5c, 0xd7, 0xde, 0x08, 0x34, 0xb5, 0xc8, 0x0d, 0x95,
-	0x51, 0x3e, 0x80, 0xf5, 0xd7, 0x17, 0xdd, 0x77, 0x6d, 0x32, 0x46, 0x35, 0x58, 0x89, 0x17, 0x8d,
-	0xd9, 0xc5, 0xbc, 0x1b, 0xdc, 0x48, 0x02, 0xe5, 0x8d, 0x8b, 0xd1, 0xca, 0xb9, 0x8f, 0x79, 0x57,
-	0x35, 0xf9, 0x54, 0x83, 0xc2, 0xd4, 0x85, 0xd0, 0x3d, 0x48, 0xcd, 0xfc, 0x90, 0xa6, 0xbc, 0x1e,
-	0x7a, 0x00, 0x69, 0xa9, 0x94, 0xd4, 0xac, 0x4a, 0x91, 0x55, 0xca, 0x3f, 0x6b, 0x70, 0xf5, 0x5c,
-	0x92, 0xe5, 0x43, 0x65, 0xb1, 0xd1, 0x07, 0x78, 0xff, 0x2d, 0x36, 0x6a, 0xf6, 0xe4, 0x1f, 0x18,
-	0x07, 0x18, 0x81, 0xf6, 0x52, 0x6a, 0x78, 0x39, 0x1c, 0xe1, 0xf2, 0xf2, 0x6f, 0x1a, 0x5c, 0x6d,
-	0x91, 0x3e, 0xb1, 0x04, 0x1d, 0x91, 0x89, 0xb4, 0xf6, 0xe4, 0x57, 0x89, 0x6b, 0x11, 0x74, 0x1d,
-	0x96, 0xcf, 0xb0, 0x10, 0xbc, 0xbb, 0x46, 0x61, 0x8a, 0x00, 0x64, 0xc0, 0x52, 0xf4, 0xa4, 0xcd,
-	0xf8, 0xc6, 0x2e, 0x84, 0xaf, 0x19, 0xda, 0x82, 0x4b, 0x3e, 0x91, 0x9a, 0xf4, 0x89, 0x6d, 0x86,
-	0xd5, 0x79, 0x2f, 0x58, 0x11, 0x46, 0x31, 0x72, 0xdd, 0x93, 0xe1, 0xad, 0xde, 0x27, 0x7b, 0x70,
-	0x69, 0x4a, 0x66, 0x2d, 0x81, 0xc5, 0x90, 0xa3, 0x1c, 0x2c, 0x34, 0xf7, 0x0e, 0x1a, 0xfb, 0x07,
-	0x5f, 0x15, 0xe7, 0x10, 0x40, 0x76, 0x67, 0xb7, 0xbd, 0xff, 0x64, 0xaf, 0xa8, 0xa1, 0x3c, 0x2c,
-	0x1e, 0x1e, 0xd4, 0x1f, 0x1f, 0x34, 0xf6, 0x1a, 0xc5, 0x14, 0x5a, 0x80, 0xf4, 0xce, 0xc1, 0xb7,
-	0xc5, 0x74, 0xfd, 0xe1, 0xef, 0x2f, 0x37, 0xb4, 0xe7, 0x2f, 0x37, 0xb4, 0x3f, 0x5f, 0x6e, 0x68,
-	0xbf, 0xbc, 0xda, 0x98, 0x7b, 0xfe, 0x6a, 0x63, 0xee, 0x8f, 0x57, 0x1b, 0x73, 0xdf, 0xfd, 0xe7,
-	0x65, 0xc6, 0xc9, 0x4f, 0x7b, 0x75, 0xb3, 0x4e, 0x56, 0x7d, 0xda, 0xdf, 0xfe, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0xbf, 0xab, 0xbe, 0x1d, 0x93, 0x0c, 0x00, 0x00,
+	// 1421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x4f, 0x1b, 0xc7,
+	0x17, 0x67, 0x6d, 0x63, 0xe0, 0xd9, 0x06, 0x67, 0x43, 0xc8, 0x06, 0xf4, 0x05, 0xbe, 0x6e, 0x9a,
+	0xa2, 0x36, 0xd8, 0x81, 0xa4, 0x55, 0x73, 0xe8, 0x01, 0x03, 0x69, 0x50, 0x12, 0xe2, 0xae, 0x21,
+	0x55, 0x5b, 0xa9, 0xab, 0xf1, 0xee, 0x78, 0xbd, 0xb5, 0xbd, 0xb3, 0xdd, 0x19, 0xbb, 0xe6, 0x8f,
+	0xa8, 0x94, 0x3f, 0xa2, 0xb7, 0x5e, 0x73, 0xec, 0xb9, 0x8a, 0xd4, 0x4b, 0x94, 0x53, 0xc5, 0x01,
+	0x55, 0xe1, 0xdc, 0xff, 0xa0, 0x87, 0x6a, 0x7e, 0x78, 0x77, 0x9d, 0x40, 0x9a, 0x04, 0xa4, 0xf6,
+	0xb6, 0xf3, 0x7e, 0x7c, 0xde, 0x9b, 0xf7, 0x3e, 0xf3, 0x66, 0x16, 0xae, 0x35, 0x50, 0xe3, 0xa0,
+	0x43, 0xfc, 0x4a, 0x83, 0xd9, 0x94, 0xa1, 0xb6, 0xe7, 0xbb, 0x95, 0xfe, 0x5a, 0x62, 0x55, 0x0e,
+	0x42, 0xc2, 0x88, 0x7e, 0x49, 0xd9, 0x95, 0x13, 0x9a, 0xfe, 0xda, 0xfc, 0xac, 0x4b, 0x5c, 0x22,
+	0x2c, 0x2a, 0xfc, 0x4b, 0x1a, 0xcf, 0x5f, 0xb1, 0x09, 0xed, 0x12, 0x6a, 0x49, 0x85, 0x5c, 0x28,
+	0xd5, 0x55, 0xb9, 0xaa, 0xc4, 0xb1, 0x1a, 0x98, 0xa1, 0xb5, 0xca, 0x48, 0xb4, 0xf9, 0xa5, 0x93,
+	0xb3, 0x0a, 0x48, 0x20, 0x0d, 0x4a, 0x7f, 0xa5, 0xa1, 0x78, 0xc7, 0xf3, 0x51, 0xc7, 0x63, 0x07,
+	0xb5, 0x90, 0xf4, 0x3d, 0x07, 0x87, 0xfa, 0x75, 0xc8, 0x20, 0xc7, 0x09, 0x0d, 0x6d, 0x59, 0x5b,
+	0x99, 0xaa, 0x1a, 0xcf, 0x9f, 0xac, 0xce, 0xaa, 0xd8, 0x1b, 0x8e, 0x13, 0x62, 0x4a, 0xeb, 0x2c,
+	0xf4, 0x7c, 0xd7, 0x14, 0x56, 0xfa, 0x36, 0xe4, 0x1c, 0x4c, 0xed, 0xd0, 0x0b, 0x98, 0x47, 0x7c,
+	0x23, 0xb5, 0xac, 0xad, 0xe4, 0xd6, 0xdf, 0x2b, 0x2b, 0x8f, 0x78, 0x8f, 0x22, 0xbf, 0xf2, 0x56,
+	0x6c, 0x6a, 0x26, 0xfd, 0xf4, 0x07, 0x00, 0x36, 0xe9, 0x76, 0x3d, 0x4a, 0x39, 0x4a, 0x5a, 0x84,
+	0x5e, 0x3d, 0x3c, 0x5a, 0x5a, 0x90, 0x40, 0xd4, 0x69, 0x97, 0x3d, 0x52, 0xe9, 0x22, 0xd6, 0x2a,
+	0xdf, 0xc7, 0x2e, 0xb2, 0x0f, 0xb6, 0xb0, 0xfd, 0xfc, 0xc9, 0x2a, 0xa8, 0x38, 0x5b, 0xd8, 0x36,
+	0x13, 0x00, 0xfa, 0x03, 0xc8, 0x36, 0x98, 0x6d, 0x05, 0x6d, 0x23, 0xb3, 0xac, 0xad, 0xe4, 0xab,
+	0x9f, 0x1c, 0x1e, 0x2d, 0xad, 0xbb, 0x1e, 0x6b, 0xf5, 0x1a, 0x65, 0x9b, 0x74, 0x2b, 0xaa, 0x30,
+	0x76, 0x0b, 0x79, 0xfe, 0x70, 0x51, 0x61, 0x07, 0x01, 0xa6, 0xe5, 0xea, 0x4e, 0xed, 0xe6, 0xad,
+	0x1b, 0xb5, 0x5e, 0xe3, 0x1e, 0x3e, 0x30, 0xc7, 0x1b, 0xcc, 0xae, 0xb5, 0xf5, 0xcf, 0x20, 0x1d,
+	0x90, 0xc0, 0x18, 0x17, 0x9b, 0xfb, 0xa8, 0x7c, 0x62, 0x13, 0xcb, 0xb5, 0x90, 0x90, 0xe6, 0xc3,
+	0x66, 0x8d, 0x50, 0x8a, 0x45, 0x16, 0xd5, 0xbd, 0x4d, 0x93, 0xfb, 0xe9, 0xb7, 0x60, 0x8e, 0x76,
+	0x10, 0x6d, 0x61, 0xc7, 0x52, 0xae, 0x56, 0x0b, 0x7b, 0x6e, 0x8b, 0x19, 0xd9, 0x65, 0x6d, 0x25,
+	0x63, 0xce, 0x2a, 0x6d, 0x55, 0x2a, 0xef, 0x0a, 0x9d, 0x7e, 0x1d, 0xf4, 0xc8, 0x8b, 0xd9, 0x43,
+	0x8f, 0x09, 0xe1, 0x51, 0x1c, 0x7a, 0x30, 0x5b, 0x59, 0xcf, 0xc3, 0x24, 0xed, 0xf4, 0x5c, 0xd7,
+	0xa3, 0x2d, 0x63, 0x72, 0x59, 0x5b, 0x99, 0x34, 0xa3, 0xb5, 0x3e, 0x07, 0xd9, 0xef, 0x90, 0xd7,
+	0xc1, 0x8e, 0x31, 0x25, 0x34, 0x6a, 0x55, 0xfa, 0x25, 0x05, 0xc6, 0xcb, 0xed, 0xff, 0xd2, 0x63,
+	0xad, 0x07, 0x98, 0xa1, 0x44, 0x09, 0xb5, 0xf3, 0x28, 0xe1, 0x1c, 0x64, 0xd5, 0x0e, 0x52, 0x62,
+	0x07, 0x6a, 0xa5, 0xff, 0x1f, 0xf2, 0x7d, 0xc2, 0x3c, 0xdf, 0xb5, 0x02, 0xf2, 0x03, 0x0e, 0x45,
+	0xeb, 0x33, 0x66, 0x4e, 0xca, 0x6a, 0x5c, 0xf4, 0x9a, 0xf2, 0x65, 0xde, 0xba, 0x7c, 0xe3, 0x6f,
+	0x50, 0xbe, 0xec, 0xa9, 0xe5, 0x9b, 0x18, 0x29, 0xdf, 0x9f, 0x59, 0x28, 0x54, 0xf7, 0x36, 0xb7,
+	0x70, 0x07, 0xbb, 0x48, 0xb0, 0xf8, 0x36, 0xe4, 0x38, 0x21, 0x70, 0x68, 0xbd, 0xd1, 0x09, 0x02,
+	0x69, 0xcc, 0x85, 0x89, 0x72, 0xa7, 0xce, 0x91, 0xb1, 0xe9, 0x77, 0x64, 0xec, 0x37, 0x30, 0xdd,
+	0x0c, 0x2c, 0x99, 0x90, 0xd5, 0xf1, 0x28, 0x2f, 0x75, 0xfa, 0x0c, 0x59, 0xe5, 0x9a, 0x41, 0x95,
+	0xe7, 0x75, 0xdf, 0xa3, 0xa2, 0xe5, 0x94, 0xa1, 0x90, 0x8d, 0xf6, 0x24, 0x27, 0x64, 0xaa, 0x1d,
+	0xff, 0x03, 0xc0, 0xbe, 0x33, 0x7a, 0x4a, 0xa6, 0xb0, 0xef, 0x28, 0xf5, 0x02, 0x4c, 0x31, 0xc2,
+	0x50, 0xc7, 0xa2, 0x68, 0x78, 0x22, 0x26, 0x85, 0xa0, 0x8e, 0x84, 0xaf, 0xda, 0xa3, 0xc5, 0x06,
+	0xe2, 0x2c, 0xe4, 0xcd, 0x29, 0x25, 0xd9, 0x1b, 0x08, 0x5e, 0x28, 0x35, 0xe9, 0xb1, 0xa0, 0xc7,
+	0x2c, 0xcf, 0x19, 0x88, 0x83, 0x51, 0x30, 0x8b, 0x4a, 0xf3, 0x50, 0x28, 0x76, 0x9c, 0x81, 0xbe,
+	0x0e, 0x39, 0xc1, 0x15, 0x85, 0x06, 0xa2, 0x37, 0x17, 0x0e, 0x8f, 0x96, 0x78, 0xe7, 0xeb, 0x4a,
+	0xb3, 0x37, 0x30, 0x81, 0x46, 0xdf, 0xfa, 0xb7, 0x50, 0x70, 0x24, 0x27, 0x48, 0x68, 0x51, 0xcf,
+	0x35, 0x72, 0xc2, 0xeb, 0xf6, 0xe1, 0xd1, 0xd2, 0xc7, 0x6f, 0x53, 0xbb, 0xba, 0xe7, 0xfa, 0x88,
+	0xf5, 0x42, 0x6c, 0xe6, 0x23, 0xbc, 0xba, 0xe7, 0xea, 0xfb, 0x50, 0xb0, 0x49, 0x1f, 0xfb, 0xc8,
+	0x67, 0x1c, 0x9e, 0x1a, 0xf9, 0xe5, 0xf4, 0x4a, 0x6e, 0xfd, 0xc6, 0x29, 0x5d, 0xde, 0x54, 0xb6,
+	0x1b, 0x0e, 0x0a, 0x24, 0x82, 0x44, 0xa5, 0x66, 0x7e, 0x08, 0x53, 0xf7, 0x5c, 0xaa, 0xbf, 0x0f,
+	0xd3, 0x3d, 0xbf, 0x41, 0x7c, 0x47, 0xec, 0xd5, 0xeb, 0x62, 0xa3, 0x20, 0x8a, 0x52, 0x88, 0xa4,
+	0x7b, 0x5e, 0x17, 0xeb, 0x5f, 0x40, 0x91, 0xf3, 0xa2, 0xe7, 0x3b, 0x11, 0xef, 0x8d, 0x69, 0x41,
+	0xb3, 0x6b, 0xa7, 0x24, 0x50, 0xdd, 0xdb, 0xdc, 0x4f, 0x58, 0x9b, 0x33, 0x0d, 0x66, 0x27, 0x05,
+	0x3c, 0x72, 0x80, 0x42, 0xd4, 0xa5, 0x56, 0x1f, 0x87, 0xe2, 0x02, 0x98, 0x91, 0x91, 0xa5, 0xf4,
+	0x91, 0x14, 0x96, 0x7e, 0xcb, 0xc0, 0xcc, 0x4b, 0x58, 0x9c, 0x4b, 0x89, 0xa4, 0x07, 0x72, 0x56,
+	0x99, 0xb9, 0x38, 0xe5, 0x57, 0x5a, 0x98, 0x7a, 0x93, 0x16, 0x7e, 0x0f, 0x97, 0xe3, 0x16, 0xc6,
+	0x01, 0x78, 0x33, 0xd3, 0x67, 0x6d, 0xe6, 0xa5, 0x08, 0x79, 0x7f, 0x08, 0xcc, 0xbb, 0x4a, 0x60,
+	0x2e, 0xc1, 0x9a, 0x61, 0xc2, 0x3c, 0x62, 0xe6, 0xac, 0x11, 0x67, 0x63, 0xfa, 0x28, 0x5c, 0x1e,
+	0xb0, 0x09, 0x73, 0x31, 0x8d, 0x12, 0xf1, 0xa8, 0x31, 0xfe, 0x8e, 0x7c, 0x9a, 0x8d, 0xf8, 0x14,
+	0x87, 0xa1, 0xba, 0x0d, 0x0b, 0x51, 0x9c, 0x91, 0x52, 0xca, 0xc1, 0x92, 0x15, 0xc1, 0xae, 0x9e,
+	0x12, 0x2c, 0x42, 0xdf, 0xf1, 0x9b, 0xc4, 0x34, 0x86, 0x40, 0xc9, 0xca, 0x89, 0x99, 0x72, 0x03,
+	0x66, 0x63, 0xec, 0x57, 0xae, 0x4b, 0x3d, 0xd2, 0x45, 0x13, 0xbf, 0x54, 0x87, 0xcb, 0xf1, 0xf0,
+	0x26, 0x61, 0x3c, 0xc5, 0xa9, 0xfe, 0x29, 0x64, 0x1c, 0xdc, 0xa1, 0x86, 0xf6, 0xda, 0xd4, 0x46,
+	0x46, 0xbf, 0x29, 0x3c, 0x4a, 0xbb, 0xb0, 0x70, 0x32, 0xe8, 0x8e, 0xef, 0xe0, 0x81, 0x5e, 0x81,
+	0xd9, 0x78, 0x34, 0x59, 0x2d, 0x44, 0x5b, 0xb2, 0x06, 0x3c, 0x50, 0xde, 0xbc, 0x10, 0x0d, 0xa9,
+	0xbb, 0x88, 0xb6, 0xf8, 0xb6, 0x4a, 0x3f, 0x69, 0x50, 0x18, 0x29, 0x81, 0x7e, 0x07, 0x52, 0x67,
+	0xbe, 0x92, 0x53, 0x41, 0x5b, 0xbf, 0x07, 0x69, 0xce, 0xad, 0xd4, 0x59, 0xb9, 0xc5, 0x51, 0x4a,
+	0x3f, 0x6a, 0x70, 0xe5, 0x54, 0x5a, 0xf0, 0xab, 0xcd, 0x26, 0xfd, 0x73, 0x78, 0x49, 0xd8, 0xa4,
+	0x5f, 0x6b, 0xf3, 0x23, 0x8f, 0x64, 0x0c, 0xc9, 0xd6, 0x94, 0x28, 0x5e, 0x0e, 0x45, 0x71, 0x69,
+	0xe9, 0x57, 0x0d, 0xae, 0xd4, 0x71, 0x07, 0xdb, 0xcc, 0xeb, 0xe3, 0x21, 0x19, 0xb7, 0xf9, 0xfb,
+	0xc6, 0xb7, 0xb1, 0x7e, 0x0d, 0x66, 0x5e, 0xea, 0x82, 0xbc, 0xa9, 0xcd, 0xc2, 0x48, 0x03, 0x74,
+	0x13, 0xa6, 0xa2, 0x4b, 0xf0, 0x8c, 0xb7, 0xf2, 0x84, 0xba, 0xff, 0xf4, 0x55, 0xb8, 0x18, 0x62,
+	0xce, 0xe2, 0x10, 0x3b, 0x96, 0x42, 0xa7, 0x6d, 0x39, 0x54, 0xcc, 0x62, 0xa4, 0xba, 0xc3, 0xcd,
+	0xeb, 0xed, 0xd2, 0xcf, 0x1a, 0x5c, 0xa8, 0x61, 0xc9, 0xf4, 0x78, 0x3a, 0x8d, 0x24, 0xa6, 0x9d,
+	0x4f, 0x62, 0x27, 0x14, 0x25, 0x75, 0x52, 0x51, 0xe6, 0xa3, 0xd8, 0x51, 0xda, 0x12, 0xa3, 0xde,
+	0x2e, 0x1d, 0x6b, 0x50, 0xe4, 0x7d, 0xc7, 0xce, 0x7f, 0x24, 0xd9, 0xa5, 0xd1, 0xd1, 0x2f, 0xd3,
+	0x4d, 0xce, 0xf9, 0x75, 0xb8, 0x94, 0x18, 0x49, 0x09, 0x53, 0x31, 0x73, 0xcd, 0x8b, 0x91, 0x32,
+	0xde, 0x50, 0xe9, 0x71, 0x1a, 0xa6, 0x87, 0x4b, 0x13, 0xdb, 0x24, 0x74, 0xfe, 0xd5, 0x3d, 0x3e,
+	0x82, 0x42, 0x94, 0x78, 0x88, 0x18, 0x56, 0x3f, 0x4f, 0x6b, 0x4f, 0x8f, 0x96, 0xc6, 0xde, 0xee,
+	0x07, 0x2a, 0x3f, 0xc4, 0x31, 0x11, 0xc3, 0xf1, 0x73, 0x00, 0x3b, 0x16, 0x46, 0x61, 0xe7, 0x40,
+	0xd4, 0x64, 0x72, 0xf8, 0x1c, 0xc0, 0xce, 0x36, 0x17, 0xf2, 0xd3, 0x28, 0x9f, 0x62, 0xa8, 0x4b,
+	0x7a, 0x7e, 0xf4, 0x98, 0x13, 0xb2, 0x0d, 0x21, 0xe2, 0x48, 0xc3, 0x97, 0xb8, 0x32, 0x92, 0x0f,
+	0xba, 0x82, 0x92, 0x2a, 0xb3, 0x0f, 0x60, 0x26, 0xc4, 0xac, 0x17, 0xfa, 0xb1, 0x9d, 0x9c, 0xde,
+	0xd3, 0x43, 0xb1, 0x32, 0x2c, 0x42, 0xba, 0x89, 0xb1, 0x78, 0xd9, 0x65, 0x4c, 0xfe, 0xf9, 0xe1,
+	0x36, 0x5c, 0x1c, 0x99, 0xc6, 0x75, 0x86, 0x58, 0x8f, 0xea, 0x39, 0x98, 0xa8, 0x6d, 0xef, 0x6e,
+	0xed, 0xec, 0x7e, 0x5e, 0x1c, 0xd3, 0x01, 0xb2, 0x1b, 0x9b, 0x7b, 0x3b, 0x8f, 0xb6, 0x8b, 0x9a,
+	0x9e, 0x87, 0xc9, 0xfd, 0xdd, 0xea, 0xc3, 0xdd, 0xad, 0xed, 0xad, 0x62, 0x4a, 0x9f, 0x80, 0xf4,
+	0xc6, 0xee, 0x57, 0xc5, 0x74, 0xf5, 0xfe, 0xd3, 0x17, 0x8b, 0xda, 0xb3, 0x17, 0x8b, 0xda, 0x1f,
+	0x2f, 0x16, 0xb5, 0xc7, 0xc7, 0x8b, 0x63, 0xcf, 0x8e, 0x17, 0xc7, 0x7e, 0x3f, 0x5e, 0x1c, 0xfb,
+	0xfa, 0x1f, 0x3b, 0x39, 0x48, 0xfe, 0x63, 0x8b, 0xb6, 0x36, 0xb2, 0xe2, 0x1f, 0xfb, 0xe6, 0xdf,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0xad, 0x14, 0xa4, 0xeb, 0x1c, 0x10, 0x00, 0x00,
 }
 
 func (m *FinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -861,6 +1143,16 @@ func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Sluggish {
 		i--
 		if m.Sluggish {
@@ -959,6 +1251,16 @@ func (m *FinalityProviderWithMeta) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Sluggish {
 		i--
 		if m.Sluggish {
@@ -1179,6 +1481,11 @@ func (m *BTCUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondingBtcHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.UnbondingBtcHeight))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.CovenantUnbondingSigList) > 0 {
 		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1462,64 +1769,254 @@ func (m *SelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBtcstaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBtcstaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingSlashingTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *FinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PendingSlashingTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSlashingTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovBtcstaking(uint64(l))
-	}
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovBtcstaking(uint64(l))
-	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovBtcstaking(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovBtcstaking(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovBtcstaking(uint64(l))
-	}
-	if m.SlashedBabylonHeight != 0 {
-		n += 1 + sovBtcstaking(uint64(m.SlashedBabylonHeight))
+	if len(m.FpBtcSk) > 0 {
+		i -= len(m.FpBtcSk)
+		copy(dAtA[i:], m.FpBtcSk)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.FpBtcSk)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.SlashedBtcHeight != 0 {
-		n += 1 + sovBtcstaking(uint64(m.SlashedBtcHeight))
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Sluggish {
-		n += 2
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FinalityProviderWithMeta) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignedSlashingTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedSlashingTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedSlashingTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovBtcstaking(uint64(l))
+	if len(m.UnbondingSlashingTx) > 0 {
+		i -= len(m.UnbondingSlashingTx)
+		copy(dAtA[i:], m.UnbondingSlashingTx)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.UnbondingSlashingTx)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SlashingTx) > 0 {
+		i -= len(m.SlashingTx)
+		copy(dAtA[i:], m.SlashingTx)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.SlashingTx)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fee != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReturnedAmount != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ReturnedAmount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SlashedAmount != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedAmount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalAmount != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.TotalAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UnbondedEarly {
+		i--
+		if m.UnbondedEarly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SlashingRate.Size()
+		i -= size
+		if _, err := m.SlashingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtcstaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtcstaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.SlashedBabylonHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.SlashedBabylonHeight))
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.SlashedBtcHeight))
+	}
+	if m.Sluggish {
+		n += 2
+	}
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *FinalityProviderWithMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
 	}
 	if m.Height != 0 {
 		n += 1 + sovBtcstaking(uint64(m.Height))
@@ -1536,6 +2033,9 @@ func (m *FinalityProviderWithMeta) Size() (n int) {
 	if m.Sluggish {
 		n += 2
 	}
+	if m.Jailed {
+		n += 2
+	}
 	return n
 }
 
@@ -1640,6 +2140,9 @@ func (m *BTCUndelegation) Size() (n int) {
 			n += 1 + l + sovBtcstaking(uint64(l))
 		}
 	}
+	if m.UnbondingBtcHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.UnbondingBtcHeight))
+	}
 	return n
 }
 
@@ -1730,6 +2233,86 @@ func (m *SelectiveSlashingEvidence) Size() (n int) {
 	return n
 }
 
+func (m *PendingSlashingTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.FpBtcSk)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *SignedSlashingTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.SlashingTx)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.UnbondingSlashingTx)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = m.SlashingRate.Size()
+	n += 1 + l + sovBtcstaking(uint64(l))
+	if m.UnbondedEarly {
+		n += 2
+	}
+	if m.TotalAmount != 0 {
+		n += 1 + sovBtcstaking(uint64(m.TotalAmount))
+	}
+	if m.SlashedAmount != 0 {
+		n += 1 + sovBtcstaking(uint64(m.SlashedAmount))
+	}
+	if m.ReturnedAmount != 0 {
+		n += 1 + sovBtcstaking(uint64(m.ReturnedAmount))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovBtcstaking(uint64(m.Fee))
+	}
+	return n
+}
+
 func sovBtcstaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1998,6 +2581,26 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Sluggish = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -2179,6 +2782,26 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Sluggish = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -2912,11 +3535,30 @@ func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingBtcHeight", wireType)
+			}
+			m.UnbondingBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBtcstaking
@@ -3487,6 +4129,589 @@ func (m *SelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingSlashingTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSlashingTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSlashingTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcSk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcSk = append(m.FpBtcSk[:0], dAtA[iNdEx:postIndex]...)
+			if m.FpBtcSk == nil {
+				m.FpBtcSk = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedSlashingTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedSlashingTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedSlashingTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTx = append(m.SlashingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.SlashingTx == nil {
+				m.SlashingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingSlashingTx = append(m.UnbondingSlashingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingSlashingTx == nil {
+				m.UnbondingSlashingTx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondedEarly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnbondedEarly = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			m.TotalAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			m.SlashedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnedAmount", wireType)
+			}
+			m.ReturnedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReturnedAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBtcstaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
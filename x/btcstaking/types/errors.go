@@ -31,4 +31,10 @@ var (
 	ErrVotingPowerTableNotUpdated   = errorsmod.Register(ModuleName, 1122, "voting power table has not been updated")
 	ErrVotingPowerDistCacheNotFound = errorsmod.Register(ModuleName, 1123, "the voting power distribution cache is not found")
 	ErrParamsNotFound               = errorsmod.Register(ModuleName, 1124, "the parameters are not found")
+	ErrInvalidCovenantRotation      = errorsmod.Register(ModuleName, 1125, "the covenant committee rotation is not valid")
+	ErrCovenantRotationNotFound     = errorsmod.Register(ModuleName, 1126, "no covenant committee rotation is scheduled")
+	ErrSlashingRecordNotFound       = errorsmod.Register(ModuleName, 1127, "the slashing record is not found")
+	ErrFpAlreadyJailed              = errorsmod.Register(ModuleName, 1128, "the finality provider has already been jailed")
+	ErrFpNotJailed                  = errorsmod.Register(ModuleName, 1129, "the finality provider is not jailed")
+	ErrInvalidAdaptorSigEnvelope    = errorsmod.Register(ModuleName, 1130, "the adaptor signature envelope is not valid")
 )
@@ -28,4 +28,16 @@ type CheckpointingKeeper interface {
 
 type BtcStakingHooks interface {
 	AfterFinalityProviderActivated(ctx context.Context, fpPk *bbn.BIP340PubKey) error
+	// AfterBTCDelegationActivated is called when a BTC delegation gains voting
+	// power, i.e., when its activation event is processed in `BeginBlock`
+	AfterBTCDelegationActivated(ctx context.Context, btcDel *BTCDelegation) error
+	// AfterBTCDelegationUnbonded is called when the staker submits a valid
+	// signature on the unbonding tx, i.e., the BTC delegation is unbonded early
+	AfterBTCDelegationUnbonded(ctx context.Context, btcDel *BTCDelegation) error
+	// AfterBTCDelegationExpired is called when an active BTC delegation loses
+	// its voting power due to its timelock reaching `endHeight - w`
+	AfterBTCDelegationExpired(ctx context.Context, btcDel *BTCDelegation) error
+	// AfterBTCDelegationSlashed is called for each BTC delegation under a
+	// finality provider that is slashed
+	AfterBTCDelegationSlashed(ctx context.Context, fpPk *bbn.BIP340PubKey, btcDel *BTCDelegation) error
 }
@@ -4,7 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/codec"
+
+	bbn "github.com/babylonchain/babylon/types"
 )
 
 // DefaultGenesis returns the default genesis state
@@ -22,12 +26,323 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("params cannot be empty")
 	}
 
-	// TODO: add validation to other properties of genstate.
 	for _, params := range gs.Params {
 		if err := params.Validate(); err != nil {
 			return err
 		}
 	}
+
+	if gs.CovenantRotation != nil {
+		if err := gs.CovenantRotation.Validate(); err != nil {
+			return fmt.Errorf("invalid covenant committee rotation: %w", err)
+		}
+	}
+
+	fps, err := gs.validateFinalityProviders()
+	if err != nil {
+		return err
+	}
+	btcDels, err := gs.validateBTCDelegations(fps)
+	if err != nil {
+		return err
+	}
+	if err := gs.validateBTCDelegators(fps, btcDels); err != nil {
+		return err
+	}
+	if err := gs.validateVotingPowers(fps); err != nil {
+		return err
+	}
+	if err := gs.validateBlockHeightChains(); err != nil {
+		return err
+	}
+	if err := gs.validateEvents(); err != nil {
+		return err
+	}
+	if err := gs.validateSignedSlashingTxs(fps, btcDels); err != nil {
+		return err
+	}
+	if err := gs.validateSlashingRecords(fps, btcDels); err != nil {
+		return err
+	}
+	return gs.validatePendingSlashingTxs(fps, btcDels)
+}
+
+// validateFinalityProviders ensures all finality providers are well-formed
+// and unique, and returns them keyed by the hex string of their BTC PKs
+func (gs GenesisState) validateFinalityProviders() (map[string]*FinalityProvider, error) {
+	fps := make(map[string]*FinalityProvider, len(gs.FinalityProviders))
+	for _, fp := range gs.FinalityProviders {
+		if fp == nil {
+			return nil, fmt.Errorf("null finality provider")
+		}
+		if err := fp.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid finality provider: %w", err)
+		}
+		key := fp.BtcPk.MarshalHex()
+		if _, ok := fps[key]; ok {
+			return nil, fmt.Errorf("duplicate finality provider %s", key)
+		}
+		fps[key] = fp
+	}
+	return fps, nil
+}
+
+// validateBTCDelegations ensures all BTC delegations are well-formed, unique,
+// refer to existing params versions and finality providers, and returns them
+// keyed by their staking tx hashes
+func (gs GenesisState) validateBTCDelegations(fps map[string]*FinalityProvider) (map[string]*BTCDelegation, error) {
+	btcDels := make(map[string]*BTCDelegation, len(gs.BtcDelegations))
+	for _, btcDel := range gs.BtcDelegations {
+		if btcDel == nil {
+			return nil, fmt.Errorf("null BTC delegation")
+		}
+		if err := btcDel.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid BTC delegation: %w", err)
+		}
+		stakingTxHash, err := btcDel.GetStakingTxHash()
+		if err != nil {
+			return nil, err
+		}
+		key := stakingTxHash.String()
+		if _, ok := btcDels[key]; ok {
+			return nil, fmt.Errorf("duplicate BTC delegation %s", key)
+		}
+		if int(btcDel.ParamsVersion) >= len(gs.Params) {
+			return nil, fmt.Errorf("BTC delegation %s refers to non-existing params version %d", key, btcDel.ParamsVersion)
+		}
+		for _, fpBTCPK := range btcDel.FpBtcPkList {
+			if _, ok := fps[fpBTCPK.MarshalHex()]; !ok {
+				return nil, fmt.Errorf("BTC delegation %s refers to non-existing finality provider %s", key, fpBTCPK.MarshalHex())
+			}
+		}
+		btcDels[key] = btcDel
+	}
+	return btcDels, nil
+}
+
+// validateBTCDelegators ensures each BTC delegator index refers to existing
+// BTC delegations from the same delegator under the same finality provider
+func (gs GenesisState) validateBTCDelegators(fps map[string]*FinalityProvider, btcDels map[string]*BTCDelegation) error {
+	seen := make(map[string]struct{}, len(gs.BtcDelegators))
+	for _, del := range gs.BtcDelegators {
+		if del == nil || del.Idx == nil || del.FpBtcPk == nil || del.DelBtcPk == nil {
+			return fmt.Errorf("incomplete BTC delegator index")
+		}
+		fpKey := del.FpBtcPk.MarshalHex()
+		if _, ok := fps[fpKey]; !ok {
+			return fmt.Errorf("BTC delegator index refers to non-existing finality provider %s", fpKey)
+		}
+		key := fpKey + del.DelBtcPk.MarshalHex()
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate BTC delegator index of delegator %s under finality provider %s", del.DelBtcPk.MarshalHex(), fpKey)
+		}
+		seen[key] = struct{}{}
+
+		for _, stakingTxHashBytes := range del.Idx.StakingTxHashList {
+			stakingTxHash, err := chainhash.NewHash(stakingTxHashBytes)
+			if err != nil {
+				return fmt.Errorf("invalid staking tx hash in BTC delegator index: %w", err)
+			}
+			btcDel, ok := btcDels[stakingTxHash.String()]
+			if !ok {
+				return fmt.Errorf("BTC delegator index refers to non-existing BTC delegation %s", stakingTxHash)
+			}
+			if !btcDel.BtcPk.Equals(del.DelBtcPk) {
+				return fmt.Errorf("BTC delegation %s is not from delegator %s", stakingTxHash, del.DelBtcPk.MarshalHex())
+			}
+			if btcDel.GetFpIdx(del.FpBtcPk) < 0 {
+				return fmt.Errorf("BTC delegation %s is not under finality provider %s", stakingTxHash, fpKey)
+			}
+		}
+	}
+	return nil
+}
+
+// validateVotingPowers ensures the voting power table and the voting power
+// distribution caches refer to existing finality providers, and are consistent
+// with each other at the heights where both exist
+func (gs GenesisState) validateVotingPowers(fps map[string]*FinalityProvider) error {
+	// voting power of each finality provider at each height in the caches
+	cachedPowers := make(map[uint64]map[string]uint64, len(gs.VpDstCache))
+	for _, vpCache := range gs.VpDstCache {
+		if vpCache == nil || vpCache.VpDistribution == nil {
+			return fmt.Errorf("null voting power distribution cache")
+		}
+		if _, ok := cachedPowers[vpCache.BlockHeight]; ok {
+			return fmt.Errorf("duplicate voting power distribution cache at height %d", vpCache.BlockHeight)
+		}
+		powers := make(map[string]uint64, len(vpCache.VpDistribution.FinalityProviders))
+		sumPower := uint64(0)
+		for _, fpDistInfo := range vpCache.VpDistribution.FinalityProviders {
+			if fpDistInfo == nil || fpDistInfo.BtcPk == nil {
+				return fmt.Errorf("incomplete finality provider in the voting power distribution cache at height %d", vpCache.BlockHeight)
+			}
+			fpKey := fpDistInfo.BtcPk.MarshalHex()
+			if _, ok := fps[fpKey]; !ok {
+				return fmt.Errorf("voting power distribution cache at height %d refers to non-existing finality provider %s", vpCache.BlockHeight, fpKey)
+			}
+			if _, ok := powers[fpKey]; ok {
+				return fmt.Errorf("duplicate finality provider %s in the voting power distribution cache at height %d", fpKey, vpCache.BlockHeight)
+			}
+			powers[fpKey] = fpDistInfo.TotalVotingPower
+			sumPower += fpDistInfo.TotalVotingPower
+		}
+		// the total voting power only counts active finality providers
+		if vpCache.VpDistribution.TotalVotingPower > sumPower {
+			return fmt.Errorf("total voting power %d in the voting power distribution cache at height %d exceeds the sum of voting powers %d",
+				vpCache.VpDistribution.TotalVotingPower, vpCache.BlockHeight, sumPower)
+		}
+		cachedPowers[vpCache.BlockHeight] = powers
+	}
+
+	seen := make(map[uint64]map[string]struct{})
+	for _, vp := range gs.VotingPowers {
+		if vp == nil || vp.FpBtcPk == nil {
+			return fmt.Errorf("incomplete voting power entry")
+		}
+		fpKey := vp.FpBtcPk.MarshalHex()
+		if _, ok := fps[fpKey]; !ok {
+			return fmt.Errorf("voting power at height %d refers to non-existing finality provider %s", vp.BlockHeight, fpKey)
+		}
+		if _, ok := seen[vp.BlockHeight]; !ok {
+			seen[vp.BlockHeight] = make(map[string]struct{})
+		}
+		if _, ok := seen[vp.BlockHeight][fpKey]; ok {
+			return fmt.Errorf("duplicate voting power of finality provider %s at height %d", fpKey, vp.BlockHeight)
+		}
+		seen[vp.BlockHeight][fpKey] = struct{}{}
+
+		powers, ok := cachedPowers[vp.BlockHeight]
+		if !ok {
+			continue
+		}
+		if power, ok := powers[fpKey]; !ok || power != vp.VotingPower {
+			return fmt.Errorf("voting power %d of finality provider %s at height %d does not match the voting power distribution cache",
+				vp.VotingPower, fpKey, vp.BlockHeight)
+		}
+	}
+	return nil
+}
+
+// validateBlockHeightChains ensures each Babylon height maps to at most one
+// BTC height
+func (gs GenesisState) validateBlockHeightChains() error {
+	seen := make(map[uint64]struct{}, len(gs.BlockHeightChains))
+	for _, b := range gs.BlockHeightChains {
+		if b == nil {
+			return fmt.Errorf("null block height pair")
+		}
+		if _, ok := seen[b.BlockHeightBbn]; ok {
+			return fmt.Errorf("duplicate BTC height of Babylon height %d", b.BlockHeightBbn)
+		}
+		seen[b.BlockHeightBbn] = struct{}{}
+	}
+	return nil
+}
+
+// validateEvents ensures the power distribution update events are non-empty
+// and uniquely indexed at each BTC height
+func (gs GenesisState) validateEvents() error {
+	seen := make(map[uint64]map[uint64]struct{})
+	for _, evt := range gs.Events {
+		if evt == nil || evt.Event == nil || evt.Event.Ev == nil {
+			return fmt.Errorf("empty power distribution update event")
+		}
+		if _, ok := seen[evt.BlockHeightBtc]; !ok {
+			seen[evt.BlockHeightBtc] = make(map[uint64]struct{})
+		}
+		if _, ok := seen[evt.BlockHeightBtc][evt.Idx]; ok {
+			return fmt.Errorf("duplicate power distribution update event %d at BTC height %d", evt.Idx, evt.BlockHeightBtc)
+		}
+		seen[evt.BlockHeightBtc][evt.Idx] = struct{}{}
+	}
+	return nil
+}
+
+// validateSignedSlashingTxs ensures the signed slashing txs refer to existing
+// BTC delegations under existing slashed finality providers
+func (gs GenesisState) validateSignedSlashingTxs(fps map[string]*FinalityProvider, btcDels map[string]*BTCDelegation) error {
+	for _, tx := range gs.SignedSlashingTxs {
+		if tx == nil || tx.FpBtcPk == nil {
+			return fmt.Errorf("incomplete signed slashing tx")
+		}
+		if err := validateSlashedDelegation(fps, btcDels, tx.FpBtcPk, tx.StakingTxHash); err != nil {
+			return fmt.Errorf("invalid signed slashing tx: %w", err)
+		}
+		// the slashing tx is not assembled if the BTC delegation has
+		// unbonded early
+		if len(tx.UnbondingSlashingTx) == 0 || (len(tx.SlashingTx) == 0 && !btcDels[tx.StakingTxHash].IsUnbondedEarly()) {
+			return fmt.Errorf("empty signed slashing tx of BTC delegation %s", tx.StakingTxHash)
+		}
+	}
+	return nil
+}
+
+// validateSlashingRecords ensures the slashing records refer to existing BTC
+// delegations under existing slashed finality providers, and their amounts
+// add up
+func (gs GenesisState) validateSlashingRecords(fps map[string]*FinalityProvider, btcDels map[string]*BTCDelegation) error {
+	seen := make(map[string]struct{}, len(gs.SlashingRecords))
+	for _, record := range gs.SlashingRecords {
+		if record == nil || record.FpBtcPk == nil {
+			return fmt.Errorf("incomplete slashing record")
+		}
+		if err := validateSlashedDelegation(fps, btcDels, record.FpBtcPk, record.StakingTxHash); err != nil {
+			return fmt.Errorf("invalid slashing record: %w", err)
+		}
+		if _, ok := seen[record.StakingTxHash]; ok {
+			return fmt.Errorf("duplicate slashing record of BTC delegation %s", record.StakingTxHash)
+		}
+		seen[record.StakingTxHash] = struct{}{}
+		if record.SlashedAmount+record.ReturnedAmount+record.Fee != record.TotalAmount {
+			return fmt.Errorf("amounts in the slashing record of BTC delegation %s do not add up", record.StakingTxHash)
+		}
+	}
+	return nil
+}
+
+// validatePendingSlashingTxs ensures the pending slashing txs refer to existing
+// BTC delegations under existing slashed finality providers, and carry the
+// secret keys of these finality providers
+func (gs GenesisState) validatePendingSlashingTxs(fps map[string]*FinalityProvider, btcDels map[string]*BTCDelegation) error {
+	for _, pendingTx := range gs.PendingSlashingTxs {
+		if pendingTx == nil || pendingTx.FpBtcPk == nil {
+			return fmt.Errorf("incomplete pending slashing tx")
+		}
+		if err := validateSlashedDelegation(fps, btcDels, pendingTx.FpBtcPk, pendingTx.StakingTxHash); err != nil {
+			return fmt.Errorf("invalid pending slashing tx: %w", err)
+		}
+		if len(pendingTx.FpBtcSk) != btcec.PrivKeyBytesLen {
+			return fmt.Errorf("invalid pending slashing tx of BTC delegation %s: invalid secret key length %d", pendingTx.StakingTxHash, len(pendingTx.FpBtcSk))
+		}
+		fpSK, _ := btcec.PrivKeyFromBytes(pendingTx.FpBtcSk)
+		if !bbn.NewBIP340PubKeyFromBTCPK(fpSK.PubKey()).Equals(pendingTx.FpBtcPk) {
+			return fmt.Errorf("invalid pending slashing tx of BTC delegation %s: secret key does not match finality provider %s", pendingTx.StakingTxHash, pendingTx.FpBtcPk.MarshalHex())
+		}
+	}
+	return nil
+}
+
+func validateSlashedDelegation(
+	fps map[string]*FinalityProvider,
+	btcDels map[string]*BTCDelegation,
+	fpBTCPK *bbn.BIP340PubKey,
+	stakingTxHash string,
+) error {
+	fp, ok := fps[fpBTCPK.MarshalHex()]
+	if !ok {
+		return fmt.Errorf("non-existing finality provider %s", fpBTCPK.MarshalHex())
+	}
+	if !fp.IsSlashed() {
+		return fmt.Errorf("finality provider %s is not slashed", fpBTCPK.MarshalHex())
+	}
+	btcDel, ok := btcDels[stakingTxHash]
+	if !ok {
+		return fmt.Errorf("non-existing BTC delegation %s", stakingTxHash)
+	}
+	if btcDel.GetFpIdx(fpBTCPK) < 0 {
+		return fmt.Errorf("BTC delegation %s is not under finality provider %s", stakingTxHash, fpBTCPK.MarshalHex())
+	}
 	return nil
 }
 
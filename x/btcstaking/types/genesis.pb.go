@@ -43,6 +43,16 @@ type GenesisState struct {
 	// vp_dst_cache is the table of all providers voting power with the total at one specific block.
 	// TODO: remove this after not storing in the keeper store it anymore.
 	VpDstCache []*VotingPowerDistCacheBlkHeight `protobuf:"bytes,8,rep,name=vp_dst_cache,json=vpDstCache,proto3" json:"vp_dst_cache,omitempty"`
+	// covenant_rotation is the scheduled covenant committee rotation, if any.
+	CovenantRotation *CovenantRotation `protobuf:"bytes,9,opt,name=covenant_rotation,json=covenantRotation,proto3" json:"covenant_rotation,omitempty"`
+	// signed_slashing_txs contains the fully witnessed slashing txs of BTC
+	// delegations under slashed finality providers.
+	SignedSlashingTxs []*SignedSlashingTx `protobuf:"bytes,10,rep,name=signed_slashing_txs,json=signedSlashingTxs,proto3" json:"signed_slashing_txs,omitempty"`
+	// slashing_records contains the slashing records of slashed BTC delegations.
+	SlashingRecords []*SlashingRecord `protobuf:"bytes,11,rep,name=slashing_records,json=slashingRecords,proto3" json:"slashing_records,omitempty"`
+	// pending_slashing_txs contains the BTC delegations under slashed finality
+	// providers whose signed slashing txs are yet to be assembled.
+	PendingSlashingTxs []*PendingSlashingTx `protobuf:"bytes,12,rep,name=pending_slashing_txs,json=pendingSlashingTxs,proto3" json:"pending_slashing_txs,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +144,34 @@ func (m *GenesisState) GetVpDstCache() []*VotingPowerDistCacheBlkHeight {
 	return nil
 }
 
+func (m *GenesisState) GetCovenantRotation() *CovenantRotation {
+	if m != nil {
+		return m.CovenantRotation
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSignedSlashingTxs() []*SignedSlashingTx {
+	if m != nil {
+		return m.SignedSlashingTxs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashingRecords() []*SlashingRecord {
+	if m != nil {
+		return m.SlashingRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSlashingTxs() []*PendingSlashingTx {
+	if m != nil {
+		return m.PendingSlashingTxs
+	}
+	return nil
+}
+
 // VotingPowerFP contains the information about the voting power
 // of an finality provider in a specific block height.
 type VotingPowerFP struct {
@@ -430,51 +468,58 @@ func init() {
 }
 
 var fileDescriptor_85d7b95fa5620238 = []byte{
-	// 694 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xdd, 0x4e, 0xd4, 0x4e,
-	0x18, 0xc6, 0x29, 0xbb, 0x2c, 0xfc, 0x67, 0x97, 0x05, 0x86, 0xbf, 0x49, 0x43, 0xc2, 0x0a, 0xc5,
-	0x8f, 0x8d, 0x26, 0x5d, 0x59, 0xd0, 0xc4, 0x43, 0xcb, 0x8a, 0xe2, 0x47, 0xd2, 0xd4, 0x95, 0x03,
-	0x4e, 0x9a, 0x4e, 0x3b, 0xdb, 0x9d, 0x6c, 0x99, 0x69, 0x3a, 0x43, 0x65, 0xaf, 0xc1, 0x13, 0x0f,
-	0xbd, 0x05, 0xef, 0xc4, 0x43, 0x0e, 0x8d, 0x07, 0xc6, 0xc0, 0x1d, 0x78, 0x01, 0xc6, 0x74, 0x5a,
-	0x68, 0xc1, 0x5d, 0xc0, 0x18, 0xcf, 0x3a, 0x93, 0xe7, 0xfd, 0xcd, 0xfb, 0xbc, 0xf3, 0x4c, 0x0a,
-	0xd6, 0x90, 0x83, 0x86, 0x01, 0xa3, 0x2d, 0x24, 0x5c, 0x2e, 0x9c, 0x01, 0xa1, 0x7e, 0x2b, 0x5e,
-	0x6f, 0xf9, 0x98, 0x62, 0x4e, 0xb8, 0x1e, 0x46, 0x4c, 0x30, 0x78, 0x23, 0x13, 0xe9, 0xb9, 0x48,
-	0x8f, 0xd7, 0x97, 0xfe, 0xf7, 0x99, 0xcf, 0xa4, 0xa2, 0x95, 0x7c, 0xa5, 0xe2, 0x25, 0x6d, 0x34,
-	0x31, 0x74, 0x22, 0x67, 0x3f, 0x03, 0x2e, 0xdd, 0x19, 0xad, 0x29, 0xe0, 0x53, 0xdd, 0xed, 0xd1,
-	0x3a, 0x42, 0x5d, 0x4c, 0x05, 0x89, 0xf1, 0xe5, 0x47, 0xe2, 0x18, 0x53, 0x91, 0x1d, 0xa9, 0xfd,
-	0x28, 0x83, 0xda, 0xb3, 0xd4, 0xd5, 0x1b, 0xe1, 0x08, 0x0c, 0x1f, 0x82, 0x4a, 0xda, 0x93, 0xaa,
-	0xac, 0x94, 0x9a, 0xd5, 0xf6, 0xb2, 0x3e, 0xd2, 0xa5, 0x6e, 0x4a, 0x91, 0x95, 0x89, 0xe1, 0x2e,
-	0x80, 0x3d, 0x42, 0x9d, 0x80, 0x88, 0xa1, 0x1d, 0x46, 0x2c, 0x26, 0x1e, 0x8e, 0xb8, 0x3a, 0x29,
-	0x11, 0x77, 0xc7, 0x20, 0xb6, 0xb3, 0x02, 0x33, 0xd3, 0x5b, 0x0b, 0xbd, 0x0b, 0x3b, 0x1c, 0xbe,
-	0x06, 0x73, 0x48, 0xb8, 0xb6, 0x87, 0x03, 0xec, 0x3b, 0x82, 0x30, 0xca, 0xd5, 0x92, 0x84, 0xde,
-	0x1a, 0x03, 0x35, 0xba, 0x5b, 0x9d, 0x33, 0xb1, 0x55, 0x47, 0xc2, 0xcd, 0x97, 0x1c, 0xee, 0x80,
-	0xd9, 0x98, 0x09, 0x42, 0x7d, 0x3b, 0x64, 0xef, 0x92, 0x0e, 0xcb, 0x97, 0xc2, 0x76, 0xa5, 0xd6,
-	0x4c, 0xa4, 0xdb, 0xa6, 0x55, 0x8b, 0xf3, 0x25, 0x87, 0x7b, 0x60, 0x11, 0x05, 0xcc, 0x1d, 0xd8,
-	0x7d, 0x4c, 0xfc, 0xbe, 0xb0, 0xdd, 0xbe, 0x43, 0x28, 0x57, 0xa7, 0x24, 0xf0, 0xde, 0xb8, 0xee,
-	0x92, 0x8a, 0xe7, 0xb2, 0xc0, 0x40, 0xb4, 0xcb, 0x0c, 0xe1, 0x5a, 0x0b, 0x28, 0xdf, 0xdc, 0x92,
-	0x10, 0xf8, 0x02, 0xd4, 0x0b, 0xae, 0x59, 0xc4, 0xd5, 0x8a, 0xc4, 0xae, 0x5d, 0x69, 0x9a, 0x45,
-	0xd6, 0x6c, 0xee, 0x99, 0x45, 0x1c, 0x3e, 0x06, 0x95, 0xf4, 0xc6, 0xd5, 0x69, 0xc9, 0x58, 0x1d,
-	0xc3, 0x78, 0x9a, 0x88, 0x76, 0xa8, 0x87, 0x0f, 0xad, 0xac, 0x00, 0xee, 0x82, 0x5a, 0x1c, 0xda,
-	0x1e, 0x17, 0xb6, 0xeb, 0xb8, 0x7d, 0xac, 0xce, 0x48, 0xc0, 0xe6, 0xd5, 0xc3, 0xea, 0x10, 0x2e,
-	0xb6, 0x92, 0x12, 0x23, 0xc8, 0x8c, 0x59, 0x20, 0x0e, 0x3b, 0xd9, 0xa6, 0xf6, 0x49, 0x01, 0xb3,
-	0xe7, 0x46, 0x0b, 0x57, 0x41, 0xad, 0x38, 0x4c, 0x55, 0x59, 0x51, 0x9a, 0x65, 0xab, 0x5a, 0x98,
-	0x0c, 0xb4, 0xc0, 0x7f, 0xbd, 0xd0, 0x4e, 0xc6, 0x12, 0x0e, 0xd4, 0xc9, 0x15, 0xa5, 0x59, 0x33,
-	0x1e, 0x7d, 0xfd, 0x76, 0xb3, 0xed, 0x13, 0xd1, 0x3f, 0x40, 0xba, 0xcb, 0xf6, 0x5b, 0x59, 0x5f,
-	0xf2, 0x26, 0x4e, 0x17, 0x2d, 0x31, 0x0c, 0x31, 0xd7, 0x8d, 0x1d, 0x73, 0x63, 0xf3, 0x81, 0x79,
-	0x80, 0x5e, 0xe2, 0xa1, 0x35, 0xdd, 0x0b, 0x0d, 0xe1, 0x9a, 0x83, 0xe4, 0xd8, 0x62, 0x1c, 0xd4,
-	0x52, 0x7a, 0x6c, 0xe1, 0x9e, 0xb5, 0x8f, 0x0a, 0x58, 0xbe, 0xd4, 0xd9, 0x75, 0x7a, 0xef, 0x82,
-	0xb9, 0x64, 0x90, 0x84, 0x8b, 0x88, 0xa0, 0x83, 0x24, 0x8a, 0xd2, 0x41, 0xb5, 0x7d, 0xff, 0x0f,
-	0x66, 0x69, 0xd5, 0xe3, 0xb0, 0x53, 0x40, 0x68, 0x04, 0x2c, 0x8e, 0xc8, 0x13, 0x6c, 0x82, 0xf9,
-	0x73, 0xc1, 0x44, 0x88, 0x66, 0x3d, 0xd5, 0xd1, 0x39, 0xf9, 0xef, 0x4a, 0xe1, 0xca, 0xbe, 0x2e,
-	0x28, 0x85, 0xab, 0xfd, 0x54, 0x40, 0xad, 0x18, 0x32, 0xd8, 0x01, 0x25, 0xe2, 0x1d, 0x4a, 0x6e,
-	0xb5, 0xdd, 0xbe, 0x46, 0x2c, 0xf3, 0x57, 0x98, 0x66, 0x2c, 0x29, 0xff, 0x27, 0x77, 0xda, 0x05,
-	0xc0, 0xc3, 0xc1, 0x29, 0xb4, 0xf4, 0x57, 0xd0, 0x19, 0x0f, 0x07, 0x92, 0xaa, 0xbd, 0x57, 0x00,
-	0xc8, 0x5f, 0x08, 0x9c, 0xcf, 0xed, 0x97, 0x53, 0x2b, 0xd7, 0x9e, 0x25, 0x7c, 0x02, 0xa6, 0xe4,
-	0xfb, 0x92, 0xbd, 0x8d, 0x8f, 0x80, 0x3c, 0xed, 0x2c, 0x01, 0x6f, 0x43, 0xcf, 0x11, 0xd8, 0x4a,
-	0x2b, 0x8d, 0x57, 0x9f, 0x8f, 0x1b, 0xca, 0xd1, 0x71, 0x43, 0xf9, 0x7e, 0xdc, 0x50, 0x3e, 0x9c,
-	0x34, 0x26, 0x8e, 0x4e, 0x1a, 0x13, 0x5f, 0x4e, 0x1a, 0x13, 0x7b, 0x57, 0xba, 0x3c, 0x2c, 0xfe,
-	0x0d, 0xa4, 0x65, 0x54, 0x91, 0xbf, 0x82, 0x8d, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0xe9,
-	0x80, 0x92, 0xf5, 0x06, 0x00, 0x00,
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xdf, 0x6e, 0xfb, 0x34,
+	0x14, 0xc7, 0x97, 0x75, 0xbf, 0x6e, 0x73, 0xbb, 0xae, 0xf5, 0x86, 0x14, 0x4d, 0x5a, 0xe9, 0x3a,
+	0x06, 0x15, 0x48, 0x2d, 0xeb, 0x06, 0x12, 0x97, 0xa4, 0x65, 0x30, 0xfe, 0x48, 0x91, 0x57, 0x86,
+	0xb4, 0x9b, 0x28, 0x71, 0xdc, 0xd4, 0x6a, 0x66, 0x47, 0xb1, 0x17, 0xda, 0x67, 0xe0, 0x86, 0x4b,
+	0x5e, 0x81, 0x37, 0xe1, 0x8e, 0x5d, 0x22, 0x2e, 0x10, 0xda, 0xde, 0x03, 0xa1, 0x38, 0xd9, 0x92,
+	0x96, 0xb6, 0x1b, 0x42, 0xdc, 0xd5, 0x47, 0xdf, 0xf3, 0x39, 0xe7, 0xd4, 0xdf, 0xe3, 0x80, 0x63,
+	0xc7, 0x76, 0xa6, 0x3e, 0x67, 0x1d, 0x47, 0x62, 0x21, 0xed, 0x31, 0x65, 0x5e, 0x27, 0x3a, 0xed,
+	0x78, 0x84, 0x11, 0x41, 0x45, 0x3b, 0x08, 0xb9, 0xe4, 0xf0, 0xad, 0x54, 0xd4, 0xce, 0x44, 0xed,
+	0xe8, 0xf4, 0x60, 0xdf, 0xe3, 0x1e, 0x57, 0x8a, 0x4e, 0xfc, 0x2b, 0x11, 0x1f, 0x34, 0x17, 0x13,
+	0x03, 0x3b, 0xb4, 0x6f, 0x53, 0xe0, 0xc1, 0xbb, 0x8b, 0x35, 0x39, 0x7c, 0xa2, 0x3b, 0x59, 0xac,
+	0xa3, 0x0c, 0x13, 0x26, 0x69, 0x44, 0x56, 0x97, 0x24, 0x11, 0x61, 0x32, 0x2d, 0xd9, 0xfc, 0x75,
+	0x13, 0x94, 0x3f, 0x4f, 0xa6, 0xba, 0x92, 0xb6, 0x24, 0xf0, 0x23, 0x50, 0x4c, 0x7a, 0xd2, 0xb5,
+	0x46, 0xa1, 0x55, 0xea, 0x1e, 0xb6, 0x17, 0x4e, 0xd9, 0x36, 0x95, 0x08, 0xa5, 0x62, 0x78, 0x0d,
+	0xe0, 0x90, 0x32, 0xdb, 0xa7, 0x72, 0x6a, 0x05, 0x21, 0x8f, 0xa8, 0x4b, 0x42, 0xa1, 0xaf, 0x2b,
+	0xc4, 0x7b, 0x4b, 0x10, 0x17, 0x69, 0x82, 0x99, 0xea, 0x51, 0x6d, 0x38, 0x17, 0x11, 0xf0, 0x1b,
+	0xb0, 0xeb, 0x48, 0x6c, 0xb9, 0xc4, 0x27, 0x9e, 0x2d, 0x29, 0x67, 0x42, 0x2f, 0x28, 0xe8, 0x3b,
+	0x4b, 0xa0, 0xc6, 0xa0, 0xd7, 0x7f, 0x16, 0xa3, 0x8a, 0x23, 0x71, 0x76, 0x14, 0xf0, 0x12, 0xec,
+	0x44, 0x5c, 0x52, 0xe6, 0x59, 0x01, 0xff, 0x3e, 0xee, 0x70, 0x63, 0x25, 0xec, 0x5a, 0x69, 0xcd,
+	0x58, 0x7a, 0x61, 0xa2, 0x72, 0x94, 0x1d, 0x05, 0xbc, 0x01, 0x7b, 0x8e, 0xcf, 0xf1, 0xd8, 0x1a,
+	0x11, 0xea, 0x8d, 0xa4, 0x85, 0x47, 0x36, 0x65, 0x42, 0x7f, 0xa3, 0x80, 0xef, 0x2f, 0xeb, 0x2e,
+	0xce, 0xf8, 0x42, 0x25, 0x18, 0x0e, 0x1b, 0x70, 0x43, 0x62, 0x54, 0x73, 0xb2, 0x60, 0x4f, 0x41,
+	0xe0, 0x97, 0xa0, 0x92, 0x9b, 0x9a, 0x87, 0x42, 0x2f, 0x2a, 0xec, 0xf1, 0x8b, 0x43, 0xf3, 0x10,
+	0xed, 0x64, 0x33, 0xf3, 0x50, 0xc0, 0x4f, 0x40, 0x31, 0xb9, 0x71, 0x7d, 0x53, 0x31, 0x8e, 0x96,
+	0x30, 0x3e, 0x8b, 0x45, 0x97, 0xcc, 0x25, 0x13, 0x94, 0x26, 0xc0, 0x6b, 0x50, 0x8e, 0x02, 0xcb,
+	0x15, 0xd2, 0xc2, 0x36, 0x1e, 0x11, 0x7d, 0x4b, 0x01, 0xce, 0x5f, 0xfe, 0xb3, 0xfa, 0x54, 0xc8,
+	0x5e, 0x9c, 0x62, 0xf8, 0xe9, 0x60, 0x08, 0x44, 0x41, 0x3f, 0x0d, 0xc2, 0x01, 0xa8, 0x61, 0x1e,
+	0x11, 0x66, 0x33, 0x69, 0x85, 0x5c, 0xaa, 0xbb, 0xd1, 0xb7, 0x1b, 0xda, 0x0a, 0xaf, 0xf4, 0x52,
+	0x3d, 0x4a, 0xe5, 0xa8, 0x8a, 0xe7, 0x22, 0xf0, 0x3b, 0xb0, 0x27, 0xa8, 0xc7, 0x88, 0x6b, 0x09,
+	0xdf, 0x16, 0xa3, 0xf8, 0x92, 0xe5, 0x44, 0xe8, 0x60, 0xa5, 0x07, 0xaf, 0x54, 0xc6, 0x55, 0x9a,
+	0x30, 0x98, 0xa0, 0x9a, 0x98, 0x8b, 0x08, 0x68, 0x82, 0xea, 0x33, 0x31, 0x24, 0x98, 0x87, 0xae,
+	0xd0, 0x4b, 0x8a, 0x7a, 0xb2, 0x8c, 0x9a, 0xca, 0x91, 0x52, 0xa3, 0x5d, 0x31, 0x73, 0x8e, 0xbd,
+	0xb3, 0x1f, 0x10, 0xe6, 0xc6, 0xc0, 0x99, 0x5e, 0xcb, 0x8a, 0xda, 0x5a, 0xb6, 0x72, 0x49, 0x4a,
+	0xae, 0x59, 0x18, 0xcc, 0x87, 0x44, 0xf3, 0x67, 0x0d, 0xec, 0xcc, 0xf8, 0x16, 0x1e, 0x81, 0x72,
+	0xde, 0xa9, 0xba, 0xd6, 0xd0, 0x5a, 0x1b, 0xa8, 0x94, 0xb3, 0x1d, 0x44, 0x60, 0x7b, 0x18, 0x58,
+	0xb1, 0xe7, 0x82, 0xb1, 0xbe, 0xde, 0xd0, 0x5a, 0x65, 0xe3, 0xe3, 0xdf, 0xff, 0x78, 0xbb, 0xeb,
+	0x51, 0x39, 0xba, 0x73, 0xda, 0x98, 0xdf, 0x76, 0xd2, 0x9e, 0x94, 0xcd, 0x9f, 0x0e, 0x1d, 0x39,
+	0x0d, 0x88, 0x68, 0x1b, 0x97, 0xe6, 0xd9, 0xf9, 0x87, 0xe6, 0x9d, 0xf3, 0x15, 0x99, 0xa2, 0xcd,
+	0x61, 0x60, 0x48, 0x6c, 0x8e, 0xe3, 0xb2, 0xf9, 0x5d, 0xd3, 0x0b, 0x49, 0xd9, 0xdc, 0x12, 0x35,
+	0x7f, 0xd2, 0xc0, 0xe1, 0x4a, 0xdb, 0xbc, 0xa6, 0xf7, 0x01, 0xd8, 0x8d, 0x5d, 0x4a, 0x85, 0x0c,
+	0xa9, 0x73, 0xa7, 0xbc, 0xb4, 0xae, 0xbc, 0xf4, 0xc1, 0xbf, 0x30, 0x2a, 0xaa, 0x44, 0x41, 0x3f,
+	0x87, 0x68, 0x52, 0xb0, 0xb7, 0x60, 0x59, 0x61, 0x0b, 0x54, 0x67, 0xb6, 0xde, 0x71, 0x58, 0xda,
+	0x53, 0xc5, 0x99, 0x91, 0xff, 0x53, 0x29, 0xb1, 0xea, 0x6b, 0x4e, 0x29, 0x71, 0xf3, 0x2f, 0x0d,
+	0x94, 0xf3, 0x1b, 0x0c, 0xfb, 0xa0, 0x40, 0xdd, 0x89, 0xe2, 0x96, 0xba, 0xdd, 0x57, 0xec, 0x7c,
+	0xf6, 0xc4, 0x25, 0x0b, 0x1c, 0xa7, 0xff, 0x2f, 0x77, 0x3a, 0x00, 0xc0, 0x25, 0xfe, 0x13, 0xb4,
+	0xf0, 0x9f, 0xa0, 0x5b, 0x2e, 0xf1, 0x15, 0xb5, 0xf9, 0x83, 0x06, 0x40, 0xf6, 0xfc, 0xc0, 0x6a,
+	0x36, 0xfe, 0x46, 0x32, 0xca, 0xab, 0xff, 0x4b, 0xf8, 0x29, 0x78, 0xa3, 0x1e, 0x2f, 0xd5, 0xdb,
+	0x72, 0x0b, 0xa8, 0x6a, 0xcf, 0x0e, 0xf8, 0x36, 0x70, 0x6d, 0x49, 0x50, 0x92, 0x69, 0x7c, 0xfd,
+	0xcb, 0x43, 0x5d, 0xbb, 0x7f, 0xa8, 0x6b, 0x7f, 0x3e, 0xd4, 0xb5, 0x1f, 0x1f, 0xeb, 0x6b, 0xf7,
+	0x8f, 0xf5, 0xb5, 0xdf, 0x1e, 0xeb, 0x6b, 0x37, 0x2f, 0x4e, 0x39, 0xc9, 0x7f, 0x6a, 0xd5, 0xc8,
+	0x4e, 0x51, 0x7d, 0x67, 0xcf, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x72, 0xcf, 0x79, 0x52,
+	0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -497,6 +542,60 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingSlashingTxs) > 0 {
+		for iNdEx := len(m.PendingSlashingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSlashingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SlashingRecords) > 0 {
+		for iNdEx := len(m.SlashingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.SignedSlashingTxs) > 0 {
+		for iNdEx := len(m.SignedSlashingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignedSlashingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.CovenantRotation != nil {
+		{
+			size, err := m.CovenantRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.VpDstCache) > 0 {
 		for iNdEx := len(m.VpDstCache) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -899,6 +998,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.CovenantRotation != nil {
+		l = m.CovenantRotation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.SignedSlashingTxs) > 0 {
+		for _, e := range m.SignedSlashingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashingRecords) > 0 {
+		for _, e := range m.SlashingRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSlashingTxs) > 0 {
+		for _, e := range m.PendingSlashingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1299,6 +1420,144 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CovenantRotation == nil {
+				m.CovenantRotation = &CovenantRotation{}
+			}
+			if err := m.CovenantRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedSlashingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedSlashingTxs = append(m.SignedSlashingTxs, &SignedSlashingTx{})
+			if err := m.SignedSlashingTxs[len(m.SignedSlashingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingRecords = append(m.SlashingRecords, &SlashingRecord{})
+			if err := m.SlashingRecords[len(m.SlashingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlashingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSlashingTxs = append(m.PendingSlashingTxs, &PendingSlashingTx{})
+			if err := m.PendingSlashingTxs[len(m.PendingSlashingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,10 +1,14 @@
 package types_test
 
 import (
+	"math/rand"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/stretchr/testify/require"
 )
@@ -80,3 +84,184 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ValidateCrossReferences(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	net := &chaincfg.SimNetParams
+
+	fpSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, fpSK)
+	require.NoError(t, err)
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+	slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+	require.NoError(t, err)
+	btcDel, err := datagen.GenRandomBTCDelegation(
+		r,
+		t,
+		net,
+		[]bbn.BIP340PubKey{*fp.BtcPk},
+		delSK,
+		covenantSKs,
+		covenantPKs,
+		covenantQuorum,
+		slashingAddress.EncodeAddress(),
+		1000,
+		1100,
+		100000,
+		sdkmath.LegacyNewDecWithPrec(1, 1),
+		101,
+	)
+	require.NoError(t, err)
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+
+	// genGenesis returns a consistent genesis state with a finality provider,
+	// its BTC delegation and the voting power
+	genGenesis := func() *types.GenesisState {
+		p := types.DefaultParams()
+		return &types.GenesisState{
+			Params:            []*types.Params{&p},
+			FinalityProviders: []*types.FinalityProvider{fp},
+			BtcDelegations:    []*types.BTCDelegation{btcDel},
+			BtcDelegators: []*types.BTCDelegator{{
+				Idx:      &types.BTCDelegatorDelegationIndex{StakingTxHashList: [][]byte{stakingTxHash[:]}},
+				FpBtcPk:  fp.BtcPk,
+				DelBtcPk: btcDel.BtcPk,
+			}},
+			VotingPowers: []*types.VotingPowerFP{{
+				BlockHeight: 10,
+				FpBtcPk:     fp.BtcPk,
+				VotingPower: btcDel.TotalSat,
+			}},
+			VpDstCache: []*types.VotingPowerDistCacheBlkHeight{{
+				BlockHeight: 10,
+				VpDistribution: &types.VotingPowerDistCache{
+					TotalVotingPower: btcDel.TotalSat,
+					FinalityProviders: []*types.FinalityProviderDistInfo{{
+						BtcPk:            fp.BtcPk,
+						TotalVotingPower: btcDel.TotalSat,
+					}},
+				},
+			}},
+		}
+	}
+
+	tests := []struct {
+		desc   string
+		mutate func(gs *types.GenesisState)
+		valid  bool
+	}{
+		{
+			desc:   "consistent genesis state",
+			mutate: func(gs *types.GenesisState) {},
+			valid:  true,
+		},
+		{
+			desc: "duplicate finality provider",
+			mutate: func(gs *types.GenesisState) {
+				gs.FinalityProviders = append(gs.FinalityProviders, fp)
+			},
+			valid: false,
+		},
+		{
+			desc: "BTC delegation under non-existing finality provider",
+			mutate: func(gs *types.GenesisState) {
+				gs.FinalityProviders = nil
+				gs.BtcDelegators = nil
+				gs.VotingPowers = nil
+				gs.VpDstCache = nil
+			},
+			valid: false,
+		},
+		{
+			desc: "BTC delegation with non-existing params version",
+			mutate: func(gs *types.GenesisState) {
+				del := *btcDel
+				del.ParamsVersion = 1
+				gs.BtcDelegations = []*types.BTCDelegation{&del}
+			},
+			valid: false,
+		},
+		{
+			desc: "BTC delegator index of non-existing BTC delegation",
+			mutate: func(gs *types.GenesisState) {
+				gs.BtcDelegations = nil
+			},
+			valid: false,
+		},
+		{
+			desc: "BTC delegator index of another delegator",
+			mutate: func(gs *types.GenesisState) {
+				gs.BtcDelegators[0].DelBtcPk = fp.BtcPk
+			},
+			valid: false,
+		},
+		{
+			desc: "voting power mismatching the distribution cache",
+			mutate: func(gs *types.GenesisState) {
+				gs.VotingPowers[0].VotingPower++
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate voting power distribution cache",
+			mutate: func(gs *types.GenesisState) {
+				gs.VpDstCache = append(gs.VpDstCache, gs.VpDstCache[0])
+			},
+			valid: false,
+		},
+		{
+			desc: "slashing record under a non-slashed finality provider",
+			mutate: func(gs *types.GenesisState) {
+				gs.SlashingRecords = []*types.SlashingRecord{{
+					FpBtcPk:       fp.BtcPk,
+					StakingTxHash: stakingTxHash.String(),
+					SlashingRate:  sdkmath.LegacyNewDecWithPrec(1, 1),
+				}}
+			},
+			valid: false,
+		},
+		{
+			desc: "pending slashing tx under a slashed finality provider",
+			mutate: func(gs *types.GenesisState) {
+				slashedFp := *fp
+				slashedFp.SlashedBabylonHeight = 10
+				gs.FinalityProviders = []*types.FinalityProvider{&slashedFp}
+				gs.PendingSlashingTxs = []*types.PendingSlashingTx{{
+					FpBtcPk:       fp.BtcPk,
+					StakingTxHash: stakingTxHash.String(),
+					FpBtcSk:       fpSK.Serialize(),
+				}}
+			},
+			valid: true,
+		},
+		{
+			desc: "pending slashing tx with another finality provider's secret key",
+			mutate: func(gs *types.GenesisState) {
+				slashedFp := *fp
+				slashedFp.SlashedBabylonHeight = 10
+				gs.FinalityProviders = []*types.FinalityProvider{&slashedFp}
+				gs.PendingSlashingTxs = []*types.PendingSlashingTx{{
+					FpBtcPk:       fp.BtcPk,
+					StakingTxHash: stakingTxHash.String(),
+					FpBtcSk:       delSK.Serialize(),
+				}}
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			gs := genGenesis()
+			tc.mutate(gs)
+			err := gs.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
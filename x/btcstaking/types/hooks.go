@@ -24,3 +24,43 @@ func (h MultiBtcStakingHooks) AfterFinalityProviderActivated(ctx context.Context
 
 	return nil
 }
+
+func (h MultiBtcStakingHooks) AfterBTCDelegationActivated(ctx context.Context, btcDel *BTCDelegation) error {
+	for i := range h {
+		if err := h[i].AfterBTCDelegationActivated(ctx, btcDel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiBtcStakingHooks) AfterBTCDelegationUnbonded(ctx context.Context, btcDel *BTCDelegation) error {
+	for i := range h {
+		if err := h[i].AfterBTCDelegationUnbonded(ctx, btcDel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiBtcStakingHooks) AfterBTCDelegationExpired(ctx context.Context, btcDel *BTCDelegation) error {
+	for i := range h {
+		if err := h[i].AfterBTCDelegationExpired(ctx, btcDel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiBtcStakingHooks) AfterBTCDelegationSlashed(ctx context.Context, fpPk *types.BIP340PubKey, btcDel *BTCDelegation) error {
+	for i := range h {
+		if err := h[i].AfterBTCDelegationSlashed(ctx, fpPk, btcDel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -49,8 +49,17 @@ func (dc *VotingPowerDistCache) ApplyActiveFinalityProviders(maxActiveFPs uint32
 	}
 }
 
+// GetNumActiveFPs returns the number of active finality providers, i.e.,
+// min(maxActiveFPs, number of non-jailed finality providers). Jailed
+// finality providers are never active
 func (dc *VotingPowerDistCache) GetNumActiveFPs(maxActiveFPs uint32) uint32 {
-	return min(maxActiveFPs, uint32(len(dc.FinalityProviders)))
+	numNonJailedFPs := uint32(0)
+	for _, fp := range dc.FinalityProviders {
+		if !fp.IsJailed {
+			numNonJailedFPs++
+		}
+	}
+	return min(maxActiveFPs, numNonJailedFPs)
 }
 
 // GetActiveFinalityProviderSet returns a set of active finality providers
@@ -100,6 +109,7 @@ func NewFinalityProviderDistInfo(fp *FinalityProvider) *FinalityProviderDistInfo
 		Commission:       fp.Commission,
 		TotalVotingPower: 0,
 		BtcDels:          []*BTCDelDistInfo{},
+		IsJailed:         fp.Jailed,
 	}
 }
 
@@ -94,6 +94,9 @@ type FinalityProviderDistInfo struct {
 	TotalVotingPower uint64 `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
 	// btc_dels is a list of BTC delegations' voting power information under this finality provider
 	BtcDels []*BTCDelDistInfo `protobuf:"bytes,5,rep,name=btc_dels,json=btcDels,proto3" json:"btc_dels,omitempty"`
+	// is_jailed indicates whether the finality provider is jailed, in which
+	// case it is not counted in the active set
+	IsJailed bool `protobuf:"varint,6,opt,name=is_jailed,json=isJailed,proto3" json:"is_jailed,omitempty"`
 }
 
 func (m *FinalityProviderDistInfo) Reset()         { *m = FinalityProviderDistInfo{} }
@@ -150,6 +153,13 @@ func (m *FinalityProviderDistInfo) GetBtcDels() []*BTCDelDistInfo {
 	return nil
 }
 
+func (m *FinalityProviderDistInfo) GetIsJailed() bool {
+	if m != nil {
+		return m.IsJailed
+	}
+	return false
+}
+
 // BTCDelDistInfo contains the information related to reward distribution for a BTC delegation
 type BTCDelDistInfo struct {
 	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
@@ -228,39 +238,40 @@ func init() {
 }
 
 var fileDescriptor_ac354c3bd6d7a66b = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xae, 0x1b, 0xe0, 0x8e, 0x7f, 0x56, 0x91, 0xc2, 0x90, 0xb2, 0x52, 0x69, 0xa8,
-	0x87, 0x35, 0x61, 0x1b, 0x42, 0xe2, 0x06, 0x59, 0x84, 0x98, 0xd8, 0xa4, 0x28, 0x4c, 0x1c, 0x38,
-	0x10, 0x39, 0x8e, 0x9b, 0x58, 0x4d, 0xec, 0x2a, 0xf6, 0x42, 0xf3, 0x2d, 0xf8, 0x10, 0x7c, 0x84,
-	0x7d, 0x08, 0x8e, 0xd3, 0x0e, 0x08, 0xed, 0x30, 0xa1, 0x56, 0x7c, 0x0f, 0x14, 0x27, 0xb0, 0x82,
-	0x5a, 0xc1, 0x81, 0x9b, 0xed, 0xe7, 0x79, 0xfd, 0xbe, 0xcf, 0x4f, 0x7a, 0xc1, 0x56, 0x80, 0x82,
-	0x22, 0xe1, 0xcc, 0x0a, 0x24, 0x16, 0x12, 0x8d, 0x28, 0x8b, 0xac, 0x7c, 0xc7, 0xa2, 0x0c, 0x13,
-	0x26, 0x69, 0x4e, 0xcc, 0x71, 0xc6, 0x25, 0x87, 0xf7, 0x6a, 0x9b, 0x79, 0x65, 0x33, 0xf3, 0x9d,
-	0x8d, 0x4e, 0xc4, 0x23, 0xae, 0x1c, 0x56, 0x79, 0xaa, 0xcc, 0x1b, 0xf7, 0x31, 0x17, 0x29, 0x17,
-	0x7e, 0x25, 0x54, 0x97, 0x4a, 0xea, 0x7d, 0xd2, 0x40, 0xe7, 0x2d, 0x97, 0x94, 0x45, 0x2e, 0xff,
-	0x40, 0x32, 0x87, 0x0a, 0xb9, 0x8f, 0x70, 0x4c, 0xe0, 0x36, 0x80, 0x92, 0x4b, 0x94, 0xf8, 0xb9,
-	0x52, 0xfd, 0x71, 0x29, 0xeb, 0x5a, 0x57, 0xeb, 0xb7, 0xbc, 0x3b, 0x4a, 0x99, 0x2b, 0x83, 0xef,
-	0x01, 0x1c, 0x52, 0x86, 0x12, 0x2a, 0x8b, 0xb2, 0x4b, 0x4e, 0x43, 0x92, 0x09, 0xbd, 0xd9, 0x5d,
-	0xe9, 0xb7, 0x77, 0x2d, 0x73, 0xe1, 0xac, 0xe6, 0xcb, 0xba, 0xc0, 0xad, 0xfd, 0x65, 0xef, 0x03,
-	0x36, 0xe4, 0xde, 0xdd, 0xe1, 0x1f, 0x8a, 0xe8, 0x7d, 0x69, 0x02, 0x7d, 0x99, 0x1f, 0x1e, 0x81,
-	0xb5, 0x40, 0x62, 0x7f, 0x3c, 0x52, 0xe3, 0xad, 0xdb, 0x4f, 0x2f, 0x2e, 0x37, 0x77, 0x23, 0x2a,
-	0xe3, 0x93, 0xc0, 0xc4, 0x3c, 0xb5, 0xea, 0xf6, 0x38, 0x46, 0x94, 0xfd, 0xbc, 0x58, 0xb2, 0x18,
-	0x13, 0x61, 0xda, 0x07, 0xee, 0xde, 0x93, 0xc7, 0xee, 0x49, 0xf0, 0x9a, 0x14, 0xde, 0x6a, 0x20,
-	0xb1, 0x3b, 0x82, 0xdb, 0xa0, 0x85, 0xc2, 0x30, 0xd3, 0x9b, 0x5d, 0xad, 0x7f, 0xc3, 0xd6, 0xcf,
-	0x4f, 0x07, 0x9d, 0x1a, 0xd9, 0x8b, 0x30, 0xcc, 0x88, 0x10, 0x6f, 0x64, 0x46, 0x59, 0xe4, 0x29,
-	0x17, 0x3c, 0x02, 0x00, 0xf3, 0x34, 0xa5, 0x42, 0x50, 0xce, 0xf4, 0x15, 0x55, 0x33, 0xb8, 0xb8,
-	0xdc, 0x7c, 0x50, 0xd5, 0x88, 0x70, 0x64, 0x52, 0x6e, 0xa5, 0x48, 0xc6, 0xe6, 0x21, 0x89, 0x10,
-	0x2e, 0x1c, 0x82, 0xcf, 0x4f, 0x07, 0xa0, 0xfe, 0xd2, 0x21, 0xd8, 0x9b, 0xfb, 0x60, 0x09, 0xf6,
-	0xd6, 0x12, 0xec, 0xcf, 0xc1, 0xf5, 0x32, 0x79, 0x48, 0x12, 0xa1, 0xaf, 0x2a, 0xd8, 0x5b, 0x4b,
-	0x60, 0xdb, 0xc7, 0xfb, 0x0e, 0x49, 0x7e, 0x21, 0xbe, 0x16, 0x48, 0xec, 0x90, 0x44, 0xf4, 0xbe,
-	0x6b, 0xe0, 0xd6, 0xef, 0xda, 0xff, 0xc6, 0xf9, 0x0c, 0xb4, 0xcb, 0x39, 0x48, 0xe6, 0xff, 0x13,
-	0x55, 0x50, 0x99, 0xcb, 0x47, 0xf8, 0x08, 0xdc, 0xae, 0x23, 0xf8, 0x72, 0xe2, 0xc7, 0x48, 0xc4,
-	0x15, 0x60, 0xef, 0x66, 0xfd, 0x7c, 0x3c, 0x79, 0x85, 0x44, 0x0c, 0x1f, 0x82, 0xf5, 0x05, 0xb8,
-	0xda, 0xf9, 0x15, 0x29, 0xfb, 0xf0, 0xf3, 0xd4, 0xd0, 0xce, 0xa6, 0x86, 0xf6, 0x6d, 0x6a, 0x68,
-	0x1f, 0x67, 0x46, 0xe3, 0x6c, 0x66, 0x34, 0xbe, 0xce, 0x8c, 0xc6, 0xbb, 0xbf, 0x46, 0x9b, 0xcc,
-	0xaf, 0xa2, 0xca, 0x19, 0xac, 0xa9, 0xe5, 0xd9, 0xfb, 0x11, 0x00, 0x00, 0xff, 0xff, 0x42, 0x6b,
-	0x62, 0xab, 0xad, 0x03, 0x00, 0x00,
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x9b, 0xae, 0x2b, 0x9d, 0x3b, 0xfe, 0x59, 0x45, 0x0a, 0x9b, 0x94, 0x95, 0x4a, 0x43,
+	0xbd, 0x58, 0x13, 0xb6, 0x21, 0x24, 0xee, 0xa0, 0xab, 0x10, 0x83, 0x4d, 0x8a, 0xc2, 0xc4, 0x05,
+	0x17, 0x44, 0x8e, 0xe3, 0x26, 0xa6, 0x89, 0x5d, 0xc5, 0x5e, 0x68, 0xde, 0x82, 0x87, 0xe0, 0x11,
+	0xf6, 0x10, 0x5c, 0x4e, 0xbb, 0x42, 0xbb, 0x98, 0x50, 0x2b, 0x9e, 0x80, 0x17, 0x40, 0x71, 0x02,
+	0x2b, 0x68, 0x15, 0x5c, 0xec, 0xce, 0xc7, 0xdf, 0xf7, 0xf9, 0x9c, 0xf3, 0x93, 0x0c, 0x36, 0x3d,
+	0xe4, 0x65, 0x11, 0x67, 0x96, 0x27, 0xb1, 0x90, 0x68, 0x44, 0x59, 0x60, 0xa5, 0xdb, 0x16, 0x65,
+	0x98, 0x30, 0x49, 0x53, 0x62, 0x8e, 0x13, 0x2e, 0x39, 0xbc, 0x57, 0xda, 0xcc, 0x4b, 0x9b, 0x99,
+	0x6e, 0xaf, 0xb5, 0x02, 0x1e, 0x70, 0xe5, 0xb0, 0xf2, 0x53, 0x61, 0x5e, 0xbb, 0x8f, 0xb9, 0x88,
+	0xb9, 0x70, 0x0b, 0xa1, 0x28, 0x0a, 0xa9, 0xf3, 0x59, 0x03, 0xad, 0xb7, 0x5c, 0x52, 0x16, 0xd8,
+	0xfc, 0x23, 0x49, 0x06, 0x54, 0xc8, 0x3d, 0x84, 0x43, 0x02, 0xb7, 0x00, 0x94, 0x5c, 0xa2, 0xc8,
+	0x4d, 0x95, 0xea, 0x8e, 0x73, 0x59, 0xd7, 0xda, 0x5a, 0xb7, 0xe6, 0xdc, 0x51, 0xca, 0x5c, 0x0c,
+	0xbe, 0x07, 0x70, 0x48, 0x19, 0x8a, 0xa8, 0xcc, 0xf2, 0x2e, 0x29, 0xf5, 0x49, 0x22, 0xf4, 0x6a,
+	0x7b, 0xa9, 0xdb, 0xdc, 0xb1, 0xcc, 0x2b, 0x67, 0x35, 0x5f, 0x94, 0x01, 0xbb, 0xf4, 0xe7, 0xbd,
+	0xf7, 0xd9, 0x90, 0x3b, 0x77, 0x87, 0x7f, 0x29, 0xa2, 0xf3, 0xa3, 0x0a, 0xf4, 0x45, 0x7e, 0x78,
+	0x08, 0xea, 0x9e, 0xc4, 0xee, 0x78, 0xa4, 0xc6, 0x5b, 0xed, 0x3f, 0x39, 0xbf, 0xd8, 0xd8, 0x09,
+	0xa8, 0x0c, 0x8f, 0x3d, 0x13, 0xf3, 0xd8, 0x2a, 0xdb, 0xe3, 0x10, 0x51, 0xf6, 0xab, 0xb0, 0x64,
+	0x36, 0x26, 0xc2, 0xec, 0xef, 0xdb, 0xbb, 0x8f, 0x1f, 0xd9, 0xc7, 0xde, 0x6b, 0x92, 0x39, 0xcb,
+	0x9e, 0xc4, 0xf6, 0x08, 0x6e, 0x81, 0x1a, 0xf2, 0xfd, 0x44, 0xaf, 0xb6, 0xb5, 0xee, 0x4a, 0x5f,
+	0x3f, 0x3b, 0xe9, 0xb5, 0x4a, 0x64, 0xcf, 0x7d, 0x3f, 0x21, 0x42, 0xbc, 0x91, 0x09, 0x65, 0x81,
+	0xa3, 0x5c, 0xf0, 0x10, 0x00, 0xcc, 0xe3, 0x98, 0x0a, 0x41, 0x39, 0xd3, 0x97, 0x54, 0xa6, 0x77,
+	0x7e, 0xb1, 0xb1, 0x5e, 0x64, 0x84, 0x3f, 0x32, 0x29, 0xb7, 0x62, 0x24, 0x43, 0xf3, 0x80, 0x04,
+	0x08, 0x67, 0x03, 0x82, 0xcf, 0x4e, 0x7a, 0xa0, 0x7c, 0x72, 0x40, 0xb0, 0x33, 0xf7, 0xc0, 0x02,
+	0xec, 0xb5, 0x05, 0xd8, 0x9f, 0x81, 0x46, 0xbe, 0xb9, 0x4f, 0x22, 0xa1, 0x2f, 0x2b, 0xd8, 0x9b,
+	0x0b, 0x60, 0xf7, 0x8f, 0xf6, 0x06, 0x24, 0xfa, 0x8d, 0xf8, 0x86, 0x27, 0xf1, 0x80, 0x44, 0x02,
+	0xae, 0x83, 0x15, 0x2a, 0xdc, 0x0f, 0x88, 0x46, 0xc4, 0xd7, 0xeb, 0x6d, 0xad, 0xdb, 0x70, 0x1a,
+	0x54, 0xbc, 0x52, 0x75, 0xe7, 0xbb, 0x06, 0x6e, 0xfd, 0x19, 0xbc, 0x6e, 0xd6, 0x4f, 0x41, 0x33,
+	0x1f, 0x92, 0x24, 0xee, 0x7f, 0x21, 0x07, 0x85, 0x39, 0xbf, 0x84, 0x0f, 0xc1, 0xed, 0x72, 0x3f,
+	0x57, 0x4e, 0xdc, 0x10, 0x89, 0xb0, 0xa0, 0xef, 0xdc, 0x2c, 0xaf, 0x8f, 0x26, 0x2f, 0x91, 0x08,
+	0xe1, 0x03, 0xb0, 0x7a, 0x05, 0xcb, 0x66, 0x7a, 0x89, 0xb1, 0x7f, 0xf0, 0x65, 0x6a, 0x68, 0xa7,
+	0x53, 0x43, 0xfb, 0x36, 0x35, 0xb4, 0x4f, 0x33, 0xa3, 0x72, 0x3a, 0x33, 0x2a, 0x5f, 0x67, 0x46,
+	0xe5, 0xdd, 0x3f, 0x57, 0x9b, 0xcc, 0xff, 0x53, 0xb5, 0xa7, 0x57, 0x57, 0x3f, 0x6b, 0xf7, 0x67,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x6a, 0x66, 0x72, 0xca, 0x03, 0x00, 0x00,
 }
 
 func (m *VotingPowerDistCache) Marshal() (dAtA []byte, err error) {
@@ -325,6 +336,16 @@ func (m *FinalityProviderDistInfo) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.IsJailed {
+		i--
+		if m.IsJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.BtcDels) > 0 {
 		for iNdEx := len(m.BtcDels) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -488,6 +509,9 @@ func (m *FinalityProviderDistInfo) Size() (n int) {
 			n += 1 + l + sovIncentive(uint64(l))
 		}
 	}
+	if m.IsJailed {
+		n += 2
+	}
 	return n
 }
 
@@ -809,6 +833,26 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsJailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentive(dAtA[iNdEx:])
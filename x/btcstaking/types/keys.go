@@ -12,6 +12,13 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_btcstaking"
+
+	// MaxPendingSlashingTxsPerBlock is the maximum number of pending slashing
+	// txs assembled at the end of each block. Assembling a slashing tx involves
+	// decrypting adaptor signatures, which is not metered by gas, so the work
+	// of slashing a finality provider with many BTC delegations is spread
+	// across blocks.
+	MaxPendingSlashingTxsPerBlock = 100
 )
 
 var (
@@ -23,4 +30,8 @@ var (
 	BTCHeightKey            = []byte{0x06} // key prefix for the BTC heights
 	VotingPowerDistCacheKey = []byte{0x07} // key prefix for voting power distribution cache
 	PowerDistUpdateKey      = []byte{0x08} // key prefix for power distribution update events
+	CovenantRotationKey     = []byte{0x09} // key for the scheduled covenant committee rotation
+	SignedSlashingTxKey     = []byte{0x0A} // key prefix for the signed slashing txs of slashed finality providers
+	SlashingRecordKey       = []byte{0x0B} // key prefix for the slashing records of slashed BTC delegations
+	PendingSlashingTxKey    = []byte{0x0C} // key prefix for the BTC delegations whose signed slashing txs are yet to be assembled
 )
@@ -206,6 +206,62 @@ func (m *MockBtcStakingHooks) EXPECT() *MockBtcStakingHooksMockRecorder {
 	return m.recorder
 }
 
+// AfterBTCDelegationActivated mocks base method.
+func (m *MockBtcStakingHooks) AfterBTCDelegationActivated(ctx context.Context, btcDel *BTCDelegation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterBTCDelegationActivated", ctx, btcDel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterBTCDelegationActivated indicates an expected call of AfterBTCDelegationActivated.
+func (mr *MockBtcStakingHooksMockRecorder) AfterBTCDelegationActivated(ctx, btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBTCDelegationActivated", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterBTCDelegationActivated), ctx, btcDel)
+}
+
+// AfterBTCDelegationExpired mocks base method.
+func (m *MockBtcStakingHooks) AfterBTCDelegationExpired(ctx context.Context, btcDel *BTCDelegation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterBTCDelegationExpired", ctx, btcDel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterBTCDelegationExpired indicates an expected call of AfterBTCDelegationExpired.
+func (mr *MockBtcStakingHooksMockRecorder) AfterBTCDelegationExpired(ctx, btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBTCDelegationExpired", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterBTCDelegationExpired), ctx, btcDel)
+}
+
+// AfterBTCDelegationSlashed mocks base method.
+func (m *MockBtcStakingHooks) AfterBTCDelegationSlashed(ctx context.Context, fpPk *types.BIP340PubKey, btcDel *BTCDelegation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterBTCDelegationSlashed", ctx, fpPk, btcDel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterBTCDelegationSlashed indicates an expected call of AfterBTCDelegationSlashed.
+func (mr *MockBtcStakingHooksMockRecorder) AfterBTCDelegationSlashed(ctx, fpPk, btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBTCDelegationSlashed", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterBTCDelegationSlashed), ctx, fpPk, btcDel)
+}
+
+// AfterBTCDelegationUnbonded mocks base method.
+func (m *MockBtcStakingHooks) AfterBTCDelegationUnbonded(ctx context.Context, btcDel *BTCDelegation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterBTCDelegationUnbonded", ctx, btcDel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterBTCDelegationUnbonded indicates an expected call of AfterBTCDelegationUnbonded.
+func (mr *MockBtcStakingHooksMockRecorder) AfterBTCDelegationUnbonded(ctx, btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBTCDelegationUnbonded", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterBTCDelegationUnbonded), ctx, btcDel)
+}
+
 // AfterFinalityProviderActivated mocks base method.
 func (m *MockBtcStakingHooks) AfterFinalityProviderActivated(ctx context.Context, fpPk *types.BIP340PubKey) error {
 	m.ctrl.T.Helper()
@@ -18,6 +18,7 @@ var (
 	_ sdk.Msg = &MsgCreateBTCDelegation{}
 	_ sdk.Msg = &MsgAddCovenantSigs{}
 	_ sdk.Msg = &MsgBTCUndelegate{}
+	_ sdk.Msg = &MsgRotateCovenantCommittee{}
 )
 
 func (m *MsgCreateFinalityProvider) ValidateBasic() error {
@@ -189,3 +189,39 @@ func (p Params) CovenantPksHex() []string {
 	}
 	return covPksHex
 }
+
+// HasSameCovenantCommittee returns whether the given params share the same
+// covenant committee and quorum with p
+func (p Params) HasSameCovenantCommittee(p2 *Params) bool {
+	if p.CovenantQuorum != p2.CovenantQuorum || len(p.CovenantPks) != len(p2.CovenantPks) {
+		return false
+	}
+	for i := range p.CovenantPks {
+		if !p2.HasCovenantPK(&p.CovenantPks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyCovenantRotation returns a copy of the params where the covenant
+// committee is replaced by the one in the given rotation
+func (p Params) ApplyCovenantRotation(r *CovenantRotation) Params {
+	p.CovenantPks = r.CovenantPks
+	p.CovenantQuorum = r.CovenantQuorum
+	return p
+}
+
+// Validate validates the covenant committee of the rotation
+func (r *CovenantRotation) Validate() error {
+	if r.CovenantQuorum == 0 {
+		return fmt.Errorf("covenant quorum size has to be positive")
+	}
+	if r.CovenantQuorum*2 <= uint32(len(r.CovenantPks)) {
+		return fmt.Errorf("covenant quorum size has to be more than 1/2 of the covenant committee size")
+	}
+	if r.CovenantQuorum > uint32(len(r.CovenantPks)) {
+		return fmt.Errorf("covenant quorum size cannot be larger than the covenant committee size")
+	}
+	return validateCovenantPks(r.CovenantPks)
+}
@@ -125,6 +125,68 @@ func (m *Params) GetMinUnbondingTime() uint32 {
 	return 0
 }
 
+// CovenantRotation is a scheduled rotation of the covenant committee. Once the
+// BTC tip reaches activation_btc_height, a new version of parameters with the
+// new committee is stored. BTC delegations created before that keep being
+// verified against the committee of their own parameters version.
+type CovenantRotation struct {
+	// covenant_pks is the list of public keys held by the new covenant committee
+	CovenantPks []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,rep,name=covenant_pks,json=covenantPks,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"covenant_pks,omitempty"`
+	// covenant_quorum is the minimum number of signatures needed for the new
+	// covenant multisignature
+	CovenantQuorum uint32 `protobuf:"varint,2,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// activation_btc_height is the BTC height from which the new covenant
+	// committee takes effect
+	ActivationBtcHeight uint64 `protobuf:"varint,3,opt,name=activation_btc_height,json=activationBtcHeight,proto3" json:"activation_btc_height,omitempty"`
+}
+
+func (m *CovenantRotation) Reset()         { *m = CovenantRotation{} }
+func (m *CovenantRotation) String() string { return proto.CompactTextString(m) }
+func (*CovenantRotation) ProtoMessage()    {}
+func (*CovenantRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d1392776a3e15b9, []int{1}
+}
+func (m *CovenantRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CovenantRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantRotation.Merge(m, src)
+}
+func (m *CovenantRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CovenantRotation proto.InternalMessageInfo
+
+func (m *CovenantRotation) GetCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CovenantQuorum
+	}
+	return 0
+}
+
+func (m *CovenantRotation) GetActivationBtcHeight() uint64 {
+	if m != nil {
+		return m.ActivationBtcHeight
+	}
+	return 0
+}
+
 // StoredParams attach information about the version of stored parameters
 type StoredParams struct {
 	// version of the stored parameters. Each parameters update
@@ -138,7 +200,7 @@ func (m *StoredParams) Reset()         { *m = StoredParams{} }
 func (m *StoredParams) String() string { return proto.CompactTextString(m) }
 func (*StoredParams) ProtoMessage()    {}
 func (*StoredParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d1392776a3e15b9, []int{1}
+	return fileDescriptor_8d1392776a3e15b9, []int{2}
 }
 func (m *StoredParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,6 +245,7 @@ func (m *StoredParams) GetParams() Params {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btcstaking.v1.Params")
+	proto.RegisterType((*CovenantRotation)(nil), "babylon.btcstaking.v1.CovenantRotation")
 	proto.RegisterType((*StoredParams)(nil), "babylon.btcstaking.v1.StoredParams")
 }
 
@@ -191,42 +254,44 @@ func init() {
 }
 
 var fileDescriptor_8d1392776a3e15b9 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xbf, 0xe4, 0x4b, 0xe9, 0x34, 0xa5, 0x65, 0x00, 0x61, 0x82, 0xea, 0x44, 0x61, 0x41,
-	0x90, 0xc0, 0x26, 0x6d, 0xc5, 0x02, 0x56, 0x09, 0xa8, 0x12, 0xa2, 0x8b, 0xe0, 0x14, 0x24, 0xd8,
-	0x8c, 0xc6, 0xf6, 0xd4, 0x19, 0x25, 0x33, 0x13, 0x3c, 0x13, 0xcb, 0x7e, 0x0b, 0x96, 0x2c, 0x79,
-	0x08, 0x1e, 0xa2, 0xcb, 0x8a, 0x15, 0xea, 0x22, 0x42, 0xc9, 0x1b, 0xf0, 0x04, 0xc8, 0x63, 0x3b,
-	0xfc, 0x08, 0x09, 0xc4, 0xce, 0xf7, 0xdc, 0x73, 0xcf, 0xfd, 0xf1, 0x19, 0xd0, 0xf1, 0xb0, 0x97,
-	0x4e, 0x05, 0x77, 0x3c, 0xe5, 0x4b, 0x85, 0x27, 0x94, 0x87, 0x4e, 0xdc, 0x73, 0x66, 0x38, 0xc2,
-	0x4c, 0xda, 0xb3, 0x48, 0x28, 0x01, 0xaf, 0x17, 0x1c, 0xfb, 0x3b, 0xc7, 0x8e, 0x7b, 0xcd, 0x6b,
-	0xa1, 0x08, 0x85, 0x66, 0x38, 0xd9, 0x57, 0x4e, 0x6e, 0xde, 0xf4, 0x85, 0x64, 0x42, 0xa2, 0x3c,
-	0x91, 0x07, 0x79, 0xaa, 0xf3, 0xb5, 0x06, 0xea, 0x43, 0x2d, 0x0c, 0x5f, 0x83, 0x86, 0x2f, 0x62,
-	0xc2, 0x31, 0x57, 0x68, 0x36, 0x91, 0xa6, 0xd1, 0xae, 0x76, 0x1b, 0x83, 0x87, 0x17, 0x8b, 0xd6,
-	0x7e, 0x48, 0xd5, 0x78, 0xee, 0xd9, 0xbe, 0x60, 0x4e, 0xd1, 0xd7, 0x1f, 0x63, 0xca, 0xcb, 0xc0,
-	0x51, 0xe9, 0x8c, 0x48, 0x7b, 0xf0, 0x6c, 0x78, 0x70, 0xf8, 0x60, 0x38, 0xf7, 0x9e, 0x93, 0xd4,
-	0xdd, 0x2a, 0xb5, 0x86, 0x13, 0x09, 0xef, 0x80, 0x9d, 0xb5, 0xf4, 0xdb, 0xb9, 0x88, 0xe6, 0xcc,
-	0xfc, 0xaf, 0x6d, 0x74, 0xb7, 0xdd, 0xcb, 0x25, 0xfc, 0x42, 0xa3, 0xf0, 0x2e, 0xd8, 0x95, 0x53,
-	0x2c, 0xc7, 0x94, 0x87, 0x08, 0x07, 0x41, 0x44, 0xa4, 0x34, 0xab, 0x6d, 0xa3, 0xbb, 0xe9, 0xee,
-	0x94, 0x78, 0x3f, 0x87, 0xe1, 0x21, 0xb8, 0xc1, 0x28, 0x47, 0x6b, 0xba, 0x4a, 0xd0, 0x29, 0x21,
-	0x48, 0x62, 0x65, 0xd6, 0xda, 0x46, 0xb7, 0xea, 0x5e, 0x65, 0x94, 0x8f, 0x8a, 0xec, 0x49, 0x72,
-	0x44, 0xc8, 0x08, 0x2b, 0x38, 0x02, 0x19, 0x8c, 0x7c, 0xc1, 0x18, 0x95, 0x92, 0x0a, 0x8e, 0x22,
-	0xac, 0x88, 0xf9, 0x7f, 0xd6, 0x63, 0x70, 0xfb, 0x6c, 0xd1, 0xaa, 0x5c, 0x2c, 0x5a, 0xb7, 0xf2,
-	0x13, 0xc9, 0x60, 0x62, 0x53, 0xe1, 0x30, 0xac, 0xc6, 0xf6, 0x31, 0x09, 0xb1, 0x9f, 0x3e, 0x25,
-	0xbe, 0x7b, 0x85, 0x51, 0xfe, 0x64, 0x5d, 0xee, 0x62, 0x45, 0xe0, 0x2b, 0xb0, 0xbd, 0x1e, 0x43,
-	0xcb, 0xd5, 0xb5, 0x5c, 0xef, 0x2f, 0xe4, 0x3e, 0x7d, 0xbc, 0x0f, 0x8a, 0x1f, 0x92, 0x89, 0x37,
-	0x4a, 0x1d, 0xad, 0xdb, 0x07, 0x7b, 0x0c, 0x27, 0x08, 0xfb, 0x8a, 0xc6, 0x04, 0x9d, 0x52, 0x8e,
-	0xa7, 0x54, 0xa5, 0xd9, 0x6f, 0x8c, 0x69, 0x40, 0x22, 0x69, 0x6e, 0xe8, 0x23, 0x36, 0x19, 0x4e,
-	0xfa, 0x9a, 0x73, 0x54, 0x50, 0x86, 0x25, 0x03, 0xde, 0x03, 0x30, 0xdb, 0x77, 0xce, 0x3d, 0xc1,
-	0x03, 0x7d, 0x26, 0xca, 0x88, 0x79, 0x49, 0xd7, 0xed, 0x32, 0xca, 0x5f, 0x96, 0x89, 0x13, 0xca,
-	0x08, 0x44, 0xbf, 0xb2, 0xf5, 0x36, 0x9b, 0xff, 0xba, 0xcd, 0x4f, 0x0d, 0xb2, 0x8d, 0x1e, 0xd5,
-	0xde, 0x7f, 0x68, 0x55, 0x3a, 0x04, 0x34, 0x46, 0x4a, 0x44, 0x24, 0x28, 0x9c, 0x67, 0x82, 0x8d,
-	0x98, 0x44, 0xd9, 0x39, 0x4d, 0x43, 0x4f, 0x56, 0x86, 0xf0, 0x31, 0xa8, 0xe7, 0xb6, 0xd7, 0x7e,
-	0xd9, 0xda, 0xdf, 0xb3, 0x7f, 0xeb, 0x7b, 0x3b, 0x17, 0x1a, 0xd4, 0xb2, 0x19, 0xdd, 0xa2, 0x64,
-	0x70, 0x7c, 0xb6, 0xb4, 0x8c, 0xf3, 0xa5, 0x65, 0x7c, 0x59, 0x5a, 0xc6, 0xbb, 0x95, 0x55, 0x39,
-	0x5f, 0x59, 0x95, 0xcf, 0x2b, 0xab, 0xf2, 0xe6, 0x8f, 0x86, 0x4e, 0x7e, 0x7c, 0x7b, 0xda, 0xdd,
-	0x5e, 0x5d, 0x3f, 0x98, 0x83, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x91, 0xdb, 0xb2, 0x9e,
-	0x03, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x69, 0x48, 0xe9, 0x36, 0xa5, 0xc5, 0xa5, 0xc2, 0x04, 0xd5, 0x89, 0xc2, 0x81, 0x20,
+	0x81, 0x4d, 0xd2, 0x8a, 0x03, 0x9c, 0xe2, 0xa2, 0x0a, 0x44, 0x0f, 0xc1, 0x29, 0x48, 0x70, 0x59,
+	0xad, 0xed, 0xad, 0xbd, 0x4a, 0x76, 0x37, 0x78, 0x37, 0x56, 0xf2, 0x17, 0x1c, 0x39, 0xf2, 0x11,
+	0x7c, 0x44, 0xc5, 0xa9, 0xe2, 0x84, 0x7a, 0x88, 0x50, 0xf2, 0x07, 0x7c, 0x01, 0xf2, 0xda, 0x4e,
+	0x01, 0x21, 0x81, 0x38, 0x71, 0xf3, 0xce, 0x7b, 0xfb, 0x66, 0xe7, 0xcd, 0x8c, 0x41, 0xd3, 0x43,
+	0xde, 0x74, 0xc8, 0x99, 0xed, 0x49, 0x5f, 0x48, 0x34, 0x20, 0x2c, 0xb4, 0x93, 0xb6, 0x3d, 0x42,
+	0x31, 0xa2, 0xc2, 0x1a, 0xc5, 0x5c, 0x72, 0x7d, 0x27, 0xe7, 0x58, 0x17, 0x1c, 0x2b, 0x69, 0xd7,
+	0xae, 0x87, 0x3c, 0xe4, 0x8a, 0x61, 0xa7, 0x5f, 0x19, 0xb9, 0x76, 0xd3, 0xe7, 0x82, 0x72, 0x01,
+	0x33, 0x20, 0x3b, 0x64, 0x50, 0xf3, 0x5b, 0x19, 0x54, 0x7a, 0x4a, 0x58, 0x7f, 0x0d, 0xaa, 0x3e,
+	0x4f, 0x30, 0x43, 0x4c, 0xc2, 0xd1, 0x40, 0x18, 0x5a, 0x63, 0xa5, 0x55, 0x75, 0x1e, 0x9e, 0xcf,
+	0xea, 0x9d, 0x90, 0xc8, 0x68, 0xec, 0x59, 0x3e, 0xa7, 0x76, 0x9e, 0xd7, 0x8f, 0x10, 0x61, 0xc5,
+	0xc1, 0x96, 0xd3, 0x11, 0x16, 0x96, 0xf3, 0xac, 0xb7, 0xb7, 0xff, 0xa0, 0x37, 0xf6, 0x9e, 0xe3,
+	0xa9, 0xbb, 0x5e, 0x68, 0xf5, 0x06, 0x42, 0xbf, 0x03, 0x36, 0x97, 0xd2, 0x6f, 0xc7, 0x3c, 0x1e,
+	0x53, 0xe3, 0x52, 0x43, 0x6b, 0x6d, 0xb8, 0x57, 0x8b, 0xf0, 0x0b, 0x15, 0xd5, 0xef, 0x82, 0x2d,
+	0x31, 0x44, 0x22, 0x22, 0x2c, 0x84, 0x28, 0x08, 0x62, 0x2c, 0x84, 0xb1, 0xd2, 0xd0, 0x5a, 0x6b,
+	0xee, 0x66, 0x11, 0xef, 0x66, 0x61, 0x7d, 0x1f, 0xdc, 0xa0, 0x84, 0xc1, 0x25, 0x5d, 0x4e, 0xe0,
+	0x09, 0xc6, 0x50, 0x20, 0x69, 0x94, 0x1b, 0x5a, 0x6b, 0xc5, 0xdd, 0xa6, 0x84, 0xf5, 0x73, 0xf4,
+	0x78, 0x72, 0x88, 0x71, 0x1f, 0x49, 0xbd, 0x0f, 0xd2, 0x30, 0xf4, 0x39, 0xa5, 0x44, 0x08, 0xc2,
+	0x19, 0x8c, 0x91, 0xc4, 0xc6, 0xe5, 0x34, 0x87, 0x73, 0xfb, 0x74, 0x56, 0x2f, 0x9d, 0xcf, 0xea,
+	0xb7, 0x32, 0x8b, 0x44, 0x30, 0xb0, 0x08, 0xb7, 0x29, 0x92, 0x91, 0x75, 0x84, 0x43, 0xe4, 0x4f,
+	0x9f, 0x60, 0xdf, 0xbd, 0x46, 0x09, 0x3b, 0x58, 0x5e, 0x77, 0x91, 0xc4, 0xfa, 0x2b, 0xb0, 0xb1,
+	0x7c, 0x86, 0x92, 0xab, 0x28, 0xb9, 0xf6, 0x5f, 0xc8, 0x7d, 0xfe, 0x78, 0x1f, 0xe4, 0x0d, 0x49,
+	0xc5, 0xab, 0x85, 0x8e, 0xd2, 0xed, 0x82, 0x5d, 0x8a, 0x26, 0x10, 0xf9, 0x92, 0x24, 0x18, 0x9e,
+	0x10, 0x86, 0x86, 0x44, 0x4e, 0xd3, 0x36, 0x26, 0x24, 0xc0, 0xb1, 0x30, 0x56, 0x95, 0x89, 0x35,
+	0x8a, 0x26, 0x5d, 0xc5, 0x39, 0xcc, 0x29, 0xbd, 0x82, 0xa1, 0xdf, 0x03, 0x7a, 0x5a, 0xef, 0x98,
+	0x79, 0x9c, 0x05, 0xca, 0x26, 0x42, 0xb1, 0x71, 0x45, 0xdd, 0xdb, 0xa2, 0x84, 0xbd, 0x2c, 0x80,
+	0x63, 0x42, 0xb1, 0x0e, 0x7f, 0x65, 0xab, 0x6a, 0xd6, 0xfe, 0xb5, 0x9a, 0x9f, 0x12, 0xa4, 0x15,
+	0x3d, 0x2a, 0xbf, 0xff, 0x50, 0x2f, 0x35, 0x3f, 0x69, 0x60, 0xeb, 0x20, 0x6f, 0xbc, 0xcb, 0x25,
+	0x92, 0x84, 0xb3, 0xff, 0x62, 0xfc, 0x3a, 0x60, 0x47, 0x99, 0xad, 0x5e, 0x04, 0x3d, 0xe9, 0xc3,
+	0x08, 0x93, 0x30, 0x92, 0x6a, 0x06, 0xcb, 0xee, 0xf6, 0x05, 0xe8, 0x48, 0xff, 0xa9, 0x82, 0x9a,
+	0x18, 0x54, 0xfb, 0x92, 0xc7, 0x38, 0xc8, 0xd7, 0xc8, 0x00, 0xab, 0x09, 0x8e, 0xd3, 0xd9, 0x30,
+	0x34, 0x95, 0xa4, 0x38, 0xea, 0x8f, 0x41, 0x25, 0xdb, 0x61, 0x95, 0x7d, 0xbd, 0xb3, 0x6b, 0xfd,
+	0x76, 0x89, 0xad, 0x4c, 0xc8, 0x29, 0xa7, 0x86, 0xbb, 0xf9, 0x15, 0xe7, 0xe8, 0x74, 0x6e, 0x6a,
+	0x67, 0x73, 0x53, 0xfb, 0x3a, 0x37, 0xb5, 0x77, 0x0b, 0xb3, 0x74, 0xb6, 0x30, 0x4b, 0x5f, 0x16,
+	0x66, 0xe9, 0xcd, 0x1f, 0xed, 0x99, 0xfc, 0xf8, 0x23, 0x51, 0x5e, 0x79, 0x15, 0xb5, 0xfd, 0x7b,
+	0xdf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x96, 0xad, 0x56, 0xfa, 0x6b, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -323,6 +388,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CovenantRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationBtcHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationBtcHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CovenantPks) > 0 {
+		for iNdEx := len(m.CovenantPks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CovenantPks[iNdEx].Size()
+				i -= size
+				if _, err := m.CovenantPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StoredParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -409,6 +521,27 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *CovenantRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CovenantPks) > 0 {
+		for _, e := range m.CovenantPks {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovParams(uint64(m.CovenantQuorum))
+	}
+	if m.ActivationBtcHeight != 0 {
+		n += 1 + sovParams(uint64(m.ActivationBtcHeight))
+	}
+	return n
+}
+
 func (m *StoredParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -724,6 +857,129 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CovenantRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.CovenantPks = append(m.CovenantPks, v)
+			if err := m.CovenantPks[len(m.CovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationBtcHeight", wireType)
+			}
+			m.ActivationBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *StoredParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
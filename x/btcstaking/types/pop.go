@@ -9,6 +9,7 @@ import (
 	"github.com/babylonchain/babylon/crypto/ecdsa"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -67,6 +68,34 @@ func NewPoPBTCWithECDSABTCSig(addr sdk.AccAddress, btcSK *btcec.PrivateKey) (*Pr
 	return &pop, nil
 }
 
+// NewPoPBTCWithECDSAAddressSig generates a new proof of possession where Bitcoin signature is in ECDSA format
+// and is verified against the given BTC address of the secret key
+// a proof of possession contains one signature together with the BTC address:
+// - pop.BtcSig = (btcAddress, ecdsa_sign(sk_BTC, addr))
+func NewPoPBTCWithECDSAAddressSig(addr sdk.AccAddress, btcSK *btcec.PrivateKey, btcAddress btcutil.Address) (*ProofOfPossessionBTC, error) {
+	// NOTE: ecdsa.SignWithAddress has to take the message as string.
+	// So we have to hex addr before signing
+	addrHex := hex.EncodeToString(addr.Bytes())
+	btcSig, err := ecdsa.SignWithAddress(btcSK, addrHex, btcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ecdsaAddressSig := ECDSAAddressSig{
+		Address: btcAddress.EncodeAddress(),
+		Sig:     btcSig,
+	}
+	ecdsaAddressSigEncoded, err := ecdsaAddressSig.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProofOfPossessionBTC{
+		BtcSigType: BTCSigType_ECDSA_ADDRESS,
+		BtcSig:     ecdsaAddressSigEncoded,
+	}, nil
+}
+
 func newPoPBTCWithBIP322Sig[A btcutil.Address](
 	addressToSign sdk.AccAddress,
 	btcSK *btcec.PrivateKey,
@@ -145,10 +174,12 @@ func (pop *ProofOfPossessionBTC) Verify(staker sdk.AccAddress, bip340PK *bbn.BIP
 	switch pop.BtcSigType {
 	case BTCSigType_BIP340:
 		return pop.VerifyBIP340(staker, bip340PK)
-	case BTCSigType_BIP322:
+	case BTCSigType_BIP322, BTCSigType_BIP322_FULL:
 		return pop.VerifyBIP322(staker, bip340PK, net)
 	case BTCSigType_ECDSA:
 		return pop.VerifyECDSA(staker, bip340PK)
+	case BTCSigType_ECDSA_ADDRESS:
+		return pop.VerifyECDSAAddress(staker, bip340PK, net)
 	default:
 		return fmt.Errorf("invalid BTC signature type")
 	}
@@ -197,16 +228,184 @@ func NewPoPBTCWithBIP322P2TRBIP86Sig(
 	return newPoPBTCWithBIP322Sig(addrToSign, btcSK, net, bip322.SignWithP2TrSpendAddress)
 }
 
-// isSupportedAddressAndWitness checks whether provided address and witness are
-// valid for proof of possession verification.
+// NewPoPBTCWithBIP322FullP2PKHSig creates a proof of possession of type
+// BIP322_FULL that signs the address with the BTC secret key via its P2PKH
+// address
+func NewPoPBTCWithBIP322FullP2PKHSig(
+	addrToSign sdk.AccAddress,
+	btcSK *btcec.PrivateKey,
+	net *chaincfg.Params,
+) (*ProofOfPossessionBTC, error) {
+	return newPoPBTCWithBIP322FullSig(addrToSign, btcSK, net, bip322.SignFullWithP2PKHAddress)
+}
+
+// NewPoPBTCWithBIP322FullP2SHP2WPKHSig creates a proof of possession of type
+// BIP322_FULL that signs the address with the BTC secret key via its
+// P2SH-P2WPKH address
+func NewPoPBTCWithBIP322FullP2SHP2WPKHSig(
+	addrToSign sdk.AccAddress,
+	btcSK *btcec.PrivateKey,
+	net *chaincfg.Params,
+) (*ProofOfPossessionBTC, error) {
+	return newPoPBTCWithBIP322FullSig(addrToSign, btcSK, net, bip322.SignFullWithP2SHP2WPKHAddress)
+}
+
+func newPoPBTCWithBIP322FullSig[A btcutil.Address](
+	addressToSign sdk.AccAddress,
+	btcSK *btcec.PrivateKey,
+	net *chaincfg.Params,
+	bip322SignFn bip322Sign[A],
+) (*ProofOfPossessionBTC, error) {
+	pop, err := newPoPBTCWithBIP322Sig(addressToSign, btcSK, net, bip322SignFn)
+	if err != nil {
+		return nil, err
+	}
+	pop.BtcSigType = BTCSigType_BIP322_FULL
+	return pop, nil
+}
+
+// NewPoPBTCWithBIP322P2WSHMultisigSig creates a proof of possession of type
+// BIP322 that signs the address with the P2WSH address of the multisig script
+// requiring nRequired signatures of the BTC public keys. The BTC secret keys
+// have to be the keys of nRequired of the public keys. The proof of
+// possession is valid for each of the BTC public keys of the secret keys.
+func NewPoPBTCWithBIP322P2WSHMultisigSig(
+	addrToSign sdk.AccAddress,
+	btcSKs []*btcec.PrivateKey,
+	btcPKs []*btcec.PublicKey,
+	nRequired int,
+	net *chaincfg.Params,
+) (*ProofOfPossessionBTC, error) {
+	address, witnessSignature, err := bip322.SignWithP2WSHMultisigAddress(
+		tmhash.Sum(addrToSign.Bytes()), btcSKs, btcPKs, nRequired, net,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bip322Sig := BIP322Sig{
+		Address: address.EncodeAddress(),
+		Sig:     witnessSignature,
+	}
+	bip322SigEncoded, err := bip322Sig.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProofOfPossessionBTC{
+		BtcSigType: BTCSigType_BIP322,
+		BtcSig:     bip322SigEncoded,
+	}, nil
+}
+
+// keyMatchesWitnessPubKey returns a function checking whether the staker key
+// is the given public key in a witness or signature script
+func keyMatchesWitnessPubKey(pubKeyBytes []byte) checkStakerKey {
+	return func(stakerKey *bbn.BIP340PubKey) error {
+		keyFromWitness, err := btcec.ParsePubKey(pubKeyBytes)
+
+		if err != nil {
+			return err
+		}
+
+		keyFromWitnessBytess := schnorr.SerializePubKey(keyFromWitness)
+
+		stakerKeyEncoded, err := stakerKey.Marshal()
+
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(keyFromWitnessBytess, stakerKeyEncoded) {
+			return fmt.Errorf("bip322Sig.Address does not correspond to bip340PK")
+		}
+
+		return nil
+	}
+}
+
+// keySignedMultisig returns a function checking whether the staker key is
+// one of the public keys of the multisig witness script, and whether one of
+// the signatures in the witness is by the staker key. Note that it is not
+// sufficient for the staker key to be one of the public keys, as the other
+// signers of the multisig might have produced the signature without it.
+func keySignedMultisig(
+	witnessScript []byte,
+	sigs [][]byte,
+	toSpend *wire.MsgTx,
+	toSign *wire.MsgTx,
+	net *chaincfg.Params,
+) checkStakerKey {
+	return func(stakerKey *bbn.BIP340PubKey) error {
+		stakerKeyEncoded, err := stakerKey.Marshal()
+		if err != nil {
+			return err
+		}
+
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(witnessScript, net)
+		if err != nil {
+			return err
+		}
+		stakerPKs := make([]*btcec.PublicKey, 0, 1)
+		for _, addr := range addrs {
+			pkAddr, ok := addr.(*btcutil.AddressPubKey)
+			if !ok {
+				continue
+			}
+			if bytes.Equal(schnorr.SerializePubKey(pkAddr.PubKey()), stakerKeyEncoded) {
+				stakerPKs = append(stakerPKs, pkAddr.PubKey())
+			}
+		}
+		if len(stakerPKs) == 0 {
+			return fmt.Errorf("bip322Sig.Address does not correspond to bip340PK")
+		}
+
+		fetcher := txscript.NewCannedPrevOutputFetcher(toSpend.TxOut[0].PkScript, toSpend.TxOut[0].Value)
+		sigHashes := txscript.NewTxSigHashes(toSign, fetcher)
+		for _, sig := range sigs {
+			if len(sig) == 0 {
+				continue
+			}
+			// the last byte of the signature is the sighash type
+			hashType := txscript.SigHashType(sig[len(sig)-1])
+			sigHash, err := txscript.CalcWitnessSigHash(
+				witnessScript, sigHashes, hashType, toSign, 0, toSpend.TxOut[0].Value,
+			)
+			if err != nil {
+				return err
+			}
+			parsedSig, err := btcecdsa.ParseDERSignature(sig[:len(sig)-1])
+			if err != nil {
+				return err
+			}
+			for _, pk := range stakerPKs {
+				if parsedSig.Verify(sigHash, pk) {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("bip322Sig.Address does not correspond to bip340PK: the multisig is not signed by bip340PK")
+	}
+}
+
+// isSupportedAddressAndInput checks whether provided address and the input
+// of the toSign transaction, i.e., its signature script and witness, are valid
+// for proof of possession verification.
 // Currently the only supported options are:
-// 1. p2wpkh address which should only 2 elements in witness: signature and public key
-// 2. p2tr address which should only 1 element in witness: signature i.e p2tr key spend
+// 1. p2tr address which should only 1 element in witness: signature i.e p2tr key spend
+// 2. p2wpkh address which should only 2 elements in witness: signature and public key
+// 3. p2pkh address which should only 2 elements in signature script: signature and public key
+// 4. p2sh-p2wpkh address which should only the p2wpkh redeem script in signature script
+// and 2 elements in witness: signature and public key
+// 5. p2wsh address whose witness script is a multisig script, which should only
+// the signatures and the witness script in witness
 // If validation succeeds, it returns a function which can be used to check whether
 // bip340PK corresponds to verified address.
-func isSupportedAddressAndWitness(
+func isSupportedAddressAndInput(
 	address btcutil.Address,
-	witness wire.TxWitness,
+	toSpend *wire.MsgTx,
+	toSign *wire.MsgTx,
 	net *chaincfg.Params) (checkStakerKey, error) {
 	script, err := txscript.PayToAddrScript(address)
 
@@ -214,8 +413,11 @@ func isSupportedAddressAndWitness(
 		return nil, err
 	}
 
+	sigScript := toSign.TxIn[0].SignatureScript
+	witness := toSign.TxIn[0].Witness
+
 	// pay to taproot key spending path have only signature in witness
-	if txscript.IsPayToTaproot(script) && len(witness) == 1 {
+	if txscript.IsPayToTaproot(script) && len(sigScript) == 0 && len(witness) == 1 {
 		return func(stakerKey *bbn.BIP340PubKey) error {
 			btcKey, err := stakerKey.ToBTCPK()
 
@@ -238,39 +440,98 @@ func isSupportedAddressAndWitness(
 	}
 
 	// pay to witness key hash have signature and public key in witness
-	if txscript.IsPayToWitnessPubKeyHash(script) && len(witness) == 2 {
-		return func(stakerKey *bbn.BIP340PubKey) error {
-			keyFromWitness, err := btcec.ParsePubKey(witness[1])
+	if txscript.IsPayToWitnessPubKeyHash(script) && len(sigScript) == 0 && len(witness) == 2 {
+		return keyMatchesWitnessPubKey(witness[1]), nil
+	}
 
-			if err != nil {
-				return err
+	// pay to public key hash have signature and public key in signature script
+	if txscript.IsPayToPubKeyHash(script) && len(witness) == 0 && txscript.IsPushOnlyScript(sigScript) {
+		pushes, err := txscript.PushedData(sigScript)
+		if err == nil && len(pushes) == 2 {
+			return keyMatchesWitnessPubKey(pushes[1]), nil
+		}
+	}
+
+	// pay to script hash nesting pay to witness key hash have the redeem
+	// script in signature script, and signature and public key in witness
+	if txscript.IsPayToScriptHash(script) && len(witness) == 2 && txscript.IsPushOnlyScript(sigScript) {
+		pushes, err := txscript.PushedData(sigScript)
+		if err == nil && len(pushes) == 1 && txscript.IsPayToWitnessPubKeyHash(pushes[0]) {
+			return keyMatchesWitnessPubKey(witness[1]), nil
+		}
+	}
+
+	// pay to witness script hash of a multisig script have an empty element,
+	// the signatures and the multisig script in witness
+	if txscript.IsPayToWitnessScriptHash(script) && len(sigScript) == 0 && len(witness) >= 3 {
+		witnessScript := witness[len(witness)-1]
+		if isMultisig, err := txscript.IsMultisigScript(witnessScript); err == nil && isMultisig {
+			_, nRequired, err := txscript.CalcMultiSigStats(witnessScript)
+			if err == nil && len(witness) == nRequired+2 && len(witness[0]) == 0 {
+				return keySignedMultisig(witnessScript, witness[1:len(witness)-1], toSpend, toSign, net), nil
 			}
+		}
+	}
 
-			keyFromWitnessBytess := schnorr.SerializePubKey(keyFromWitness)
+	return nil, fmt.Errorf("unsupported bip322 address type. Only supported options are p2pkh, p2sh-p2wpkh, p2wpkh, p2wsh multisig and p2tr bip86 key spending path")
+}
 
-			stakerKeyEncoded, err := stakerKey.Marshal()
+// verifyBIP322ToSignPop verifies that the toSign transaction is a bip322
+// signature over `msg` by `btcAddress`, and that `btcAddress` corresponds to
+// `pubKeyNoCoord`. The toSign transaction is verified by `verifyFn`, depending
+// on the format of the signature.
+func verifyBIP322ToSignPop(
+	msg []byte,
+	btcAddress btcutil.Address,
+	toSign *wire.MsgTx,
+	pubKeyNoCoord []byte,
+	net *chaincfg.Params,
+	verifyFn func() error,
+) error {
+	if len(toSign.TxIn) == 0 {
+		return fmt.Errorf("bip322 toSign transaction has no input")
+	}
 
-			if err != nil {
-				return err
-			}
+	toSpend, err := bip322.GetToSpendTx(msg, btcAddress)
+	if err != nil {
+		return err
+	}
 
-			if !bytes.Equal(keyFromWitnessBytess, stakerKeyEncoded) {
-				return fmt.Errorf("bip322Sig.Address does not correspond to bip340PK")
-			}
+	// we check whether address and input are valid for proof of possession verification
+	// before verifying bip322 signature. This is require to avoid cases in which
+	// we receive some long running btc script to execute (like taproot script with 100 signatures)
+	// for proof of possession, we only support the standard address types listed in
+	// isSupportedAddressAndInput, for which we are able to link bip340PK public key
+	// to the btc address used in bip322 signature verification.
+	stakerKeyMatchesBtcAddressFn, err := isSupportedAddressAndInput(btcAddress, toSpend, toSign, net)
+	if err != nil {
+		return err
+	}
 
-			return nil
+	if err := verifyFn(); err != nil {
+		return err
+	}
 
-		}, nil
+	key, err := bbn.NewBIP340PubKey(pubKeyNoCoord)
+	if err != nil {
+		return err
+	}
+
+	// rule 3: verify bip322Sig.Address corresponds to bip340PK
+	if err := stakerKeyMatchesBtcAddressFn(key); err != nil {
+		return err
 	}
 
-	return nil, fmt.Errorf("unsupported bip322 address type. Only supported options are p2wpkh and p2tr bip86 key spending path")
+	return nil
 }
 
-// VerifyBIP322SigPop verifies bip322 `signature` over `msg` and also checks whether
-// `address` corresponds to `pubKeyNoCoord` in the given network.
-// It supports only two type of addresses:
+// VerifyBIP322SigPop verifies bip322 `signature` in the simple format over
+// `msg` and also checks whether `address` corresponds to `pubKeyNoCoord` in
+// the given network.
+// It supports only the following types of addresses:
 // 1. p2wpkh address
-// 2. p2tr address which is defined in bip88
+// 2. p2tr address which is defined in bip86
+// 3. p2wsh address of a multisig script
 // Parameters:
 // - msg: message which was signed
 // - address: address which was used to sign the message
@@ -297,42 +558,71 @@ func VerifyBIP322SigPop(
 		return err
 	}
 
-	// we check whether address and witness are valid for proof of possession verification
-	// before verifying bip322 signature. This is require to avoid cases in which
-	// we receive some long running btc script to execute (like taproot script with 100 signatures)
-	// for proof of possession, we only support two types of cases:
-	// 1. address is p2wpkh address
-	// 2. address is p2tr address and we are dealing with bip86 (https://github.com/bitcoin/bips/blob/master/bip-0086.mediawiki)
-	// key spending path.
-	// In those two cases we are able to link bip340PK public key to the btc address
-	// used in bip322 signature verification.
-	stakerKeyMatchesBtcAddressFn, err := isSupportedAddressAndWitness(btcAddress, witness, net)
+	// the simple format only consists of the witness of the toSign transaction
+	toSpend, err := bip322.GetToSpendTx(msg, btcAddress)
 	if err != nil {
 		return err
 	}
+	toSign := bip322.GetToSignTx(toSpend)
+	toSign.TxIn[0].Witness = witness
 
-	if err := bip322.Verify(msg, witness, btcAddress, net); err != nil {
-		return err
+	return verifyBIP322ToSignPop(msg, btcAddress, toSign, pubKeyNoCoord, net, func() error {
+		return bip322.Verify(msg, witness, btcAddress, net)
+	})
+}
+
+// VerifyBIP322FullSigPop verifies bip322 `signature` in the full format over
+// `msg` and also checks whether `address` corresponds to `pubKeyNoCoord` in
+// the given network.
+// It supports only the following types of addresses:
+// 1. p2pkh address
+// 2. p2sh-p2wpkh address
+// 3. p2wpkh address
+// 4. p2tr address which is defined in bip86
+// 5. p2wsh address of a multisig script
+// Parameters:
+// - msg: message which was signed
+// - address: address which was used to sign the message
+// - signature: bip322 signature over the message, i.e., the serialized toSign transaction
+// - pubKeyNoCoord: public key in 32 bytes format which was used to derive address
+func VerifyBIP322FullSigPop(
+	msg []byte,
+	address string,
+	signature []byte,
+	pubKeyNoCoord []byte,
+	net *chaincfg.Params,
+) error {
+	if len(msg) == 0 || len(address) == 0 || len(signature) == 0 || len(pubKeyNoCoord) == 0 {
+		return fmt.Errorf("cannot verfiy bip322 signature. One of the required parameters is empty")
 	}
 
-	key, err := bbn.NewBIP340PubKey(pubKeyNoCoord)
+	toSign, err := bip322.DecodeFullSig(signature)
 	if err != nil {
 		return err
 	}
 
-	// rule 3: verify bip322Sig.Address corresponds to bip340PK
-	if err := stakerKeyMatchesBtcAddressFn(key); err != nil {
+	btcAddress, err := btcutil.DecodeAddress(address, net)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	return verifyBIP322ToSignPop(msg, btcAddress, toSign, pubKeyNoCoord, net, func() error {
+		return bip322.VerifyFull(msg, toSign, btcAddress, net)
+	})
 }
 
 // VerifyBIP322 verifies the validity of PoP where Bitcoin signature is in BIP-322
 // after decoding pop.BtcSig to bip322Sig which contains sig and address,
-// verify whether bip322 pop signature where msg=signedMsg
+// verify whether bip322 pop signature where msg=signedMsg. The signature is
+// in the simple format for BIP322, and in the full format for BIP322_FULL.
 func VerifyBIP322(sigType BTCSigType, btcSigRaw []byte, bip340PK *bbn.BIP340PubKey, signedMsg []byte, net *chaincfg.Params) error {
-	if sigType != BTCSigType_BIP322 {
+	var verifySigPop func(msg []byte, address string, signature []byte, pubKeyNoCoord []byte, net *chaincfg.Params) error
+	switch sigType {
+	case BTCSigType_BIP322:
+		verifySigPop = VerifyBIP322SigPop
+	case BTCSigType_BIP322_FULL:
+		verifySigPop = VerifyBIP322FullSigPop
+	default:
 		return fmt.Errorf("the Bitcoin signature in this proof of possession is not using BIP-322 encoding")
 	}
 	// unmarshal pop.BtcSig to bip322Sig
@@ -347,7 +637,7 @@ func VerifyBIP322(sigType BTCSigType, btcSigRaw []byte, bip340PK *bbn.BIP340PubK
 	}
 
 	// Verify Bip322 proof of possession signature
-	if err := VerifyBIP322SigPop(
+	if err := verifySigPop(
 		signedMsg,
 		bip322Sig.Address,
 		bip322Sig.Sig,
@@ -400,6 +690,53 @@ func (pop *ProofOfPossessionBTC) VerifyECDSA(addr sdk.AccAddress, bip340PK *bbn.
 	return VerifyECDSA(pop.BtcSigType, pop.BtcSig, bip340PK, addr.Bytes())
 }
 
+// VerifyECDSAAddress verifies the validity of PoP where Bitcoin signature is in ECDSA encoding
+// after decoding pop.BtcSig to ecdsaAddressSig which contains sig and address,
+// 1. verify(sig=sig_btc, address=btc_address, msg=msg)?
+// 2. verify the public key recovered from sig_btc is bip340PK
+func VerifyECDSAAddress(sigType BTCSigType, btcSigRaw []byte, bip340PK *bbn.BIP340PubKey, msg []byte, net *chaincfg.Params) error {
+	if sigType != BTCSigType_ECDSA_ADDRESS {
+		return fmt.Errorf("the Bitcoin signature in this proof of possession is not using ECDSA encoding with address")
+	}
+
+	var ecdsaAddressSig ECDSAAddressSig
+	if err := ecdsaAddressSig.Unmarshal(btcSigRaw); err != nil {
+		return err
+	}
+	btcAddress, err := btcutil.DecodeAddress(ecdsaAddressSig.Address, net)
+	if err != nil {
+		return err
+	}
+
+	// rule 1: verify(sig=sig_btc, address=btc_address, msg=msg)?
+	// NOTE: ecdsa.RecoverWithAddress has to take message as a string
+	// So we have to hex msg before verifying the signature
+	msgHex := hex.EncodeToString(msg)
+	recoveredPK, err := ecdsa.RecoverWithAddress(btcAddress, msgHex, ecdsaAddressSig.Sig)
+	if err != nil {
+		return fmt.Errorf("failed to verify btcSigRaw: %w", err)
+	}
+
+	// rule 2: verify the public key recovered from sig_btc is bip340PK
+	stakerKeyEncoded, err := bip340PK.Marshal()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(schnorr.SerializePubKey(recoveredPK), stakerKeyEncoded) {
+		return fmt.Errorf("ecdsaAddressSig.Address does not correspond to bip340PK")
+	}
+
+	return nil
+}
+
+// VerifyECDSAAddress verifies the validity of PoP where Bitcoin signature is in ECDSA encoding
+// and is verified against the BTC address in the PoP
+// 1. verify(sig=sig_btc, address=btc_address, msg=addr)?
+// 2. verify the public key recovered from sig_btc is bip340PK
+func (pop *ProofOfPossessionBTC) VerifyECDSAAddress(addr sdk.AccAddress, bip340PK *bbn.BIP340PubKey, net *chaincfg.Params) error {
+	return VerifyECDSAAddress(pop.BtcSigType, pop.BtcSig, bip340PK, addr.Bytes(), net)
+}
+
 // ValidateBasic checks if there is a BTC Signature.
 func (pop *ProofOfPossessionBTC) ValidateBasic() error {
 	if pop.BtcSig == nil {
@@ -419,11 +756,32 @@ func (pop *ProofOfPossessionBTC) ValidateBasic() error {
 			return fmt.Errorf("invalid BTC BIP322 signature: %w", err)
 		}
 		return nil
+	case BTCSigType_BIP322_FULL:
+		var bip322Sig BIP322Sig
+		if err := bip322Sig.Unmarshal(pop.BtcSig); err != nil {
+			return fmt.Errorf("invalid BTC BIP322 signature: %w", err)
+		}
+		if _, err := bip322.DecodeFullSig(bip322Sig.Sig); err != nil {
+			return fmt.Errorf("invalid BTC BIP322 full signature: %w", err)
+		}
+		return nil
 	case BTCSigType_ECDSA:
 		if len(pop.BtcSig) != 65 { // size of compact signature
 			return fmt.Errorf("invalid BTC ECDSA signature size")
 		}
 		return nil
+	case BTCSigType_ECDSA_ADDRESS:
+		var ecdsaAddressSig ECDSAAddressSig
+		if err := ecdsaAddressSig.Unmarshal(pop.BtcSig); err != nil {
+			return fmt.Errorf("invalid BTC ECDSA signature with address: %w", err)
+		}
+		if len(ecdsaAddressSig.Address) == 0 {
+			return fmt.Errorf("empty BTC address of ECDSA signature")
+		}
+		if len(ecdsaAddressSig.Sig) != 65 { // size of compact signature
+			return fmt.Errorf("invalid BTC ECDSA signature size")
+		}
+		return nil
 	default:
 		return fmt.Errorf("invalid BTC signature type")
 	}
@@ -28,23 +28,37 @@ type BTCSigType int32
 const (
 	// BIP340 means the btc_sig will follow the BIP-340 encoding
 	BTCSigType_BIP340 BTCSigType = 0
-	// BIP322 means the btc_sig will follow the BIP-322 encoding
+	// BIP322 means the btc_sig will follow the BIP-322 encoding, where the
+	// signature is in the simple format, i.e., a witness stack
 	BTCSigType_BIP322 BTCSigType = 1
 	// ECDSA means the btc_sig will follow the ECDSA encoding
 	// ref: https://github.com/okx/js-wallet-sdk/blob/a57c2acbe6ce917c0aa4e951d96c4e562ad58444/packages/coin-bitcoin/src/BtcWallet.ts#L331
 	BTCSigType_ECDSA BTCSigType = 2
+	// BIP322_FULL means the btc_sig will follow the BIP-322 encoding, where the
+	// signature is in the full format, i.e., the serialized to_sign transaction
+	// ref: https://github.com/bitcoin/bips/blob/master/bip-0322.mediawiki#full
+	BTCSigType_BIP322_FULL BTCSigType = 3
+	// ECDSA_ADDRESS means the btc_sig will follow the ECDSA encoding, verified
+	// against the signer's address with any of the header bytes used by
+	// Bitcoin Core, Electrum and Trezor
+	// ref: https://github.com/bitcoin/bips/blob/master/bip-0137.mediawiki
+	BTCSigType_ECDSA_ADDRESS BTCSigType = 4
 )
 
 var BTCSigType_name = map[int32]string{
 	0: "BIP340",
 	1: "BIP322",
 	2: "ECDSA",
+	3: "BIP322_FULL",
+	4: "ECDSA_ADDRESS",
 }
 
 var BTCSigType_value = map[string]int32{
-	"BIP340": 0,
-	"BIP322": 1,
-	"ECDSA":  2,
+	"BIP340":        0,
+	"BIP322":        1,
+	"ECDSA":         2,
+	"BIP322_FULL":   3,
+	"ECDSA_ADDRESS": 4,
 }
 
 func (x BTCSigType) String() string {
@@ -118,7 +132,8 @@ func (m *ProofOfPossessionBTC) GetBtcSig() []byte {
 type BIP322Sig struct {
 	// address is the signer's address
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// sig is the actual signature in BIP-322 format
+	// sig is the actual signature in BIP-322 format, either in the simple format
+	// or in the full format depending on the btc_sig_type of the pop
 	Sig []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
 }
 
@@ -169,34 +184,96 @@ func (m *BIP322Sig) GetSig() []byte {
 	return nil
 }
 
+// ECDSAAddressSig is an ECDSA signature together with the address
+// corresponding to the signer
+type ECDSAAddressSig struct {
+	// address is the signer's address, which is a p2pkh, p2sh-p2wpkh or p2wpkh
+	// address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sig is the actual signature in the compact ECDSA format, whose header
+	// byte indicates the type of the address
+	Sig []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (m *ECDSAAddressSig) Reset()         { *m = ECDSAAddressSig{} }
+func (m *ECDSAAddressSig) String() string { return proto.CompactTextString(m) }
+func (*ECDSAAddressSig) ProtoMessage()    {}
+func (*ECDSAAddressSig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d6ceb088d9e9f3a, []int{2}
+}
+func (m *ECDSAAddressSig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ECDSAAddressSig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ECDSAAddressSig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ECDSAAddressSig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ECDSAAddressSig.Merge(m, src)
+}
+func (m *ECDSAAddressSig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ECDSAAddressSig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ECDSAAddressSig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ECDSAAddressSig proto.InternalMessageInfo
+
+func (m *ECDSAAddressSig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ECDSAAddressSig) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("babylon.btcstaking.v1.BTCSigType", BTCSigType_name, BTCSigType_value)
 	proto.RegisterType((*ProofOfPossessionBTC)(nil), "babylon.btcstaking.v1.ProofOfPossessionBTC")
 	proto.RegisterType((*BIP322Sig)(nil), "babylon.btcstaking.v1.BIP322Sig")
+	proto.RegisterType((*ECDSAAddressSig)(nil), "babylon.btcstaking.v1.ECDSAAddressSig")
 }
 
 func init() { proto.RegisterFile("babylon/btcstaking/v1/pop.proto", fileDescriptor_9d6ceb088d9e9f3a) }
 
 var fileDescriptor_9d6ceb088d9e9f3a = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x1c, 0xc5, 0x9b, 0x89, 0x1b, 0xfb, 0x33, 0xa4, 0x04, 0xc5, 0x9d, 0xe2, 0xdc, 0x69, 0x78, 0x48,
-	0x5c, 0x26, 0x78, 0xb6, 0xd5, 0x83, 0x20, 0x38, 0xd6, 0x9e, 0xbc, 0x8c, 0xa6, 0xeb, 0xba, 0xa0,
-	0x36, 0xa5, 0x89, 0xc3, 0x7e, 0x0b, 0x3f, 0x96, 0xc7, 0x1d, 0x3d, 0x4a, 0xfb, 0x45, 0xa4, 0xb3,
-	0xa5, 0x1e, 0xbc, 0xbd, 0x47, 0x5e, 0xde, 0xe3, 0xff, 0x83, 0x33, 0x11, 0x88, 0xfc, 0x45, 0x25,
-	0x4c, 0x98, 0x50, 0x9b, 0xe0, 0x59, 0x26, 0x31, 0xdb, 0x4e, 0x59, 0xaa, 0x52, 0x9a, 0x66, 0xca,
-	0x28, 0x7c, 0x52, 0x07, 0x68, 0x1b, 0xa0, 0xdb, 0xe9, 0xd8, 0xc0, 0xf1, 0x3c, 0x53, 0x6a, 0xfd,
-	0xb8, 0x9e, 0x2b, 0xad, 0x23, 0xad, 0xa5, 0x4a, 0x1c, 0xdf, 0xc5, 0x2e, 0x0c, 0x84, 0x09, 0x97,
-	0x5a, 0xc6, 0x4b, 0x93, 0xa7, 0xd1, 0x10, 0x8d, 0xd0, 0xe4, 0x88, 0x9f, 0xd3, 0x7f, 0x5b, 0xa8,
-	0xe3, 0xbb, 0x9e, 0x8c, 0xfd, 0x3c, 0x8d, 0x16, 0x20, 0x4c, 0x58, 0x6b, 0x7c, 0x0a, 0xbd, 0xba,
-	0x64, 0xd8, 0x19, 0xa1, 0xc9, 0x60, 0xd1, 0xfd, 0x7d, 0x1c, 0x5f, 0x43, 0xdf, 0xb9, 0x9f, 0xcf,
-	0x38, 0xf7, 0x64, 0x8c, 0x87, 0xd0, 0x0b, 0x56, 0xab, 0x2c, 0xd2, 0x7a, 0xbf, 0xd2, 0x5f, 0x34,
-	0x16, 0xdb, 0x70, 0xd0, 0xfe, 0xad, 0xe4, 0x05, 0x03, 0x68, 0xb7, 0x30, 0x40, 0xb7, 0xaa, 0xb9,
-	0xba, 0xb4, 0xad, 0x46, 0x73, 0x6e, 0x23, 0xdc, 0x87, 0xc3, 0x3b, 0xf7, 0xd6, 0xbb, 0xb1, 0x3b,
-	0xce, 0xc3, 0x67, 0x41, 0xd0, 0xae, 0x20, 0xe8, 0xbb, 0x20, 0xe8, 0xa3, 0x24, 0xd6, 0xae, 0x24,
-	0xd6, 0x57, 0x49, 0xac, 0x27, 0x1e, 0x4b, 0xb3, 0x79, 0x13, 0x34, 0x54, 0xaf, 0xac, 0xbe, 0x2a,
-	0xdc, 0x04, 0x32, 0x69, 0x0c, 0x7b, 0xff, 0xcb, 0xb2, 0x82, 0xa0, 0x45, 0x77, 0xcf, 0x72, 0xf6,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x31, 0xf3, 0x97, 0x6e, 0x01, 0x00, 0x00,
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0xc1, 0x4e, 0xc2, 0x40,
+	0x10, 0xed, 0x82, 0x42, 0x18, 0x51, 0xea, 0x46, 0x23, 0xa7, 0x8a, 0x9c, 0x88, 0x87, 0x56, 0x16,
+	0x13, 0x4f, 0x1e, 0x68, 0xc1, 0xc4, 0x84, 0x44, 0xd2, 0xc5, 0x8b, 0x97, 0xa6, 0x5b, 0x4a, 0xd9,
+	0xa8, 0xdd, 0x86, 0x5d, 0x89, 0xfc, 0x85, 0x9f, 0xe5, 0x91, 0xa3, 0x47, 0x03, 0x3f, 0x62, 0x28,
+	0x25, 0xf5, 0xe0, 0xc5, 0xdb, 0x9b, 0x99, 0x37, 0x33, 0x2f, 0xef, 0xc1, 0x39, 0xf3, 0xd9, 0xe2,
+	0x45, 0xc4, 0x16, 0x53, 0x81, 0x54, 0xfe, 0x33, 0x8f, 0x23, 0x6b, 0xde, 0xb6, 0x12, 0x91, 0x98,
+	0xc9, 0x4c, 0x28, 0x81, 0x4f, 0x33, 0x82, 0x99, 0x13, 0xcc, 0x79, 0xbb, 0xa9, 0xe0, 0x64, 0x38,
+	0x13, 0x62, 0xf2, 0x30, 0x19, 0x0a, 0x29, 0x43, 0x29, 0xb9, 0x88, 0xed, 0x91, 0x83, 0x1d, 0xa8,
+	0x32, 0x15, 0x78, 0x92, 0x47, 0x9e, 0x5a, 0x24, 0x61, 0x1d, 0x35, 0x50, 0xeb, 0x88, 0x5c, 0x98,
+	0x7f, 0x5e, 0x31, 0xed, 0x91, 0x43, 0x79, 0x34, 0x5a, 0x24, 0xa1, 0x0b, 0x4c, 0x05, 0x19, 0xc6,
+	0x67, 0x50, 0xce, 0x8e, 0xd4, 0x0b, 0x0d, 0xd4, 0xaa, 0xba, 0xa5, 0xed, 0xb0, 0x79, 0x03, 0x15,
+	0xfb, 0x7e, 0xd8, 0x21, 0x84, 0xf2, 0x08, 0xd7, 0xa1, 0xec, 0x8f, 0xc7, 0xb3, 0x50, 0xca, 0xf4,
+	0x4b, 0xc5, 0xdd, 0x95, 0x58, 0x87, 0x62, 0xbe, 0xbb, 0x81, 0xcd, 0x5b, 0xa8, 0xf5, 0x9d, 0x1e,
+	0xed, 0x76, 0xb7, 0x8c, 0x7f, 0xae, 0x5f, 0x52, 0x80, 0x5c, 0x2a, 0x06, 0x28, 0x6d, 0x54, 0x5c,
+	0x5f, 0xe9, 0xda, 0x0e, 0x13, 0xa2, 0x23, 0x5c, 0x81, 0xfd, 0xf4, 0x89, 0x5e, 0xc0, 0x35, 0x38,
+	0xd8, 0xb6, 0xbd, 0xbb, 0xc7, 0xc1, 0x40, 0x2f, 0xe2, 0x63, 0x38, 0x4c, 0x67, 0x5e, 0xb7, 0xd7,
+	0x73, 0xfb, 0x94, 0xea, 0x7b, 0xf6, 0xe0, 0x73, 0x65, 0xa0, 0xe5, 0xca, 0x40, 0xdf, 0x2b, 0x03,
+	0x7d, 0xac, 0x0d, 0x6d, 0xb9, 0x36, 0xb4, 0xaf, 0xb5, 0xa1, 0x3d, 0x91, 0x88, 0xab, 0xe9, 0x1b,
+	0x33, 0x03, 0xf1, 0x6a, 0x65, 0xc6, 0x05, 0x53, 0x9f, 0xc7, 0xbb, 0xc2, 0x7a, 0xff, 0x1d, 0xd7,
+	0xc6, 0x67, 0xc9, 0x4a, 0x69, 0x5c, 0x9d, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x8a, 0x9d,
+	0x4f, 0xd1, 0x01, 0x00, 0x00,
 }
 
 func (m *ProofOfPossessionBTC) Marshal() (dAtA []byte, err error) {
@@ -271,6 +348,43 @@ func (m *BIP322Sig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ECDSAAddressSig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ECDSAAddressSig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ECDSAAddressSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintPop(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPop(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPop(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPop(v)
 	base := offset
@@ -315,6 +429,23 @@ func (m *BIP322Sig) Size() (n int) {
 	return n
 }
 
+func (m *ECDSAAddressSig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPop(uint64(l))
+	}
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovPop(uint64(l))
+	}
+	return n
+}
+
 func sovPop(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -540,6 +671,122 @@ func (m *BIP322Sig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ECDSAAddressSig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ECDSAAddressSig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ECDSAAddressSig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPop(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
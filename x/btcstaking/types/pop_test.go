@@ -7,10 +7,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/babylonchain/babylon/crypto/bip322"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
@@ -74,6 +77,56 @@ func FuzzPoP_ECDSA(f *testing.F) {
 	})
 }
 
+func FuzzPoP_ECDSAAddress(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// generate two BTC key pairs
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		bip340PK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+
+		_, btcPK1, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		bip340PK1 := bbn.NewBIP340PubKeyFromBTCPK(btcPK1)
+
+		accAddr := datagen.GenRandomAccount().GetAddress()
+
+		// generate the addresses of the BTC key
+		p2pkhAddr, err := bip322.PubKeyToP2PKHAddress(btcPK, net)
+		require.NoError(t, err)
+		p2shP2WPKHAddr, err := bip322.PubKeyToP2SHP2WPKHAddress(btcPK, net)
+		require.NoError(t, err)
+		p2wpkhAddr, err := bip322.PubkeyToP2WPKHAddress(btcPK, net)
+		require.NoError(t, err)
+
+		for _, btcAddr := range []btcutil.Address{p2pkhAddr, p2shP2WPKHAddr, p2wpkhAddr} {
+			// generate and verify PoP, correct case
+			pop, err := types.NewPoPBTCWithECDSAAddressSig(accAddr, btcSK, btcAddr)
+			require.NoError(t, err)
+			require.NoError(t, pop.ValidateBasic())
+			err = pop.Verify(accAddr, bip340PK, net)
+			require.NoError(t, err)
+
+			// verify PoP with incorrect staker key
+			err = pop.Verify(accAddr, bip340PK1, net)
+			require.Error(t, err)
+
+			// verify PoP with incorrect staker address
+			err = pop.Verify(datagen.GenRandomAccount().GetAddress(), bip340PK, net)
+			require.Error(t, err)
+		}
+
+		// the BTC address has to be derived from the BTC key
+		p2wpkhAddr1, err := bip322.PubkeyToP2WPKHAddress(btcPK1, net)
+		require.NoError(t, err)
+		_, err = types.NewPoPBTCWithECDSAAddressSig(accAddr, btcSK, p2wpkhAddr1)
+		require.Error(t, err)
+	})
+}
+
 func FuzzPoP_BIP322_P2WPKH(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -116,6 +169,82 @@ func FuzzPoP_BIP322_P2Tr_BIP86(f *testing.F) {
 	})
 }
 
+func FuzzPoP_BIP322Full(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// generate two BTC key pairs
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		bip340PK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+
+		_, btcPK1, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		bip340PK1 := bbn.NewBIP340PubKeyFromBTCPK(btcPK1)
+
+		accAddr := datagen.GenRandomAccount().GetAddress()
+
+		newPoPFns := []func(sdk.AccAddress, *btcec.PrivateKey, *chaincfg.Params) (*types.ProofOfPossessionBTC, error){
+			types.NewPoPBTCWithBIP322FullP2PKHSig,
+			types.NewPoPBTCWithBIP322FullP2SHP2WPKHSig,
+		}
+		for _, newPoPFn := range newPoPFns {
+			// generate and verify PoP, correct case
+			pop, err := newPoPFn(accAddr, btcSK, net)
+			require.NoError(t, err)
+			require.NoError(t, pop.ValidateBasic())
+			err = pop.Verify(accAddr, bip340PK, net)
+			require.NoError(t, err)
+
+			// verify PoP with incorrect staker key
+			err = pop.Verify(accAddr, bip340PK1, net)
+			require.Error(t, err)
+
+			// the full signature is not a simple signature
+			pop.BtcSigType = types.BTCSigType_BIP322
+			err = pop.Verify(accAddr, bip340PK, net)
+			require.Error(t, err)
+		}
+	})
+}
+
+func FuzzPoP_BIP322_P2WSHMultisig(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// generate a 2-of-3 multisig, where the first two keys sign
+		btcSKs := make([]*btcec.PrivateKey, 0, 3)
+		btcPKs := make([]*btcec.PublicKey, 0, 3)
+		for i := 0; i < 3; i++ {
+			btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcSKs = append(btcSKs, btcSK)
+			btcPKs = append(btcPKs, btcPK)
+		}
+
+		accAddr := datagen.GenRandomAccount().GetAddress()
+
+		pop, err := types.NewPoPBTCWithBIP322P2WSHMultisigSig(accAddr, btcSKs[:2], btcPKs, 2, net)
+		require.NoError(t, err)
+
+		// the PoP is valid for the keys that signed
+		for _, btcPK := range btcPKs[:2] {
+			err = pop.Verify(accAddr, bbn.NewBIP340PubKeyFromBTCPK(btcPK), net)
+			require.NoError(t, err)
+		}
+		// the PoP is not valid for the key of the multisig that did not sign
+		err = pop.Verify(accAddr, bbn.NewBIP340PubKeyFromBTCPK(btcPKs[2]), net)
+		require.Error(t, err)
+		// the PoP is not valid for another address
+		err = pop.Verify(datagen.GenRandomAccount().GetAddress(), bbn.NewBIP340PubKeyFromBTCPK(btcPKs[0]), net)
+		require.Error(t, err)
+	})
+}
+
 // TODO: Add more negative cases
 func FuzzPop_ValidBip322SigNotMatchingBip340PubKey(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
@@ -209,6 +338,14 @@ func TestPoPBTCValidateBasic(t *testing.T) {
 			},
 			fmt.Errorf("invalid BTC BIP322 signature: unexpected EOF"),
 		},
+		{
+			"invalid: BIP 322 full - bad sig",
+			&types.ProofOfPossessionBTC{
+				BtcSigType: types.BTCSigType_BIP322_FULL,
+				BtcSig:     popBip322.BtcSig,
+			},
+			fmt.Errorf("invalid BTC BIP322 full signature: unexpected EOF"),
+		},
 		{
 			"invalid: ECDSA - bad sig",
 			&types.ProofOfPossessionBTC{
@@ -2,6 +2,9 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
+
+	bbn "github.com/babylonchain/babylon/types"
 )
 
 // NewBTCDelegationResponse returns a new delegation response structure.
@@ -35,6 +38,102 @@ func NewBTCDelegationResponse(btcDel *BTCDelegation, status BTCDelegationStatus)
 	return resp
 }
 
+// ToBTCDelegation parses a BTCDelegationResponse back into a BTCDelegation,
+// e.g., for building its slashing txs off-chain. The PoP of the BTC
+// delegation is not part of the response thus is left empty.
+func (resp *BTCDelegationResponse) ToBTCDelegation() (*BTCDelegation, error) {
+	stakingTx, err := hex.DecodeString(resp.StakingTxHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid staking tx: %w", err)
+	}
+	btcDel := &BTCDelegation{
+		StakerAddr:       resp.StakerAddr,
+		BtcPk:            resp.BtcPk,
+		FpBtcPkList:      resp.FpBtcPkList,
+		StartHeight:      resp.StartHeight,
+		EndHeight:        resp.EndHeight,
+		TotalSat:         resp.TotalSat,
+		StakingTx:        stakingTx,
+		StakingOutputIdx: resp.StakingOutputIdx,
+		CovenantSigs:     resp.CovenantSigs,
+		UnbondingTime:    resp.UnbondingTime,
+		ParamsVersion:    resp.ParamsVersion,
+	}
+
+	if len(resp.SlashingTxHex) > 0 {
+		if btcDel.SlashingTx, err = NewBTCSlashingTxFromHex(resp.SlashingTxHex); err != nil {
+			return nil, fmt.Errorf("invalid slashing tx: %w", err)
+		}
+	}
+	if len(resp.DelegatorSlashSigHex) > 0 {
+		if btcDel.DelegatorSig, err = bbn.NewBIP340SignatureFromHex(resp.DelegatorSlashSigHex); err != nil {
+			return nil, fmt.Errorf("invalid delegator slashing sig: %w", err)
+		}
+	}
+	if resp.UndelegationResponse != nil {
+		if btcDel.BtcUndelegation, err = resp.UndelegationResponse.ToBTCUndelegation(); err != nil {
+			return nil, err
+		}
+	}
+
+	return btcDel, nil
+}
+
+// ToBTCUndelegation parses a BTCUndelegationResponse back into a
+// BTCUndelegation
+func (resp *BTCUndelegationResponse) ToBTCUndelegation() (*BTCUndelegation, error) {
+	unbondingTx, err := hex.DecodeString(resp.UnbondingTxHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unbonding tx: %w", err)
+	}
+	ud := &BTCUndelegation{
+		UnbondingTx:              unbondingTx,
+		CovenantUnbondingSigList: resp.CovenantUnbondingSigList,
+		CovenantSlashingSigs:     resp.CovenantSlashingSigs,
+	}
+
+	if len(resp.SlashingTxHex) > 0 {
+		if ud.SlashingTx, err = NewBTCSlashingTxFromHex(resp.SlashingTxHex); err != nil {
+			return nil, fmt.Errorf("invalid unbonding slashing tx: %w", err)
+		}
+	}
+	if len(resp.DelegatorUnbondingSigHex) > 0 {
+		if ud.DelegatorUnbondingSig, err = bbn.NewBIP340SignatureFromHex(resp.DelegatorUnbondingSigHex); err != nil {
+			return nil, fmt.Errorf("invalid delegator unbonding sig: %w", err)
+		}
+	}
+	if len(resp.DelegatorSlashingSigHex) > 0 {
+		if ud.DelegatorSlashingSig, err = bbn.NewBIP340SignatureFromHex(resp.DelegatorSlashingSigHex); err != nil {
+			return nil, fmt.Errorf("invalid delegator unbonding slashing sig: %w", err)
+		}
+	}
+
+	return ud, nil
+}
+
+// ToResponse parses a SignedSlashingTx into SignedSlashingTxResponse
+func (s *SignedSlashingTx) ToResponse() *SignedSlashingTxResponse {
+	return &SignedSlashingTxResponse{
+		StakingTxHashHex:       s.StakingTxHash,
+		SlashingTxHex:          hex.EncodeToString(s.SlashingTx),
+		UnbondingSlashingTxHex: hex.EncodeToString(s.UnbondingSlashingTx),
+	}
+}
+
+// ToResponse parses a SlashingRecord into SlashingRecordResponse
+func (r *SlashingRecord) ToResponse() *SlashingRecordResponse {
+	return &SlashingRecordResponse{
+		FpBtcPkHex:       r.FpBtcPk.MarshalHex(),
+		StakingTxHashHex: r.StakingTxHash,
+		SlashingRate:     r.SlashingRate,
+		UnbondedEarly:    r.UnbondedEarly,
+		TotalAmount:      r.TotalAmount,
+		SlashedAmount:    r.SlashedAmount,
+		ReturnedAmount:   r.ReturnedAmount,
+		Fee:              r.Fee,
+	}
+}
+
 // ToResponse parses an BTCUndelegation into BTCUndelegationResponse.
 func (ud *BTCUndelegation) ToResponse() (resp *BTCUndelegationResponse) {
 	resp = &BTCUndelegationResponse{
@@ -67,6 +166,7 @@ func NewFinalityProviderResponse(f *FinalityProvider, bbnBlockHeight, votingPowe
 		SlashedBabylonHeight: f.SlashedBabylonHeight,
 		SlashedBtcHeight:     f.SlashedBtcHeight,
 		Sluggish:             f.Sluggish,
+		Jailed:               f.Jailed,
 		Height:               bbnBlockHeight,
 		VotingPower:          votingPower,
 	}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// NewSlashingRecord computes the slashing record of the given BTC delegation
+// from its signed slashing txs. If the BTC delegation has unbonded early, the
+// unbonding slashing tx is the effective one, otherwise the slashing tx is.
+func NewSlashingRecord(
+	btcDel *BTCDelegation,
+	signedSlashingTx *SignedSlashingTx,
+	slashingRate sdkmath.LegacyDec,
+) (*SlashingRecord, error) {
+	var (
+		fundingTxBytes  []byte
+		fundingOutIdx   uint32
+		slashingTxBytes []byte
+		unbondedEarly   = btcDel.IsUnbondedEarly()
+	)
+	if unbondedEarly {
+		fundingTxBytes = btcDel.BtcUndelegation.UnbondingTx
+		fundingOutIdx = 0
+		slashingTxBytes = signedSlashingTx.UnbondingSlashingTx
+	} else {
+		fundingTxBytes = btcDel.StakingTx
+		fundingOutIdx = btcDel.StakingOutputIdx
+		slashingTxBytes = signedSlashingTx.SlashingTx
+	}
+
+	fundingTx, err := bbn.NewBTCTxFromBytes(fundingTxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the tx spent by the slashing tx: %w", err)
+	}
+	if fundingOutIdx >= uint32(len(fundingTx.TxOut)) {
+		return nil, fmt.Errorf("invalid output index %d, tx has %d outputs", fundingOutIdx, len(fundingTx.TxOut))
+	}
+	slashingTx, err := bbn.NewBTCTxFromBytes(slashingTxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the slashing tx: %w", err)
+	}
+	// a valid slashing tx has exactly two outputs, where the first one goes
+	// to the slashing address and the second one returns the change to the
+	// staker (see btcstaking.ValidateSlashingTx)
+	if len(slashingTx.TxOut) != 2 {
+		return nil, fmt.Errorf("invalid slashing tx: expected 2 outputs, got %d", len(slashingTx.TxOut))
+	}
+
+	totalAmount := fundingTx.TxOut[fundingOutIdx].Value
+	slashedAmount := slashingTx.TxOut[0].Value
+	returnedAmount := slashingTx.TxOut[1].Value
+	fee := totalAmount - slashedAmount - returnedAmount
+	if slashedAmount < 0 || returnedAmount < 0 || fee < 0 {
+		return nil, fmt.Errorf("invalid slashing tx: outputs %d and %d exceed the spent value %d", slashedAmount, returnedAmount, totalAmount)
+	}
+
+	return &SlashingRecord{
+		FpBtcPk:        signedSlashingTx.FpBtcPk,
+		StakingTxHash:  signedSlashingTx.StakingTxHash,
+		SlashingRate:   slashingRate,
+		UnbondedEarly:  unbondedEarly,
+		TotalAmount:    uint64(totalAmount),
+		SlashedAmount:  uint64(slashedAmount),
+		ReturnedAmount: uint64(returnedAmount),
+		Fee:            uint64(fee),
+	}, nil
+}
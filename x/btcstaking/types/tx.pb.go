@@ -834,6 +834,101 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRotateCovenantCommittee defines a message for scheduling a rotation of
+// the covenant committee at a given BTC height.
+type MsgRotateCovenantCommittee struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// rotation is the scheduled covenant committee rotation. It replaces any
+	// rotation that is scheduled but not yet in effect.
+	Rotation CovenantRotation `protobuf:"bytes,2,opt,name=rotation,proto3" json:"rotation"`
+}
+
+func (m *MsgRotateCovenantCommittee) Reset()         { *m = MsgRotateCovenantCommittee{} }
+func (m *MsgRotateCovenantCommittee) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateCovenantCommittee) ProtoMessage()    {}
+func (*MsgRotateCovenantCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{14}
+}
+func (m *MsgRotateCovenantCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateCovenantCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateCovenantCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateCovenantCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateCovenantCommittee.Merge(m, src)
+}
+func (m *MsgRotateCovenantCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateCovenantCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateCovenantCommittee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateCovenantCommittee proto.InternalMessageInfo
+
+func (m *MsgRotateCovenantCommittee) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRotateCovenantCommittee) GetRotation() CovenantRotation {
+	if m != nil {
+		return m.Rotation
+	}
+	return CovenantRotation{}
+}
+
+// MsgRotateCovenantCommitteeResponse is the response to the
+// MsgRotateCovenantCommittee message.
+type MsgRotateCovenantCommitteeResponse struct {
+}
+
+func (m *MsgRotateCovenantCommitteeResponse) Reset()         { *m = MsgRotateCovenantCommitteeResponse{} }
+func (m *MsgRotateCovenantCommitteeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateCovenantCommitteeResponse) ProtoMessage()    {}
+func (*MsgRotateCovenantCommitteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{15}
+}
+func (m *MsgRotateCovenantCommitteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateCovenantCommitteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateCovenantCommitteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateCovenantCommitteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateCovenantCommitteeResponse.Merge(m, src)
+}
+func (m *MsgRotateCovenantCommitteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateCovenantCommitteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateCovenantCommitteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateCovenantCommitteeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProvider")
 	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
@@ -849,91 +944,98 @@ func init() {
 	proto.RegisterType((*MsgSelectiveSlashingEvidenceResponse)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidenceResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btcstaking.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btcstaking.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRotateCovenantCommittee)(nil), "babylon.btcstaking.v1.MsgRotateCovenantCommittee")
+	proto.RegisterType((*MsgRotateCovenantCommitteeResponse)(nil), "babylon.btcstaking.v1.MsgRotateCovenantCommitteeResponse")
 }
 
 func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
 
 var fileDescriptor_4baddb53e97f38f2 = []byte{
-	// 1259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x13, 0x47,
-	0x14, 0xcf, 0xda, 0x89, 0x69, 0x9e, 0xe3, 0x24, 0x5d, 0x42, 0xd8, 0x6c, 0xc1, 0x76, 0x0c, 0x85,
-	0x40, 0xc9, 0x9a, 0x84, 0x82, 0x4a, 0x50, 0x0f, 0x38, 0x09, 0x02, 0x15, 0xab, 0xd6, 0xda, 0xe9,
-	0xa1, 0x3d, 0x58, 0xeb, 0xdd, 0xc9, 0x7a, 0x64, 0x7b, 0x67, 0xb5, 0x33, 0xb1, 0x1c, 0x55, 0xaa,
-	0x2a, 0xd4, 0x6b, 0xa5, 0x9e, 0x7a, 0xe8, 0xa7, 0xe0, 0xc0, 0x47, 0xa8, 0x2a, 0x8e, 0x88, 0x53,
-	0x95, 0x43, 0x54, 0x41, 0x25, 0x3e, 0x41, 0xa5, 0xde, 0x5a, 0xed, 0xec, 0x5f, 0xbb, 0xde, 0x92,
-	0x60, 0x6e, 0xde, 0x99, 0xdf, 0xfb, 0xbd, 0xf7, 0x7e, 0xef, 0xbd, 0x99, 0x31, 0xe4, 0x5b, 0x5a,
-	0xeb, 0xb0, 0x4b, 0xac, 0x72, 0x8b, 0xe9, 0x94, 0x69, 0x1d, 0x6c, 0x99, 0xe5, 0xfe, 0x46, 0x99,
-	0x0d, 0x14, 0xdb, 0x21, 0x8c, 0x88, 0xe7, 0xfc, 0x7d, 0x25, 0xda, 0x57, 0xfa, 0x1b, 0xf2, 0x92,
-	0x49, 0x4c, 0xc2, 0x11, 0x65, 0xf7, 0x97, 0x07, 0x96, 0x57, 0x74, 0x42, 0x7b, 0x84, 0x36, 0xbd,
-	0x0d, 0xef, 0xc3, 0xdf, 0x3a, 0xef, 0x7d, 0x95, 0x7b, 0x94, 0xf3, 0xf7, 0xa8, 0xe9, 0x6f, 0x94,
-	0xc6, 0x07, 0x60, 0x6b, 0x8e, 0xd6, 0x0b, 0x8c, 0x6f, 0xc4, 0x30, 0x7a, 0x1b, 0xe9, 0x1d, 0x9b,
-	0x60, 0x8b, 0xb9, 0xb0, 0xa1, 0x05, 0x1f, 0x7d, 0xd9, 0x77, 0x15, 0xb1, 0xb5, 0x10, 0xd3, 0x36,
-	0x82, 0x6f, 0x1f, 0x55, 0x48, 0xf0, 0x4b, 0x6c, 0x0f, 0x50, 0xfa, 0x3b, 0x05, 0x2b, 0x55, 0x6a,
-	0x6e, 0x3b, 0x48, 0x63, 0xe8, 0x01, 0xb6, 0xb4, 0x2e, 0x66, 0x87, 0x35, 0x87, 0xf4, 0xb1, 0x81,
-	0x1c, 0xf1, 0x06, 0x4c, 0x6b, 0x86, 0xe1, 0x48, 0x42, 0x51, 0x58, 0x9b, 0xad, 0x48, 0x2f, 0x9f,
-	0xad, 0x2f, 0xf9, 0xf9, 0xde, 0x37, 0x0c, 0x07, 0x51, 0x5a, 0x67, 0x0e, 0xb6, 0x4c, 0x95, 0xa3,
-	0xc4, 0x5d, 0xc8, 0x1a, 0x88, 0xea, 0x0e, 0xb6, 0x19, 0x26, 0x96, 0x94, 0x2a, 0x0a, 0x6b, 0xd9,
-	0xcd, 0x4b, 0x8a, 0x6f, 0x11, 0xe9, 0xca, 0x03, 0x55, 0x76, 0x22, 0xa8, 0x1a, 0xb7, 0x13, 0xab,
-	0x00, 0x3a, 0xe9, 0xf5, 0x30, 0xa5, 0x2e, 0x4b, 0x9a, 0xbb, 0x5e, 0x3f, 0x3a, 0x2e, 0x7c, 0xe4,
-	0x11, 0x51, 0xa3, 0xa3, 0x60, 0x52, 0xee, 0x69, 0xac, 0xad, 0x3c, 0x46, 0xa6, 0xa6, 0x1f, 0xee,
-	0x20, 0xfd, 0xe5, 0xb3, 0x75, 0xf0, 0xfd, 0xec, 0x20, 0x5d, 0x8d, 0x11, 0x88, 0x55, 0xc8, 0xb4,
-	0x98, 0xde, 0xb4, 0x3b, 0xd2, 0x74, 0x51, 0x58, 0x9b, 0xab, 0xdc, 0x39, 0x3a, 0x2e, 0x6c, 0x9a,
-	0x98, 0xb5, 0x0f, 0x5a, 0x8a, 0x4e, 0x7a, 0x65, 0x5f, 0x21, 0xbd, 0xad, 0x61, 0x2b, 0xf8, 0x28,
-	0xb3, 0x43, 0x1b, 0x51, 0xa5, 0xf2, 0xa8, 0x76, 0xeb, 0xd3, 0x9b, 0xb5, 0x83, 0xd6, 0x17, 0xe8,
-	0x50, 0x9d, 0x69, 0x31, 0xbd, 0xd6, 0x11, 0x3f, 0x87, 0xb4, 0x4d, 0x6c, 0x69, 0x86, 0x27, 0xf7,
-	0x89, 0x32, 0xb6, 0x71, 0x94, 0x9a, 0x43, 0xc8, 0xfe, 0x97, 0xfb, 0x35, 0x42, 0x29, 0xe2, 0x51,
-	0x54, 0x1a, 0xdb, 0xaa, 0x6b, 0xb7, 0x35, 0xfb, 0xe4, 0xcd, 0xd3, 0xeb, 0x5c, 0xae, 0xd2, 0x25,
-	0x58, 0x4d, 0x54, 0x5e, 0x45, 0xd4, 0x26, 0x16, 0x45, 0xa5, 0x7f, 0x04, 0x38, 0x5f, 0xa5, 0xe6,
-	0xae, 0x81, 0xd9, 0x84, 0xd5, 0x39, 0x17, 0xea, 0xe0, 0x16, 0x66, 0x2e, 0xc8, 0x67, 0xa4, 0x68,
-	0xe9, 0xf7, 0x52, 0xb4, 0xe9, 0x09, 0x8b, 0x16, 0x97, 0x69, 0x15, 0x0a, 0x09, 0x02, 0x84, 0x22,
-	0xfd, 0x76, 0x06, 0x96, 0x43, 0x29, 0x2b, 0x8d, 0xed, 0x1d, 0xd4, 0x45, 0xa6, 0xc6, 0xe3, 0xba,
-	0x0b, 0x59, 0x37, 0x07, 0xe4, 0x34, 0x4f, 0x24, 0x15, 0x78, 0x60, 0x77, 0x31, 0xa8, 0x74, 0xea,
-	0xdd, 0x2a, 0x1d, 0xeb, 0xbb, 0xf4, 0xfb, 0xe8, 0xbb, 0x6f, 0x60, 0x7e, 0xdf, 0x6e, 0x7a, 0x8c,
-	0xcd, 0x2e, 0xa6, 0x4c, 0x9a, 0x2e, 0xa6, 0x27, 0xa0, 0xcd, 0xee, 0xdb, 0x15, 0x97, 0xf8, 0x31,
-	0xa6, 0x4c, 0x5c, 0x85, 0x39, 0x3f, 0xa7, 0x26, 0xc3, 0x3d, 0xc4, 0xbb, 0x3b, 0xa7, 0x66, 0xfd,
-	0xb5, 0x06, 0xee, 0x21, 0xf1, 0x12, 0xe4, 0x02, 0x48, 0x5f, 0xeb, 0x1e, 0x20, 0x29, 0x53, 0x14,
-	0xd6, 0xd2, 0x6a, 0x60, 0xf7, 0x95, 0xbb, 0x26, 0x3e, 0x04, 0x08, 0x79, 0x06, 0xd2, 0x19, 0xae,
-	0xdc, 0xb5, 0xb8, 0x72, 0xb1, 0x63, 0xac, 0xbf, 0xa1, 0x34, 0x1c, 0xcd, 0xa2, 0x9a, 0xee, 0x16,
-	0xea, 0x91, 0xb5, 0x4f, 0xd4, 0xd9, 0xc0, 0xe1, 0x40, 0xdc, 0x84, 0x2c, 0xed, 0x6a, 0xb4, 0xed,
-	0x53, 0x7d, 0xc0, 0x25, 0xfc, 0xf0, 0xe8, 0xb8, 0x90, 0xab, 0x34, 0xb6, 0xeb, 0xfe, 0x4e, 0x63,
-	0xa0, 0x02, 0x0d, 0x7f, 0x8b, 0x04, 0x96, 0x0d, 0xaf, 0xf2, 0xc4, 0x69, 0x86, 0xd6, 0x14, 0x9b,
-	0xd2, 0x2c, 0x37, 0xbf, 0x7b, 0x74, 0x5c, 0xb8, 0x7d, 0x1a, 0xa9, 0xea, 0xd8, 0xb4, 0x34, 0x76,
-	0xe0, 0x20, 0x75, 0x29, 0x24, 0x0e, 0x7c, 0xd7, 0xb1, 0x29, 0x7e, 0x0c, 0xf3, 0x07, 0x56, 0x8b,
-	0x58, 0x46, 0x28, 0x1c, 0x70, 0xe1, 0x72, 0xe1, 0x2a, 0x97, 0x6e, 0x15, 0xe6, 0x62, 0xb0, 0x81,
-	0x94, 0xe5, 0xf3, 0x97, 0x8d, 0x40, 0x03, 0xf1, 0x2a, 0x2c, 0x44, 0x10, 0x4f, 0xdf, 0x39, 0xae,
-	0x6f, 0xe4, 0xc0, 0x53, 0x78, 0x17, 0xce, 0x45, 0xc0, 0xb8, 0x42, 0xb9, 0x24, 0x85, 0xce, 0x86,
-	0xf8, 0x68, 0x51, 0x7c, 0x22, 0x40, 0x31, 0xd2, 0x6a, 0x0c, 0xa3, 0xab, 0xda, 0xfc, 0xa4, 0xaa,
-	0x5d, 0x0c, 0x5d, 0xec, 0x8d, 0xc6, 0x50, 0xc7, 0xe6, 0xd6, 0xa2, 0x3b, 0xe4, 0xf1, 0xf1, 0x2c,
-	0x15, 0x21, 0x3f, 0x7e, 0x8e, 0xc3, 0x51, 0xff, 0x2b, 0x05, 0x62, 0x95, 0x9a, 0xf7, 0x0d, 0x63,
-	0x9b, 0xf4, 0x91, 0xa5, 0x59, 0xac, 0x8e, 0x4d, 0x2a, 0x2e, 0x43, 0x86, 0x62, 0xd3, 0x42, 0xfe,
-	0x84, 0xab, 0xfe, 0x97, 0xf8, 0x00, 0x52, 0xc1, 0x81, 0xf7, 0xce, 0x93, 0x92, 0xb2, 0x3b, 0xe2,
-	0x15, 0x58, 0x88, 0x1a, 0xbb, 0xd9, 0xd6, 0x68, 0xdb, 0xbb, 0x98, 0xd4, 0x5c, 0xd8, 0xb2, 0x0f,
-	0x35, 0xda, 0x16, 0xd7, 0x60, 0x31, 0x56, 0x14, 0x57, 0x45, 0xea, 0xcd, 0xa9, 0x3a, 0x1f, 0x35,
-	0x2a, 0x8f, 0x58, 0x87, 0xc5, 0x78, 0x53, 0x70, 0xc1, 0x67, 0x26, 0x15, 0x7c, 0x3e, 0xd6, 0x53,
-	0x6e, 0x83, 0xde, 0x03, 0x39, 0x0c, 0x67, 0xd4, 0x1b, 0x95, 0x32, 0x3c, 0xb0, 0xf3, 0x01, 0x62,
-	0x6f, 0xc8, 0x96, 0x6e, 0x65, 0xdd, 0xf2, 0xf8, 0x42, 0x96, 0x2e, 0x80, 0xfc, 0x5f, 0xd9, 0xc3,
-	0xaa, 0xfc, 0x2a, 0xc0, 0x62, 0x95, 0x9a, 0x95, 0xc6, 0xf6, 0x9e, 0xe5, 0xd7, 0x1c, 0x25, 0xd6,
-	0x64, 0x8c, 0x96, 0xa9, 0x71, 0x5a, 0x8e, 0x53, 0x28, 0xfd, 0x9e, 0x15, 0x1a, 0x4e, 0x52, 0x06,
-	0x69, 0x34, 0x8b, 0x30, 0xc5, 0x5f, 0x04, 0xb8, 0x50, 0xa5, 0x66, 0x1d, 0x75, 0x91, 0xce, 0x70,
-	0x1f, 0x05, 0x8d, 0xbc, 0xeb, 0x5e, 0x45, 0x96, 0x3e, 0x79, 0xba, 0xeb, 0x70, 0xd6, 0x41, 0x3a,
-	0xe9, 0x23, 0x07, 0x19, 0x4d, 0xff, 0xa8, 0xa7, 0xfe, 0xe5, 0xa1, 0x2e, 0x86, 0x5b, 0x0f, 0xdc,
-	0x63, 0xbb, 0xde, 0x19, 0x0e, 0xfc, 0x0a, 0x5c, 0xfe, 0xbf, 0xd8, 0xc2, 0x24, 0x7e, 0x16, 0x60,
-	0xa1, 0x4a, 0xcd, 0x3d, 0xdb, 0xd0, 0x18, 0xaa, 0xf1, 0xc7, 0xa7, 0x78, 0x07, 0x66, 0xb5, 0x03,
-	0xd6, 0x26, 0x0e, 0x66, 0x87, 0x6f, 0xbd, 0x1f, 0x23, 0xa8, 0x78, 0x0f, 0x32, 0xde, 0xf3, 0xd5,
-	0xbf, 0x21, 0x2f, 0x26, 0xdd, 0x90, 0x1c, 0x54, 0x99, 0x7e, 0x7e, 0x5c, 0x98, 0x52, 0x7d, 0x93,
-	0xad, 0x79, 0x37, 0xfa, 0x88, 0xac, 0xb4, 0xc2, 0x5f, 0x39, 0xf1, 0xb8, 0x82, 0x98, 0x37, 0xff,
-	0xcc, 0x40, 0xba, 0x4a, 0x4d, 0xf1, 0x07, 0x01, 0x96, 0x13, 0x9e, 0xa9, 0x37, 0x13, 0x5c, 0x27,
-	0x3e, 0xaf, 0xe4, 0xcf, 0x4e, 0x6b, 0x11, 0x84, 0x23, 0x7e, 0x07, 0x4b, 0x63, 0x1f, 0x63, 0x4a,
-	0x32, 0xe3, 0x38, 0xbc, 0x7c, 0xe7, 0x74, 0xf8, 0xd0, 0xff, 0xb7, 0x70, 0x76, 0xdc, 0x3b, 0x67,
-	0xfd, 0x6d, 0x09, 0x0d, 0xc1, 0xe5, 0xdb, 0xa7, 0x82, 0x87, 0xce, 0x09, 0x2c, 0x8c, 0x9e, 0xbc,
-	0xd7, 0x92, 0x99, 0x46, 0xa0, 0xf2, 0xc6, 0x89, 0xa1, 0xa1, 0x43, 0x0c, 0xb9, 0xe1, 0x43, 0xe5,
-	0x6a, 0x32, 0xc7, 0x10, 0x50, 0x2e, 0x9f, 0x10, 0x18, 0xba, 0xfa, 0x51, 0x80, 0x95, 0xe4, 0xe9,
-	0xbe, 0x95, 0x4c, 0x97, 0x68, 0x24, 0xdf, 0x7b, 0x07, 0xa3, 0x30, 0x9e, 0x7d, 0x98, 0x1b, 0x9a,
-	0xd3, 0x2b, 0xc9, 0x64, 0x71, 0x9c, 0xac, 0x9c, 0x0c, 0x17, 0xf8, 0x91, 0x67, 0xbe, 0x7f, 0xf3,
-	0xf4, 0xba, 0x50, 0x79, 0xfc, 0xfc, 0x55, 0x5e, 0x78, 0xf1, 0x2a, 0x2f, 0xfc, 0xf1, 0x2a, 0x2f,
-	0xfc, 0xf4, 0x3a, 0x3f, 0xf5, 0xe2, 0x75, 0x7e, 0xea, 0xf7, 0xd7, 0xf9, 0xa9, 0xaf, 0xdf, 0x7a,
-	0x67, 0x0e, 0xe2, 0xff, 0x2e, 0xf9, 0xb1, 0xdb, 0xca, 0xf0, 0x7f, 0x97, 0xb7, 0xfe, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0xf6, 0xe9, 0xb3, 0x62, 0x79, 0x0f, 0x00, 0x00,
+	// 1329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0xc6, 0x49, 0x20, 0xcf, 0xf9, 0xf5, 0x5d, 0x42, 0xe2, 0xec, 0x17, 0x6c, 0xc7, 0x50,
+	0x08, 0x94, 0xac, 0x49, 0x28, 0xa8, 0x04, 0xf5, 0x80, 0x9d, 0x20, 0x50, 0xb1, 0x6a, 0xad, 0x9d,
+	0x1e, 0xda, 0x83, 0xb5, 0xde, 0x9d, 0xac, 0x47, 0xb6, 0x77, 0x56, 0x3b, 0x13, 0xcb, 0x51, 0xa5,
+	0xaa, 0x42, 0x95, 0x7a, 0xaa, 0xd4, 0x53, 0x0f, 0xfd, 0x27, 0xca, 0x81, 0x3f, 0xa1, 0xaa, 0x38,
+	0x22, 0x4e, 0x55, 0x0e, 0x51, 0x05, 0x07, 0x6e, 0xbd, 0x55, 0xea, 0xad, 0xd5, 0xce, 0xee, 0xce,
+	0xae, 0x83, 0x17, 0xf2, 0x83, 0x9b, 0x77, 0xe6, 0xf3, 0xde, 0xfb, 0xbc, 0xcf, 0x7b, 0x33, 0xf3,
+	0x0c, 0xd9, 0xa6, 0xde, 0xdc, 0xeb, 0x10, 0xbb, 0xd8, 0x64, 0x06, 0x65, 0x7a, 0x1b, 0xdb, 0x56,
+	0xb1, 0xb7, 0x56, 0x64, 0x7d, 0xd5, 0x71, 0x09, 0x23, 0xf2, 0xf9, 0x60, 0x5f, 0x8d, 0xf6, 0xd5,
+	0xde, 0x9a, 0x32, 0x6f, 0x11, 0x8b, 0x70, 0x44, 0xd1, 0xfb, 0xe5, 0x83, 0x95, 0x25, 0x83, 0xd0,
+	0x2e, 0xa1, 0x0d, 0x7f, 0xc3, 0xff, 0x08, 0xb6, 0x16, 0xfd, 0xaf, 0x62, 0x97, 0x72, 0xff, 0x5d,
+	0x6a, 0x05, 0x1b, 0x85, 0xe1, 0x04, 0x1c, 0xdd, 0xd5, 0xbb, 0xa1, 0xf1, 0x8d, 0x18, 0xc6, 0x68,
+	0x21, 0xa3, 0xed, 0x10, 0x6c, 0x33, 0x0f, 0x36, 0xb0, 0x10, 0xa0, 0x2f, 0x07, 0xa1, 0x22, 0x6f,
+	0x4d, 0xc4, 0xf4, 0xb5, 0xf0, 0x3b, 0x40, 0xe5, 0x12, 0xe2, 0x12, 0xc7, 0x07, 0x14, 0xfe, 0x19,
+	0x85, 0xa5, 0x0a, 0xb5, 0xca, 0x2e, 0xd2, 0x19, 0x7a, 0x80, 0x6d, 0xbd, 0x83, 0xd9, 0x5e, 0xd5,
+	0x25, 0x3d, 0x6c, 0x22, 0x57, 0xbe, 0x01, 0x63, 0xba, 0x69, 0xba, 0x19, 0x29, 0x2f, 0xad, 0x4c,
+	0x96, 0x32, 0x2f, 0x9f, 0xad, 0xce, 0x07, 0xf9, 0xde, 0x37, 0x4d, 0x17, 0x51, 0x5a, 0x63, 0x2e,
+	0xb6, 0x2d, 0x8d, 0xa3, 0xe4, 0x2d, 0x48, 0x9b, 0x88, 0x1a, 0x2e, 0x76, 0x18, 0x26, 0x76, 0x66,
+	0x34, 0x2f, 0xad, 0xa4, 0xd7, 0x2f, 0xa9, 0x81, 0x45, 0xa4, 0x2b, 0x27, 0xaa, 0x6e, 0x46, 0x50,
+	0x2d, 0x6e, 0x27, 0x57, 0x00, 0x0c, 0xd2, 0xed, 0x62, 0x4a, 0x3d, 0x2f, 0x29, 0x1e, 0x7a, 0x75,
+	0xff, 0x20, 0xf7, 0x7f, 0xdf, 0x11, 0x35, 0xdb, 0x2a, 0x26, 0xc5, 0xae, 0xce, 0x5a, 0xea, 0x63,
+	0x64, 0xe9, 0xc6, 0xde, 0x26, 0x32, 0x5e, 0x3e, 0x5b, 0x85, 0x20, 0xce, 0x26, 0x32, 0xb4, 0x98,
+	0x03, 0xb9, 0x02, 0x13, 0x4d, 0x66, 0x34, 0x9c, 0x76, 0x66, 0x2c, 0x2f, 0xad, 0x4c, 0x95, 0xee,
+	0xec, 0x1f, 0xe4, 0xd6, 0x2d, 0xcc, 0x5a, 0xbb, 0x4d, 0xd5, 0x20, 0xdd, 0x62, 0xa0, 0x90, 0xd1,
+	0xd2, 0xb1, 0x1d, 0x7e, 0x14, 0xd9, 0x9e, 0x83, 0xa8, 0x5a, 0x7a, 0x54, 0xbd, 0xf5, 0xc9, 0xcd,
+	0xea, 0x6e, 0xf3, 0x73, 0xb4, 0xa7, 0x8d, 0x37, 0x99, 0x51, 0x6d, 0xcb, 0x9f, 0x41, 0xca, 0x21,
+	0x4e, 0x66, 0x9c, 0x27, 0xf7, 0xb1, 0x3a, 0xb4, 0x71, 0xd4, 0xaa, 0x4b, 0xc8, 0xce, 0x17, 0x3b,
+	0x55, 0x42, 0x29, 0xe2, 0x2c, 0x4a, 0xf5, 0xb2, 0xe6, 0xd9, 0x6d, 0x4c, 0x3e, 0x79, 0xf3, 0xf4,
+	0x3a, 0x97, 0xab, 0x70, 0x09, 0x96, 0x13, 0x95, 0xd7, 0x10, 0x75, 0x88, 0x4d, 0x51, 0xe1, 0x5f,
+	0x09, 0x16, 0x2b, 0xd4, 0xda, 0x32, 0x31, 0x3b, 0x65, 0x75, 0xce, 0x0b, 0x1d, 0xbc, 0xc2, 0x4c,
+	0x85, 0xf9, 0x1c, 0x2a, 0x5a, 0xea, 0x83, 0x14, 0x6d, 0xec, 0x94, 0x45, 0x8b, 0xcb, 0xb4, 0x0c,
+	0xb9, 0x04, 0x01, 0x84, 0x48, 0xbf, 0x9f, 0x81, 0x05, 0x21, 0x65, 0xa9, 0x5e, 0xde, 0x44, 0x1d,
+	0x64, 0xe9, 0x9c, 0xd7, 0x5d, 0x48, 0x7b, 0x39, 0x20, 0xb7, 0x71, 0x24, 0xa9, 0xc0, 0x07, 0x7b,
+	0x8b, 0x61, 0xa5, 0x47, 0x4f, 0x56, 0xe9, 0x58, 0xdf, 0xa5, 0x3e, 0x44, 0xdf, 0x7d, 0x0d, 0x33,
+	0x3b, 0x4e, 0xc3, 0xf7, 0xd8, 0xe8, 0x60, 0xca, 0x32, 0x63, 0xf9, 0xd4, 0x29, 0xdc, 0xa6, 0x77,
+	0x9c, 0x92, 0xe7, 0xf8, 0x31, 0xa6, 0x4c, 0x5e, 0x86, 0xa9, 0x20, 0xa7, 0x06, 0xc3, 0x5d, 0xc4,
+	0xbb, 0x7b, 0x5a, 0x4b, 0x07, 0x6b, 0x75, 0xdc, 0x45, 0xf2, 0x25, 0x98, 0x0e, 0x21, 0x3d, 0xbd,
+	0xb3, 0x8b, 0x32, 0x13, 0x79, 0x69, 0x25, 0xa5, 0x85, 0x76, 0x5f, 0x7a, 0x6b, 0xf2, 0x43, 0x00,
+	0xe1, 0xa7, 0x9f, 0x39, 0xc3, 0x95, 0xbb, 0x16, 0x57, 0x2e, 0x76, 0x8d, 0xf5, 0xd6, 0xd4, 0xba,
+	0xab, 0xdb, 0x54, 0x37, 0xbc, 0x42, 0x3d, 0xb2, 0x77, 0x88, 0x36, 0x19, 0x06, 0xec, 0xcb, 0xeb,
+	0x90, 0xa6, 0x1d, 0x9d, 0xb6, 0x02, 0x57, 0x67, 0xb9, 0x84, 0xff, 0xdb, 0x3f, 0xc8, 0x4d, 0x97,
+	0xea, 0xe5, 0x5a, 0xb0, 0x53, 0xef, 0x6b, 0x40, 0xc5, 0x6f, 0x99, 0xc0, 0x82, 0xe9, 0x57, 0x9e,
+	0xb8, 0x0d, 0x61, 0x4d, 0xb1, 0x95, 0x99, 0xe4, 0xe6, 0x77, 0xf7, 0x0f, 0x72, 0xb7, 0x8f, 0x23,
+	0x55, 0x0d, 0x5b, 0xb6, 0xce, 0x76, 0x5d, 0xa4, 0xcd, 0x0b, 0xc7, 0x61, 0xec, 0x1a, 0xb6, 0xe4,
+	0x8f, 0x60, 0x66, 0xd7, 0x6e, 0x12, 0xdb, 0x14, 0xc2, 0x01, 0x17, 0x6e, 0x5a, 0xac, 0x72, 0xe9,
+	0x96, 0x61, 0x2a, 0x06, 0xeb, 0x67, 0xd2, 0xfc, 0xfc, 0xa5, 0x23, 0x50, 0x5f, 0xbe, 0x0a, 0xb3,
+	0x11, 0xc4, 0xd7, 0x77, 0x8a, 0xeb, 0x1b, 0x05, 0xf0, 0x15, 0xde, 0x82, 0xf3, 0x11, 0x30, 0xae,
+	0xd0, 0x74, 0x92, 0x42, 0xe7, 0x04, 0x3e, 0x5a, 0x94, 0x9f, 0x48, 0x90, 0x8f, 0xb4, 0x1a, 0xe2,
+	0xd1, 0x53, 0x6d, 0xe6, 0xb4, 0xaa, 0x5d, 0x14, 0x21, 0xb6, 0x0f, 0x73, 0xa8, 0x61, 0x6b, 0x63,
+	0xce, 0x3b, 0xe4, 0xf1, 0xe3, 0x59, 0xc8, 0x43, 0x76, 0xf8, 0x39, 0x16, 0x47, 0xfd, 0xef, 0x51,
+	0x90, 0x2b, 0xd4, 0xba, 0x6f, 0x9a, 0x65, 0xd2, 0x43, 0xb6, 0x6e, 0xb3, 0x1a, 0xb6, 0xa8, 0xbc,
+	0x00, 0x13, 0x14, 0x5b, 0x36, 0x0a, 0x4e, 0xb8, 0x16, 0x7c, 0xc9, 0x0f, 0x60, 0x34, 0xbc, 0xf0,
+	0x4e, 0x7c, 0x52, 0x46, 0x9d, 0xb6, 0x7c, 0x05, 0x66, 0xa3, 0xc6, 0x6e, 0xb4, 0x74, 0xda, 0xf2,
+	0x1f, 0x26, 0x6d, 0x5a, 0xb4, 0xec, 0x43, 0x9d, 0xb6, 0xe4, 0x15, 0x98, 0x8b, 0x15, 0xc5, 0x53,
+	0x91, 0xfa, 0xe7, 0x54, 0x9b, 0x89, 0x1a, 0x95, 0x33, 0x36, 0x60, 0x2e, 0xde, 0x14, 0x5c, 0xf0,
+	0xf1, 0xd3, 0x0a, 0x3e, 0x13, 0xeb, 0x29, 0xaf, 0x41, 0xef, 0x81, 0x22, 0xe8, 0x1c, 0x8e, 0x46,
+	0x33, 0x13, 0x9c, 0xd8, 0x62, 0x88, 0xd8, 0x1e, 0xb0, 0xa5, 0x1b, 0x69, 0xaf, 0x3c, 0x81, 0x90,
+	0x85, 0x0b, 0xa0, 0xbc, 0x2d, 0xbb, 0xa8, 0xca, 0x6f, 0x12, 0xcc, 0x55, 0xa8, 0x55, 0xaa, 0x97,
+	0xb7, 0xed, 0xa0, 0xe6, 0x28, 0xb1, 0x26, 0x43, 0xb4, 0x1c, 0x1d, 0xa6, 0xe5, 0x30, 0x85, 0x52,
+	0x1f, 0x58, 0xa1, 0xc1, 0x24, 0x15, 0xc8, 0x1c, 0xce, 0x42, 0xa4, 0xf8, 0x8b, 0x04, 0x17, 0x2a,
+	0xd4, 0xaa, 0xa1, 0x0e, 0x32, 0x18, 0xee, 0xa1, 0xb0, 0x91, 0xb7, 0xbc, 0xa7, 0xc8, 0x36, 0x4e,
+	0x9f, 0xee, 0x2a, 0x9c, 0x73, 0x91, 0x41, 0x7a, 0xc8, 0x45, 0x66, 0x23, 0xb8, 0xea, 0x69, 0xf0,
+	0x78, 0x68, 0x73, 0x62, 0xeb, 0x81, 0x77, 0x6d, 0xd7, 0xda, 0x83, 0xc4, 0xaf, 0xc0, 0xe5, 0x77,
+	0x71, 0x13, 0x49, 0xfc, 0x2c, 0xc1, 0x6c, 0x85, 0x5a, 0xdb, 0x8e, 0xa9, 0x33, 0x54, 0xe5, 0xc3,
+	0xa7, 0x7c, 0x07, 0x26, 0xf5, 0x5d, 0xd6, 0x22, 0x2e, 0x66, 0x7b, 0xef, 0x7d, 0x1f, 0x23, 0xa8,
+	0x7c, 0x0f, 0x26, 0xfc, 0xf1, 0x35, 0x78, 0x21, 0x2f, 0x26, 0xbd, 0x90, 0x1c, 0x54, 0x1a, 0x7b,
+	0x7e, 0x90, 0x1b, 0xd1, 0x02, 0x93, 0x8d, 0x19, 0x8f, 0x7d, 0xe4, 0xac, 0xb0, 0xc4, 0xa7, 0x9c,
+	0x38, 0x2f, 0xc1, 0xf9, 0x57, 0x89, 0xb7, 0x9e, 0x46, 0x98, 0xce, 0x50, 0xd8, 0x7d, 0x65, 0x6f,
+	0x54, 0x60, 0x0c, 0xa1, 0x13, 0xd3, 0x7f, 0x04, 0x67, 0x5d, 0xcf, 0x65, 0x34, 0xa9, 0x5e, 0x4d,
+	0x48, 0x20, 0x8c, 0xa9, 0x05, 0xf0, 0x20, 0x15, 0x61, 0xfe, 0x56, 0x32, 0x97, 0xa1, 0x90, 0x4c,
+	0x38, 0xcc, 0x6b, 0xfd, 0xaf, 0x33, 0x90, 0xaa, 0x50, 0x4b, 0xfe, 0x5e, 0x82, 0x85, 0x84, 0xf1,
+	0xfb, 0x66, 0x02, 0xa3, 0xc4, 0xb1, 0x51, 0xf9, 0xf4, 0xb8, 0x16, 0x21, 0x1d, 0xf9, 0x5b, 0x98,
+	0x1f, 0x3a, 0x64, 0xaa, 0xc9, 0x1e, 0x87, 0xe1, 0x95, 0x3b, 0xc7, 0xc3, 0x8b, 0xf8, 0xdf, 0xc0,
+	0xb9, 0x61, 0xf3, 0xdb, 0xea, 0xfb, 0x12, 0x1a, 0x80, 0x2b, 0xb7, 0x8f, 0x05, 0x17, 0xc1, 0x09,
+	0xcc, 0x1e, 0x7e, 0x51, 0xae, 0x25, 0x7b, 0x3a, 0x04, 0x55, 0xd6, 0x8e, 0x0c, 0x15, 0x01, 0x31,
+	0x4c, 0x0f, 0x5e, 0x96, 0x57, 0x93, 0x7d, 0x0c, 0x00, 0x95, 0xe2, 0x11, 0x81, 0x22, 0xd4, 0x8f,
+	0x12, 0x2c, 0x25, 0xdf, 0x5a, 0xb7, 0x92, 0xdd, 0x25, 0x1a, 0x29, 0xf7, 0x4e, 0x60, 0x24, 0xf8,
+	0xec, 0xc0, 0xd4, 0xc0, 0xfd, 0x73, 0x25, 0xd9, 0x59, 0x1c, 0xa7, 0xa8, 0x47, 0xc3, 0x89, 0x38,
+	0x3f, 0x48, 0xb0, 0x98, 0x74, 0x69, 0xbc, 0xa3, 0x62, 0x09, 0x26, 0xca, 0xdd, 0x63, 0x9b, 0x84,
+	0x4c, 0x94, 0xf1, 0xef, 0xde, 0x3c, 0xbd, 0x2e, 0x95, 0x1e, 0x3f, 0x7f, 0x95, 0x95, 0x5e, 0xbc,
+	0xca, 0x4a, 0x7f, 0xbe, 0xca, 0x4a, 0x3f, 0xbd, 0xce, 0x8e, 0xbc, 0x78, 0x9d, 0x1d, 0xf9, 0xe3,
+	0x75, 0x76, 0xe4, 0xab, 0xf7, 0x4e, 0x25, 0xfd, 0xf8, 0xff, 0x77, 0xfe, 0xb0, 0x35, 0x27, 0xf8,
+	0xff, 0xf7, 0x5b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xfe, 0x48, 0xb9, 0xdb, 0x10, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -963,6 +1065,8 @@ type MsgClient interface {
 	SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error)
 	// UpdateParams updates the btcstaking module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RotateCovenantCommittee schedules a rotation of the covenant committee
+	RotateCovenantCommittee(ctx context.Context, in *MsgRotateCovenantCommittee, opts ...grpc.CallOption) (*MsgRotateCovenantCommitteeResponse, error)
 }
 
 type msgClient struct {
@@ -1036,6 +1140,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RotateCovenantCommittee(ctx context.Context, in *MsgRotateCovenantCommittee, opts ...grpc.CallOption) (*MsgRotateCovenantCommitteeResponse, error) {
+	out := new(MsgRotateCovenantCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/RotateCovenantCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateFinalityProvider creates a new finality provider
@@ -1053,6 +1166,8 @@ type MsgServer interface {
 	SelectiveSlashingEvidence(context.Context, *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error)
 	// UpdateParams updates the btcstaking module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RotateCovenantCommittee schedules a rotation of the covenant committee
+	RotateCovenantCommittee(context.Context, *MsgRotateCovenantCommittee) (*MsgRotateCovenantCommitteeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1080,6 +1195,9 @@ func (*UnimplementedMsgServer) SelectiveSlashingEvidence(ctx context.Context, re
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RotateCovenantCommittee(ctx context.Context, req *MsgRotateCovenantCommittee) (*MsgRotateCovenantCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCovenantCommittee not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1211,6 +1329,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateCovenantCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateCovenantCommittee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateCovenantCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/RotateCovenantCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateCovenantCommittee(ctx, req.(*MsgRotateCovenantCommittee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btcstaking.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1243,6 +1379,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RotateCovenantCommittee",
+			Handler:    _Msg_RotateCovenantCommittee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btcstaking/v1/tx.proto",
@@ -1915,6 +2055,69 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRotateCovenantCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateCovenantCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateCovenantCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateCovenantCommitteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateCovenantCommitteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateCovenantCommitteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2200,6 +2403,30 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotateCovenantCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rotation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRotateCovenantCommitteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4103,6 +4330,171 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotateCovenantCommittee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateCovenantCommittee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateCovenantCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateCovenantCommitteeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateCovenantCommitteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateCovenantCommitteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,6 +19,10 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	epoch := k.GetEpoch(ctx)
 	if epoch.IsFirstBlock(ctx) {
+		// the rotated BLS keys take effect from the new epoch
+		if err := k.ApplyBlsKeyRotations(ctx); err != nil {
+			panic(fmt.Errorf("failed to apply BLS key rotations: %w", err))
+		}
 		err := k.InitValidatorBLSSet(ctx)
 		if err != nil {
 			panic(fmt.Errorf("failed to store validator BLS set: %w", err))
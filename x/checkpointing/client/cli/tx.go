@@ -32,6 +32,7 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdWrappedCreateValidator(authcodec.NewBech32Codec(appparams.Bech32PrefixValAddr)))
+	cmd.AddCommand(CmdRotateBlsKey())
 
 	return cmd
 }
@@ -83,3 +84,53 @@ before running the command (e.g., via babylond create-bls-key).`)
 
 	return cmd
 }
+
+func CmdRotateBlsKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-bls-key",
+		Args:  cobra.NoArgs,
+		Short: "Rotate the BLS key of a validator",
+		Long: strings.TrimSpace(`rotate-bls-key will rotate the BLS key of the validator to a new BLS key,
+which takes effect from the next epoch. The old BLS key remains in effect until the end
+of the current epoch.
+
+This command creates a new BLS key with a proof-of-possession w.r.t. the consensus key in
+priv_validator_key.json, and saves the validator keys with the new BLS key to
+priv_validator_key.json.next. If priv_validator_key.json.next exists, e.g., when retrying the
+command, its BLS key is used instead. The command fails if the node would not be able to load
+priv_validator_key.json.next. Once the new BLS key takes effect, the node replaces
+priv_validator_key.json with priv_validator_key.json.next, and refuses to start if it is missing
+before then. A remote BLS signer, if any, has to be restarted with the new BLS key on the signer
+host once the new BLS key takes effect.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			home, _ := cmd.Flags().GetString(flags.FlagHome)
+			valKey, err := getNextValKeyFromFile(home)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateBlsKey(clientCtx.GetFromAddress(), &valKey.BlsPubkey, valKey.PoP)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	// see the HACK in CmdWrappedCreateValidator
+	userHomeDir, err := os.UserHomeDir()
+	if err != nil {
+		panic(err)
+	}
+	defaultNodeHome := filepath.Join(userHomeDir, ".babylond")
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The node home directory")
+
+	return cmd
+}
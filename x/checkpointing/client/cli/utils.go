@@ -23,6 +23,7 @@ import (
 	staketypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	flag "github.com/spf13/pflag"
 
+	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/privval"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 )
@@ -213,3 +214,43 @@ func getValKeyFromFile(homeDir string) (*privval.ValidatorKeys, error) {
 
 	return privval.NewValidatorKeys(wrappedPV.GetValPrivKey(), wrappedPV.GetBlsPrivKey())
 }
+
+// getNextValKeyFromFile returns the validator keys with the new BLS key in
+// priv_validator_key.json.next, which is created with a newly generated BLS
+// key and the validator key in priv_validator_key.json if it does not exist
+func getNextValKeyFromFile(homeDir string) (*privval.ValidatorKeys, error) {
+	nodeCfg := cmtconfig.DefaultConfig()
+	keyPath := filepath.Join(homeDir, nodeCfg.PrivValidatorKeyFile())
+	statePath := filepath.Join(homeDir, nodeCfg.PrivValidatorStateFile())
+	nextKeyPath := keyPath + privval.NextKeyFileSuffix
+	if !cmtos.FileExists(keyPath) {
+		return nil, errors.New("validator key file does not exist")
+	}
+	wrappedPV := privval.LoadWrappedFilePV(keyPath, statePath)
+
+	var nextPV *privval.WrappedFilePV
+	if cmtos.FileExists(nextKeyPath) {
+		var err error
+		nextPV, err = privval.LoadWrappedFilePVWithBlsPassphrase(nextKeyPath, statePath, "")
+		if err != nil {
+			return nil, err
+		}
+		if !nextPV.Key.PubKey.Equals(wrappedPV.Key.PubKey) {
+			return nil, fmt.Errorf("the validator key in %s does not match the one in %s", nextKeyPath, keyPath)
+		}
+	} else {
+		nextPV = privval.NewWrappedFilePV(wrappedPV.GetValPrivKey(), bls12381.GenPrivKey(), nextKeyPath, statePath)
+		nextPV.Key.DelegatorAddress = wrappedPV.Key.DelegatorAddress
+		nextPV.Key.Save()
+	}
+	if nextPV.Key.BlsPubKey.Equal(wrappedPV.Key.BlsPubKey) {
+		return nil, fmt.Errorf("the BLS key in %s is the same as the current one", nextKeyPath)
+	}
+	// ensure the node is able to switch to the new BLS key once it takes
+	// effect, as the next key file is loaded in the same way
+	if err := wrappedPV.CheckNextBlsKey(nextPV.Key.BlsPubKey); err != nil {
+		return nil, fmt.Errorf("the node cannot switch to the new BLS key: %w", err)
+	}
+
+	return privval.NewValidatorKeys(nextPV.GetValPrivKey(), nextPV.GetBlsPrivKey())
+}
@@ -12,6 +12,7 @@ import (
 // TODO: importing/exporting genesis
 func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetGenBlsKeys(ctx, genState.GenesisKeys)
+	k.SetGenBlsKeyRotations(ctx, genState.RotatedBlsKeys, genState.PendingBlsKeys)
 	// set epoch 0 to be finalised at genesis
 	k.SetLastFinalizedEpoch(ctx, 0)
 }
@@ -19,5 +20,11 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisSta
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
+	rotatedKeys, pendingKeys, err := k.GetGenBlsKeyRotations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.RotatedBlsKeys = rotatedKeys
+	genesis.PendingBlsKeys = pendingKeys
 	return genesis
 }
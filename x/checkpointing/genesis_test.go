@@ -50,3 +50,49 @@ func TestInitGenesis(t *testing.T) {
 		require.True(t, genKeys[i].BlsKey.Pubkey.Equal(blsKey))
 	}
 }
+
+func TestExportGenesisBlsKeyRotations(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false)
+	ckptKeeper := app.CheckpointingKeeper
+
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	oldBlsPK := bls12381.GenPrivKey().PubKey()
+	newBlsPK := bls12381.GenPrivKey().PubKey()
+	pendingBlsPK := bls12381.GenPrivKey().PubKey()
+	genesisState := types.GenesisState{
+		RotatedBlsKeys: []*types.RotatedBlsKey{{
+			ValidatorAddress: valAddr.String(),
+			Pubkey:           &oldBlsPK,
+			LastEpoch:        5,
+		}},
+		PendingBlsKeys: []*types.ValidatorPendingBlsKey{{
+			ValidatorAddress: valAddr.String(),
+			PendingBlsKey: &types.PendingBlsKey{
+				Pubkey:         &pendingBlsPK,
+				EffectiveEpoch: 10,
+			},
+		}},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	checkpointing.InitGenesis(ctx, ckptKeeper, genesisState)
+	require.NoError(t, ckptKeeper.CreateRegistration(ctx, newBlsPK, valAddr))
+
+	// the rotated BLS key is in effect until its last epoch
+	blsKey, err := ckptKeeper.GetBlsPubKeyAtEpoch(ctx, valAddr, 5)
+	require.NoError(t, err)
+	require.True(t, oldBlsPK.Equal(blsKey))
+	blsKey, err = ckptKeeper.GetBlsPubKeyAtEpoch(ctx, valAddr, 6)
+	require.NoError(t, err)
+	require.True(t, newBlsPK.Equal(blsKey))
+
+	// the rotated and pending BLS keys cannot be registered by others
+	otherAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	require.ErrorIs(t, ckptKeeper.CreateRegistration(ctx, oldBlsPK, otherAddr), types.ErrBlsKeyAlreadyExist)
+	require.ErrorIs(t, ckptKeeper.CreateRegistration(ctx, pendingBlsPK, otherAddr), types.ErrBlsKeyAlreadyExist)
+
+	exported := checkpointing.ExportGenesis(ctx, ckptKeeper)
+	require.Equal(t, genesisState.RotatedBlsKeys, exported.RotatedBlsKeys)
+	require.Equal(t, genesisState.PendingBlsKeys, exported.PendingBlsKeys)
+}
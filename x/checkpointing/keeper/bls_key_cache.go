@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/boljen/go-bitmap"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+)
+
+// blsKeyCacheCapacity is the number of decompressed BLS public keys that are
+// cached
+const blsKeyCacheCapacity = 1024
+
+// epochBlsKeys is the validator set of an epoch, together with the BLS public
+// keys of the validators in effect at the epoch, both compressed and
+// decompressed, in the same order as the validator set
+type epochBlsKeys struct {
+	valSet       epochingtypes.ValidatorSet
+	blsKeys      []bls12381.PublicKey
+	decompressed []*bls12381.BlsPubKey
+}
+
+// blsKeyCache caches the decompressed forms of BLS public keys, keyed by the
+// compressed BLS public keys, so that verifying BLS signatures and
+// checkpoints does not need to decompress the BLS public keys of the
+// validators upon each verification. It only saves computation rather than
+// store reads, such that the gas consumed by a verification does not depend
+// on the state of the cache, which differs across nodes. When the number of
+// cached keys reaches the capacity, all cached keys are evicted. It is safe
+// for concurrent use.
+type blsKeyCache struct {
+	mu       sync.Mutex
+	capacity int
+	keys     map[string]*bls12381.BlsPubKey
+}
+
+func newBlsKeyCache(capacity int) *blsKeyCache {
+	return &blsKeyCache{
+		capacity: capacity,
+		keys:     make(map[string]*bls12381.BlsPubKey),
+	}
+}
+
+func (c *blsKeyCache) get(blsKey bls12381.PublicKey) (*bls12381.BlsPubKey, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	decompressed, ok := c.keys[string(blsKey)]
+	return decompressed, ok
+}
+
+func (c *blsKeyCache) set(blsKey bls12381.PublicKey, decompressed *bls12381.BlsPubKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.keys) >= c.capacity {
+		clear(c.keys)
+	}
+	c.keys[string(blsKey)] = decompressed
+}
+
+// decompressBlsPubKey returns the decompressed form of the given BLS public
+// key via the BLS key cache
+func (k Keeper) decompressBlsPubKey(blsKey bls12381.PublicKey) (*bls12381.BlsPubKey, error) {
+	if decompressed, ok := k.blsKeyCache.get(blsKey); ok {
+		return decompressed, nil
+	}
+	decompressed, err := bls12381.DecompressPubKey(blsKey)
+	if err != nil {
+		return nil, err
+	}
+	k.blsKeyCache.set(blsKey, decompressed)
+	return decompressed, nil
+}
+
+// getEpochBlsKeys returns the validator set and the BLS public keys of the
+// given epoch. The validator set and the BLS public keys are always read
+// from the store, and only their decompression goes through the BLS key
+// cache.
+func (k Keeper) getEpochBlsKeys(ctx context.Context, epochNumber uint64) (*epochBlsKeys, error) {
+	valSet := k.GetValidatorSet(ctx, epochNumber)
+	keys := &epochBlsKeys{
+		valSet:       valSet,
+		blsKeys:      make([]bls12381.PublicKey, len(valSet)),
+		decompressed: make([]*bls12381.BlsPubKey, len(valSet)),
+	}
+	rs := k.RegistrationState(ctx)
+	for i, val := range valSet {
+		blsKey, err := rs.GetBlsPubKeyAtEpoch(val.Addr, epochNumber)
+		if err != nil {
+			return nil, err
+		}
+		decompressed, err := k.decompressBlsPubKey(blsKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid BLS public key of validator %s: %w", val.GetValAddressStr(), err)
+		}
+		keys.blsKeys[i] = blsKey
+		keys.decompressed[i] = decompressed
+	}
+	return keys, nil
+}
+
+// GetDecompressedBlsPubKeyAtEpoch returns the decompressed BLS public key of
+// the validator that is in effect at the given epoch
+func (k Keeper) GetDecompressedBlsPubKeyAtEpoch(ctx context.Context, address sdk.ValAddress, epochNumber uint64) (*bls12381.BlsPubKey, error) {
+	blsKey, err := k.GetBlsPubKeyAtEpoch(ctx, address, epochNumber)
+	if err != nil {
+		return nil, err
+	}
+	return k.decompressBlsPubKey(blsKey)
+}
+
+// aggrSignersBlsPubKeys aggregates the BLS public keys of the validators of
+// the given epoch that are indicated by the bitmap, and returns the
+// aggregator together with the sum of the voting power of these validators
+func (k Keeper) aggrSignersBlsPubKeys(ctx context.Context, epochNumber uint64, bm bitmap.Bitmap) (*bls12381.PubKeyAggregator, int64, error) {
+	keys, err := k.getEpochBlsKeys(ctx, epochNumber)
+	if err != nil {
+		return nil, 0, err
+	}
+	// ensure the bitmap is big enough to contain the validator set
+	if bm.Len() < len(keys.valSet) {
+		return nil, 0, fmt.Errorf("failed to get the signer set via bitmap of epoch %d: bitmap (with %d bits) is not large enough to contain the validator set with size %d", epochNumber, bm.Len(), len(keys.valSet))
+	}
+
+	aggr := bls12381.NewPubKeyAggregator()
+	var sum int64
+	for i, val := range keys.valSet {
+		if bm.Get(i) {
+			aggr.AddDecompressed(keys.decompressed[i])
+			sum += val.Power
+		}
+	}
+	return aggr, sum, nil
+}
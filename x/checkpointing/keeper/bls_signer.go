@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"context"
+	"errors"
+
 	"github.com/cometbft/cometbft/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -8,18 +11,83 @@ import (
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 )
 
+// BlsSigner signs BLS signatures over checkpoints on behalf of the validator.
+// It is implemented by the local wrapped file private validator and by the
+// remote BLS signer in privval/remote.
 type BlsSigner interface {
 	GetAddress() sdk.ValAddress
-	SignMsgWithBls(msg []byte) (bls12381.Signature, error)
+	SignBlsCheckpoint(epochNum uint64, blockHash types.BlockHash) (bls12381.Signature, error)
 	GetBlsPubkey() (bls12381.PublicKey, error)
 	GetValidatorPubkey() (crypto.PubKey, error)
 }
 
+// BlsKeySwitcher is implemented by BLS signers that can switch to the new BLS
+// key of the validator once a BLS key rotation takes effect
+type BlsKeySwitcher interface {
+	SwitchBlsKey(blsPubKey bls12381.PublicKey) error
+}
+
+// NextBlsKeyChecker is implemented by BLS signers that can check whether they
+// are able to switch to the new BLS key of a pending BLS key rotation before
+// it takes effect. The remote BLS signer does not implement it, as its BLS key
+// is switched on the signer host.
+type NextBlsKeyChecker interface {
+	CheckNextBlsKey(blsPubKey bls12381.PublicKey) error
+}
+
+// CheckPendingBlsKey checks that the BLS signer is able to switch to the
+// pending BLS key of the validator, if any, once it takes effect. It is called
+// upon starting the node, so that a missing BLS key fails loudly rather than
+// at the last block of the epoch where it takes effect.
+func (k Keeper) CheckPendingBlsKey(ctx context.Context) error {
+	pending, err := k.GetPendingBlsPubKey(ctx, k.blsSigner.GetAddress())
+	if errors.Is(err, types.ErrBlsKeyDoesNotExist) {
+		// the validator has no pending BLS key rotation, or the signer
+		// is not a validator
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	checker, ok := k.blsSigner.(NextBlsKeyChecker)
+	if !ok {
+		return nil
+	}
+	if err := checker.CheckNextBlsKey(pending); err != nil {
+		return types.ErrBlsKeyMismatch.Wrapf("the BLS signer cannot switch to the pending BLS key %X: %v", pending, err)
+	}
+	return nil
+}
+
+// SwitchBlsKeyAtEpoch ensures the BLS signer signs with the BLS key of the
+// validator that is in effect at the given epoch, switching the BLS key of
+// the signer if the BLS key has been rotated
+func (k Keeper) SwitchBlsKeyAtEpoch(ctx context.Context, epochNum uint64) error {
+	expected, err := k.GetBlsPubKeyAtEpoch(ctx, k.blsSigner.GetAddress(), epochNum)
+	if err != nil {
+		return err
+	}
+	current, err := k.blsSigner.GetBlsPubkey()
+	if err != nil {
+		return err
+	}
+	if current.Equal(expected) {
+		return nil
+	}
+
+	switcher, ok := k.blsSigner.(BlsKeySwitcher)
+	if !ok {
+		return types.ErrBlsKeyMismatch.Wrapf("the BLS signer cannot switch to the BLS key %X in effect at epoch %d", expected, epochNum)
+	}
+	if err := switcher.SwitchBlsKey(expected); err != nil {
+		return types.ErrBlsKeyMismatch.Wrapf("failed to switch to the BLS key %X in effect at epoch %d: %v", expected, epochNum, err)
+	}
+	return nil
+}
+
 // SignBLS signs a BLS signature over the given information
 func (k Keeper) SignBLS(epochNum uint64, blockHash types.BlockHash) (bls12381.Signature, error) {
-	// get BLS signature by signing
-	signBytes := types.GetSignBytes(epochNum, blockHash)
-	return k.blsSigner.SignMsgWithBls(signBytes)
+	return k.blsSigner.SignBlsCheckpoint(epochNum, blockHash)
 }
 
 func (k Keeper) GetBLSSignerAddress() sdk.ValAddress {
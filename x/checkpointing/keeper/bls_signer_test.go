@@ -1,10 +1,22 @@
 package keeper_test
 
 import (
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	cmtos "github.com/cometbft/cometbft/libs/os"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/privval"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/testutil/mocks"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 )
 
@@ -28,3 +40,131 @@ var (
 	blsPubKey2  = blsPrivKey2.PubKey()
 	pubkeys     = []bls12381.PublicKey{blsPubKey1, blsPubKey2}
 )
+
+func FuzzSwitchBlsKeyAtEpoch(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		dir := t.TempDir()
+		keyPath := filepath.Join(dir, "config", "priv_validator_key.json")
+		statePath := filepath.Join(dir, "data", "priv_validator_state.json")
+		require.NoError(t, cmtos.EnsureDir(filepath.Dir(keyPath), 0777))
+		pv := privval.GenWrappedFilePV(keyPath, statePath)
+		pv.SetAccAddress(datagen.GenRandomAccount().GetAddress())
+		valAddr := pv.GetAddress()
+		oldBlsPK := pv.Key.BlsPubKey
+
+		k, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, pv)
+		require.NoError(t, k.CreateRegistration(ctx, oldBlsPK, valAddr))
+
+		// the BLS key is rotated from a random epoch
+		newBlsSK := bls12381.GenPrivKey()
+		newBlsPK := newBlsSK.PubKey()
+		rotatedEpoch := datagen.RandomInt(r, 100) + 2
+		rs := k.RegistrationState(ctx)
+		require.NoError(t, rs.CreatePendingRotation(newBlsPK, valAddr, rotatedEpoch))
+		_, err := rs.ApplyPendingRotations(rotatedEpoch)
+		require.NoError(t, err)
+
+		// the old BLS key is still used before the rotated epoch
+		require.NoError(t, k.SwitchBlsKeyAtEpoch(ctx, rotatedEpoch-1))
+		require.True(t, oldBlsPK.Equal(pv.Key.BlsPubKey))
+
+		// the signer cannot switch without the new BLS key in the next key file
+		err = k.SwitchBlsKeyAtEpoch(ctx, rotatedEpoch)
+		require.ErrorIs(t, err, types.ErrBlsKeyMismatch)
+		require.True(t, oldBlsPK.Equal(pv.Key.BlsPubKey))
+
+		// the signer switches to the new BLS key in the next key file, which
+		// replaces the key file
+		nextPV := privval.NewWrappedFilePV(pv.GetValPrivKey(), newBlsSK, keyPath+privval.NextKeyFileSuffix, statePath)
+		nextPV.Key.DelegatorAddress = pv.Key.DelegatorAddress
+		nextPV.Key.Save()
+		require.NoError(t, k.SwitchBlsKeyAtEpoch(ctx, rotatedEpoch))
+		require.True(t, newBlsPK.Equal(pv.Key.BlsPubKey))
+		require.Equal(t, valAddr, pv.GetAddress())
+		require.False(t, cmtos.FileExists(keyPath+privval.NextKeyFileSuffix))
+		reloadedPV := privval.LoadWrappedFilePVEmptyState(keyPath, statePath)
+		require.True(t, newBlsPK.Equal(reloadedPV.Key.BlsPubKey))
+
+		// the checkpoint of the rotated epoch is signed with the new BLS key
+		blockHash := types.BlockHash(datagen.GenRandomByteArray(r, 32))
+		blsSig, err := k.SignBLS(rotatedEpoch, blockHash)
+		require.NoError(t, err)
+		valid, err := bls12381.Verify(blsSig, newBlsPK, types.GetSignBytes(rotatedEpoch, blockHash))
+		require.NoError(t, err)
+		require.True(t, valid)
+
+		// a BLS signer that cannot switch its BLS key is rejected
+		ctrl := gomock.NewController(t)
+		signer := mocks.NewMockBlsSigner(ctrl)
+		signer.EXPECT().GetAddress().Return(valAddr).AnyTimes()
+		signer.EXPECT().GetBlsPubkey().Return(oldBlsPK, nil).AnyTimes()
+		k2, ctx2, _ := testkeeper.CheckpointingKeeper(t, nil, signer)
+		require.NoError(t, k2.CreateRegistration(ctx2, oldBlsPK, valAddr))
+		rs2 := k2.RegistrationState(ctx2)
+		require.NoError(t, rs2.CreatePendingRotation(newBlsPK, valAddr, rotatedEpoch))
+		_, err = rs2.ApplyPendingRotations(rotatedEpoch)
+		require.NoError(t, err)
+		require.NoError(t, k2.SwitchBlsKeyAtEpoch(ctx2, rotatedEpoch-1))
+		err = k2.SwitchBlsKeyAtEpoch(ctx2, rotatedEpoch)
+		require.ErrorIs(t, err, types.ErrBlsKeyMismatch)
+	})
+}
+
+func FuzzCheckPendingBlsKey(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		dir := t.TempDir()
+		keyPath := filepath.Join(dir, "config", "priv_validator_key.json")
+		statePath := filepath.Join(dir, "data", "priv_validator_state.json")
+		require.NoError(t, cmtos.EnsureDir(filepath.Dir(keyPath), 0777))
+		pv := privval.GenWrappedFilePV(keyPath, statePath)
+		pv.SetAccAddress(datagen.GenRandomAccount().GetAddress())
+		valAddr := pv.GetAddress()
+		oldBlsPK := pv.Key.BlsPubKey
+
+		// nothing to check without a pending BLS key
+		k, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, pv)
+		require.NoError(t, k.CheckPendingBlsKey(ctx))
+		require.NoError(t, k.CreateRegistration(ctx, oldBlsPK, valAddr))
+		require.NoError(t, k.CheckPendingBlsKey(ctx))
+
+		// the BLS key is rotated from a random epoch, while the next key file
+		// is missing
+		newBlsSK := bls12381.GenPrivKey()
+		newBlsPK := newBlsSK.PubKey()
+		rotatedEpoch := datagen.RandomInt(r, 100) + 2
+		require.NoError(t, k.RegistrationState(ctx).CreatePendingRotation(newBlsPK, valAddr, rotatedEpoch))
+		err := k.CheckPendingBlsKey(ctx)
+		require.ErrorIs(t, err, types.ErrBlsKeyMismatch)
+
+		// the next key file with another BLS key is rejected
+		otherPV := privval.NewWrappedFilePV(pv.GetValPrivKey(), bls12381.GenPrivKey(), keyPath+privval.NextKeyFileSuffix, statePath)
+		otherPV.Key.Save()
+		err = k.CheckPendingBlsKey(ctx)
+		require.ErrorIs(t, err, types.ErrBlsKeyMismatch)
+
+		// the next key file with the pending BLS key passes the check,
+		// which does not switch the BLS key yet
+		nextPV := privval.NewWrappedFilePV(pv.GetValPrivKey(), newBlsSK, keyPath+privval.NextKeyFileSuffix, statePath)
+		nextPV.Key.DelegatorAddress = pv.Key.DelegatorAddress
+		nextPV.Key.Save()
+		require.NoError(t, k.CheckPendingBlsKey(ctx))
+		require.True(t, oldBlsPK.Equal(pv.Key.BlsPubKey))
+		require.True(t, cmtos.FileExists(keyPath+privval.NextKeyFileSuffix))
+
+		// a BLS signer that cannot check the next BLS key beforehand, e.g.,
+		// the remote BLS signer, passes the check
+		ctrl := gomock.NewController(t)
+		signer := mocks.NewMockBlsSigner(ctrl)
+		signer.EXPECT().GetAddress().Return(valAddr).AnyTimes()
+		k2, ctx2, _ := testkeeper.CheckpointingKeeper(t, nil, signer)
+		require.NoError(t, k2.CreateRegistration(ctx2, oldBlsPK, valAddr))
+		require.NoError(t, k2.RegistrationState(ctx2).CreatePendingRotation(newBlsPK, valAddr, rotatedEpoch))
+		require.NoError(t, k2.CheckPendingBlsKey(ctx2))
+	})
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,3 +29,42 @@ func (k Keeper) SetGenBlsKeys(ctx context.Context, genKeys []*types.GenesisKey)
 		}
 	}
 }
+
+// SetGenBlsKeyRotations records the rotated BLS keys and the pending BLS keys
+// of the validators at genesis
+func (k Keeper) SetGenBlsKeyRotations(ctx context.Context, rotatedKeys []*types.RotatedBlsKey, pendingKeys []*types.ValidatorPendingBlsKey) {
+	rs := k.RegistrationState(ctx)
+	for _, rotated := range rotatedKeys {
+		addr, err := sdk.ValAddressFromBech32(rotated.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if err := rs.SetRotatedBlsKey(addr, *rotated.Pubkey, rotated.LastEpoch); err != nil {
+			panic(fmt.Errorf("failed to set a rotated BLS key: %w", err))
+		}
+	}
+	for _, pending := range pendingKeys {
+		addr, err := sdk.ValAddressFromBech32(pending.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if err := rs.SetPendingRotation(addr, pending.PendingBlsKey); err != nil {
+			panic(fmt.Errorf("failed to set a pending BLS key: %w", err))
+		}
+	}
+}
+
+// GetGenBlsKeyRotations returns the rotated BLS keys and the pending BLS keys
+// of all validators for exporting genesis
+func (k Keeper) GetGenBlsKeyRotations(ctx context.Context) ([]*types.RotatedBlsKey, []*types.ValidatorPendingBlsKey, error) {
+	rs := k.RegistrationState(ctx)
+	rotatedKeys, err := rs.RotatedBlsKeys()
+	if err != nil {
+		return nil, nil, err
+	}
+	pendingKeys, err := rs.PendingRotations()
+	if err != nil {
+		return nil, nil, err
+	}
+	return rotatedKeys, pendingKeys, nil
+}
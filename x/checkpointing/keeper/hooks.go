@@ -18,6 +18,14 @@ func (k Keeper) AfterBlsKeyRegistered(ctx context.Context, valAddr sdk.ValAddres
 	return nil
 }
 
+// AfterBlsKeyRotated - call hook if a rotated BLS key takes effect
+func (k Keeper) AfterBlsKeyRotated(ctx context.Context, valAddr sdk.ValAddress, epoch uint64) error {
+	if k.hooks != nil {
+		return k.hooks.AfterBlsKeyRotated(ctx, valAddr, epoch)
+	}
+	return nil
+}
+
 // AfterRawCheckpointSealed - call hook if the checkpoint is sealed
 func (k Keeper) AfterRawCheckpointSealed(ctx context.Context, epoch uint64) error {
 	if k.hooks != nil {
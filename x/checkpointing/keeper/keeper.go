@@ -27,6 +27,7 @@ type (
 		blsSigner      BlsSigner
 		epochingKeeper types.EpochingKeeper
 		hooks          types.CheckpointingHooks
+		blsKeyCache    *blsKeyCache
 	}
 )
 
@@ -42,6 +43,7 @@ func NewKeeper(
 		blsSigner:      signer,
 		epochingKeeper: ek,
 		hooks:          nil,
+		blsKeyCache:    newBlsKeyCache(blsKeyCacheCapacity),
 	}
 }
 
@@ -99,14 +101,14 @@ func (k Keeper) VerifyBLSSig(ctx context.Context, sig *types.BlsSig) error {
 		return err
 	}
 
-	signerBlsKey, err := k.GetBlsPubKey(ctx, signerAddr)
+	signerBlsKey, err := k.GetDecompressedBlsPubKeyAtEpoch(ctx, signerAddr, sig.GetEpochNum())
 	if err != nil {
 		return err
 	}
 
 	// verify BLS sig
 	signBytes := types.GetSignBytes(sig.GetEpochNum(), *sig.BlockHash)
-	ok, err := bls12381.Verify(*sig.BlsSig, signerBlsKey, signBytes)
+	ok, err := bls12381.VerifyDecompressed(*sig.BlsSig, signerBlsKey, signBytes)
 	if err != nil {
 		return err
 	}
@@ -161,24 +163,15 @@ func (k Keeper) VerifyRawCheckpoint(ctx context.Context, ckpt *types.RawCheckpoi
 	// check whether sufficient voting power is accumulated
 	// and verify if the multi signature is valid
 	totalPower := k.GetTotalVotingPower(ctx, ckpt.EpochNum)
-	signerSet, err := k.GetValidatorSet(ctx, ckpt.EpochNum).FindSubset(ckpt.Bitmap)
+	aggr, sum, err := k.aggrSignersBlsPubKeys(ctx, ckpt.EpochNum, ckpt.Bitmap)
 	if err != nil {
-		return fmt.Errorf("failed to get the signer set via bitmap of epoch %d: %w", ckpt.EpochNum, err)
-	}
-	var sum int64
-	signersPubKeys := make([]bls12381.PublicKey, len(signerSet))
-	for i, v := range signerSet {
-		signersPubKeys[i], err = k.GetBlsPubKey(ctx, v.Addr)
-		if err != nil {
-			return err
-		}
-		sum += v.Power
+		return err
 	}
 	if sum*3 <= totalPower*2 {
 		return types.ErrInvalidRawCheckpoint.Wrap("insufficient voting power")
 	}
 	msgBytes := types.GetSignBytes(ckpt.GetEpochNum(), *ckpt.BlockHash)
-	ok, err := bls12381.VerifyMultiSig(*ckpt.BlsMultiSig, signersPubKeys, msgBytes)
+	ok, err := aggr.VerifyMultiSig(*ckpt.BlsMultiSig, msgBytes)
 	if err != nil {
 		return err
 	}
@@ -373,16 +366,15 @@ func (k Keeper) CreateRegistration(ctx context.Context, blsPubKey bls12381.Publi
 
 // GetBLSPubKeySet returns the set of BLS public keys in the same order of the validator set for a given epoch
 func (k Keeper) GetBLSPubKeySet(ctx context.Context, epochNumber uint64) ([]*types.ValidatorWithBlsKey, error) {
-	valset := k.GetValidatorSet(ctx, epochNumber)
-	valWithblsKeys := make([]*types.ValidatorWithBlsKey, len(valset))
-	for i, val := range valset {
-		pubkey, err := k.GetBlsPubKey(ctx, val.Addr)
-		if err != nil {
-			return nil, err
-		}
+	keys, err := k.getEpochBlsKeys(ctx, epochNumber)
+	if err != nil {
+		return nil, err
+	}
+	valWithblsKeys := make([]*types.ValidatorWithBlsKey, len(keys.valSet))
+	for i, val := range keys.valSet {
 		valWithblsKeys[i] = &types.ValidatorWithBlsKey{
 			ValidatorAddress: val.GetValAddressStr(),
-			BlsPubKey:        pubkey,
+			BlsPubKey:        keys.blsKeys[i],
 			VotingPower:      uint64(val.Power),
 		}
 	}
@@ -394,6 +386,60 @@ func (k Keeper) GetBlsPubKey(ctx context.Context, address sdk.ValAddress) (bls12
 	return k.RegistrationState(ctx).GetBlsPubKey(address)
 }
 
+// GetBlsPubKeyAtEpoch returns the BLS public key of the validator that is in
+// effect at the given epoch, which differs from the current BLS public key
+// if the BLS key has been rotated since the epoch
+func (k Keeper) GetBlsPubKeyAtEpoch(ctx context.Context, address sdk.ValAddress, epochNumber uint64) (bls12381.PublicKey, error) {
+	return k.RegistrationState(ctx).GetBlsPubKeyAtEpoch(address, epochNumber)
+}
+
+// GetPendingBlsPubKey returns the new BLS public key of the validator that
+// takes effect in the next epoch
+func (k Keeper) GetPendingBlsPubKey(ctx context.Context, address sdk.ValAddress) (bls12381.PublicKey, error) {
+	return k.RegistrationState(ctx).GetPendingBlsPubKey(address)
+}
+
+// ApplyBlsKeyRotations lets the pending BLS keys of the validators take
+// effect from the current epoch. This is called upon the first block of an
+// epoch, before the validator BLS set of the epoch is stored.
+func (k Keeper) ApplyBlsKeyRotations(ctx context.Context) error {
+	epochNumber := k.GetEpoch(ctx).EpochNumber
+	if epochNumber == 0 {
+		return nil
+	}
+	rs := k.RegistrationState(ctx)
+	valAddrs, err := rs.ApplyPendingRotations(epochNumber)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, valAddr := range valAddrs {
+		oldKey, err := rs.GetBlsPubKeyAtEpoch(valAddr, epochNumber-1)
+		if err != nil {
+			return err
+		}
+		newKey, err := rs.GetBlsPubKey(valAddr)
+		if err != nil {
+			return err
+		}
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventBlsKeyRotated{
+			ValidatorAddress: valAddr.String(),
+			OldBlsPubKey:     &oldKey,
+			NewBlsPubKey:     &newKey,
+			EpochNum:         epochNumber,
+		}); err != nil {
+			k.Logger(sdkCtx).Error("failed to emit BLS key rotated event", "validator", valAddr.String(), "err", err)
+		}
+		if err := k.AfterBlsKeyRotated(ctx, valAddr, epochNumber); err != nil {
+			k.Logger(sdkCtx).Error("failed to trigger BLS key rotated hook", "validator", valAddr.String(), "err", err)
+		}
+		k.Logger(sdkCtx).Info(fmt.Sprintf("Checkpointing: BLS key of validator %s is rotated from epoch %d", valAddr.String(), epochNumber))
+	}
+
+	return nil
+}
+
 func (k Keeper) GetEpoch(ctx context.Context) *epochingtypes.Epoch {
 	return k.epochingKeeper.GetEpoch(ctx)
 }
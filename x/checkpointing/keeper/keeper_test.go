@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"testing"
 
+	storetypes "cosmossdk.io/store/types"
 	"github.com/boljen/go-bitmap"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -229,6 +230,61 @@ func FuzzKeeperCheckpointEpoch(f *testing.F) {
 	})
 }
 
+// FuzzKeeperVerifyCheckpointGas checks that verifying a checkpoint consumes
+// the same gas with a cold and a warm BLS key cache, as the state of the
+// cache differs across nodes
+func FuzzKeeperVerifyCheckpointGas(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetValidatorSet(gomock.Any(), gomock.Any()).Return(valSet).AnyTimes()
+		ek.EXPECT().GetTotalVotingPower(gomock.Any(), gomock.Any()).Return(int64(10)).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+		for i, val := range valSet {
+			err := ckptKeeper.CreateRegistration(ctx, pubkeys[i], val.Addr)
+			require.NoError(t, err)
+		}
+
+		// add an accumulating local checkpoint signed by the first validator,
+		// such that the same checkpoint from BTC is fully verified
+		bm := bitmap.New(types.BitmapBits)
+		bm.Set(0, true)
+		localCkptWithMeta := datagen.GenRandomRawCheckpointWithMeta(r)
+		localCkptWithMeta.Status = types.Accumulating
+		localCkptWithMeta.Ckpt.Bitmap = bm
+		msgBytes := types.GetSignBytes(localCkptWithMeta.Ckpt.EpochNum, *localCkptWithMeta.Ckpt.BlockHash)
+		sig := bls12381.Sign(blsPrivKey1, msgBytes)
+		localCkptWithMeta.Ckpt.BlsMultiSig = &sig
+		_ = ckptKeeper.AddRawCheckpoint(ctx, localCkptWithMeta)
+		rawBtcCheckpoint := makeBtcCkptBytes(
+			r,
+			localCkptWithMeta.Ckpt.EpochNum,
+			localCkptWithMeta.Ckpt.BlockHash.MustMarshal(),
+			localCkptWithMeta.Ckpt.Bitmap,
+			localCkptWithMeta.Ckpt.BlsMultiSig.Bytes(),
+			t,
+		)
+
+		// the first verification populates the cache, and the following
+		// ones use the cache
+		gasUsed := make([]uint64, 3)
+		for i := range gasUsed {
+			gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			err := ckptKeeper.VerifyCheckpoint(gasCtx, *rawBtcCheckpoint)
+			require.NoError(t, err)
+			gasUsed[i] = gasCtx.GasMeter().GasConsumed()
+		}
+		require.NotZero(t, gasUsed[0])
+		for i := range gasUsed {
+			require.Equal(t, gasUsed[0], gasUsed[i])
+		}
+	})
+}
+
 func makeBtcCkptBytes(r *rand.Rand, epoch uint64, appHash []byte, bitmap []byte, blsSig []byte, t *testing.T) *btctxformatter.RawBtcCheckpoint {
 	tag := datagen.GenRandomByteArray(r, btctxformatter.TagLength)
 	babylonTag := btctxformatter.BabylonTag(tag[:btctxformatter.TagLength])
@@ -53,3 +53,41 @@ func (m msgServer) WrappedCreateValidator(goCtx context.Context, msg *types.MsgW
 
 	return &types.MsgWrappedCreateValidatorResponse{}, err
 }
+
+// RotateBlsKey records the new BLS public key of a validator, which takes
+// effect from the next epoch
+func (m msgServer) RotateBlsKey(goCtx context.Context, msg *types.MsgRotateBlsKey) (*types.MsgRotateBlsKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	valAddr := sdk.ValAddress(signer)
+
+	// verify the proof-of-possession of the new BLS key w.r.t. the consensus
+	// public key of the validator
+	valPubKey, err := m.k.epochingKeeper.GetValidatorConsPubKey(ctx, valAddr)
+	if err != nil {
+		return nil, types.ErrInvalidBlsKeyRotation.Wrapf("failed to get the validator: %v", err)
+	}
+	if !msg.VerifyPoP(valPubKey) {
+		return nil, types.ErrInvalidPoP
+	}
+
+	// store the pending BLS public key, which takes effect from the next epoch
+	effectiveEpoch := m.k.GetEpoch(ctx).EpochNumber + 1
+	if err := m.k.RegistrationState(ctx).CreatePendingRotation(*msg.Key.Pubkey, valAddr, effectiveEpoch); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBlsKeyRotationRequested{
+		ValidatorAddress: valAddr.String(),
+		NewBlsPubKey:     msg.Key.Pubkey,
+		EpochNum:         effectiveEpoch,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateBlsKeyResponse{}, nil
+}
@@ -202,6 +202,116 @@ func FuzzWrappedCreateValidator(f *testing.F) {
 	})
 }
 
+// FuzzRotateBlsKey tests rotating the BLS key of a validator via
+// MsgRotateBlsKey, where the new BLS key takes effect from the next epoch
+// and the old BLS key remains for verifying the checkpoints of the previous
+// epochs
+func FuzzRotateBlsKey(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 4)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// a genesis validator is generate for setup
+		helper := testhelper.NewHelper(t)
+		ctx := helper.Ctx
+		ek := helper.App.EpochingKeeper
+		ck := helper.App.CheckpointingKeeper
+		msgServer := checkpointingkeeper.NewMsgServerImpl(ck)
+
+		// epoch 1 right now
+		epoch := ek.GetEpoch(ctx)
+		require.Equal(t, uint64(1), epoch.EpochNumber)
+
+		val := helper.GenValidators.Keys[0]
+		valAddr, err := sdk.ValAddressFromBech32(val.ValidatorAddress)
+		require.NoError(t, err)
+		oldBlsPK, err := ck.GetBlsPubKey(ctx, valAddr)
+		require.NoError(t, err)
+
+		// a new BLS key with a PoP w.r.t. another consensus key is rejected
+		newBlsSK := bls12381.GenPrivKey()
+		newBlsPK := newBlsSK.PubKey()
+		invalidPoP, err := privval.BuildPoP(ed25519.GenPrivKey(), newBlsSK)
+		require.NoError(t, err)
+		_, err = msgServer.RotateBlsKey(ctx, types.NewMsgRotateBlsKey(sdk.AccAddress(valAddr), &newBlsPK, invalidPoP))
+		require.ErrorIs(t, err, types.ErrInvalidPoP)
+
+		// the current BLS key cannot be rotated to
+		oldPoP, err := privval.BuildPoP(val.PrivKey, val.PrivateKey)
+		require.NoError(t, err)
+		_, err = msgServer.RotateBlsKey(ctx, types.NewMsgRotateBlsKey(sdk.AccAddress(valAddr), &oldBlsPK, oldPoP))
+		require.ErrorIs(t, err, types.ErrBlsKeyAlreadyExist)
+
+		// a non-validator cannot rotate the BLS key
+		pop, err := privval.BuildPoP(val.PrivKey, newBlsSK)
+		require.NoError(t, err)
+		_, err = msgServer.RotateBlsKey(ctx, types.NewMsgRotateBlsKey(datagen.GenRandomAccount().GetAddress(), &newBlsPK, pop))
+		require.ErrorIs(t, err, types.ErrInvalidBlsKeyRotation)
+
+		// rotate the BLS key, which does not take effect in the current epoch
+		msg := types.NewMsgRotateBlsKey(sdk.AccAddress(valAddr), &newBlsPK, pop)
+		require.NoError(t, msg.ValidateBasic())
+		_, err = msgServer.RotateBlsKey(ctx, msg)
+		require.NoError(t, err)
+		blsPK, err := ck.GetBlsPubKey(ctx, valAddr)
+		require.NoError(t, err)
+		require.True(t, oldBlsPK.Equal(blsPK))
+		pendingBlsPK, err := ck.GetPendingBlsPubKey(ctx, valAddr)
+		require.NoError(t, err)
+		require.True(t, newBlsPK.Equal(pendingBlsPK))
+
+		// the pending BLS key cannot be registered by another validator
+		addrs, err := app.AddTestAddrs(helper.App, helper.Ctx, 1, math.NewInt(100000000))
+		require.NoError(t, err)
+		err = ck.CreateRegistration(ctx, newBlsPK, sdk.ValAddress(addrs[0]))
+		require.ErrorIs(t, err, types.ErrBlsKeyAlreadyExist)
+
+		// go to epoch 2, where the checkpoint of epoch 1 is signed with the
+		// old BLS key
+		for ek.GetEpoch(ctx).EpochNumber < 2 {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+
+		// the new BLS key takes effect from epoch 2
+		blsPK, err = ck.GetBlsPubKey(ctx, valAddr)
+		require.NoError(t, err)
+		require.True(t, newBlsPK.Equal(blsPK))
+		_, err = ck.GetPendingBlsPubKey(ctx, valAddr)
+		require.ErrorIs(t, err, types.ErrBlsKeyDoesNotExist)
+		require.True(t, newBlsPK.Equal(ck.GetValidatorBlsKeySet(ctx, 2).ValSet[0].BlsPubKey))
+		require.True(t, oldBlsPK.Equal(ck.GetValidatorBlsKeySet(ctx, 1).ValSet[0].BlsPubKey))
+
+		// the old BLS key remains for epoch 1
+		blsPK, err = ck.GetBlsPubKeyAtEpoch(ctx, valAddr, 1)
+		require.NoError(t, err)
+		require.True(t, oldBlsPK.Equal(blsPK))
+		blsPK, err = ck.GetBlsPubKeyAtEpoch(ctx, valAddr, 2)
+		require.NoError(t, err)
+		require.True(t, newBlsPK.Equal(blsPK))
+		ckpt1, err := ck.GetRawCheckpoint(ctx, 1)
+		require.NoError(t, err)
+		require.NoError(t, ck.VerifyRawCheckpoint(ctx, ckpt1.Ckpt))
+
+		// the old BLS key cannot be registered again
+		err = ck.CreateRegistration(ctx, oldBlsPK, sdk.ValAddress(addrs[0]))
+		require.ErrorIs(t, err, types.ErrBlsKeyAlreadyExist)
+
+		// go to epoch 3, where the checkpoint of epoch 2 is signed with the
+		// new BLS key
+		val.PrivateKey = newBlsSK
+		for ek.GetEpoch(ctx).EpochNumber < 3 {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		ckpt2, err := ck.GetRawCheckpoint(ctx, 2)
+		require.NoError(t, err)
+		require.NoError(t, ck.VerifyRawCheckpoint(ctx, ckpt2.Ckpt))
+		require.NoError(t, ck.VerifyRawCheckpoint(ctx, ckpt1.Ckpt))
+	})
+}
+
 func buildMsgWrappedCreateValidator(addr sdk.AccAddress) (*types.MsgWrappedCreateValidator, error) {
 	bondTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	return buildMsgWrappedCreateValidatorWithAmount(addr, bondTokens)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 type RegistrationState struct {
@@ -18,15 +20,23 @@ type RegistrationState struct {
 	addrToBlsKeys storetypes.KVStore
 	// blsKeysToAddr maps BLS public keys to validator addresses
 	blsKeysToAddr storetypes.KVStore
+	// pendingBlsKeys maps validator addresses to the new BLS public keys
+	// that take effect in the next epoch
+	pendingBlsKeys storetypes.KVStore
+	// blsKeyHistory maps validator addresses and epoch numbers to the
+	// rotated BLS public keys that were in effect until the epochs
+	blsKeyHistory storetypes.KVStore
 }
 
 func (k Keeper) RegistrationState(ctx context.Context) RegistrationState {
 	// Build the RegistrationState storage
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return RegistrationState{
-		cdc:           k.cdc,
-		addrToBlsKeys: prefix.NewStore(storeAdapter, types.AddrToBlsKeyPrefix),
-		blsKeysToAddr: prefix.NewStore(storeAdapter, types.BlsKeyToAddrPrefix),
+		cdc:            k.cdc,
+		addrToBlsKeys:  prefix.NewStore(storeAdapter, types.AddrToBlsKeyPrefix),
+		blsKeysToAddr:  prefix.NewStore(storeAdapter, types.BlsKeyToAddrPrefix),
+		pendingBlsKeys: prefix.NewStore(storeAdapter, types.PendingBlsKeyPrefix),
+		blsKeyHistory:  prefix.NewStore(storeAdapter, types.BlsKeyHistoryPrefix),
 	}
 }
 
@@ -77,3 +87,197 @@ func (rs RegistrationState) Exists(addr sdk.ValAddress) bool {
 	pkKey := types.AddrToBlsKeyKey(addr)
 	return rs.addrToBlsKeys.Has(pkKey)
 }
+
+// CreatePendingRotation records the new BLS key of a registered validator,
+// which takes effect from the given epoch upon ApplyPendingRotations. A
+// pending BLS key replaces the previous pending BLS key of the validator, if
+// any.
+func (rs RegistrationState) CreatePendingRotation(key bls12381.PublicKey, valAddr sdk.ValAddress, effectiveEpoch uint64) error {
+	if !rs.Exists(valAddr) {
+		return types.ErrBlsKeyDoesNotExist.Wrapf("the validator has not registered a BLS public key")
+	}
+
+	// we should disallow a BLS public key that is or was registered, or is
+	// pending, including by the validator itself
+	bkToAddrKey := types.BlsKeyToAddrKey(key)
+	if rs.blsKeysToAddr.Has(bkToAddrKey) {
+		return types.ErrBlsKeyAlreadyExist.Wrapf("the BLS public key has been registered")
+	}
+
+	// release the previous pending BLS public key
+	if prev, err := rs.getPendingBlsKey(valAddr); err == nil {
+		rs.blsKeysToAddr.Delete(types.BlsKeyToAddrKey(*prev.Pubkey))
+	}
+
+	// the pending BLS public key is reserved so that no other validator can
+	// register it
+	pending := &types.PendingBlsKey{
+		Pubkey:         &key,
+		EffectiveEpoch: effectiveEpoch,
+	}
+	rs.pendingBlsKeys.Set(types.PendingBlsKeyKey(valAddr), rs.cdc.MustMarshal(pending))
+	rs.blsKeysToAddr.Set(bkToAddrKey, valAddr.Bytes())
+
+	return nil
+}
+
+// GetPendingBlsPubKey retrieves the pending BLS public key by validator's address
+func (rs RegistrationState) GetPendingBlsPubKey(addr sdk.ValAddress) (bls12381.PublicKey, error) {
+	pending, err := rs.getPendingBlsKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	return *pending.Pubkey, nil
+}
+
+func (rs RegistrationState) getPendingBlsKey(addr sdk.ValAddress) (*types.PendingBlsKey, error) {
+	rawBytes := rs.pendingBlsKeys.Get(types.PendingBlsKeyKey(addr))
+	if rawBytes == nil {
+		return nil, types.ErrBlsKeyDoesNotExist.Wrapf("pending BLS public key does not exist with address %s", addr)
+	}
+	pending := new(types.PendingBlsKey)
+	if err := rs.cdc.Unmarshal(rawBytes, pending); err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+// ApplyPendingRotations replaces the BLS public keys of the validators with
+// their pending BLS public keys that take effect from the given epoch, where
+// the replaced BLS public keys were in effect until the previous epoch. It
+// returns the addresses of the validators whose BLS public keys are rotated.
+func (rs RegistrationState) ApplyPendingRotations(epoch uint64) ([]sdk.ValAddress, error) {
+	// collect the pending BLS keys before modifying the store
+	var (
+		valAddrs []sdk.ValAddress
+		newKeys  []bls12381.PublicKey
+	)
+	iter := rs.pendingBlsKeys.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		pending := new(types.PendingBlsKey)
+		if err := rs.cdc.Unmarshal(iter.Value(), pending); err != nil {
+			iter.Close()
+			return nil, err
+		}
+		if pending.EffectiveEpoch > epoch {
+			continue
+		}
+		valAddrs = append(valAddrs, sdk.ValAddress(iter.Key()))
+		newKeys = append(newKeys, *pending.Pubkey)
+	}
+	iter.Close()
+
+	for i, valAddr := range valAddrs {
+		oldKey, err := rs.GetBlsPubKey(valAddr)
+		if err != nil {
+			return nil, err
+		}
+		// the old BLS public key remains mapped to the validator, so that
+		// it cannot be registered again
+		rs.blsKeyHistory.Set(types.BlsKeyHistoryKey(valAddr, epoch-1), oldKey)
+		rs.addrToBlsKeys.Set(types.AddrToBlsKeyKey(valAddr), newKeys[i])
+		rs.pendingBlsKeys.Delete(types.PendingBlsKeyKey(valAddr))
+	}
+
+	return valAddrs, nil
+}
+
+// GetBlsPubKeyAtEpoch retrieves the BLS public key that is in effect at the
+// given epoch by validator's address
+func (rs RegistrationState) GetBlsPubKeyAtEpoch(addr sdk.ValAddress, epoch uint64) (bls12381.PublicKey, error) {
+	// the first rotated BLS key whose last epoch is no earlier than the
+	// given epoch was in effect at the epoch
+	start := types.BlsKeyHistoryKey(addr, epoch)
+	end := storetypes.PrefixEndBytes(address.MustLengthPrefix(addr))
+	iter := rs.blsKeyHistory.Iterator(start, end)
+	defer iter.Close()
+	if iter.Valid() {
+		pk := new(bls12381.PublicKey)
+		err := pk.Unmarshal(iter.Value())
+		return *pk, err
+	}
+
+	// otherwise, the BLS key has not been rotated since the epoch
+	return rs.GetBlsPubKey(addr)
+}
+
+// SetPendingRotation records the given pending BLS key of the validator at
+// genesis, and reserves the BLS key so that no other validator can register
+// it
+func (rs RegistrationState) SetPendingRotation(valAddr sdk.ValAddress, pending *types.PendingBlsKey) error {
+	if err := rs.reserveBlsKey(*pending.Pubkey, valAddr); err != nil {
+		return err
+	}
+	rs.pendingBlsKeys.Set(types.PendingBlsKeyKey(valAddr), rs.cdc.MustMarshal(pending))
+	return nil
+}
+
+// SetRotatedBlsKey records the given rotated BLS key of the validator, which
+// was in effect until the given epoch, at genesis, and reserves the BLS key
+// so that it cannot be registered again
+func (rs RegistrationState) SetRotatedBlsKey(valAddr sdk.ValAddress, key bls12381.PublicKey, lastEpoch uint64) error {
+	if err := rs.reserveBlsKey(key, valAddr); err != nil {
+		return err
+	}
+	rs.blsKeyHistory.Set(types.BlsKeyHistoryKey(valAddr, lastEpoch), key)
+	return nil
+}
+
+// reserveBlsKey maps the BLS key to the validator, unless it is mapped to
+// another validator
+func (rs RegistrationState) reserveBlsKey(key bls12381.PublicKey, valAddr sdk.ValAddress) error {
+	bkToAddrKey := types.BlsKeyToAddrKey(key)
+	rawAddr := rs.blsKeysToAddr.Get(bkToAddrKey)
+	if rawAddr != nil && !sdk.ValAddress(rawAddr).Equals(valAddr) {
+		return types.ErrBlsKeyAlreadyExist.Wrapf("same BLS public key is registered by another validator")
+	}
+	rs.blsKeysToAddr.Set(bkToAddrKey, valAddr.Bytes())
+	return nil
+}
+
+// PendingRotations returns the pending BLS keys of all validators
+func (rs RegistrationState) PendingRotations() ([]*types.ValidatorPendingBlsKey, error) {
+	iter := rs.pendingBlsKeys.Iterator(nil, nil)
+	defer iter.Close()
+
+	pendingKeys := make([]*types.ValidatorPendingBlsKey, 0)
+	for ; iter.Valid(); iter.Next() {
+		pending := new(types.PendingBlsKey)
+		if err := rs.cdc.Unmarshal(iter.Value(), pending); err != nil {
+			return nil, err
+		}
+		pendingKeys = append(pendingKeys, &types.ValidatorPendingBlsKey{
+			ValidatorAddress: sdk.ValAddress(iter.Key()).String(),
+			PendingBlsKey:    pending,
+		})
+	}
+	return pendingKeys, nil
+}
+
+// RotatedBlsKeys returns the rotated BLS keys of all validators, along with
+// the last epochs in which they were in effect
+func (rs RegistrationState) RotatedBlsKeys() ([]*types.RotatedBlsKey, error) {
+	iter := rs.blsKeyHistory.Iterator(nil, nil)
+	defer iter.Close()
+
+	rotatedKeys := make([]*types.RotatedBlsKey, 0)
+	for ; iter.Valid(); iter.Next() {
+		// key contains the length-prefixed validator address and the last epoch
+		key := iter.Key()
+		if len(key) < 9 || len(key) != 1+int(key[0])+8 {
+			return nil, fmt.Errorf("malformed key of rotated BLS key: %X", key)
+		}
+		valAddr := sdk.ValAddress(key[1 : 1+key[0]])
+		lastEpoch := sdk.BigEndianToUint64(key[1+key[0]:])
+		pk := new(bls12381.PublicKey)
+		if err := pk.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		rotatedKeys = append(rotatedKeys, &types.RotatedBlsKey{
+			ValidatorAddress: valAddr.String(),
+			Pubkey:           pk,
+			LastEpoch:        lastEpoch,
+		})
+	}
+	return rotatedKeys, nil
+}
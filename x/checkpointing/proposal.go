@@ -13,6 +13,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	"github.com/babylonchain/babylon/crypto/bls12381"
 	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
 )
 
@@ -116,6 +117,7 @@ func (h *ProposalHandler) buildCheckpointFromVoteExtensions(ctx sdk.Context, epo
 	validBLSSigs := h.getValidBlsSigs(ctx, extendedVotes, prevBlockID)
 	vals := h.ckptKeeper.GetValidatorSet(ctx, epoch)
 	totalPower := h.ckptKeeper.GetTotalVotingPower(ctx, epoch)
+	aggr := bls12381.NewPubKeyAggregator()
 	// TODO: maybe we don't need to verify BLS sigs anymore as they are already
 	//  verified by VerifyVoteExtension
 	for _, sig := range validBLSSigs {
@@ -128,7 +130,7 @@ func (h *ProposalHandler) buildCheckpointFromVoteExtensions(ctx sdk.Context, epo
 			)
 			continue
 		}
-		signerBlsKey, err := h.ckptKeeper.GetBlsPubKey(ctx, signerAddress)
+		signerBlsKey, err := h.ckptKeeper.GetDecompressedBlsPubKeyAtEpoch(ctx, signerAddress, epoch)
 		if err != nil {
 			h.logger.Error(
 				"skip invalid BLS sig",
@@ -136,7 +138,7 @@ func (h *ProposalHandler) buildCheckpointFromVoteExtensions(ctx sdk.Context, epo
 			)
 			continue
 		}
-		err = ckpt.Accumulate(vals, signerAddress, signerBlsKey, *sig.BlsSig, totalPower)
+		err = ckpt.AccumulateWithAggregator(vals, signerAddress, signerBlsKey, *sig.BlsSig, totalPower, aggr)
 		if err != nil {
 			h.logger.Error(
 				"skip invalid BLS sig",
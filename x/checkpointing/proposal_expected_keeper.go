@@ -15,7 +15,7 @@ type CheckpointingKeeper interface {
 	GetEpoch(ctx context.Context) *epochingtypes.Epoch
 	GetValidatorSet(ctx context.Context, epochNumber uint64) epochingtypes.ValidatorSet
 	GetTotalVotingPower(ctx context.Context, epochNumber uint64) int64
-	GetBlsPubKey(ctx context.Context, address sdk.ValAddress) (bls12381.PublicKey, error)
+	GetDecompressedBlsPubKeyAtEpoch(ctx context.Context, address sdk.ValAddress, epochNumber uint64) (*bls12381.BlsPubKey, error)
 	VerifyBLSSig(ctx context.Context, sig *types.BlsSig) error
 	SealCheckpoint(ctx context.Context, ckptWithMeta *types.RawCheckpointWithMeta) error
 }
@@ -105,6 +105,12 @@ func (v *TestValidator) BlsPubKey() bls12381.PublicKey {
 	return *v.Keys.BlsKey.Pubkey
 }
 
+func (v *TestValidator) DecompressedBlsPubKey(t *testing.T) *bls12381.BlsPubKey {
+	decompressed, err := bls12381.DecompressPubKey(v.BlsPubKey())
+	require.NoError(t, err)
+	return decompressed
+}
+
 func genNTestValidators(t *testing.T, n int) []TestValidator {
 	if n == 0 {
 		return []TestValidator{}
@@ -321,7 +327,7 @@ func TestPrepareProposalAtVoteExtensionHeight(t *testing.T) {
 					validator := val
 					ek.EXPECT().GetPubKeyByConsAddr(gomock.Any(), sdk.ConsAddress(validator.ValidatorAddress(t).Bytes())).Return(validator.ProtoPubkey(), nil).AnyTimes()
 					ek.EXPECT().VerifyBLSSig(gomock.Any(), validatorAndExtensions.Extensions[i].ToBLSSig()).Return(nil).AnyTimes()
-					ek.EXPECT().GetBlsPubKey(gomock.Any(), validator.ValidatorAddress(t)).Return(validator.BlsPubKey(), nil).AnyTimes()
+					ek.EXPECT().GetDecompressedBlsPubKeyAtEpoch(gomock.Any(), validator.ValidatorAddress(t), gomock.Any()).Return(validator.DecompressedBlsPubKey(t), nil).AnyTimes()
 					// empty vote extension
 					signedExtension := validator.SignVoteExtension(t, []byte{}, ec.Ctx.HeaderInfo().Height-1, ec.Ctx.ChainID())
 					signedVoteExtensions = append(signedVoteExtensions, signedExtension)
@@ -355,7 +361,7 @@ func TestPrepareProposalAtVoteExtensionHeight(t *testing.T) {
 					} else {
 						ek.EXPECT().VerifyBLSSig(gomock.Any(), validatorAndExtensions.Extensions[i].ToBLSSig()).Return(nil).AnyTimes()
 					}
-					ek.EXPECT().GetBlsPubKey(gomock.Any(), validator.ValidatorAddress(t)).Return(validator.BlsPubKey(), nil).AnyTimes()
+					ek.EXPECT().GetDecompressedBlsPubKeyAtEpoch(gomock.Any(), validator.ValidatorAddress(t), gomock.Any()).Return(validator.DecompressedBlsPubKey(t), nil).AnyTimes()
 					marshaledExtension, err := validatorAndExtensions.Extensions[i].Marshal()
 					require.NoError(t, err)
 					signedExtension := validator.SignVoteExtension(t, marshaledExtension, ec.Ctx.HeaderInfo().Height-1, ec.Ctx.ChainID())
@@ -390,7 +396,7 @@ func TestPrepareProposalAtVoteExtensionHeight(t *testing.T) {
 					} else {
 						ek.EXPECT().VerifyBLSSig(gomock.Any(), validatorAndExtensions.Extensions[i].ToBLSSig()).Return(nil).AnyTimes()
 					}
-					ek.EXPECT().GetBlsPubKey(gomock.Any(), validator.ValidatorAddress(t)).Return(validator.BlsPubKey(), nil).AnyTimes()
+					ek.EXPECT().GetDecompressedBlsPubKeyAtEpoch(gomock.Any(), validator.ValidatorAddress(t), gomock.Any()).Return(validator.DecompressedBlsPubKey(t), nil).AnyTimes()
 					marshaledExtension, err := validatorAndExtensions.Extensions[i].Marshal()
 					require.NoError(t, err)
 					signedExtension := validator.SignVoteExtension(t, marshaledExtension, ec.Ctx.HeaderInfo().Height-1, ec.Ctx.ChainID())
@@ -427,7 +433,7 @@ func TestPrepareProposalAtVoteExtensionHeight(t *testing.T) {
 					validator := val
 					ek.EXPECT().GetPubKeyByConsAddr(gomock.Any(), sdk.ConsAddress(validator.ValidatorAddress(t).Bytes())).Return(validator.ProtoPubkey(), nil).AnyTimes()
 					ek.EXPECT().VerifyBLSSig(gomock.Any(), allExtensions[i].ToBLSSig()).Return(nil).AnyTimes()
-					ek.EXPECT().GetBlsPubKey(gomock.Any(), validator.ValidatorAddress(t)).Return(validator.BlsPubKey(), nil).AnyTimes()
+					ek.EXPECT().GetDecompressedBlsPubKeyAtEpoch(gomock.Any(), validator.ValidatorAddress(t), gomock.Any()).Return(validator.DecompressedBlsPubKey(t), nil).AnyTimes()
 					marshaledExtension, err := allExtensions[i].Marshal()
 					require.NoError(t, err)
 					signedExtension := validator.SignVoteExtension(t, marshaledExtension, ec.Ctx.HeaderInfo().Height-1, ec.Ctx.ChainID())
@@ -453,7 +459,7 @@ func TestPrepareProposalAtVoteExtensionHeight(t *testing.T) {
 					validator := val
 					ek.EXPECT().GetPubKeyByConsAddr(gomock.Any(), sdk.ConsAddress(validator.ValidatorAddress(t).Bytes())).Return(validator.ProtoPubkey(), nil).AnyTimes()
 					ek.EXPECT().VerifyBLSSig(gomock.Any(), validatorAndExtensions.Extensions[i].ToBLSSig()).Return(nil).AnyTimes()
-					ek.EXPECT().GetBlsPubKey(gomock.Any(), validator.ValidatorAddress(t)).Return(validator.BlsPubKey(), nil).AnyTimes()
+					ek.EXPECT().GetDecompressedBlsPubKeyAtEpoch(gomock.Any(), validator.ValidatorAddress(t), gomock.Any()).Return(validator.DecompressedBlsPubKey(t), nil).AnyTimes()
 					marshaledExtension, err := validatorAndExtensions.Extensions[i].Marshal()
 					require.NoError(t, err)
 					signedExtension := validator.SignVoteExtension(t, marshaledExtension, ec.Ctx.HeaderInfo().Height-1, ec.Ctx.ChainID())
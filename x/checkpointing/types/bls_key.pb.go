@@ -310,12 +310,63 @@ func (m *VoteExtension) GetHeight() uint64 {
 	return 0
 }
 
+// PendingBlsKey is a new BLS public key of a validator that is pending to
+// take effect
+type PendingBlsKey struct {
+	// pubkey is the new BLS public key of the validator
+	Pubkey *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,1,opt,name=pubkey,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"pubkey,omitempty"`
+	// effective_epoch is the epoch from which the new BLS public key takes
+	// effect
+	EffectiveEpoch uint64 `protobuf:"varint,2,opt,name=effective_epoch,json=effectiveEpoch,proto3" json:"effective_epoch,omitempty"`
+}
+
+func (m *PendingBlsKey) Reset()         { *m = PendingBlsKey{} }
+func (m *PendingBlsKey) String() string { return proto.CompactTextString(m) }
+func (*PendingBlsKey) ProtoMessage()    {}
+func (*PendingBlsKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8c0d37ce63f038, []int{5}
+}
+func (m *PendingBlsKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBlsKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBlsKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBlsKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBlsKey.Merge(m, src)
+}
+func (m *PendingBlsKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBlsKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBlsKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBlsKey proto.InternalMessageInfo
+
+func (m *PendingBlsKey) GetEffectiveEpoch() uint64 {
+	if m != nil {
+		return m.EffectiveEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BlsKey)(nil), "babylon.checkpointing.v1.BlsKey")
 	proto.RegisterType((*ProofOfPossession)(nil), "babylon.checkpointing.v1.ProofOfPossession")
 	proto.RegisterType((*ValidatorWithBlsKeySet)(nil), "babylon.checkpointing.v1.ValidatorWithBlsKeySet")
 	proto.RegisterType((*ValidatorWithBlsKey)(nil), "babylon.checkpointing.v1.ValidatorWithBlsKey")
 	proto.RegisterType((*VoteExtension)(nil), "babylon.checkpointing.v1.VoteExtension")
+	proto.RegisterType((*PendingBlsKey)(nil), "babylon.checkpointing.v1.PendingBlsKey")
 }
 
 func init() {
@@ -323,41 +374,43 @@ func init() {
 }
 
 var fileDescriptor_3a8c0d37ce63f038 = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x8b, 0xd3, 0x4e,
-	0x18, 0xc7, 0x3b, 0xed, 0xfe, 0xb2, 0xbf, 0x4e, 0xbb, 0xe0, 0x46, 0x59, 0x82, 0x42, 0x5a, 0x7b,
-	0x90, 0xc2, 0x6a, 0x42, 0xbb, 0x14, 0xdc, 0xc3, 0x1e, 0x2c, 0x28, 0xc2, 0x82, 0x5b, 0x52, 0xac,
-	0xe0, 0x25, 0x66, 0xd2, 0xd9, 0xcc, 0xd0, 0x69, 0x66, 0xc8, 0x4c, 0xe2, 0xe6, 0x05, 0x78, 0x13,
-	0xf4, 0x15, 0xf8, 0x7a, 0x3c, 0xee, 0x51, 0xf6, 0xb0, 0x48, 0xfb, 0x46, 0x64, 0x92, 0xb8, 0xf8,
-	0xa7, 0x45, 0x10, 0x6f, 0x33, 0xdf, 0xef, 0x93, 0xef, 0x7c, 0x9e, 0x67, 0x32, 0xf0, 0x01, 0x0a,
-	0x50, 0xce, 0x78, 0xec, 0x86, 0x04, 0x87, 0x0b, 0xc1, 0x69, 0xac, 0x68, 0x1c, 0xb9, 0xd9, 0xc0,
-	0x45, 0x4c, 0xfa, 0x0b, 0x9c, 0x3b, 0x22, 0xe1, 0x8a, 0x9b, 0x56, 0x55, 0xe7, 0xfc, 0x54, 0xe7,
-	0x64, 0x83, 0xbb, 0x77, 0x22, 0x1e, 0xf1, 0xa2, 0xc8, 0xd5, 0xab, 0xb2, 0xbe, 0xf7, 0x09, 0x40,
-	0x63, 0xcc, 0xe4, 0x29, 0xce, 0xcd, 0x97, 0xd0, 0x10, 0x29, 0x5a, 0xe0, 0xdc, 0x02, 0x5d, 0xd0,
-	0x6f, 0x8f, 0x4f, 0xae, 0xae, 0x3b, 0xc7, 0x11, 0x55, 0x24, 0x45, 0x4e, 0xc8, 0x97, 0x6e, 0x95,
-	0x1c, 0x92, 0x80, 0xc6, 0xee, 0x0d, 0x4e, 0x92, 0x0b, 0xc5, 0x35, 0xc4, 0x60, 0x78, 0xf4, 0x78,
-	0xe0, 0x4c, 0x52, 0xc4, 0x68, 0x78, 0x8a, 0x73, 0xaf, 0x0a, 0x33, 0x4f, 0x60, 0x43, 0x70, 0x61,
-	0xd5, 0xbb, 0xa0, 0xdf, 0x1a, 0x1e, 0x3a, 0xdb, 0xf8, 0x9c, 0x49, 0xc2, 0xf9, 0xf9, 0xd9, 0xf9,
-	0x84, 0x4b, 0x89, 0xa5, 0xa4, 0x3c, 0xf6, 0xf4, 0x77, 0xbd, 0xf7, 0x00, 0xee, 0xff, 0x66, 0x99,
-	0x1d, 0xd8, 0xc2, 0xf3, 0xe1, 0x68, 0x34, 0x38, 0xf6, 0x25, 0x8d, 0x4a, 0x60, 0x0f, 0x56, 0xd2,
-	0x94, 0x46, 0xe6, 0x0c, 0xee, 0xea, 0xc1, 0x68, 0xb3, 0xfe, 0xf7, 0xdd, 0x4c, 0x69, 0x14, 0x07,
-	0x2a, 0x4d, 0xb0, 0x67, 0x20, 0x26, 0xa7, 0x34, 0xea, 0xbd, 0x81, 0x07, 0xb3, 0x80, 0xd1, 0x79,
-	0xa0, 0x78, 0xf2, 0x8a, 0x2a, 0x52, 0xce, 0x6e, 0x8a, 0x95, 0xf9, 0x0c, 0xee, 0x66, 0x01, 0xf3,
-	0x25, 0x56, 0x16, 0xe8, 0x36, 0xfa, 0xad, 0xe1, 0xa3, 0xed, 0xbd, 0x6e, 0x88, 0xf0, 0x8c, 0x2c,
-	0x60, 0x53, 0xac, 0x7a, 0xef, 0x00, 0xbc, 0xbd, 0xc1, 0x37, 0x0f, 0xe1, 0x7e, 0xf6, 0x5d, 0xf6,
-	0x83, 0xf9, 0x3c, 0xc1, 0x52, 0x16, 0x8d, 0x37, 0xbd, 0x5b, 0x37, 0xc6, 0x93, 0x52, 0x37, 0x6d,
-	0xd8, 0xd2, 0xed, 0x8b, 0x14, 0xe9, 0x7f, 0xa3, 0x1c, 0x81, 0xd7, 0x44, 0x4c, 0x4e, 0x52, 0xa4,
-	0xc3, 0xee, 0xc3, 0x76, 0xc6, 0x35, 0x8d, 0x2f, 0xf8, 0x5b, 0x9c, 0x58, 0x8d, 0x2e, 0xe8, 0xef,
-	0x78, 0xad, 0x52, 0x9b, 0x68, 0xa9, 0xf7, 0xa1, 0x0e, 0xf7, 0x66, 0x5c, 0xe1, 0xa7, 0x17, 0x0a,
-	0xc7, 0xc5, 0xd0, 0x0f, 0xa0, 0x21, 0x69, 0x14, 0xe3, 0xa4, 0x3a, 0xb6, 0xda, 0x6d, 0x26, 0xab,
-	0x6f, 0x21, 0x7b, 0x08, 0x21, 0x62, 0x3c, 0x5c, 0xf8, 0x24, 0x90, 0xa4, 0x38, 0xb7, 0x3d, 0xde,
-	0xbb, 0xba, 0xee, 0x34, 0xc7, 0x5a, 0x7d, 0x1e, 0x48, 0xa2, 0x39, 0xab, 0xa5, 0x79, 0x0f, 0x36,
-	0xb1, 0xe0, 0x21, 0xf1, 0xe3, 0x74, 0x69, 0xed, 0x14, 0x90, 0xff, 0x17, 0xc2, 0x8b, 0x74, 0xa9,
-	0x79, 0x08, 0xa6, 0x11, 0x51, 0xd6, 0x7f, 0x85, 0x53, 0xed, 0x7e, 0xbc, 0x7b, 0xe3, 0x1f, 0xde,
-	0xfd, 0xf8, 0xec, 0xf3, 0xca, 0x06, 0x97, 0x2b, 0x1b, 0x7c, 0x5d, 0xd9, 0xe0, 0xe3, 0xda, 0xae,
-	0x5d, 0xae, 0xed, 0xda, 0x97, 0xb5, 0x5d, 0x7b, 0x3d, 0xfa, 0x53, 0xf8, 0xc5, 0x2f, 0xef, 0x56,
-	0xe5, 0x02, 0x4b, 0x64, 0x14, 0x6f, 0xf0, 0xe8, 0x5b, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xf8,
-	0x77, 0x30, 0xdd, 0x03, 0x00, 0x00,
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0x3b, 0x69, 0x3f, 0xf7, 0xcb, 0xa4, 0x05, 0x6a, 0x50, 0x65, 0x81, 0xe4, 0x84, 0x2c,
+	0x20, 0x52, 0xc1, 0x56, 0x52, 0x45, 0xa2, 0x8b, 0x2e, 0x88, 0x54, 0x84, 0x54, 0x89, 0x5a, 0x8e,
+	0x08, 0x12, 0x1b, 0xe3, 0x71, 0x6e, 0xec, 0x51, 0x1c, 0x8f, 0xe5, 0x19, 0x9b, 0xfa, 0x01, 0xd8,
+	0x21, 0x95, 0x27, 0xe0, 0x79, 0x58, 0x76, 0x89, 0xba, 0xa8, 0x50, 0xf2, 0x22, 0x68, 0x6c, 0x13,
+	0xf1, 0x27, 0x11, 0x12, 0x82, 0xdd, 0xcc, 0xb9, 0x37, 0x77, 0x7e, 0xe7, 0x4c, 0x3c, 0xf8, 0x01,
+	0x71, 0x49, 0x1e, 0xb2, 0xc8, 0xf4, 0x02, 0xf0, 0xa6, 0x31, 0xa3, 0x91, 0xa0, 0x91, 0x6f, 0x66,
+	0x5d, 0x93, 0x84, 0xdc, 0x99, 0x42, 0x6e, 0xc4, 0x09, 0x13, 0x4c, 0xd5, 0xaa, 0x3e, 0xe3, 0x87,
+	0x3e, 0x23, 0xeb, 0xde, 0xbd, 0xe3, 0x33, 0x9f, 0x15, 0x4d, 0xa6, 0x5c, 0x95, 0xfd, 0xed, 0x8f,
+	0x08, 0x2b, 0x83, 0x90, 0x9f, 0x42, 0xae, 0xbe, 0xc4, 0x4a, 0x9c, 0x92, 0x29, 0xe4, 0x1a, 0x6a,
+	0xa1, 0xce, 0xce, 0xe0, 0xf8, 0xea, 0xba, 0x79, 0xe4, 0x53, 0x11, 0xa4, 0xc4, 0xf0, 0xd8, 0xcc,
+	0xac, 0x26, 0x7b, 0x81, 0x4b, 0x23, 0x73, 0x89, 0x93, 0xe4, 0xb1, 0x60, 0x12, 0xa2, 0xdb, 0x3b,
+	0x7c, 0xd2, 0x35, 0xac, 0x94, 0x84, 0xd4, 0x3b, 0x85, 0xdc, 0xae, 0x86, 0xa9, 0xc7, 0x78, 0x33,
+	0x66, 0xb1, 0x56, 0x6b, 0xa1, 0x4e, 0xa3, 0x77, 0x60, 0xac, 0xe3, 0x33, 0xac, 0x84, 0xb1, 0xc9,
+	0xd9, 0xc4, 0x62, 0x9c, 0x03, 0xe7, 0x94, 0x45, 0xb6, 0xfc, 0x5d, 0xfb, 0x3d, 0xc2, 0x7b, 0xbf,
+	0x94, 0xd4, 0x26, 0x6e, 0xc0, 0xb8, 0xd7, 0xef, 0x77, 0x8f, 0x1c, 0x4e, 0xfd, 0x12, 0xd8, 0xc6,
+	0x95, 0x34, 0xa4, 0xbe, 0x3a, 0xc2, 0xdb, 0x32, 0x18, 0x59, 0xac, 0xfd, 0xb9, 0x9b, 0x21, 0xf5,
+	0x23, 0x57, 0xa4, 0x09, 0xd8, 0x0a, 0x09, 0xf9, 0x90, 0xfa, 0xed, 0x37, 0x78, 0x7f, 0xe4, 0x86,
+	0x74, 0xec, 0x0a, 0x96, 0xbc, 0xa2, 0x22, 0x28, 0xb3, 0x1b, 0x82, 0x50, 0x9f, 0xe1, 0xed, 0xcc,
+	0x0d, 0x1d, 0x0e, 0x42, 0x43, 0xad, 0xcd, 0x4e, 0xa3, 0xf7, 0x78, 0xbd, 0xd7, 0x15, 0x23, 0x6c,
+	0x25, 0x73, 0xc3, 0x21, 0x88, 0xf6, 0x3b, 0x84, 0x6f, 0xaf, 0xa8, 0xab, 0x07, 0x78, 0x2f, 0xfb,
+	0x26, 0x3b, 0xee, 0x78, 0x9c, 0x00, 0xe7, 0x85, 0xf1, 0xba, 0x7d, 0x6b, 0x59, 0x78, 0x5a, 0xea,
+	0xaa, 0x8e, 0x1b, 0xd2, 0x7e, 0x9c, 0x12, 0xf9, 0xdf, 0x28, 0x23, 0xb0, 0xeb, 0x24, 0xe4, 0x56,
+	0x4a, 0xe4, 0xb0, 0xfb, 0x78, 0x27, 0x63, 0x92, 0xc6, 0x89, 0xd9, 0x5b, 0x48, 0xb4, 0xcd, 0x16,
+	0xea, 0x6c, 0xd9, 0x8d, 0x52, 0xb3, 0xa4, 0xd4, 0xbe, 0xa8, 0xe1, 0xdd, 0x11, 0x13, 0x70, 0x72,
+	0x2e, 0x20, 0x2a, 0x42, 0xdf, 0xc7, 0x0a, 0xa7, 0x7e, 0x04, 0x49, 0x75, 0x6c, 0xb5, 0x5b, 0x4d,
+	0x56, 0x5b, 0x43, 0xf6, 0x08, 0x63, 0x12, 0x32, 0x6f, 0xea, 0x04, 0x2e, 0x0f, 0x8a, 0x73, 0x77,
+	0x06, 0xbb, 0x57, 0xd7, 0xcd, 0xfa, 0x40, 0xaa, 0xcf, 0x5d, 0x1e, 0x48, 0xce, 0x6a, 0xa9, 0xde,
+	0xc3, 0x75, 0x88, 0x99, 0x17, 0x38, 0x51, 0x3a, 0xd3, 0xb6, 0x0a, 0xc8, 0xff, 0x0b, 0xe1, 0x45,
+	0x3a, 0x93, 0x3c, 0x01, 0x50, 0x3f, 0x10, 0xda, 0x7f, 0x45, 0xa5, 0xda, 0x7d, 0x7f, 0xf7, 0xca,
+	0xdf, 0xbc, 0xfb, 0x0b, 0x84, 0x77, 0x2d, 0x88, 0xc6, 0x34, 0xf2, 0xff, 0xed, 0x27, 0xf3, 0x10,
+	0xdf, 0x84, 0xc9, 0x04, 0x3c, 0x41, 0x33, 0x70, 0x0a, 0xbb, 0x45, 0x9c, 0x5b, 0xf6, 0x8d, 0xa5,
+	0x7c, 0x22, 0xd5, 0xc1, 0xd9, 0xa7, 0xb9, 0x8e, 0x2e, 0xe7, 0x3a, 0xfa, 0x32, 0xd7, 0xd1, 0x87,
+	0x85, 0xbe, 0x71, 0xb9, 0xd0, 0x37, 0x3e, 0x2f, 0xf4, 0x8d, 0xd7, 0xfd, 0xdf, 0x51, 0x9c, 0xff,
+	0xf4, 0x92, 0x88, 0x3c, 0x06, 0x4e, 0x94, 0xe2, 0x55, 0x38, 0xfc, 0x1a, 0x00, 0x00, 0xff, 0xff,
+	0xf2, 0xdd, 0x6d, 0xd3, 0x6f, 0x04, 0x00, 0x00,
 }
 
 func (m *BlsKey) Marshal() (dAtA []byte, err error) {
@@ -599,6 +652,46 @@ func (m *VoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingBlsKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBlsKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBlsKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveEpoch != 0 {
+		i = encodeVarintBlsKey(dAtA, i, uint64(m.EffectiveEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pubkey != nil {
+		{
+			size := m.Pubkey.Size()
+			i -= size
+			if _, err := m.Pubkey.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBlsKey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlsKey(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlsKey(v)
 	base := offset
@@ -710,6 +803,22 @@ func (m *VoteExtension) Size() (n int) {
 	return n
 }
 
+func (m *PendingBlsKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovBlsKey(uint64(l))
+	}
+	if m.EffectiveEpoch != 0 {
+		n += 1 + sovBlsKey(uint64(m.EffectiveEpoch))
+	}
+	return n
+}
+
 func sovBlsKey(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1397,6 +1506,110 @@ func (m *VoteExtension) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingBlsKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlsKey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingBlsKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingBlsKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlsKey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
+			m.Pubkey = &v
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveEpoch", wireType)
+			}
+			m.EffectiveEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlsKey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlsKey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBlsKey(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,4 +19,7 @@ var (
 	ErrConflictingCheckpoint   = errorsmod.Register(ModuleName, 1213, "Conflicting checkpoint is found")
 	ErrInvalidAppHash          = errorsmod.Register(ModuleName, 1214, "Provided app hash is Invalid")
 	ErrInsufficientVotingPower = errorsmod.Register(ModuleName, 1215, "Accumulated voting power is not greater than 2/3 of total power")
+	ErrInvalidBlsKeyRotation   = errorsmod.Register(ModuleName, 1216, "BLS key rotation is invalid")
+	ErrBlsKeyMismatch          = errorsmod.Register(ModuleName, 1217, "BLS key of the signer does not match the BLS key in effect")
+	ErrBlsSignRefused          = errorsmod.Register(ModuleName, 1218, "BLS signer refused to sign the checkpoint")
 )
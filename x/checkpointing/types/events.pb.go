@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_babylonchain_babylon_crypto_bls12381 "github.com/babylonchain/babylon/crypto/bls12381"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -352,6 +354,125 @@ func (m *EventConflictingCheckpoint) GetLocalCheckpoint() *RawCheckpointWithMeta
 	return nil
 }
 
+// EventBlsKeyRotationRequested is emitted when a validator requests to rotate
+// its BLS key.
+type EventBlsKeyRotationRequested struct {
+	// validator_address is the address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// new_bls_pub_key is the new BLS public key of the validator
+	NewBlsPubKey *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,2,opt,name=new_bls_pub_key,json=newBlsPubKey,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"new_bls_pub_key,omitempty"`
+	// epoch_num is the epoch from which the new BLS key takes effect
+	EpochNum uint64 `protobuf:"varint,3,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+}
+
+func (m *EventBlsKeyRotationRequested) Reset()         { *m = EventBlsKeyRotationRequested{} }
+func (m *EventBlsKeyRotationRequested) String() string { return proto.CompactTextString(m) }
+func (*EventBlsKeyRotationRequested) ProtoMessage()    {}
+func (*EventBlsKeyRotationRequested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_950b7bd81c59f78a, []int{7}
+}
+func (m *EventBlsKeyRotationRequested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlsKeyRotationRequested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlsKeyRotationRequested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlsKeyRotationRequested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlsKeyRotationRequested.Merge(m, src)
+}
+func (m *EventBlsKeyRotationRequested) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlsKeyRotationRequested) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlsKeyRotationRequested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlsKeyRotationRequested proto.InternalMessageInfo
+
+func (m *EventBlsKeyRotationRequested) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EventBlsKeyRotationRequested) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+// EventBlsKeyRotated is emitted when the new BLS key of a validator takes
+// effect.
+type EventBlsKeyRotated struct {
+	// validator_address is the address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// old_bls_pub_key is the BLS public key of the validator until the
+	// previous epoch
+	OldBlsPubKey *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,2,opt,name=old_bls_pub_key,json=oldBlsPubKey,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"old_bls_pub_key,omitempty"`
+	// new_bls_pub_key is the BLS public key of the validator from this epoch
+	NewBlsPubKey *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,3,opt,name=new_bls_pub_key,json=newBlsPubKey,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"new_bls_pub_key,omitempty"`
+	// epoch_num is the epoch from which the new BLS key takes effect
+	EpochNum uint64 `protobuf:"varint,4,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+}
+
+func (m *EventBlsKeyRotated) Reset()         { *m = EventBlsKeyRotated{} }
+func (m *EventBlsKeyRotated) String() string { return proto.CompactTextString(m) }
+func (*EventBlsKeyRotated) ProtoMessage()    {}
+func (*EventBlsKeyRotated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_950b7bd81c59f78a, []int{8}
+}
+func (m *EventBlsKeyRotated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlsKeyRotated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlsKeyRotated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlsKeyRotated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlsKeyRotated.Merge(m, src)
+}
+func (m *EventBlsKeyRotated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlsKeyRotated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlsKeyRotated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlsKeyRotated proto.InternalMessageInfo
+
+func (m *EventBlsKeyRotated) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EventBlsKeyRotated) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventCheckpointAccumulating)(nil), "babylon.checkpointing.v1.EventCheckpointAccumulating")
 	proto.RegisterType((*EventCheckpointSealed)(nil), "babylon.checkpointing.v1.EventCheckpointSealed")
@@ -360,6 +481,8 @@ func init() {
 	proto.RegisterType((*EventCheckpointFinalized)(nil), "babylon.checkpointing.v1.EventCheckpointFinalized")
 	proto.RegisterType((*EventCheckpointForgotten)(nil), "babylon.checkpointing.v1.EventCheckpointForgotten")
 	proto.RegisterType((*EventConflictingCheckpoint)(nil), "babylon.checkpointing.v1.EventConflictingCheckpoint")
+	proto.RegisterType((*EventBlsKeyRotationRequested)(nil), "babylon.checkpointing.v1.EventBlsKeyRotationRequested")
+	proto.RegisterType((*EventBlsKeyRotated)(nil), "babylon.checkpointing.v1.EventBlsKeyRotated")
 }
 
 func init() {
@@ -367,27 +490,38 @@ func init() {
 }
 
 var fileDescriptor_950b7bd81c59f78a = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xce, 0x48, 0x4d, 0xce, 0x2e, 0xc8, 0xcf, 0xcc, 0x2b, 0xc9, 0xcc,
-	0x4b, 0xd7, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x80, 0x2a, 0xd3, 0x43, 0x51, 0xa6, 0x57, 0x66, 0x28, 0xa5, 0x89, 0xd3, 0x00,
-	0x84, 0x00, 0xc4, 0x10, 0xa5, 0x3c, 0x2e, 0x69, 0x57, 0x90, 0xa1, 0xce, 0x70, 0x09, 0xc7, 0xe4,
-	0xe4, 0xd2, 0xdc, 0xd2, 0x9c, 0x44, 0x90, 0x16, 0x21, 0x7f, 0x2e, 0x2e, 0x84, 0x16, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x7d, 0x3d, 0x5c, 0x16, 0xeb, 0x05, 0x25, 0x96, 0x23, 0x0c, 0x0a,
-	0xcf, 0x2c, 0xc9, 0xf0, 0x4d, 0x2d, 0x49, 0x0c, 0x42, 0x32, 0x42, 0x29, 0x83, 0x4b, 0x14, 0xcd,
-	0xbe, 0xe0, 0xd4, 0xc4, 0x9c, 0xd4, 0x14, 0xea, 0xdb, 0x94, 0xcd, 0x25, 0x81, 0x6e, 0x53, 0x69,
-	0x52, 0x6e, 0x66, 0x49, 0x09, 0x7d, 0x2c, 0x73, 0xce, 0xcf, 0x4b, 0xcb, 0x2c, 0xca, 0xa5, 0x8f,
-	0x65, 0x6e, 0x99, 0x79, 0x89, 0x39, 0x99, 0x55, 0x74, 0xb2, 0x2c, 0xbf, 0x28, 0x3d, 0xbf, 0xa4,
-	0x24, 0x35, 0x8f, 0xfa, 0x96, 0xdd, 0x60, 0xe4, 0x92, 0x82, 0xd8, 0x96, 0x9f, 0x97, 0x96, 0x93,
-	0x99, 0x0c, 0xd2, 0x89, 0xd0, 0x22, 0x14, 0xc7, 0x25, 0x96, 0x8c, 0x90, 0x88, 0xc7, 0xb0, 0x5b,
-	0x9d, 0x48, 0xbb, 0x83, 0x44, 0x93, 0xb1, 0x9a, 0x1f, 0xc5, 0x25, 0x90, 0x93, 0x9f, 0x9c, 0x98,
-	0x83, 0x6c, 0x32, 0x13, 0x79, 0xbe, 0xe2, 0x07, 0x1b, 0x84, 0x90, 0x70, 0xf2, 0x3f, 0xf1, 0x48,
-	0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd,
-	0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0x2d, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x30, 0x8e, 0x7e, 0x05, 0x5a,
-	0x3e, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x67, 0x60, 0x63, 0x40, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x47, 0xbc, 0x3a, 0x24, 0x2e, 0x04, 0x00, 0x00,
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0x6d, 0x11, 0x3b, 0x16, 0x5a, 0x83, 0x95, 0x65, 0x2b, 0xb1, 0x2c, 0x88, 0x15,
+	0x21, 0x61, 0x5b, 0x04, 0x3d, 0x78, 0xe8, 0x16, 0xbd, 0x14, 0x6d, 0x89, 0x07, 0xa1, 0x07, 0xc3,
+	0xcc, 0xe4, 0x35, 0x19, 0x76, 0x32, 0x2f, 0x26, 0x33, 0xbb, 0xc6, 0x4f, 0xe1, 0xe7, 0xf0, 0x93,
+	0x78, 0x92, 0x1e, 0x8b, 0x07, 0x91, 0xdd, 0x2f, 0x22, 0xc9, 0x96, 0x4d, 0xbb, 0xb6, 0x58, 0x4a,
+	0x77, 0x6f, 0x61, 0xde, 0xff, 0xfd, 0x7f, 0xef, 0xff, 0x08, 0x8f, 0x3c, 0x61, 0x94, 0x15, 0x12,
+	0x95, 0xc7, 0x63, 0xe0, 0xbd, 0x14, 0x85, 0xd2, 0x42, 0x45, 0x5e, 0xbf, 0xe3, 0x41, 0x1f, 0x94,
+	0xce, 0xdd, 0x34, 0x43, 0x8d, 0x76, 0xf3, 0x4c, 0xe6, 0x5e, 0x90, 0xb9, 0xfd, 0x4e, 0xeb, 0x41,
+	0x84, 0x11, 0x56, 0x22, 0xaf, 0xfc, 0x1a, 0xeb, 0x5b, 0xcf, 0xae, 0xb4, 0xad, 0x1f, 0xc6, 0xd2,
+	0xb6, 0x22, 0x1b, 0x6f, 0x4a, 0xd4, 0xde, 0xa4, 0xb0, 0xcb, 0xb9, 0x49, 0x8c, 0xa4, 0x65, 0x8b,
+	0x7d, 0x40, 0x48, 0xdd, 0xd2, 0xb4, 0x36, 0xad, 0xad, 0x7b, 0xdb, 0x9e, 0x7b, 0xd5, 0x38, 0xae,
+	0x4f, 0x07, 0xb5, 0xd1, 0x47, 0xa1, 0xe3, 0x77, 0xa0, 0xa9, 0x7f, 0xce, 0xa2, 0x1d, 0x93, 0xf5,
+	0x29, 0xde, 0x07, 0xa0, 0x12, 0xc2, 0xdb, 0x27, 0xf5, 0x48, 0x73, 0x9a, 0x64, 0x58, 0x22, 0xb4,
+	0x9e, 0x0f, 0x6c, 0x0f, 0xd5, 0xb1, 0xc8, 0x92, 0xf9, 0xc0, 0xde, 0x0a, 0x45, 0xa5, 0xf8, 0x3a,
+	0x27, 0x18, 0x66, 0x11, 0x6a, 0x0d, 0xea, 0xf6, 0x61, 0xa7, 0x16, 0x69, 0x8d, 0x69, 0xa8, 0x8e,
+	0xa5, 0xe0, 0x65, 0x67, 0xdd, 0x62, 0x7f, 0x22, 0x0f, 0x79, 0x5d, 0x08, 0xfe, 0x61, 0x3f, 0xbd,
+	0x26, 0xdb, 0x5f, 0xe7, 0x97, 0xfa, 0x1f, 0x91, 0x35, 0x89, 0x9c, 0xca, 0xf3, 0xce, 0x0b, 0x37,
+	0x4b, 0xb5, 0x5a, 0x19, 0xd5, 0x85, 0xf6, 0x4f, 0x8b, 0x3c, 0xaa, 0xa2, 0x75, 0x65, 0xbe, 0x0f,
+	0x85, 0x8f, 0x9a, 0x6a, 0x81, 0xca, 0x87, 0xcf, 0x06, 0xf2, 0xf2, 0x9f, 0x7c, 0x4e, 0xee, 0xf7,
+	0xa9, 0x14, 0x21, 0xd5, 0x98, 0x05, 0x34, 0x0c, 0x33, 0xc8, 0xf3, 0x2a, 0xd7, 0xb2, 0xbf, 0x36,
+	0x29, 0xec, 0x8e, 0xdf, 0xed, 0x90, 0xac, 0x2a, 0x18, 0x04, 0x4c, 0xe6, 0x41, 0x6a, 0x58, 0xd0,
+	0x83, 0xa2, 0x1a, 0x74, 0xa5, 0xfb, 0xfa, 0xd7, 0xef, 0xc7, 0xaf, 0x22, 0xa1, 0x63, 0xc3, 0x5c,
+	0x8e, 0x89, 0x77, 0x36, 0x36, 0x8f, 0xa9, 0x50, 0xde, 0xe4, 0x2c, 0x64, 0x45, 0xaa, 0xd1, 0x63,
+	0x32, 0xef, 0x6c, 0xef, 0xbc, 0xec, 0xb8, 0x87, 0x86, 0x49, 0xc1, 0xcb, 0xa9, 0x56, 0x14, 0x0c,
+	0xba, 0x32, 0x3f, 0x34, 0x6c, 0x1f, 0x0a, 0x7b, 0x83, 0x2c, 0x43, 0x8a, 0x3c, 0x0e, 0x94, 0x49,
+	0x9a, 0x8b, 0x9b, 0xd6, 0xd6, 0x92, 0x7f, 0xb7, 0x7a, 0x78, 0x6f, 0x92, 0xf6, 0xf7, 0x05, 0x62,
+	0x4f, 0x07, 0xba, 0x41, 0x0c, 0x94, 0xe1, 0x0c, 0x62, 0xa0, 0x0c, 0xeb, 0x18, 0x97, 0x2c, 0x6b,
+	0x71, 0xc6, 0xcb, 0x5a, 0xba, 0xb8, 0xac, 0xee, 0xc1, 0x8f, 0xa1, 0x63, 0x9d, 0x0c, 0x1d, 0xeb,
+	0xcf, 0xd0, 0xb1, 0xbe, 0x8d, 0x9c, 0xc6, 0xc9, 0xc8, 0x69, 0x9c, 0x8e, 0x9c, 0xc6, 0xd1, 0x8b,
+	0xff, 0xf1, 0xbf, 0x4c, 0x5d, 0x71, 0x5d, 0xa4, 0x90, 0xb3, 0x3b, 0xd5, 0xf9, 0xde, 0xf9, 0x1b,
+	0x00, 0x00, 0xff, 0xff, 0xb9, 0xce, 0xbf, 0x49, 0x42, 0x06, 0x00, 0x00,
 }
 
 func (m *EventCheckpointAccumulating) Marshal() (dAtA []byte, err error) {
@@ -647,6 +781,112 @@ func (m *EventConflictingCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlsKeyRotationRequested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlsKeyRotationRequested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlsKeyRotationRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NewBlsPubKey != nil {
+		{
+			size := m.NewBlsPubKey.Size()
+			i -= size
+			if _, err := m.NewBlsPubKey.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBlsKeyRotated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlsKeyRotated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlsKeyRotated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewBlsPubKey != nil {
+		{
+			size := m.NewBlsPubKey.Size()
+			i -= size
+			if _, err := m.NewBlsPubKey.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldBlsPubKey != nil {
+		{
+			size := m.OldBlsPubKey.Size()
+			i -= size
+			if _, err := m.OldBlsPubKey.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -753,6 +993,50 @@ func (m *EventConflictingCheckpoint) Size() (n int) {
 	return n
 }
 
+func (m *EventBlsKeyRotationRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NewBlsPubKey != nil {
+		l = m.NewBlsPubKey.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EpochNum != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *EventBlsKeyRotated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OldBlsPubKey != nil {
+		l = m.OldBlsPubKey.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NewBlsPubKey != nil {
+		l = m.NewBlsPubKey.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EpochNum != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNum))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1397,6 +1681,313 @@ func (m *EventConflictingCheckpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBlsKeyRotationRequested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBlsKeyRotationRequested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBlsKeyRotationRequested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
+			m.NewBlsPubKey = &v
+			if err := m.NewBlsPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlsKeyRotated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBlsKeyRotated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBlsKeyRotated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldBlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
+			m.OldBlsPubKey = &v
+			if err := m.OldBlsPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
+			m.NewBlsPubKey = &v
+			if err := m.NewBlsPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
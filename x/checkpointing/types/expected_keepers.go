@@ -4,6 +4,7 @@ import (
 	"context"
 
 	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -18,6 +19,7 @@ type EpochingKeeper interface {
 	GetTotalVotingPower(ctx context.Context, epochNumber uint64) int64
 	CheckMsgCreateValidator(ctx context.Context, msg *stakingtypes.MsgCreateValidator) error
 	GetPubKeyByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error)
+	GetValidatorConsPubKey(ctx context.Context, valAddr sdk.ValAddress) (cryptotypes.PubKey, error)
 }
 
 // Event Hooks
@@ -28,10 +30,11 @@ type EpochingKeeper interface {
 
 // CheckpointingHooks event hooks for raw checkpoint object (noalias)
 type CheckpointingHooks interface {
-	AfterBlsKeyRegistered(ctx context.Context, valAddr sdk.ValAddress) error         // Must be called when a BLS key is registered
-	AfterRawCheckpointSealed(ctx context.Context, epoch uint64) error                // Must be called when a raw checkpoint is SEALED
-	AfterRawCheckpointConfirmed(ctx context.Context, epoch uint64) error             // Must be called when a raw checkpoint is CONFIRMED
-	AfterRawCheckpointForgotten(ctx context.Context, ckpt *RawCheckpoint) error      // Must be called when a raw checkpoint is FORGOTTEN
-	AfterRawCheckpointFinalized(ctx context.Context, epoch uint64) error             // Must be called when a raw checkpoint is FINALIZED
-	AfterRawCheckpointBlsSigVerified(ctx context.Context, ckpt *RawCheckpoint) error // Must be called when a raw checkpoint's multi-sig is verified
+	AfterBlsKeyRegistered(ctx context.Context, valAddr sdk.ValAddress) error            // Must be called when a BLS key is registered
+	AfterBlsKeyRotated(ctx context.Context, valAddr sdk.ValAddress, epoch uint64) error // Must be called when a rotated BLS key takes effect from the epoch
+	AfterRawCheckpointSealed(ctx context.Context, epoch uint64) error                   // Must be called when a raw checkpoint is SEALED
+	AfterRawCheckpointConfirmed(ctx context.Context, epoch uint64) error                // Must be called when a raw checkpoint is CONFIRMED
+	AfterRawCheckpointForgotten(ctx context.Context, ckpt *RawCheckpoint) error         // Must be called when a raw checkpoint is FORGOTTEN
+	AfterRawCheckpointFinalized(ctx context.Context, epoch uint64) error                // Must be called when a raw checkpoint is FINALIZED
+	AfterRawCheckpointBlsSigVerified(ctx context.Context, ckpt *RawCheckpoint) error    // Must be called when a raw checkpoint's multi-sig is verified
 }
@@ -35,6 +35,28 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	pendingAddrs := make(map[string]struct{}, 0)
+	for _, pk := range gs.PendingBlsKeys {
+		if _, err := sdk.ValAddressFromBech32(pk.ValidatorAddress); err != nil {
+			return err
+		}
+		if _, exists := pendingAddrs[pk.ValidatorAddress]; exists {
+			return errors.New("duplicate pending BLS key")
+		}
+		pendingAddrs[pk.ValidatorAddress] = struct{}{}
+		if pk.PendingBlsKey == nil || pk.PendingBlsKey.Pubkey == nil {
+			return errors.New("empty pending BLS key")
+		}
+	}
+	for _, rk := range gs.RotatedBlsKeys {
+		if _, err := sdk.ValAddressFromBech32(rk.ValidatorAddress); err != nil {
+			return err
+		}
+		if rk.Pubkey == nil {
+			return errors.New("empty rotated BLS key")
+		}
+	}
+
 	return nil
 }
 
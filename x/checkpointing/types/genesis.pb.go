@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_babylonchain_babylon_crypto_bls12381 "github.com/babylonchain/babylon/crypto/bls12381"
 	ed25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -27,6 +29,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// genesis_keys defines the public keys for the genesis validators
 	GenesisKeys []*GenesisKey `protobuf:"bytes,1,rep,name=genesis_keys,json=genesisKeys,proto3" json:"genesis_keys,omitempty"`
+	// pending_bls_keys defines the new BLS keys of the validators that are
+	// pending to take effect
+	PendingBlsKeys []*ValidatorPendingBlsKey `protobuf:"bytes,2,rep,name=pending_bls_keys,json=pendingBlsKeys,proto3" json:"pending_bls_keys,omitempty"`
+	// rotated_bls_keys defines the BLS keys of the validators that have been
+	// rotated, along with the last epochs in which they were in effect
+	RotatedBlsKeys []*RotatedBlsKey `protobuf:"bytes,3,rep,name=rotated_bls_keys,json=rotatedBlsKeys,proto3" json:"rotated_bls_keys,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +77,20 @@ func (m *GenesisState) GetGenesisKeys() []*GenesisKey {
 	return nil
 }
 
+func (m *GenesisState) GetPendingBlsKeys() []*ValidatorPendingBlsKey {
+	if m != nil {
+		return m.PendingBlsKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRotatedBlsKeys() []*RotatedBlsKey {
+	if m != nil {
+		return m.RotatedBlsKeys
+	}
+	return nil
+}
+
 // GenesisKey defines public key information about the genesis validators
 type GenesisKey struct {
 	// validator_address is the address corresponding to a validator
@@ -133,9 +155,124 @@ func (m *GenesisKey) GetValPubkey() *ed25519.PubKey {
 	return nil
 }
 
+// ValidatorPendingBlsKey defines the new BLS key of a validator that is
+// pending to take effect
+type ValidatorPendingBlsKey struct {
+	// validator_address is the address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pending_bls_key is the new BLS key and the epoch it takes effect from
+	PendingBlsKey *PendingBlsKey `protobuf:"bytes,2,opt,name=pending_bls_key,json=pendingBlsKey,proto3" json:"pending_bls_key,omitempty"`
+}
+
+func (m *ValidatorPendingBlsKey) Reset()         { *m = ValidatorPendingBlsKey{} }
+func (m *ValidatorPendingBlsKey) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPendingBlsKey) ProtoMessage()    {}
+func (*ValidatorPendingBlsKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf2c524ebc9800de, []int{2}
+}
+func (m *ValidatorPendingBlsKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPendingBlsKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPendingBlsKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPendingBlsKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPendingBlsKey.Merge(m, src)
+}
+func (m *ValidatorPendingBlsKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPendingBlsKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPendingBlsKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPendingBlsKey proto.InternalMessageInfo
+
+func (m *ValidatorPendingBlsKey) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorPendingBlsKey) GetPendingBlsKey() *PendingBlsKey {
+	if m != nil {
+		return m.PendingBlsKey
+	}
+	return nil
+}
+
+// RotatedBlsKey defines a BLS key of a validator that has been rotated
+type RotatedBlsKey struct {
+	// validator_address is the address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pubkey is the rotated BLS public key
+	Pubkey *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,2,opt,name=pubkey,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"pubkey,omitempty"`
+	// last_epoch is the last epoch in which the BLS key was in effect
+	LastEpoch uint64 `protobuf:"varint,3,opt,name=last_epoch,json=lastEpoch,proto3" json:"last_epoch,omitempty"`
+}
+
+func (m *RotatedBlsKey) Reset()         { *m = RotatedBlsKey{} }
+func (m *RotatedBlsKey) String() string { return proto.CompactTextString(m) }
+func (*RotatedBlsKey) ProtoMessage()    {}
+func (*RotatedBlsKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf2c524ebc9800de, []int{3}
+}
+func (m *RotatedBlsKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotatedBlsKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotatedBlsKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotatedBlsKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotatedBlsKey.Merge(m, src)
+}
+func (m *RotatedBlsKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotatedBlsKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotatedBlsKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotatedBlsKey proto.InternalMessageInfo
+
+func (m *RotatedBlsKey) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RotatedBlsKey) GetLastEpoch() uint64 {
+	if m != nil {
+		return m.LastEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.checkpointing.v1.GenesisState")
 	proto.RegisterType((*GenesisKey)(nil), "babylon.checkpointing.v1.GenesisKey")
+	proto.RegisterType((*ValidatorPendingBlsKey)(nil), "babylon.checkpointing.v1.ValidatorPendingBlsKey")
+	proto.RegisterType((*RotatedBlsKey)(nil), "babylon.checkpointing.v1.RotatedBlsKey")
 }
 
 func init() {
@@ -143,28 +280,38 @@ func init() {
 }
 
 var fileDescriptor_bf2c524ebc9800de = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcf, 0x6a, 0x2a, 0x31,
-	0x18, 0xc5, 0xcd, 0x15, 0xbc, 0x18, 0x5d, 0xb4, 0xb3, 0x12, 0xa1, 0x61, 0x90, 0x52, 0x84, 0x42,
-	0x82, 0x16, 0x17, 0x42, 0x37, 0x75, 0xe3, 0xa2, 0x8b, 0x8a, 0x5d, 0x14, 0xba, 0x91, 0x24, 0x13,
-	0xc6, 0x60, 0x9c, 0x0c, 0x93, 0x38, 0x34, 0x6f, 0xd1, 0x67, 0xe9, 0x53, 0x74, 0xe9, 0xb2, 0xcb,
-	0xa2, 0x2f, 0x52, 0x66, 0x26, 0xb5, 0xb4, 0x30, 0xab, 0xfc, 0xf9, 0xce, 0xf9, 0xce, 0xe1, 0x07,
-	0xaf, 0x18, 0x65, 0x4e, 0xe9, 0x84, 0xf0, 0xb5, 0xe0, 0x9b, 0x54, 0xcb, 0xc4, 0xca, 0x24, 0x26,
-	0xf9, 0x88, 0xc4, 0x22, 0x11, 0x46, 0x1a, 0x9c, 0x66, 0xda, 0xea, 0xa0, 0xe7, 0x75, 0xf8, 0x97,
-	0x0e, 0xe7, 0xa3, 0x7e, 0xc8, 0xb5, 0xd9, 0x6a, 0x43, 0x78, 0xe6, 0x52, 0xab, 0x89, 0x88, 0xc6,
-	0x93, 0xc9, 0x68, 0x4a, 0x36, 0xc2, 0x79, 0x6f, 0xbf, 0x3e, 0x83, 0x29, 0xb3, 0xda, 0x08, 0x57,
-	0xe9, 0x06, 0x4f, 0xb0, 0x3b, 0xaf, 0x42, 0x1f, 0x2d, 0xb5, 0x22, 0x98, 0xc3, 0xae, 0x2f, 0x51,
-	0x88, 0x4c, 0x0f, 0x84, 0xcd, 0x61, 0x67, 0x7c, 0x89, 0xeb, 0xaa, 0x60, 0xef, 0xbe, 0x17, 0x6e,
-	0xd9, 0x89, 0x4f, 0x77, 0x33, 0x78, 0x03, 0x10, 0xfe, 0xcc, 0x82, 0x6b, 0x78, 0x9e, 0x53, 0x25,
-	0x23, 0x6a, 0x75, 0xb6, 0xa2, 0x51, 0x94, 0x09, 0x53, 0x2c, 0x07, 0xc3, 0xf6, 0xf2, 0xec, 0x34,
-	0xb8, 0xab, 0xfe, 0x83, 0x29, 0xfc, 0xef, 0x5b, 0xf6, 0xfe, 0x85, 0x60, 0xd8, 0x19, 0x87, 0xf5,
-	0xf9, 0x33, 0x55, 0x66, 0xb7, 0x58, 0x79, 0x06, 0xb7, 0x10, 0xe6, 0x54, 0xad, 0xd2, 0x1d, 0x2b,
-	0xdc, 0xcd, 0xd2, 0x7d, 0x81, 0x2b, 0x5c, 0xb8, 0xc2, 0x85, 0x3d, 0x2e, 0xbc, 0xd8, 0xb1, 0xc2,
-	0xda, 0xce, 0xa9, 0x5a, 0x94, 0xfa, 0xd9, 0xc3, 0xfb, 0x01, 0x81, 0xfd, 0x01, 0x81, 0xcf, 0x03,
-	0x02, 0xaf, 0x47, 0xd4, 0xd8, 0x1f, 0x51, 0xe3, 0xe3, 0x88, 0x1a, 0xcf, 0x93, 0x58, 0xda, 0xf5,
-	0x8e, 0x61, 0xae, 0xb7, 0xc4, 0x77, 0xe1, 0x6b, 0x2a, 0x93, 0xef, 0x07, 0x79, 0xf9, 0x43, 0xda,
-	0xba, 0x54, 0x18, 0xd6, 0x2a, 0x29, 0xdf, 0x7c, 0x05, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xab, 0xd1,
-	0x3a, 0xf3, 0x01, 0x00, 0x00,
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
+	0x1c, 0x6d, 0x5a, 0xa9, 0x74, 0xda, 0xd5, 0x1a, 0x44, 0x4a, 0x61, 0x63, 0x29, 0xa2, 0x05, 0x61,
+	0xc6, 0x74, 0x29, 0x58, 0xd0, 0x83, 0x05, 0xd9, 0x83, 0x87, 0xad, 0x15, 0x3d, 0xec, 0x25, 0xcc,
+	0x24, 0x43, 0x1a, 0x3a, 0x9b, 0x09, 0x99, 0x69, 0x30, 0x5f, 0xc0, 0xb3, 0x27, 0x3f, 0x88, 0x9f,
+	0xc2, 0xe3, 0x1e, 0xc5, 0x83, 0x48, 0xfb, 0x45, 0x64, 0xfe, 0x6c, 0x77, 0xbb, 0x18, 0x97, 0x3d,
+	0x75, 0x66, 0xfa, 0xde, 0xfb, 0xbd, 0x79, 0x2f, 0x03, 0x9e, 0x12, 0x4c, 0x4a, 0xc6, 0x53, 0x14,
+	0x2e, 0x69, 0xb8, 0xca, 0x78, 0x92, 0xca, 0x24, 0x8d, 0x51, 0xe1, 0xa3, 0x98, 0xa6, 0x54, 0x24,
+	0x02, 0x66, 0x39, 0x97, 0xdc, 0xed, 0x59, 0x1c, 0xdc, 0xc3, 0xc1, 0xc2, 0xef, 0x3f, 0x8c, 0x79,
+	0xcc, 0x35, 0x08, 0xa9, 0x95, 0xc1, 0xf7, 0x07, 0x21, 0x17, 0x67, 0x5c, 0xa0, 0x30, 0x2f, 0x33,
+	0xc9, 0x11, 0x8d, 0xc6, 0x93, 0x89, 0x3f, 0x45, 0x2b, 0x5a, 0x5a, 0xc5, 0x7e, 0xf5, 0x64, 0xc2,
+	0x44, 0xb0, 0xa2, 0xa5, 0xc1, 0x0d, 0xbf, 0xd4, 0x41, 0xe7, 0xd8, 0x78, 0xf9, 0x20, 0xb1, 0xa4,
+	0xee, 0x31, 0xe8, 0x58, 0x6f, 0x0a, 0x25, 0x7a, 0xce, 0xa0, 0x31, 0x6a, 0x8f, 0x9f, 0xc0, 0x2a,
+	0x87, 0xd0, 0xb2, 0xdf, 0xd1, 0x72, 0xd1, 0x8e, 0x77, 0x6b, 0xe1, 0x9e, 0x82, 0x6e, 0x46, 0xd3,
+	0x28, 0x49, 0xe3, 0xc0, 0x8e, 0x14, 0xbd, 0xba, 0x16, 0x7b, 0x51, 0x2d, 0xf6, 0x09, 0xb3, 0x24,
+	0xc2, 0x92, 0xe7, 0x73, 0x43, 0x9d, 0x31, 0x2d, 0x7c, 0x2f, 0xbb, 0xba, 0x15, 0xee, 0x7b, 0xd0,
+	0xcd, 0xb9, 0xb2, 0x1b, 0x5d, 0x6a, 0x37, 0xb4, 0xf6, 0xb3, 0x6a, 0xed, 0x85, 0x61, 0x5c, 0x48,
+	0xe6, 0x57, 0xb7, 0x62, 0xf8, 0xdd, 0x01, 0xe0, 0xf2, 0x2a, 0xee, 0x73, 0xf0, 0xa0, 0xb8, 0xf0,
+	0x12, 0xe0, 0x28, 0xca, 0xa9, 0x50, 0x59, 0x38, 0xa3, 0xd6, 0xa2, 0xbb, 0xfb, 0xe3, 0x8d, 0x39,
+	0x77, 0xa7, 0xe0, 0xae, 0xb5, 0xd1, 0xab, 0x0f, 0x9c, 0x51, 0x7b, 0x3c, 0xa8, 0x76, 0x61, 0xc7,
+	0x37, 0x89, 0xfe, 0x75, 0x5f, 0x01, 0x50, 0x60, 0x16, 0x64, 0x6b, 0xa2, 0xd8, 0x0d, 0xcd, 0x3e,
+	0x84, 0xa6, 0x5e, 0x68, 0xea, 0x85, 0xb6, 0x5e, 0x38, 0x5f, 0x13, 0x45, 0x6d, 0x15, 0x98, 0xcd,
+	0x35, 0x7e, 0xf8, 0xcd, 0x01, 0x8f, 0xfe, 0x1d, 0xd9, 0xed, 0x2e, 0x70, 0x02, 0xee, 0x5f, 0xeb,
+	0xca, 0x5e, 0xe4, 0x3f, 0x71, 0xee, 0x37, 0x74, 0xb0, 0xd7, 0x90, 0x4a, 0xf3, 0x60, 0x2f, 0xef,
+	0xdb, 0xf9, 0xf9, 0x08, 0x9a, 0x36, 0x11, 0x65, 0xa3, 0x33, 0x7b, 0xfd, 0xeb, 0xf7, 0xe3, 0x69,
+	0x9c, 0xc8, 0xe5, 0x9a, 0xc0, 0x90, 0x9f, 0x21, 0x6b, 0x2a, 0x5c, 0xe2, 0x24, 0x45, 0xbb, 0x2f,
+	0xdd, 0x3c, 0x06, 0xc2, 0x84, 0x3f, 0x3e, 0x7a, 0xe9, 0xab, 0xb8, 0x58, 0x12, 0xea, 0xb0, 0x8d,
+	0x98, 0x7b, 0x08, 0x00, 0xc3, 0x42, 0x06, 0x34, 0xe3, 0xe1, 0x52, 0x87, 0x7d, 0x67, 0xd1, 0x52,
+	0x27, 0x6f, 0xd5, 0xc1, 0xec, 0xe4, 0xc7, 0xc6, 0x73, 0xce, 0x37, 0x9e, 0xf3, 0x67, 0xe3, 0x39,
+	0x5f, 0xb7, 0x5e, 0xed, 0x7c, 0xeb, 0xd5, 0x7e, 0x6e, 0xbd, 0xda, 0xe9, 0xe4, 0xa6, 0xd9, 0x9f,
+	0xaf, 0xbd, 0x33, 0x59, 0x66, 0x54, 0x90, 0xa6, 0x7e, 0x63, 0x47, 0x7f, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0x13, 0x18, 0x34, 0x8e, 0x07, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -187,6 +334,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RotatedBlsKeys) > 0 {
+		for iNdEx := len(m.RotatedBlsKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RotatedBlsKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PendingBlsKeys) > 0 {
+		for iNdEx := len(m.PendingBlsKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingBlsKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.GenesisKeys) > 0 {
 		for iNdEx := len(m.GenesisKeys) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -258,6 +433,95 @@ func (m *GenesisKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorPendingBlsKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPendingBlsKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPendingBlsKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingBlsKey != nil {
+		{
+			size, err := m.PendingBlsKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotatedBlsKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotatedBlsKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotatedBlsKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pubkey != nil {
+		{
+			size := m.Pubkey.Size()
+			i -= size
+			if _, err := m.Pubkey.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -281,6 +545,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingBlsKeys) > 0 {
+		for _, e := range m.PendingBlsKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RotatedBlsKeys) > 0 {
+		for _, e := range m.RotatedBlsKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -305,6 +581,43 @@ func (m *GenesisKey) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorPendingBlsKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PendingBlsKey != nil {
+		l = m.PendingBlsKey.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RotatedBlsKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.LastEpoch))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -374,6 +687,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBlsKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBlsKeys = append(m.PendingBlsKeys, &ValidatorPendingBlsKey{})
+			if err := m.PendingBlsKeys[len(m.PendingBlsKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedBlsKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotatedBlsKeys = append(m.RotatedBlsKeys, &RotatedBlsKey{})
+			if err := m.RotatedBlsKeys[len(m.RotatedBlsKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -549,6 +930,260 @@ func (m *GenesisKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorPendingBlsKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPendingBlsKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPendingBlsKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBlsKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingBlsKey == nil {
+				m.PendingBlsKey = &PendingBlsKey{}
+			}
+			if err := m.PendingBlsKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotatedBlsKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotatedBlsKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotatedBlsKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
+			m.Pubkey = &v
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpoch", wireType)
+			}
+			m.LastEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
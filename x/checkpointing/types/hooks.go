@@ -24,6 +24,15 @@ func (h MultiCheckpointingHooks) AfterBlsKeyRegistered(ctx context.Context, valA
 	return nil
 }
 
+func (h MultiCheckpointingHooks) AfterBlsKeyRotated(ctx context.Context, valAddr sdk.ValAddress, epoch uint64) error {
+	for i := range h {
+		if err := h[i].AfterBlsKeyRotated(ctx, valAddr, epoch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h MultiCheckpointingHooks) AfterRawCheckpointSealed(ctx context.Context, epoch uint64) error {
 	for i := range h {
 		if err := h[i].AfterRawCheckpointSealed(ctx, epoch); err != nil {
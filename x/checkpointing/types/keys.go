@@ -3,6 +3,7 @@ package types
 import (
 	"github.com/babylonchain/babylon/crypto/bls12381"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -29,8 +30,10 @@ var (
 
 	CkptsObjectPrefix = append(CheckpointsPrefix, 0x0) // where we save the concrete BLS sig bytes
 
-	AddrToBlsKeyPrefix = append(RegistrationPrefix, 0x0) // where we save the concrete BLS public keys
-	BlsKeyToAddrPrefix = append(RegistrationPrefix, 0x1) // where we save BLS key set
+	AddrToBlsKeyPrefix  = append(RegistrationPrefix, 0x0) // where we save the concrete BLS public keys
+	BlsKeyToAddrPrefix  = append(RegistrationPrefix, 0x1) // where we save BLS key set
+	PendingBlsKeyPrefix = append(RegistrationPrefix, 0x2) // where we save the BLS keys pending to take effect in the next epoch
+	BlsKeyHistoryPrefix = append(RegistrationPrefix, 0x3) // where we save the rotated BLS keys along with their last epochs
 
 	LastFinalizedEpochKey = []byte{0x04} // LastFinalizedEpochKey defines the key to store the last finalised epoch
 )
@@ -50,6 +53,18 @@ func AddrToBlsKeyKey(valAddr sdk.ValAddress) []byte {
 	return valAddr
 }
 
+// PendingBlsKeyKey defines validator address
+func PendingBlsKeyKey(valAddr sdk.ValAddress) []byte {
+	return valAddr
+}
+
+// BlsKeyHistoryKey defines the length-prefixed validator address and the
+// last epoch of the rotated BLS key, so that the rotated BLS keys of a
+// validator are ordered by their last epochs
+func BlsKeyHistoryKey(valAddr sdk.ValAddress, lastEpoch uint64) []byte {
+	return append(address.MustLengthPrefix(valAddr), sdk.Uint64ToBigEndian(lastEpoch)...)
+}
+
 // BlsKeyToAddrKey defines BLS public key
 func BlsKeyToAddrKey(pk bls12381.PublicKey) []byte {
 	return pk
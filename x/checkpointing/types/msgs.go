@@ -15,6 +15,7 @@ import (
 var (
 	// Ensure that MsgInsertHeader implements all functions of the Msg interface
 	_ sdk.Msg = (*MsgWrappedCreateValidator)(nil)
+	_ sdk.Msg = (*MsgRotateBlsKey)(nil)
 )
 
 func NewMsgWrappedCreateValidator(msgCreateVal *stakingtypes.MsgCreateValidator, blsPK *bls12381.PublicKey, pop *ProofOfPossession) (*MsgWrappedCreateValidator, error) {
@@ -54,3 +55,33 @@ func (m *MsgWrappedCreateValidator) ValidateBasic() error {
 func (msg MsgWrappedCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return msg.MsgCreateValidator.UnpackInterfaces(unpacker)
 }
+
+func NewMsgRotateBlsKey(signer sdk.AccAddress, blsPK *bls12381.PublicKey, pop *ProofOfPossession) *MsgRotateBlsKey {
+	return &MsgRotateBlsKey{
+		Signer: signer.String(),
+		Key: &BlsKey{
+			Pubkey: blsPK,
+			Pop:    pop,
+		},
+	}
+}
+
+// VerifyPoP verifies the proof-of-possession of the new BLS key w.r.t. the
+// consensus public key of the validator
+func (m *MsgRotateBlsKey) VerifyPoP(valPubkey cryptotypes.PubKey) bool {
+	return m.Key.Pop.IsValid(*m.Key.Pubkey, valPubkey)
+}
+
+// ValidateBasic validates statelesss message elements
+func (m *MsgRotateBlsKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return err
+	}
+	if m.Key == nil || m.Key.Pubkey == nil || m.Key.Pop == nil || m.Key.Pop.BlsSig == nil {
+		return errors.New("the new BLS key is empty")
+	}
+	if len(*m.Key.Pubkey) != bls12381.PubKeySize {
+		return errors.New("invalid BLS public key size")
+	}
+	return m.Key.Pop.BlsSig.ValidateBasic()
+}
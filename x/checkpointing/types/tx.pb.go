@@ -107,38 +107,125 @@ func (m *MsgWrappedCreateValidatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWrappedCreateValidatorResponse proto.InternalMessageInfo
 
+// MsgRotateBlsKey defines a message to rotate the BLS key of a validator.
+// The new BLS key takes effect from the next epoch, while the old BLS key
+// remains for verifying the checkpoints of the previous epochs.
+type MsgRotateBlsKey struct {
+	// signer is the account address of the validator operator
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// key is the new BLS key of the validator with a proof-of-possession
+	// w.r.t. the consensus public key of the validator
+	Key *BlsKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *MsgRotateBlsKey) Reset()         { *m = MsgRotateBlsKey{} }
+func (m *MsgRotateBlsKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateBlsKey) ProtoMessage()    {}
+func (*MsgRotateBlsKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b16c54750152c21, []int{2}
+}
+func (m *MsgRotateBlsKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateBlsKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateBlsKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateBlsKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateBlsKey.Merge(m, src)
+}
+func (m *MsgRotateBlsKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateBlsKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateBlsKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateBlsKey proto.InternalMessageInfo
+
+// MsgRotateBlsKeyResponse defines the MsgRotateBlsKey response type
+type MsgRotateBlsKeyResponse struct {
+}
+
+func (m *MsgRotateBlsKeyResponse) Reset()         { *m = MsgRotateBlsKeyResponse{} }
+func (m *MsgRotateBlsKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateBlsKeyResponse) ProtoMessage()    {}
+func (*MsgRotateBlsKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b16c54750152c21, []int{3}
+}
+func (m *MsgRotateBlsKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateBlsKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateBlsKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateBlsKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateBlsKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateBlsKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateBlsKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateBlsKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateBlsKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgWrappedCreateValidator)(nil), "babylon.checkpointing.v1.MsgWrappedCreateValidator")
 	proto.RegisterType((*MsgWrappedCreateValidatorResponse)(nil), "babylon.checkpointing.v1.MsgWrappedCreateValidatorResponse")
+	proto.RegisterType((*MsgRotateBlsKey)(nil), "babylon.checkpointing.v1.MsgRotateBlsKey")
+	proto.RegisterType((*MsgRotateBlsKeyResponse)(nil), "babylon.checkpointing.v1.MsgRotateBlsKeyResponse")
 }
 
 func init() { proto.RegisterFile("babylon/checkpointing/v1/tx.proto", fileDescriptor_6b16c54750152c21) }
 
 var fileDescriptor_6b16c54750152c21 = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x3d, 0x4b, 0xfb, 0x40,
-	0x1c, 0xc7, 0x73, 0xff, 0xf2, 0x77, 0x38, 0xb7, 0x50, 0xb4, 0x66, 0x48, 0x1f, 0x04, 0x91, 0x0e,
-	0x77, 0xb4, 0xc5, 0x45, 0xb7, 0x3a, 0x4a, 0x11, 0x3a, 0x28, 0x88, 0x50, 0x2e, 0xe9, 0x71, 0x0d,
-	0x79, 0xb8, 0x90, 0xdf, 0x59, 0x9a, 0x4d, 0x9c, 0xc4, 0xc9, 0xd5, 0xad, 0x2f, 0xa1, 0x2f, 0xc3,
-	0xb1, 0xa3, 0xa3, 0xb4, 0x43, 0x7d, 0x19, 0xd2, 0x24, 0x45, 0xac, 0xde, 0xe2, 0x76, 0x0f, 0x9f,
-	0xdf, 0xf7, 0x81, 0x3b, 0x5c, 0x77, 0x98, 0x93, 0x06, 0x32, 0xa2, 0xee, 0x88, 0xbb, 0x7e, 0x2c,
-	0xbd, 0x48, 0x79, 0x91, 0xa0, 0xe3, 0x16, 0x55, 0x13, 0x12, 0x27, 0x52, 0x49, 0xb3, 0x52, 0x20,
-	0xe4, 0x1b, 0x42, 0xc6, 0x2d, 0xab, 0x2c, 0xa4, 0x90, 0x19, 0x44, 0xd7, 0xab, 0x9c, 0xb7, 0x8e,
-	0xb4, 0x92, 0x4e, 0x00, 0x03, 0x9f, 0xa7, 0x05, 0x57, 0x75, 0x25, 0x84, 0x12, 0x28, 0x28, 0xe6,
-	0xe7, 0x80, 0xc3, 0x15, 0xfb, 0x32, 0xb6, 0xf6, 0x0b, 0x20, 0x84, 0x6c, 0x3a, 0x04, 0x91, 0x5f,
-	0x34, 0xe6, 0x08, 0x1f, 0xf4, 0x40, 0x5c, 0x27, 0x2c, 0x8e, 0xf9, 0xf0, 0x3c, 0xe1, 0x4c, 0xf1,
-	0x2b, 0x16, 0x78, 0x43, 0xa6, 0x64, 0x62, 0xb6, 0x71, 0xc9, 0xe7, 0x69, 0x05, 0xd5, 0xd0, 0xf1,
-	0x6e, 0xbb, 0x46, 0x74, 0xe9, 0x49, 0x37, 0x80, 0x0b, 0x9e, 0xf6, 0xd7, 0xb0, 0x79, 0x8b, 0xcb,
-	0x21, 0x88, 0x81, 0x9b, 0x49, 0x0d, 0xc6, 0x1b, 0xad, 0xca, 0xbf, 0x4c, 0xa4, 0x49, 0xf2, 0x24,
-	0xa4, 0x88, 0x4a, 0x8a, 0xa8, 0xa4, 0x07, 0x62, 0xcb, 0xbd, 0x6f, 0x86, 0x3f, 0xce, 0x4e, 0xeb,
-	0x8f, 0xd3, 0xaa, 0xf1, 0x31, 0xad, 0x1a, 0x0f, 0xab, 0x59, 0xf3, 0x57, 0xa3, 0xc6, 0x21, 0xae,
-	0x6b, 0x1b, 0xf5, 0x39, 0xc4, 0x32, 0x02, 0xde, 0x7e, 0x41, 0xb8, 0xd4, 0x03, 0x61, 0x3e, 0x21,
-	0xbc, 0xa7, 0x29, 0xdf, 0xd1, 0xf7, 0xd5, 0xea, 0x5b, 0x67, 0x7f, 0x18, 0xda, 0x84, 0xb2, 0xfe,
-	0xdf, 0xaf, 0x66, 0x4d, 0xd4, 0xbd, 0x7c, 0x5d, 0xd8, 0x68, 0xbe, 0xb0, 0xd1, 0xfb, 0xc2, 0x46,
-	0xcf, 0x4b, 0xdb, 0x98, 0x2f, 0x6d, 0xe3, 0x6d, 0x69, 0x1b, 0x37, 0x27, 0xc2, 0x53, 0xa3, 0x3b,
-	0x87, 0xb8, 0x32, 0xa4, 0x85, 0x8f, 0x3b, 0x62, 0x5e, 0xb4, 0xd9, 0xd0, 0xc9, 0xd6, 0x4f, 0x51,
-	0x69, 0xcc, 0xc1, 0xd9, 0xc9, 0xde, 0xba, 0xf3, 0x19, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x29, 0x9a,
-	0x31, 0xa2, 0x02, 0x00, 0x00,
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0xc6, 0x33, 0xbd, 0x78, 0xc1, 0x51, 0x10, 0xe2, 0xa5, 0x7f, 0xb2, 0x48, 0xdb, 0x08, 0xa2,
+	0x5d, 0x4c, 0x68, 0x8b, 0x9b, 0xba, 0xab, 0x4b, 0x09, 0x42, 0x16, 0x0a, 0x22, 0x94, 0x49, 0x3a,
+	0x4c, 0x43, 0xfe, 0x4c, 0xc8, 0x19, 0x4b, 0xb3, 0x13, 0x37, 0x8a, 0x2b, 0x1f, 0xa1, 0x8f, 0xd0,
+	0xc7, 0x70, 0xd9, 0xa5, 0x4b, 0x69, 0x17, 0xf5, 0x31, 0xa4, 0xc9, 0x04, 0xdb, 0x6a, 0x44, 0xdd,
+	0xe5, 0x24, 0xbf, 0xf3, 0x7d, 0xdf, 0x39, 0x99, 0xc1, 0x7d, 0x8f, 0x7a, 0x79, 0x24, 0x12, 0xdb,
+	0x5f, 0x30, 0x3f, 0x4c, 0x45, 0x90, 0xc8, 0x20, 0xe1, 0xf6, 0x72, 0x68, 0xcb, 0x15, 0x49, 0x33,
+	0x21, 0x85, 0xde, 0x56, 0x08, 0x39, 0x43, 0xc8, 0x72, 0x68, 0xdc, 0x70, 0xc1, 0x45, 0x01, 0xd9,
+	0xc7, 0xa7, 0x92, 0x37, 0x1e, 0xd6, 0x4a, 0x7a, 0x11, 0xcc, 0x42, 0x96, 0x2b, 0xae, 0xeb, 0x0b,
+	0x88, 0x05, 0xd8, 0x20, 0x69, 0x58, 0x02, 0x1e, 0x93, 0xf4, 0xa7, 0xb1, 0xd1, 0x52, 0x40, 0x0c,
+	0x45, 0x77, 0x0c, 0xbc, 0xfc, 0x60, 0x6d, 0x11, 0xee, 0x38, 0xc0, 0x5f, 0x65, 0x34, 0x4d, 0xd9,
+	0xfc, 0x59, 0xc6, 0xa8, 0x64, 0x2f, 0x69, 0x14, 0xcc, 0xa9, 0x14, 0x99, 0x3e, 0xc2, 0x57, 0x21,
+	0xcb, 0xdb, 0xa8, 0x87, 0x1e, 0xdd, 0x19, 0xf5, 0x48, 0x5d, 0x7a, 0x32, 0x8d, 0xe0, 0x39, 0xcb,
+	0xdd, 0x23, 0xac, 0xbf, 0xc1, 0x37, 0x31, 0xf0, 0x99, 0x5f, 0x48, 0xcd, 0x96, 0x95, 0x56, 0xbb,
+	0x51, 0x88, 0x0c, 0x48, 0x99, 0x84, 0xa8, 0xa8, 0x44, 0x45, 0x25, 0x0e, 0xf0, 0x0b, 0x77, 0x57,
+	0x8f, 0x7f, 0x79, 0x37, 0xe9, 0x7f, 0x5c, 0x77, 0xb5, 0xef, 0xeb, 0xae, 0xf6, 0xfe, 0xb0, 0x19,
+	0xfc, 0xd6, 0xc8, 0x7a, 0x80, 0xfb, 0xb5, 0x13, 0xb9, 0x0c, 0x52, 0x91, 0x00, 0xb3, 0x32, 0x7c,
+	0xcf, 0x01, 0xee, 0x0a, 0x49, 0x25, 0x2b, 0xd3, 0xeb, 0x4d, 0x7c, 0x0d, 0x01, 0x4f, 0x58, 0x56,
+	0xcc, 0x7b, 0xdb, 0x55, 0x55, 0xb5, 0x84, 0xc6, 0x3f, 0x2c, 0x61, 0x72, 0xff, 0x34, 0xa6, 0x12,
+	0xb2, 0x3a, 0xb8, 0x75, 0xe1, 0x59, 0xc5, 0x19, 0x7d, 0x68, 0xe0, 0x2b, 0x07, 0xb8, 0xfe, 0x09,
+	0xe1, 0x66, 0xcd, 0xbf, 0x18, 0xd7, 0x3b, 0xd7, 0x8e, 0x6b, 0x3c, 0xfd, 0x8f, 0xa6, 0x2a, 0x94,
+	0x1e, 0xe1, 0xbb, 0x67, 0x0b, 0x7a, 0xfc, 0x47, 0xb1, 0x53, 0xd4, 0x18, 0xfe, 0x35, 0x5a, 0xb9,
+	0x19, 0xb7, 0xde, 0x1d, 0x36, 0x03, 0x34, 0x7d, 0xf1, 0x65, 0x67, 0xa2, 0xed, 0xce, 0x44, 0xdf,
+	0x76, 0x26, 0xfa, 0xbc, 0x37, 0xb5, 0xed, 0xde, 0xd4, 0xbe, 0xee, 0x4d, 0xed, 0xf5, 0x13, 0x1e,
+	0xc8, 0xc5, 0x5b, 0x8f, 0xf8, 0x22, 0xb6, 0x95, 0xba, 0xbf, 0xa0, 0x41, 0x52, 0x15, 0xf6, 0xea,
+	0xe2, 0x9a, 0xc8, 0x3c, 0x65, 0xe0, 0x5d, 0x17, 0x07, 0x7d, 0xfc, 0x23, 0x00, 0x00, 0xff, 0xff,
+	0xf2, 0xaf, 0xb2, 0xf2, 0x9f, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +242,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// WrappedCreateValidator defines a method for registering a new validator
 	WrappedCreateValidator(ctx context.Context, in *MsgWrappedCreateValidator, opts ...grpc.CallOption) (*MsgWrappedCreateValidatorResponse, error)
+	// RotateBlsKey defines a method for rotating the BLS key of a validator
+	RotateBlsKey(ctx context.Context, in *MsgRotateBlsKey, opts ...grpc.CallOption) (*MsgRotateBlsKeyResponse, error)
 }
 
 type msgClient struct {
@@ -174,10 +263,21 @@ func (c *msgClient) WrappedCreateValidator(ctx context.Context, in *MsgWrappedCr
 	return out, nil
 }
 
+func (c *msgClient) RotateBlsKey(ctx context.Context, in *MsgRotateBlsKey, opts ...grpc.CallOption) (*MsgRotateBlsKeyResponse, error) {
+	out := new(MsgRotateBlsKeyResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Msg/RotateBlsKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// WrappedCreateValidator defines a method for registering a new validator
 	WrappedCreateValidator(context.Context, *MsgWrappedCreateValidator) (*MsgWrappedCreateValidatorResponse, error)
+	// RotateBlsKey defines a method for rotating the BLS key of a validator
+	RotateBlsKey(context.Context, *MsgRotateBlsKey) (*MsgRotateBlsKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -187,6 +287,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) WrappedCreateValidator(ctx context.Context, req *MsgWrappedCreateValidator) (*MsgWrappedCreateValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WrappedCreateValidator not implemented")
 }
+func (*UnimplementedMsgServer) RotateBlsKey(ctx context.Context, req *MsgRotateBlsKey) (*MsgRotateBlsKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateBlsKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -210,6 +313,24 @@ func _Msg_WrappedCreateValidator_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateBlsKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateBlsKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateBlsKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Msg/RotateBlsKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateBlsKey(ctx, req.(*MsgRotateBlsKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.checkpointing.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -218,6 +339,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WrappedCreateValidator",
 			Handler:    _Msg_WrappedCreateValidator_Handler,
 		},
+		{
+			MethodName: "RotateBlsKey",
+			Handler:    _Msg_RotateBlsKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/checkpointing/v1/tx.proto",
@@ -293,6 +418,71 @@ func (m *MsgWrappedCreateValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRotateBlsKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateBlsKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateBlsKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateBlsKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateBlsKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateBlsKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -330,6 +520,32 @@ func (m *MsgWrappedCreateValidatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotateBlsKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateBlsKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -508,6 +724,174 @@ func (m *MsgWrappedCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotateBlsKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateBlsKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateBlsKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Key == nil {
+				m.Key = &BlsKey{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateBlsKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateBlsKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateBlsKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -58,6 +58,61 @@ func (cm *RawCheckpointWithMeta) Accumulate(
 	sig bls12381.Signature,
 	totalPower int64) error {
 
+	return cm.accumulate(vals, signerAddr, sig, totalPower, func() error {
+		// aggregate BLS public key
+		if cm.BlsAggrPk != nil {
+			aggPK, err := bls12381.AggrPK(*cm.BlsAggrPk, signerBlsKey)
+			if err != nil {
+				return err
+			}
+			cm.BlsAggrPk = &aggPK
+		} else {
+			cm.BlsAggrPk = &signerBlsKey
+		}
+		return nil
+	})
+}
+
+// AccumulateWithAggregator is the same as Accumulate, except that the BLS
+// public key (decompressed) of the signer is added to the given aggregator,
+// which keeps the aggregated BLS public key of the signers so far, and the
+// aggregated BLS public key is only compressed into BlsAggrPk once the
+// checkpoint is sealed. The aggregator should be used for accumulating a
+// single checkpoint only.
+func (cm *RawCheckpointWithMeta) AccumulateWithAggregator(
+	vals epochingtypes.ValidatorSet,
+	signerAddr sdk.ValAddress,
+	signerBlsKey *bls12381.BlsPubKey,
+	sig bls12381.Signature,
+	totalPower int64,
+	aggr *bls12381.PubKeyAggregator) error {
+
+	if err := cm.accumulate(vals, signerAddr, sig, totalPower, func() error {
+		aggr.AddDecompressed(signerBlsKey)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if cm.Status == Sealed {
+		aggPK, err := aggr.AggrPK()
+		if err != nil {
+			return err
+		}
+		cm.BlsAggrPk = &aggPK
+	}
+	return nil
+}
+
+// accumulate accumulates the BLS signature of the signer into the checkpoint,
+// where aggrPK aggregates the BLS public key of the signer
+func (cm *RawCheckpointWithMeta) accumulate(
+	vals epochingtypes.ValidatorSet,
+	signerAddr sdk.ValAddress,
+	sig bls12381.Signature,
+	totalPower int64,
+	aggrPK func() error) error {
+
 	// the checkpoint should be accumulating
 	if cm.Status != Accumulating {
 		return ErrCkptNotAccumulating
@@ -86,14 +141,8 @@ func (cm *RawCheckpointWithMeta) Accumulate(
 	}
 
 	// aggregate BLS public key
-	if cm.BlsAggrPk != nil {
-		aggPK, err := bls12381.AggrPK(*cm.BlsAggrPk, signerBlsKey)
-		if err != nil {
-			return err
-		}
-		cm.BlsAggrPk = &aggPK
-	} else {
-		cm.BlsAggrPk = &signerBlsKey
+	if err := aggrPK(); err != nil {
+		return err
 	}
 
 	// update bitmap
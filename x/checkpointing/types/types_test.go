@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
@@ -63,3 +64,47 @@ func TestRawCheckpointWithMeta_Accumulate4(t *testing.T) {
 		}
 	}
 }
+
+// 4 validators, accumulating with a BLS public key aggregator, which results in
+// the same checkpoint as Accumulate
+func TestRawCheckpointWithMeta_AccumulateWithAggregator4(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	epochNum := uint64(2)
+	n := 4
+	totalPower := int64(10) * int64(n)
+	ckptkeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
+	blockHash := datagen.GenRandomBlockHash(r)
+	msg := types.GetSignBytes(epochNum, blockHash)
+	blsPubkeys, blsSigs := datagen.GenRandomPubkeysAndSigs(n, msg)
+	ckpt, err := ckptkeeper.BuildRawCheckpoint(ctx, epochNum, blockHash)
+	require.NoError(t, err)
+	expectedCkpt := types.NewCheckpointWithMeta(types.NewCheckpoint(epochNum, blockHash), types.Accumulating)
+	valSet := datagen.GenRandomValSet(n)
+	aggr := bls12381.NewPubKeyAggregator()
+	for i := 0; i < n; i++ {
+		decompressed, err := bls12381.DecompressPubKey(blsPubkeys[i])
+		require.NoError(t, err)
+		err = ckpt.AccumulateWithAggregator(valSet, valSet[i].Addr, decompressed, blsSigs[i], totalPower, aggr)
+		expectedErr := expectedCkpt.Accumulate(valSet, valSet[i].Addr, blsPubkeys[i], blsSigs[i], totalPower)
+		if i <= 1 {
+			require.NoError(t, err)
+			require.Equal(t, types.Accumulating, ckpt.Status)
+			// the aggregated BLS public key is only set once sealed
+			require.Nil(t, ckpt.BlsAggrPk)
+		}
+		if i == 2 {
+			require.NoError(t, err)
+			require.Equal(t, types.Sealed, ckpt.Status)
+		}
+		if i == 3 {
+			require.ErrorIs(t, err, types.ErrCkptNotAccumulating)
+			require.Equal(t, types.Sealed, ckpt.Status)
+		}
+		require.Equal(t, expectedErr, err)
+	}
+	// the BLS public key of the last validator is not aggregated
+	require.Equal(t, n-1, aggr.Len())
+	require.True(t, expectedCkpt.Ckpt.Equal(ckpt.Ckpt))
+	require.True(t, expectedCkpt.BlsAggrPk.Equal(*ckpt.BlsAggrPk))
+	require.Equal(t, expectedCkpt.PowerSum, ckpt.PowerSum)
+}
@@ -1,6 +1,7 @@
 package checkpointing
 
 import (
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/log"
@@ -35,9 +36,14 @@ func (h *VoteExtensionHandler) SetHandlers(bApp *baseapp.BaseApp) {
 // sent as we cannot ensure all the vote extensions will
 // be checked by VerifyVoteExtension due to the issue
 // https://github.com/cometbft/cometbft/issues/2361
-// therefore, we panic upon any error, otherwise, empty
+// therefore, we panic upon most errors, otherwise, empty
 // vote extension will still be sent, according to
 // https://github.com/cosmos/cosmos-sdk/blob/7dbed2fc0c3ed7c285645e21cb1037d8810372ae/baseapp/abci.go#L612
+// The exceptions are ErrBlsSignRefused, i.e., the BLS signer
+// refusing to sign by its double-sign protection, and
+// ErrBlsKeyMismatch, i.e., the BLS signer missing the rotated
+// BLS key, upon which an empty vote extension is returned,
+// as they are not programmatic errors
 // TODO: revisit panicking if the CometBFT issue is resolved
 func (h *VoteExtensionHandler) ExtendVote() sdk.ExtendVoteHandler {
 	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
@@ -64,8 +70,36 @@ func (h *VoteExtensionHandler) ExtendVote() sdk.ExtendVoteHandler {
 			panic(fmt.Errorf("the BLS signer %s is not in the validator set", signer.String()))
 		}
 
-		// 2. sign BLS signature
+		// 2. switch to the BLS key in effect at the epoch if the BLS key
+		// of the validator has been rotated
+		err = k.SwitchBlsKeyAtEpoch(ctx, epoch.EpochNumber)
+		if errors.Is(err, ckpttypes.ErrBlsKeyMismatch) {
+			// NOTE: the new BLS key of a BLS key rotation is not available
+			// to the BLS signer, which has been reported upon the rotation
+			// taking effect. The validator cannot sign the checkpoint until
+			// the new BLS key is available, and the empty vote extension is
+			// skipped when building the checkpoint.
+			h.logger.Error("failed to use the BLS key in effect at the epoch",
+				"epoch", epoch.EpochNumber, "height", req.Height, "err", err)
+			return emptyRes, err
+		}
+		if err != nil {
+			// NOTE: this indicates misconfiguration of the BLS key
+			panic(fmt.Errorf("failed to use the BLS key in effect at epoch %v: %w",
+				epoch.EpochNumber, err))
+		}
+
+		// 3. sign BLS signature
 		blsSig, err := k.SignBLS(epoch.EpochNumber, req.Hash)
+		if errors.Is(err, ckpttypes.ErrBlsSignRefused) {
+			// NOTE: the BLS signer refuses to sign another block hash for
+			// the epoch that it has signed, e.g., the block proposed in an
+			// earlier round at this height. The empty vote extension is
+			// skipped when building the checkpoint.
+			h.logger.Error("the BLS signer refused to sign the checkpoint",
+				"epoch", epoch.EpochNumber, "height", req.Height, "err", err)
+			return emptyRes, err
+		}
 		if err != nil {
 			// NOTE: this indicates misconfiguration of the BLS key
 			panic(fmt.Errorf("failed to sign BLS signature at epoch %v, height %v",
@@ -78,7 +112,7 @@ func (h *VoteExtensionHandler) ExtendVote() sdk.ExtendVoteHandler {
 			panic(fmt.Errorf("invalid CometBFT hash"))
 		}
 
-		// 3. build vote extension
+		// 4. build vote extension
 		ve := &ckpttypes.VoteExtension{
 			Signer:           signer.String(),
 			ValidatorAddress: k.GetValidatorAddress().String(),
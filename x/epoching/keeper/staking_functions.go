@@ -115,3 +115,12 @@ func (k Keeper) CheckMsgCreateValidator(ctx context.Context, msg *stakingtypes.M
 func (k Keeper) GetPubKeyByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error) {
 	return k.stk.GetPubKeyByConsAddr(ctx, consAddr)
 }
+
+// GetValidatorConsPubKey returns the consensus public key of the given validator
+func (k Keeper) GetValidatorConsPubKey(ctx context.Context, valAddr sdk.ValAddress) (cryptotypes.PubKey, error) {
+	val, err := k.stk.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	return val.ConsPubKey()
+}
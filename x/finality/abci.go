@@ -38,6 +38,10 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 		if heightToExamine >= 1 {
 			k.HandleLiveness(ctx, heightToExamine)
 		}
+
+		// prune finality votes, public randomness and indexed blocks at heights
+		// that have been finalized and fall out of the retention window
+		k.PruneHeights(ctx)
 	}
 
 	return []abci.ValidatorUpdate{}, nil
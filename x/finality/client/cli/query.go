@@ -15,6 +15,8 @@ import (
 const (
 	flagQueriedBlockStatus = "queried-block-status"
 	flagStartHeight        = "start-height"
+	flagNumEpochs          = "num-epochs"
+	flagQueriedHashType    = "queried-hash-type"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -32,9 +34,14 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListPublicRandomness())
 	cmd.AddCommand(CmdListPubRandCommit())
 	cmd.AddCommand(CmdBlock())
+	cmd.AddCommand(CmdBlockByHash())
+	cmd.AddCommand(CmdLastFinalizedBlock())
 	cmd.AddCommand(CmdListBlocks())
 	cmd.AddCommand(CmdVotesAtHeight())
 	cmd.AddCommand(CmdListEvidences())
+	cmd.AddCommand(CmdFinalityProof())
+	cmd.AddCommand(CmdFinalityProviderStats())
+	cmd.AddCommand(CmdFinalityHaltStatus())
 
 	return cmd
 }
@@ -207,6 +214,98 @@ func CmdBlock() *cobra.Command {
 	return cmd
 }
 
+func CmdBlockByHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-by-hash [hash_hex]",
+		Short: "show the information of the block with a given CometBFT block hash or AppHash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			queriedHashTypeString, err := cmd.Flags().GetString(flagQueriedHashType)
+			if err != nil {
+				return err
+			}
+			queriedHashType, err := types.NewQueriedHashType(queriedHashTypeString)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlockByHash(cmd.Context(), &types.QueryBlockByHashRequest{
+				HashHex:  args[0],
+				HashType: queriedHashType,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagQueriedHashType, "BlockHash", "Type of the queried hash (BlockHash|AppHash)")
+
+	return cmd
+}
+
+func CmdLastFinalizedBlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-finalized-block",
+		Short: "show the information of the BTC-finalized block at the highest height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastFinalizedBlock(cmd.Context(), &types.QueryLastFinalizedBlockRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-proof [height]",
+		Short: "show the proof that the block at a given height is BTC-finalized",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			queriedBlockHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProof(cmd.Context(), &types.QueryFinalityProofRequest{
+				Height: queriedBlockHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdListEvidences() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-evidences",
@@ -244,3 +343,65 @@ func CmdListEvidences() *cobra.Command {
 
 	return cmd
 }
+
+func CmdFinalityProviderStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-stats",
+		Short: "list the performance statistics of finality providers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			numEpochs, err := cmd.Flags().GetUint64(flagNumEpochs)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProviderStats(cmd.Context(), &types.QueryFinalityProviderStatsRequest{
+				NumEpochs:  numEpochs,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "finality-provider-stats")
+	cmd.Flags().Uint64(flagNumEpochs, 1, "Number of the most recent epochs over which the share of voted blocks is computed")
+
+	return cmd
+}
+
+func CmdFinalityHaltStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-halt-status",
+		Short: "show whether the finality is halted at a block without quorum",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FinalityHaltStatus(cmd.Context(), &types.QueryFinalityHaltStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/hex"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -28,6 +29,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCommitPubRandListCmd(),
 		NewAddFinalitySigCmd(),
+		NewAddFinalitySigsCmd(),
+		NewSubmitFinalityEvidenceCmd(),
+		NewUnjailFinalityProviderCmd(),
 	)
 
 	return cmd
@@ -113,48 +117,214 @@ func NewAddFinalitySigCmd() *cobra.Command {
 				return err
 			}
 
-			// get block height
-			blockHeight, err := strconv.ParseUint(args[1], 10, 64)
+			entry, err := parseFinalitySigEntry(clientCtx, args[1:])
 			if err != nil {
 				return err
 			}
 
-			// get public randomness
-			pubRand, err := bbn.NewSchnorrPubRandFromHex(args[2])
+			msg := types.MsgAddFinalitySig{
+				Signer:       clientCtx.FromAddress.String(),
+				FpBtcPk:      fpBTCPK,
+				BlockHeight:  entry.BlockHeight,
+				PubRand:      entry.PubRand,
+				Proof:        entry.Proof,
+				BlockAppHash: entry.BlockAppHash,
+				FinalitySig:  entry.FinalitySig,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddFinalitySigsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-finality-sigs [fp_btc_pk] [sigs_file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add a batch of finality signatures",
+		Long: strings.TrimSpace(
+			`Add a batch of finality signatures of a finality provider over multiple heights.
+Each non-empty line of the given file is a finality signature in the format of
+"[block_height] [pub_rand] [proof] [block_app_hash] [finality_sig]", i.e., the
+same as the arguments of add-finality-sig.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			// get proof
-			proofBytes, err := hex.DecodeString(args[3])
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
 			if err != nil {
 				return err
 			}
-			var proof cmtcrypto.Proof
-			if err := clientCtx.Codec.Unmarshal(proofBytes, &proof); err != nil {
+
+			// get finality signatures
+			sigsBytes, err := os.ReadFile(args[1])
+			if err != nil {
 				return err
 			}
+			sigs := []*types.FinalitySigEntry{}
+			for i, line := range strings.Split(string(sigsBytes), "\n") {
+				fields := strings.Fields(line)
+				if len(fields) == 0 {
+					continue
+				}
+				if len(fields) != 5 {
+					return fmt.Errorf("line %d: expected 5 fields, got %d", i+1, len(fields))
+				}
+				entry, err := parseFinalitySigEntry(clientCtx, fields)
+				if err != nil {
+					return fmt.Errorf("line %d: %w", i+1, err)
+				}
+				sigs = append(sigs, entry)
+			}
+
+			msg := types.MsgAddFinalitySigs{
+				Signer:  clientCtx.FromAddress.String(),
+				FpBtcPk: fpBTCPK,
+				Sigs:    sigs,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
 
-			// get block app hash
-			appHash, err := hex.DecodeString(args[4])
+func NewSubmitFinalityEvidenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-finality-evidence [fp_btc_pk] [block_height] [pub_rand] [proof] [block_app_hash1] [finality_sig1] [block_app_hash2] [finality_sig2]",
+		Args:  cobra.ExactArgs(8),
+		Short: "Submit an evidence that a finality provider signs two conflicting blocks",
+		Long: strings.TrimSpace(
+			`Submit an evidence that a finality provider signs two conflicting blocks at the same height
+with the same public randomness. Upon a valid evidence, the finality provider is slashed and
+the submitter is rewarded.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			// get finality signature
-			finalitySig, err := bbn.NewSchnorrEOTSSigFromHex(args[5])
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
 			if err != nil {
 				return err
 			}
 
-			msg := types.MsgAddFinalitySig{
-				Signer:       clientCtx.FromAddress.String(),
-				FpBtcPk:      fpBTCPK,
-				BlockHeight:  blockHeight,
-				PubRand:      pubRand,
-				Proof:        &proof,
-				BlockAppHash: appHash,
-				FinalitySig:  finalitySig,
+			// the two conflicting finality signatures share the block height,
+			// public randomness and proof
+			entry1, err := parseFinalitySigEntry(clientCtx, args[1:6])
+			if err != nil {
+				return err
+			}
+			entry2, err := parseFinalitySigEntry(clientCtx, append(args[1:4:4], args[6:8]...))
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSubmitFinalityEvidence{
+				Signer: clientCtx.FromAddress.String(),
+				Evidence: &types.Evidence{
+					FpBtcPk:              fpBTCPK,
+					BlockHeight:          entry1.BlockHeight,
+					PubRand:              entry1.PubRand,
+					CanonicalAppHash:     entry1.BlockAppHash,
+					CanonicalFinalitySig: entry1.FinalitySig,
+					ForkAppHash:          entry2.BlockAppHash,
+					ForkFinalitySig:      entry2.FinalitySig,
+				},
+				Proof: entry1.Proof,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseFinalitySigEntry parses a finality signature from the given arguments
+// [block_height] [pub_rand] [proof] [block_app_hash] [finality_sig]
+func parseFinalitySigEntry(clientCtx client.Context, args []string) (*types.FinalitySigEntry, error) {
+	// get block height
+	blockHeight, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	// get public randomness
+	pubRand, err := bbn.NewSchnorrPubRandFromHex(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	// get proof
+	proofBytes, err := hex.DecodeString(args[2])
+	if err != nil {
+		return nil, err
+	}
+	var proof cmtcrypto.Proof
+	if err := clientCtx.Codec.Unmarshal(proofBytes, &proof); err != nil {
+		return nil, err
+	}
+
+	// get block app hash
+	appHash, err := hex.DecodeString(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	// get finality signature
+	finalitySig, err := bbn.NewSchnorrEOTSSigFromHex(args[4])
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.FinalitySigEntry{
+		BlockHeight:  blockHeight,
+		PubRand:      pubRand,
+		Proof:        &proof,
+		BlockAppHash: appHash,
+		FinalitySig:  finalitySig,
+	}, nil
+}
+
+func NewUnjailFinalityProviderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail-finality-provider [fp_btc_pk]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Unjail a jailed finality provider",
+		Long: strings.TrimSpace(
+			`Unjail a jailed finality provider after its jailing period has passed. The transaction has to be signed by the finality provider's Babylon account.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnjailFinalityProvider{
+				Signer:  clientCtx.FromAddress.String(),
+				FpBtcPk: fpBTCPK,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
@@ -15,7 +15,7 @@ func TestGenesis(t *testing.T) {
 		Params: types.DefaultParams(),
 	}
 
-	k, ctx := keepertest.FinalityKeeper(t, nil, nil)
+	k, ctx := keepertest.FinalityKeeper(t, nil, nil, nil)
 	finality.InitGenesis(ctx, *k, genesisState)
 	got := finality.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
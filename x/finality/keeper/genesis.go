@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	btcstk "github.com/babylonchain/babylon/btcstaking"
@@ -33,6 +35,26 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.SetPubRandCommit(ctx, prc.FpBtcPk, prc.PubRandCommit)
 	}
 
+	for _, prc := range gs.SupersededPubRandCommits {
+		k.SetSupersededPubRandCommit(ctx, prc.FpBtcPk, prc.PubRandCommit)
+	}
+
+	for _, prp := range gs.PubRandProofs {
+		k.SetPubRandProof(ctx, prp.FpBtcPk, prp.BlockHeight, prp.Proof)
+	}
+
+	for _, stats := range gs.FpStats {
+		if err := k.FinalityProviderStatsTracker.Set(ctx, stats.FpBtcPk.MustMarshal(), *stats); err != nil {
+			return err
+		}
+	}
+
+	for _, stats := range gs.FpEpochStats {
+		if err := k.FinalityProviderEpochStatsTracker.Set(ctx, collections.Join(stats.FpBtcPk.MustMarshal(), stats.EpochNumber), *stats); err != nil {
+			return err
+		}
+	}
+
 	for _, info := range gs.SigningInfos {
 		err := k.FinalityProviderSigningTracker.Set(ctx, info.FpBtcPk.MustMarshal(), info.FpSigningInfo)
 		if err != nil {
@@ -48,6 +70,14 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		}
 	}
 
+	if gs.NextHeightToPrune > 0 {
+		k.setNextHeightToPrune(ctx, gs.NextHeightToPrune)
+	}
+
+	if gs.FinalityHalt != nil {
+		k.setFinalityHalt(ctx, gs.FinalityHalt)
+	}
+
 	return k.SetParams(ctx, gs.Params)
 }
 
@@ -78,11 +108,26 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	supersededPrCommits, err := k.exportSupersededPubRandCommits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pubRandProofs, err := k.exportPubRandProofs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	signingInfos, missedBlocks, err := k.signingInfosAndMissedBlock(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	fpStats, fpEpochStats, err := k.exportFinalityProviderStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Params:           k.GetParams(ctx),
 		IndexedBlocks:    blocks,
@@ -92,6 +137,13 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		PubRandCommit:    prCommit,
 		SigningInfos:     signingInfos,
 		MissedBlocks:     missedBlocks,
+
+		SupersededPubRandCommits: supersededPrCommits,
+		PubRandProofs:            pubRandProofs,
+		FpStats:                  fpStats,
+		FpEpochStats:             fpEpochStats,
+		NextHeightToPrune:        k.GetNextHeightToPrune(ctx),
+		FinalityHalt:             k.GetFinalityHalt(ctx),
 	}, nil
 }
 
@@ -221,6 +273,87 @@ func (k Keeper) exportPubRandCommit(ctx context.Context) ([]*types.PubRandCommit
 	return commtRandoms, nil
 }
 
+// exportSupersededPubRandCommits iterates over all superseded public randomness
+// commitments on the store, parses the finality provider public key from the
+// iterator key and the commitment from the iterator value.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) exportSupersededPubRandCommits(ctx context.Context) ([]*types.PubRandCommitWithPK, error) {
+	store := k.supersededPubRandCommitStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	prCommits := make([]*types.PubRandCommitWithPK, 0)
+	for ; iter.Valid(); iter.Next() {
+		// key contains the fp, the start height and the commitment
+		fpBTCPK, err := bbn.NewBIP340PubKey(iter.Key()[:bbn.BIP340PubKeyLen])
+		if err != nil {
+			return nil, err
+		}
+		var prc types.PubRandCommit
+		k.cdc.MustUnmarshal(iter.Value(), &prc)
+
+		prCommits = append(prCommits, &types.PubRandCommitWithPK{
+			FpBtcPk:       fpBTCPK,
+			PubRandCommit: &prc,
+		})
+	}
+
+	return prCommits, nil
+}
+
+// exportPubRandProofs iterates over all inclusion proofs of public randomness on
+// the store, parses the finality provider public key and the height from the
+// iterator key and the proof from the iterator value.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) exportPubRandProofs(ctx context.Context) ([]*types.PubRandProof, error) {
+	store := k.pubRandProofStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	proofs := make([]*types.PubRandProof, 0)
+	for ; iter.Valid(); iter.Next() {
+		// key contains the fp and the block height
+		fpBTCPK, blkHeight, err := parsePubKeyAndBlkHeightFromStoreKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		var proof cmtcrypto.Proof
+		k.cdc.MustUnmarshal(iter.Value(), &proof)
+
+		proofs = append(proofs, &types.PubRandProof{
+			BlockHeight: blkHeight,
+			FpBtcPk:     fpBTCPK,
+			Proof:       &proof,
+		})
+	}
+
+	return proofs, nil
+}
+
+// exportFinalityProviderStats loads the cumulative and per-epoch performance
+// statistics of all finality providers
+func (k Keeper) exportFinalityProviderStats(ctx context.Context) ([]*types.FinalityProviderStats, []*types.FinalityProviderEpochStats, error) {
+	fpStats := make([]*types.FinalityProviderStats, 0)
+	err := k.FinalityProviderStatsTracker.Walk(ctx, nil, func(_ []byte, stats types.FinalityProviderStats) (bool, error) {
+		fpStats = append(fpStats, &stats)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fpEpochStats := make([]*types.FinalityProviderEpochStats, 0)
+	err = k.FinalityProviderEpochStatsTracker.Walk(ctx, nil, func(_ collections.Pair[[]byte, uint64], stats types.FinalityProviderEpochStats) (bool, error) {
+		fpEpochStats = append(fpEpochStats, &stats)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fpStats, fpEpochStats, nil
+}
+
 func (k Keeper) signingInfosAndMissedBlock(ctx context.Context) ([]types.SigningInfo, []types.FinalityProviderMissedBlocks, error) {
 	signingInfos := make([]types.SigningInfo, 0)
 	missedBlocks := make([]types.FinalityProviderMissedBlocks, 0)
@@ -4,11 +4,16 @@ import (
 	"math/rand"
 	"testing"
 
+	"cosmossdk.io/collections"
+
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	keepertest "github.com/babylonchain/babylon/testutil/keeper"
 	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/keeper"
 	"github.com/babylonchain/babylon/x/finality/types"
 )
 
@@ -16,7 +21,7 @@ func FuzzTestExportGenesis(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
 		r := rand.New(rand.NewSource(seed))
-		k, ctx := keepertest.FinalityKeeper(t, nil, nil)
+		k, ctx := keepertest.FinalityKeeper(t, nil, nil, nil)
 
 		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
 		require.NoError(t, err)
@@ -36,6 +41,7 @@ func FuzzTestExportGenesis(f *testing.F) {
 		allBlocks := make([]*types.IndexedBlock, numPubRand)
 		allEvidences := make([]*types.Evidence, numPubRand)
 		allPublicRandomness := make([]*types.PublicRandomness, numPubRand)
+		allPubRandProofs := make([]*types.PubRandProof, numPubRand)
 		for i := 0; i < int(numPubRand); i++ {
 			// Votes
 			vt := &types.VoteSig{
@@ -78,6 +84,15 @@ func FuzzTestExportGenesis(f *testing.F) {
 			}
 			allPublicRandomness[i] = randomness
 
+			// inclusion proofs of public randomness
+			prProof := &types.PubRandProof{
+				BlockHeight: blkHeight,
+				FpBtcPk:     fpBTCPK,
+				Proof:       randListInfo.ProofList[i].ToProto(),
+			}
+			k.SetPubRandProof(ctx, prProof.FpBtcPk, prProof.BlockHeight, prProof.Proof)
+			allPubRandProofs[i] = prProof
+
 			// updates the block everytime to make sure something is different.
 			blkHeight++
 		}
@@ -89,6 +104,13 @@ func FuzzTestExportGenesis(f *testing.F) {
 		}
 		k.SetPubRandCommit(ctx, fpBTCPK, prc)
 
+		supersededPrc := &types.PubRandCommit{
+			StartHeight: startHeight + numPubRand,
+			NumPubRand:  numPubRand,
+			Commitment:  datagen.GenRandomByteArray(r, 32),
+		}
+		k.SetSupersededPubRandCommit(ctx, fpBTCPK, supersededPrc)
+
 		numSigningInfo := datagen.RandomInt(r, 100) + 10
 		fpSigningInfos := map[string]*types.FinalityProviderSigningInfo{}
 		fpPks := make([]string, 0)
@@ -109,6 +131,24 @@ func FuzzTestExportGenesis(f *testing.F) {
 			fpSigningInfos[fpPk.MarshalHex()] = &signingInfo
 		}
 
+		fpStats := &types.FinalityProviderStats{
+			FpBtcPk:          fpBTCPK,
+			ExpectedVotes:    numPubRand,
+			VotedBlocks:      numPubRand,
+			FirstVotedHeight: startHeight,
+			LastVotedHeight:  startHeight + numPubRand - 1,
+		}
+		err = k.FinalityProviderStatsTracker.Set(ctx, fpBTCPK.MustMarshal(), *fpStats)
+		require.NoError(t, err)
+		fpEpochStats := &types.FinalityProviderEpochStats{
+			FpBtcPk:       fpBTCPK,
+			EpochNumber:   datagen.RandomInt(r, 10),
+			ExpectedVotes: numPubRand,
+			VotedBlocks:   numPubRand,
+		}
+		err = k.FinalityProviderEpochStatsTracker.Set(ctx, collections.Join(fpBTCPK.MustMarshal(), fpEpochStats.EpochNumber), *fpEpochStats)
+		require.NoError(t, err)
+
 		require.Equal(t, len(allVotes), int(numPubRand))
 		require.Equal(t, len(allBlocks), int(numPubRand))
 		require.Equal(t, len(allEvidences), int(numPubRand))
@@ -122,7 +162,13 @@ func FuzzTestExportGenesis(f *testing.F) {
 		require.Equal(t, allBlocks, gs.IndexedBlocks)
 		require.Equal(t, allEvidences, gs.Evidences)
 		require.Equal(t, allPublicRandomness, gs.PublicRandomness)
+		require.Equal(t, allPubRandProofs, gs.PubRandProofs)
 		require.Equal(t, prc, gs.PubRandCommit[0].PubRandCommit)
+		require.Len(t, gs.SupersededPubRandCommits, 1)
+		require.Equal(t, fpBTCPK.MustMarshal(), gs.SupersededPubRandCommits[0].FpBtcPk.MustMarshal())
+		require.Equal(t, supersededPrc, gs.SupersededPubRandCommits[0].PubRandCommit)
+		require.Equal(t, []*types.FinalityProviderStats{fpStats}, gs.FpStats)
+		require.Equal(t, []*types.FinalityProviderEpochStats{fpEpochStats}, gs.FpEpochStats)
 		require.Equal(t, len(fpPks), len(gs.SigningInfos))
 		for _, info := range gs.SigningInfos {
 			require.Equal(t, fpSigningInfos[info.FpBtcPk.MarshalHex()].MissedBlocksCounter, info.FpSigningInfo.MissedBlocksCounter)
@@ -130,3 +176,86 @@ func FuzzTestExportGenesis(f *testing.F) {
 		}
 	})
 }
+
+func FuzzTestInitExportGenesis_NextHeightToPrune(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		nextHeightToPrune := datagen.RandomInt(r, 1000) + 1
+		gs := types.DefaultGenesis()
+		gs.NextHeightToPrune = nextHeightToPrune
+		require.NoError(t, gs.Validate())
+
+		// the prune cursor survives a round trip of export and import
+		k, ctx := keepertest.FinalityKeeper(t, nil, nil, nil)
+		require.NoError(t, k.InitGenesis(ctx, *gs))
+		exported, err := k.ExportGenesis(ctx)
+		require.NoError(t, err)
+		require.Equal(t, nextHeightToPrune, exported.NextHeightToPrune)
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		importedK, importedCtx := keepertest.FinalityKeeper(t, bsKeeper, nil, nil)
+		require.NoError(t, importedK.InitGenesis(importedCtx, *exported))
+		require.Equal(t, nextHeightToPrune, importedK.GetNextHeightToPrune(importedCtx))
+		require.True(t, importedK.IsHeightPruned(importedCtx, nextHeightToPrune-1))
+		require.False(t, importedK.IsHeightPruned(importedCtx, nextHeightToPrune))
+
+		// votes at pruned heights are still rejected after the import
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		randListInfo, _, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, 0, nextHeightToPrune)
+		require.NoError(t, err)
+		prunedHeight := datagen.RandomInt(r, int(nextHeightToPrune))
+		msg, err := datagen.NewMsgAddFinalitySig(datagen.GenRandomAccount().Address, btcSK, 0, prunedHeight, randListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpBTCPK.MustMarshal()).Return(&bstypes.FinalityProvider{BtcPk: fpBTCPK}, nil).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPower(gomock.Any(), fpBTCPK.MustMarshal(), prunedHeight).Return(uint64(1)).AnyTimes()
+		_, err = keeper.NewMsgServerImpl(*importedK).AddFinalitySig(importedCtx, msg)
+		require.ErrorIs(t, err, types.ErrHeightPruned)
+	})
+}
+
+func FuzzTestInitExportGenesis_FinalityHalt(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stalledHeight := datagen.RandomInt(r, 1000) + 1
+		halt := &types.FinalityHalt{
+			StalledHeight:  stalledHeight,
+			HaltedAtHeight: stalledHeight + datagen.RandomInt(r, 100) + 1,
+		}
+		gs := types.DefaultGenesis()
+		gs.FinalityHalt = halt
+		require.NoError(t, gs.Validate())
+
+		// the finality halt survives a round trip of export and import
+		k, ctx := keepertest.FinalityKeeper(t, nil, nil, nil)
+		require.NoError(t, k.InitGenesis(ctx, *gs))
+		exported, err := k.ExportGenesis(ctx)
+		require.NoError(t, err)
+		require.Equal(t, halt, exported.FinalityHalt)
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		importedK, importedCtx := keepertest.FinalityKeeper(t, bsKeeper, nil, nil)
+		require.NoError(t, importedK.InitGenesis(importedCtx, *exported))
+		require.Equal(t, halt, importedK.GetFinalityHalt(importedCtx))
+
+		// the stalled heights can still be skipped after the import
+		bsKeeper.EXPECT().RemoveVotingPowerDistCache(gomock.Any(), gomock.Any()).Return().AnyTimes()
+		importedCtx = datagen.WithCtxHeight(importedCtx, halt.HaltedAtHeight)
+		require.NoError(t, importedK.SkipStalledHeights(importedCtx, halt.StalledHeight))
+		require.False(t, importedK.IsFinalityHalted(importedCtx))
+
+		// a genesis state without finality halt does not halt the finality
+		exported, err = importedK.ExportGenesis(importedCtx)
+		require.NoError(t, err)
+		require.Nil(t, exported.FinalityHalt)
+	})
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -20,7 +21,7 @@ var _ types.QueryServer = Keeper{}
 
 // ListPublicRandomness returns a list of public randomness committed by a given
 // finality provider
-// TODO: remove public randomness storage?
+// NOTE: public randomness out of the retention window is pruned
 func (k Keeper) ListPublicRandomness(ctx context.Context, req *types.QueryListPublicRandomnessRequest) (*types.QueryListPublicRandomnessResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -92,6 +93,9 @@ func (k Keeper) Block(ctx context.Context, req *types.QueryBlockRequest) (*types
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.IsHeightPruned(sdkCtx, req.Height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.Height)
+	}
 	b, err := k.GetBlock(sdkCtx, req.Height)
 	if err != nil {
 		return nil, err
@@ -100,6 +104,68 @@ func (k Keeper) Block(ctx context.Context, req *types.QueryBlockRequest) (*types
 	return &types.QueryBlockResponse{Block: b}, nil
 }
 
+// LastFinalizedBlock returns the finalized block at the highest height
+func (k Keeper) LastFinalizedBlock(ctx context.Context, req *types.QueryLastFinalizedBlockRequest) (*types.QueryLastFinalizedBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	b, err := k.GetLastFinalizedBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLastFinalizedBlockResponse{Block: b}, nil
+}
+
+// BlockByHash returns the block with the given CometBFT block hash or AppHash
+func (k Keeper) BlockByHash(ctx context.Context, req *types.QueryBlockByHashRequest) (*types.QueryBlockByHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := hex.DecodeString(req.HashHex)
+	if err != nil || len(hash) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hash %s", req.HashHex)
+	}
+
+	var b *types.IndexedBlock
+	switch req.HashType {
+	case types.QueriedHashType_BLOCK_HASH:
+		b, err = k.GetBlockByBlockHash(ctx, hash)
+	case types.QueriedHashType_APP_HASH:
+		b, err = k.GetBlockByAppHash(ctx, hash)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown hash type %v", req.HashType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlockByHashResponse{Block: b}, nil
+}
+
+// FinalityHaltStatus returns whether the finality is halted at a block without quorum
+func (k Keeper) FinalityHaltStatus(ctx context.Context, req *types.QueryFinalityHaltStatusRequest) (*types.QueryFinalityHaltStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// tallying starts from the BTC staking activation height if no block
+	// has been finalized or skipped yet
+	nextHeight := k.getNextHeightToFinalize(ctx)
+	if activatedHeight, err := k.BTCStakingKeeper.GetBTCStakingActivatedHeight(ctx); err == nil && nextHeight < activatedHeight {
+		nextHeight = activatedHeight
+	}
+
+	halt := k.GetFinalityHalt(ctx)
+	return &types.QueryFinalityHaltStatusResponse{
+		Halted:               halt != nil,
+		Halt:                 halt,
+		NextHeightToFinalize: nextHeight,
+	}, nil
+}
+
 // ListBlocks returns a list of blocks at the given finalisation status
 func (k Keeper) ListBlocks(ctx context.Context, req *types.QueryListBlocksRequest) (*types.QueryListBlocksResponse, error) {
 	if req == nil {
@@ -142,6 +208,9 @@ func (k Keeper) VotesAtHeight(ctx context.Context, req *types.QueryVotesAtHeight
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.IsHeightPruned(sdkCtx, req.Height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.Height)
+	}
 
 	// get the sig set of babylon block at given height
 	btcPks := []bbn.BIP340PubKey{}
@@ -273,3 +342,58 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{FpSigningInfos: signInfos, Pagination: pageRes}, nil
 }
+
+// FinalityProof returns the proof that the block at the given height is
+// BTC-finalized, which can be verified by light clients
+func (k Keeper) FinalityProof(ctx context.Context, req *types.QueryFinalityProofRequest) (*types.QueryFinalityProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if k.IsHeightPruned(ctx, req.Height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.Height)
+	}
+
+	proof, err := k.ProveFinality(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFinalityProofResponse{Proof: proof}, nil
+}
+
+// FinalityProviderStats returns the performance statistics of all finality
+// providers, including the share of blocks voted over the last epochs
+func (k Keeper) FinalityProviderStats(ctx context.Context, req *types.QueryFinalityProviderStatsRequest) (*types.QueryFinalityProviderStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	var statsList []*types.FinalityProviderStatsResponse
+
+	statsStore := prefix.NewStore(runtime.KVStoreAdapter(store), types.FinalityProviderStatsKeyPrefix)
+	pageRes, err := query.Paginate(statsStore, req.Pagination, func(key, value []byte) error {
+		var stats types.FinalityProviderStats
+		if err := k.cdc.Unmarshal(value, &stats); err != nil {
+			return err
+		}
+		expectedVotes, votedBlocks, err := k.GetRecentVotedBlocks(ctx, stats.FpBtcPk, req.NumEpochs)
+		if err != nil {
+			return err
+		}
+		statsList = append(statsList, &types.FinalityProviderStatsResponse{
+			FpBtcPkHex:          stats.FpBtcPk.MarshalHex(),
+			Stats:               &stats,
+			RecentExpectedVotes: expectedVotes,
+			RecentVotedBlocks:   votedBlocks,
+			RecentVotedShare:    types.VotedShare(expectedVotes, votedBlocks),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFinalityProviderStatsResponse{Stats: statsList, Pagination: pageRes}, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -24,7 +25,7 @@ func FuzzBlock(f *testing.F) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil)
+		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		height := datagen.RandomInt(r, 100)
@@ -49,13 +50,92 @@ func FuzzBlock(f *testing.F) {
 	})
 }
 
+func FuzzBlockByHash(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// Setup keeper and context
+		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil, nil)
+		ctx = sdk.UnwrapSDKContext(ctx)
+
+		ib := &types.IndexedBlock{
+			Height:    datagen.RandomInt(r, 100),
+			AppHash:   datagen.GenRandomByteArray(r, 32),
+			BlockHash: datagen.GenRandomByteArray(r, 32),
+		}
+		keeper.SetBlock(ctx, ib)
+
+		// query by the CometBFT block hash
+		resp, err := keeper.BlockByHash(ctx, &types.QueryBlockByHashRequest{
+			HashHex:  hex.EncodeToString(ib.BlockHash),
+			HashType: types.QueriedHashType_BLOCK_HASH,
+		})
+		require.NoError(t, err)
+		require.Equal(t, ib, resp.Block)
+
+		// query by the AppHash
+		resp, err = keeper.BlockByHash(ctx, &types.QueryBlockByHashRequest{
+			HashHex:  hex.EncodeToString(ib.AppHash),
+			HashType: types.QueriedHashType_APP_HASH,
+		})
+		require.NoError(t, err)
+		require.Equal(t, ib, resp.Block)
+
+		// the AppHash is not a CometBFT block hash
+		_, err = keeper.BlockByHash(ctx, &types.QueryBlockByHashRequest{
+			HashHex:  hex.EncodeToString(ib.AppHash),
+			HashType: types.QueriedHashType_BLOCK_HASH,
+		})
+		require.ErrorIs(t, err, types.ErrBlockNotFound)
+
+		// invalid hash
+		_, err = keeper.BlockByHash(ctx, &types.QueryBlockByHashRequest{
+			HashHex:  "invalid",
+			HashType: types.QueriedHashType_BLOCK_HASH,
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzLastFinalizedBlock(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// Setup keeper and context
+		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil, nil)
+		ctx = sdk.UnwrapSDKContext(ctx)
+
+		// no block has been finalized yet
+		_, err := keeper.LastFinalizedBlock(ctx, &types.QueryLastFinalizedBlockRequest{})
+		require.ErrorIs(t, err, types.ErrBlockNotFound)
+
+		// index a list of blocks, of which a prefix is finalized
+		numBlocks := datagen.RandomInt(r, 100) + 1
+		numFinalizedBlocks := datagen.RandomInt(r, int(numBlocks)) + 1
+		for i := uint64(1); i <= numBlocks; i++ {
+			keeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:    i,
+				AppHash:   datagen.GenRandomByteArray(r, 32),
+				Finalized: i <= numFinalizedBlocks,
+			})
+		}
+
+		resp, err := keeper.LastFinalizedBlock(ctx, &types.QueryLastFinalizedBlockRequest{})
+		require.NoError(t, err)
+		require.Equal(t, numFinalizedBlocks, resp.Block.Height)
+		require.True(t, resp.Block.Finalized)
+	})
+}
+
 func FuzzListBlocks(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil)
+		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		// index a random list of finalised blocks
@@ -146,7 +226,7 @@ func FuzzVotesAtHeight(f *testing.F) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil)
+		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		// Add random number of voted finality providers to the store
@@ -191,7 +271,7 @@ func FuzzListPubRandCommit(f *testing.F) {
 
 		// Setup keeper and context
 		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
-		fKeeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil)
+		fKeeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 		ms := keeper.NewMsgServerImpl(*fKeeper)
 
@@ -260,7 +340,7 @@ func FuzzQueryEvidence(f *testing.F) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil)
+		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		// set random BTC SK PK
@@ -307,7 +387,7 @@ func FuzzListEvidences(f *testing.F) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil)
+		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		// generate a random list of evidences since startHeight
@@ -371,7 +451,7 @@ func FuzzSigningInfo(f *testing.F) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		fKeeper, ctx := testkeeper.FinalityKeeper(t, nil, nil)
+		fKeeper, ctx := testkeeper.FinalityKeeper(t, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		// generate a random list of signing info
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// GetFinalityHalt returns the record of the finality halt, and nil if the
+// finality is not halted
+func (k Keeper) GetFinalityHalt(ctx context.Context) *types.FinalityHalt {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.FinalityHaltKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var halt types.FinalityHalt
+	k.cdc.MustUnmarshal(bz, &halt)
+	return &halt
+}
+
+// IsFinalityHalted returns whether the finality is halted
+func (k Keeper) IsFinalityHalted(ctx context.Context) bool {
+	return k.GetFinalityHalt(ctx) != nil
+}
+
+// setFinalityHalt records the finality halt
+func (k Keeper) setFinalityHalt(ctx context.Context, halt *types.FinalityHalt) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.FinalityHaltKey, k.cdc.MustMarshal(halt)); err != nil {
+		panic(err)
+	}
+}
+
+// removeFinalityHalt removes the record of the finality halt
+func (k Keeper) removeFinalityHalt(ctx context.Context) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.FinalityHaltKey); err != nil {
+		panic(err)
+	}
+}
+
+// updateFinalityHalt updates the finality halt status after tallying blocks,
+// where stalledHeight is the height of the block without quorum that tallying
+// stops at, or 0 if tallying reaches the current height.
+// If the finality moves past the height that it is halted at, the halt is
+// removed. If the finality stalls at a height for at least MaxStalledBlocks
+// blocks, the halt is recorded.
+func (k Keeper) updateFinalityHalt(ctx context.Context, stalledHeight uint64) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	curHeight := uint64(sdkCtx.HeaderInfo().Height)
+
+	halt := k.GetFinalityHalt(ctx)
+	if halt != nil && halt.StalledHeight != stalledHeight {
+		k.removeFinalityHalt(ctx)
+		k.Logger(sdkCtx).Info(
+			"finality resumed",
+			"stalled_height", halt.StalledHeight,
+			"resumed_at_height", curHeight,
+		)
+		if err := sdkCtx.EventManager().EmitTypedEvent(types.NewEventFinalityResumed(halt, curHeight)); err != nil {
+			panic(fmt.Errorf("failed to emit finality resumed event for height %d: %w", curHeight, err))
+		}
+		halt = nil
+	}
+
+	maxStalledBlocks := k.GetParams(ctx).MaxStalledBlocks
+	if halt != nil || stalledHeight == 0 || maxStalledBlocks == 0 || curHeight-stalledHeight < maxStalledBlocks {
+		return
+	}
+
+	halt = &types.FinalityHalt{
+		StalledHeight:  stalledHeight,
+		HaltedAtHeight: curHeight,
+	}
+	k.setFinalityHalt(ctx, halt)
+	k.Logger(sdkCtx).Error(
+		"finality halted",
+		"stalled_height", stalledHeight,
+		"halted_at_height", curHeight,
+	)
+	if err := sdkCtx.EventManager().EmitTypedEvent(types.NewEventFinalityHalted(halt)); err != nil {
+		panic(fmt.Errorf("failed to emit finality halted event for height %d: %w", curHeight, err))
+	}
+}
+
+// SkipStalledHeights skips the heights from the height that the finality is
+// halted at to the given end height, such that tallying continues from
+// endHeight+1 and the skipped blocks remain non-finalized. The voting power
+// distribution caches of the skipped heights are removed as they will not be
+// used for distributing rewards.
+func (k Keeper) SkipStalledHeights(ctx context.Context, endHeight uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	halt := k.GetFinalityHalt(ctx)
+	if halt == nil {
+		return types.ErrFinalityNotHalted
+	}
+	curHeight := uint64(sdkCtx.HeaderInfo().Height)
+	if endHeight < halt.StalledHeight || endHeight >= curHeight {
+		return types.ErrInvalidSkipHeight.Wrapf("the end height %d has to be in [%d, %d)", endHeight, halt.StalledHeight, curHeight)
+	}
+
+	for h := halt.StalledHeight; h <= endHeight; h++ {
+		k.BTCStakingKeeper.RemoveVotingPowerDistCache(ctx, h)
+	}
+	k.setNextHeightToFinalize(ctx, endHeight+1)
+	k.removeFinalityHalt(ctx)
+
+	return sdkCtx.EventManager().EmitTypedEvent(types.NewEventStalledHeightsSkipped(halt.StalledHeight, endHeight))
+}
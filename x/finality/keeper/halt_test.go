@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/keeper"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func FuzzFinalityHalt(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		params := types.DefaultParams()
+		params.MaxStalledBlocks = datagen.RandomInt(r, 10) + 1
+		err := fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// 4 finality providers with the same voting power at all heights
+		fpPKs := make([]*bbn.BIP340PubKey, 0, 4)
+		fpSet := map[string]uint64{}
+		for i := 0; i < 4; i++ {
+			fpPK, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			fpPKs = append(fpPKs, fpPK)
+			fpSet[fpPK.MarshalHex()] = 1
+		}
+		activatedHeight := datagen.RandomInt(r, 10) + 1
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(activatedHeight, nil).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Any()).Return(fpSet).AnyTimes()
+		bsKeeper.EXPECT().GetParams(gomock.Any()).Return(bstypes.Params{MaxActiveFinalityProviders: 100}).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPowerDistCache(gomock.Any(), gomock.Any()).Return(bstypes.NewVotingPowerDistCache(), nil).AnyTimes()
+		removedDistCaches := map[uint64]bool{}
+		bsKeeper.EXPECT().RemoveVotingPowerDistCache(gomock.Any(), gomock.Any()).Do(func(_ context.Context, height uint64) {
+			removedDistCaches[height] = true
+		}).AnyTimes()
+		iKeeper.EXPECT().RewardBTCStaking(gomock.Any(), gomock.Any(), gomock.Any()).Return().AnyTimes()
+
+		// voteAtHeight makes the given number of finality providers vote for
+		// the block at the given height
+		voteAtHeight := func(height uint64, numVotes int) {
+			for _, fpPK := range fpPKs[:numVotes] {
+				sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, height, fpPK, sig)
+			}
+		}
+		// tallyAt tallies blocks at the given height with a fresh event manager
+		tallyAt := func(height uint64) sdk.Context {
+			ctx = datagen.WithCtxHeight(ctx, height).WithEventManager(sdk.NewEventManager())
+			fKeeper.TallyBlocks(ctx)
+			return ctx
+		}
+		countEvents := func(ctx sdk.Context, event proto.Message) int {
+			count := 0
+			for _, e := range ctx.EventManager().Events() {
+				if e.Type == proto.MessageName(event) {
+					count++
+				}
+			}
+			return count
+		}
+
+		// index a list of blocks, where the first numFinalized blocks get
+		// quorum and the rest do not
+		numFinalized := datagen.RandomInt(r, 5)
+		stalledHeight := activatedHeight + numFinalized
+		lastHeight := stalledHeight + params.MaxStalledBlocks + datagen.RandomInt(r, 5) + 1
+		for i := activatedHeight; i <= lastHeight; i++ {
+			fKeeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:  i,
+				AppHash: datagen.GenRandomByteArray(r, 32),
+			})
+			if i < stalledHeight {
+				voteAtHeight(i, 3)
+			} else {
+				voteAtHeight(i, 1)
+			}
+		}
+
+		// the finality is not halted before stalling for MaxStalledBlocks blocks
+		ctx = tallyAt(stalledHeight + params.MaxStalledBlocks - 1)
+		require.False(t, fKeeper.IsFinalityHalted(ctx))
+		require.Zero(t, countEvents(ctx, &types.EventFinalityHalted{}))
+
+		// the finality is halted after stalling for MaxStalledBlocks blocks
+		haltedAtHeight := stalledHeight + params.MaxStalledBlocks
+		ctx = tallyAt(haltedAtHeight)
+		require.Equal(t, 1, countEvents(ctx, &types.EventFinalityHalted{}))
+		resp, err := fKeeper.FinalityHaltStatus(ctx, &types.QueryFinalityHaltStatusRequest{})
+		require.NoError(t, err)
+		require.True(t, resp.Halted)
+		require.Equal(t, stalledHeight, resp.Halt.StalledHeight)
+		require.Equal(t, haltedAtHeight, resp.Halt.HaltedAtHeight)
+		require.Equal(t, stalledHeight, resp.NextHeightToFinalize)
+
+		// the halt is detected only once
+		ctx = tallyAt(lastHeight)
+		require.Zero(t, countEvents(ctx, &types.EventFinalityHalted{}))
+		halt := fKeeper.GetFinalityHalt(ctx)
+		require.NotNil(t, halt)
+		require.Equal(t, haltedAtHeight, halt.HaltedAtHeight)
+
+		if r.Intn(2) == 0 {
+			// the finality resumes once the stalled blocks get quorum
+			for i := stalledHeight; i <= lastHeight; i++ {
+				voteAtHeight(i, 3)
+			}
+			ctx = tallyAt(lastHeight)
+			require.Equal(t, 1, countEvents(ctx, &types.EventFinalityResumed{}))
+			require.False(t, fKeeper.IsFinalityHalted(ctx))
+			lastFinalizedBlock, err := fKeeper.GetLastFinalizedBlock(ctx)
+			require.NoError(t, err)
+			require.Equal(t, lastHeight, lastFinalizedBlock.Height)
+			return
+		}
+
+		// only governance can skip stalled heights
+		authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+		_, err = ms.SkipStalledHeights(ctx, &types.MsgSkipStalledHeights{
+			Authority: datagen.GenRandomAccount().Address,
+			EndHeight: stalledHeight,
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+		// the skipped heights start from the stalled height and end before
+		// the current height
+		if stalledHeight > activatedHeight {
+			_, err = ms.SkipStalledHeights(ctx, &types.MsgSkipStalledHeights{
+				Authority: authority,
+				EndHeight: stalledHeight - 1,
+			})
+			require.ErrorIs(t, err, types.ErrInvalidSkipHeight)
+		}
+		_, err = ms.SkipStalledHeights(ctx, &types.MsgSkipStalledHeights{
+			Authority: authority,
+			EndHeight: lastHeight,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidSkipHeight)
+
+		// skip the stalled heights
+		endHeight := stalledHeight + datagen.RandomInt(r, int(lastHeight-stalledHeight))
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err = ms.SkipStalledHeights(ctx, &types.MsgSkipStalledHeights{
+			Authority: authority,
+			EndHeight: endHeight,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, countEvents(ctx, &types.EventStalledHeightsSkipped{}))
+		require.False(t, fKeeper.IsFinalityHalted(ctx))
+		resp, err = fKeeper.FinalityHaltStatus(ctx, &types.QueryFinalityHaltStatusRequest{})
+		require.NoError(t, err)
+		require.False(t, resp.Halted)
+		require.Equal(t, endHeight+1, resp.NextHeightToFinalize)
+		for i := stalledHeight; i <= endHeight; i++ {
+			ib, err := fKeeper.GetBlock(ctx, i)
+			require.NoError(t, err)
+			require.False(t, ib.Finalized)
+			// the voting power distribution caches of the skipped heights are removed
+			require.True(t, removedDistCaches[i])
+		}
+		require.False(t, removedDistCaches[endHeight+1])
+
+		// skipping requires the finality to be halted
+		_, err = ms.SkipStalledHeights(ctx, &types.MsgSkipStalledHeights{
+			Authority: authority,
+			EndHeight: endHeight,
+		})
+		require.ErrorIs(t, err, types.ErrFinalityNotHalted)
+
+		// the blocks after the skipped heights are finalized once they get quorum
+		for i := endHeight + 1; i <= lastHeight; i++ {
+			voteAtHeight(i, 3)
+		}
+		tallyAt(lastHeight)
+		lastFinalizedBlock, err := fKeeper.GetLastFinalizedBlock(ctx)
+		require.NoError(t, err)
+		require.Equal(t, lastHeight, lastFinalizedBlock.Height)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	bbntypes "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/babylonchain/babylon/x/finality/types"
 )
 
@@ -39,3 +40,23 @@ func (h Hooks) AfterFinalityProviderActivated(ctx context.Context, fpPk *bbntype
 
 	return h.k.FinalityProviderSigningTracker.Set(ctx, fpPk.MustMarshal(), signingInfo)
 }
+
+// AfterBTCDelegationActivated is a no-op for the finality module
+func (h Hooks) AfterBTCDelegationActivated(ctx context.Context, btcDel *bstypes.BTCDelegation) error {
+	return nil
+}
+
+// AfterBTCDelegationUnbonded is a no-op for the finality module
+func (h Hooks) AfterBTCDelegationUnbonded(ctx context.Context, btcDel *bstypes.BTCDelegation) error {
+	return nil
+}
+
+// AfterBTCDelegationExpired is a no-op for the finality module
+func (h Hooks) AfterBTCDelegationExpired(ctx context.Context, btcDel *bstypes.BTCDelegation) error {
+	return nil
+}
+
+// AfterBTCDelegationSlashed is a no-op for the finality module
+func (h Hooks) AfterBTCDelegationSlashed(ctx context.Context, fpPk *bbntypes.BIP340PubKey, btcDel *bstypes.BTCDelegation) error {
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	"cosmossdk.io/store/prefix"
@@ -17,6 +18,7 @@ func (k Keeper) IndexBlock(ctx context.Context) {
 		Height:    uint64(headerInfo.Height),
 		AppHash:   headerInfo.AppHash,
 		Finalized: false,
+		BlockHash: headerInfo.Hash,
 	}
 	k.SetBlock(ctx, ib)
 
@@ -24,10 +26,25 @@ func (k Keeper) IndexBlock(ctx context.Context) {
 	types.RecordLastHeight(uint64(headerInfo.Height))
 }
 
+// SetBlock saves the given indexed block, indexes it by its hashes, and
+// updates the last finalized height if the block is finalized
 func (k Keeper) SetBlock(ctx context.Context, block *types.IndexedBlock) {
 	store := k.blockStore(ctx)
 	blockBytes := k.cdc.MustMarshal(block)
-	store.Set(sdk.Uint64ToBigEndian(block.Height), blockBytes)
+	heightBytes := sdk.Uint64ToBigEndian(block.Height)
+	store.Set(heightBytes, blockBytes)
+
+	if len(block.BlockHash) > 0 {
+		k.blockHeightByBlockHashStore(ctx).Set(block.BlockHash, heightBytes)
+	}
+	if len(block.AppHash) > 0 {
+		k.blockHeightByAppHashStore(ctx).Set(block.AppHash, heightBytes)
+	}
+	if block.Finalized {
+		if lastFinalizedHeight, ok := k.getLastFinalizedHeight(ctx); !ok || block.Height > lastFinalizedHeight {
+			k.setLastFinalizedHeight(ctx, block.Height)
+		}
+	}
 }
 
 func (k Keeper) HasBlock(ctx context.Context, height uint64) bool {
@@ -46,6 +63,91 @@ func (k Keeper) GetBlock(ctx context.Context, height uint64) (*types.IndexedBloc
 	return &block, nil
 }
 
+// GetBlockByBlockHash returns the indexed block with the given CometBFT block hash
+func (k Keeper) GetBlockByBlockHash(ctx context.Context, blockHash []byte) (*types.IndexedBlock, error) {
+	heightBytes := k.blockHeightByBlockHashStore(ctx).Get(blockHash)
+	if len(heightBytes) == 0 {
+		return nil, types.ErrBlockNotFound
+	}
+	return k.GetBlock(ctx, sdk.BigEndianToUint64(heightBytes))
+}
+
+// GetBlockByAppHash returns the indexed block with the given AppHash
+func (k Keeper) GetBlockByAppHash(ctx context.Context, appHash []byte) (*types.IndexedBlock, error) {
+	heightBytes := k.blockHeightByAppHashStore(ctx).Get(appHash)
+	if len(heightBytes) == 0 {
+		return nil, types.ErrBlockNotFound
+	}
+	return k.GetBlock(ctx, sdk.BigEndianToUint64(heightBytes))
+}
+
+// GetLastFinalizedBlock returns the finalized block at the highest height
+func (k Keeper) GetLastFinalizedBlock(ctx context.Context) (*types.IndexedBlock, error) {
+	height, ok := k.getLastFinalizedHeight(ctx)
+	if !ok {
+		return nil, types.ErrBlockNotFound.Wrap("no block has been finalized yet")
+	}
+	if k.IsHeightPruned(ctx, height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", height)
+	}
+	return k.GetBlock(ctx, height)
+}
+
+// deleteBlock removes the indexed block at the given height, together with
+// the hash indexes pointing to it
+func (k Keeper) deleteBlock(ctx context.Context, height uint64) {
+	block, err := k.GetBlock(ctx, height)
+	if err != nil {
+		return
+	}
+	heightBytes := sdk.Uint64ToBigEndian(height)
+
+	blockHashStore := k.blockHeightByBlockHashStore(ctx)
+	if len(block.BlockHash) > 0 && bytes.Equal(blockHashStore.Get(block.BlockHash), heightBytes) {
+		blockHashStore.Delete(block.BlockHash)
+	}
+	appHashStore := k.blockHeightByAppHashStore(ctx)
+	if len(block.AppHash) > 0 && bytes.Equal(appHashStore.Get(block.AppHash), heightBytes) {
+		appHashStore.Delete(block.AppHash)
+	}
+	k.blockStore(ctx).Delete(heightBytes)
+}
+
+// getLastFinalizedHeight returns the height of the last finalized block, and
+// false if no block has been finalized
+func (k Keeper) getLastFinalizedHeight(ctx context.Context) (uint64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LastFinalizedHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// setLastFinalizedHeight sets the height of the last finalized block
+func (k Keeper) setLastFinalizedHeight(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.LastFinalizedHeightKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
+
+// getEarliestBlockHeight returns the height of the earliest indexed block, and
+// false if there is no indexed block
+func (k Keeper) getEarliestBlockHeight(ctx context.Context) (uint64, bool) {
+	store := k.blockStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(iter.Key()), true
+}
+
 // blockStore returns the KVStore of the blocks
 // prefix: BlockKey
 // key: block height
@@ -54,3 +156,23 @@ func (k Keeper) blockStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.BlockKey)
 }
+
+// blockHeightByBlockHashStore returns the KVStore of the block heights indexed
+// by CometBFT block hashes
+// prefix: BlockHeightByBlockHashKey
+// key: CometBFT block hash
+// value: block height
+func (k Keeper) blockHeightByBlockHashStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BlockHeightByBlockHashKey)
+}
+
+// blockHeightByAppHashStore returns the KVStore of the block heights indexed
+// by AppHashes
+// prefix: BlockHeightByAppHashKey
+// key: AppHash
+// value: block height
+func (k Keeper) blockHeightByAppHashStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BlockHeightByAppHashKey)
+}
@@ -7,12 +7,18 @@ import (
 	"cosmossdk.io/collections"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/babylonchain/babylon/crypto/eots"
 	"github.com/babylonchain/babylon/x/finality/types"
 )
 
+// eotsCacheSize is the number of parsed finality provider BTC PKs and lifted
+// public randomness cached for verifying finality signatures
+const eotsCacheSize = 1024
+
 type (
 	Keeper struct {
 		cdc          codec.BinaryCodec
@@ -20,16 +26,25 @@ type (
 
 		BTCStakingKeeper types.BTCStakingKeeper
 		IncentiveKeeper  types.IncentiveKeeper
+		EpochingKeeper   types.EpochingKeeper
+		storeQuerier     storetypes.Queryable
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
 
 		hooks types.FinalityHooks
+		// eotsCache caches the parsed finality provider BTC PKs and lifted
+		// public randomness across the verification of finality signatures
+		eotsCache *eots.Cache
 
 		// FinalityProviderSigningTracker key: BIP340PubKey bytes | value: FinalityProviderSigningInfo
 		FinalityProviderSigningTracker collections.Map[[]byte, types.FinalityProviderSigningInfo]
 		// FinalityProviderMissedBlockBitmap key: BIP340PubKey bytes | value: byte key for a finality provider's missed block bitmap chunk
 		FinalityProviderMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+		// FinalityProviderStatsTracker key: BIP340PubKey bytes | value: FinalityProviderStats
+		FinalityProviderStatsTracker collections.Map[[]byte, types.FinalityProviderStats]
+		// FinalityProviderEpochStatsTracker key: (BIP340PubKey bytes, epoch number) | value: FinalityProviderEpochStats
+		FinalityProviderEpochStatsTracker collections.Map[collections.Pair[[]byte, uint64], types.FinalityProviderEpochStats]
 	}
 )
 
@@ -38,6 +53,8 @@ func NewKeeper(
 	storeService corestoretypes.KVStoreService,
 	btcstakingKeeper types.BTCStakingKeeper,
 	incentiveKeeper types.IncentiveKeeper,
+	epochingKeeper types.EpochingKeeper,
+	storeQuerier storetypes.Queryable,
 	authority string,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
@@ -47,7 +64,10 @@ func NewKeeper(
 
 		BTCStakingKeeper: btcstakingKeeper,
 		IncentiveKeeper:  incentiveKeeper,
+		EpochingKeeper:   epochingKeeper,
+		storeQuerier:     storeQuerier,
 		authority:        authority,
+		eotsCache:        eots.NewCache(eotsCacheSize),
 		FinalityProviderSigningTracker: collections.NewMap(
 			sb,
 			types.FinalityProviderSigningInfoKeyPrefix,
@@ -62,6 +82,20 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		FinalityProviderStatsTracker: collections.NewMap(
+			sb,
+			types.FinalityProviderStatsKeyPrefix,
+			"finality_provider_stats",
+			collections.BytesKey,
+			codec.CollValue[types.FinalityProviderStats](cdc),
+		),
+		FinalityProviderEpochStatsTracker: collections.NewMap(
+			sb,
+			types.FinalityProviderEpochStatsKeyPrefix,
+			"finality_provider_epoch_stats",
+			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
+			codec.CollValue[types.FinalityProviderEpochStats](cdc),
+		),
 	}
 }
 
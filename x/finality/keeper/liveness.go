@@ -11,16 +11,23 @@ import (
 )
 
 // HandleLiveness handles liveness of each active finality provider for a given height
-// including identifying sluggish finality providers and applying punishment (TBD)
+// including identifying sluggish finality providers and jailing them
 func (k Keeper) HandleLiveness(ctx context.Context, height int64) {
 	// get all the active finality providers for the height
 	fpSet := k.BTCStakingKeeper.GetVotingPowerTable(ctx, uint64(height))
 	// get all the voters for the height
 	voterBTCPKs := k.GetVoters(ctx, uint64(height))
+	// get the epoch of the height for recording per-epoch statistics. The
+	// height lags behind the current height by the finality signature
+	// timeout, so it may belong to a previous epoch
+	epochNumber, err := k.getEpochNumberByHeight(ctx, uint64(height))
+	if err != nil {
+		panic(fmt.Errorf("failed to get the epoch of height %d: %w", height, err))
+	}
 
 	// Iterate over all the finality providers which *should* have signed this block
 	// store whether or not they have actually signed it, identify sluggish
-	// ones, and apply punishment (TBD)
+	// ones, and jail them
 	for fpPkHex := range fpSet {
 		fpPk, err := types.NewBIP340PubKeyFromHex(fpPkHex)
 		if err != nil {
@@ -34,12 +41,17 @@ func (k Keeper) HandleLiveness(ctx context.Context, height int64) {
 		if err != nil {
 			panic(fmt.Errorf("failed to handle liveness of finality provider %s: %w", fpPkHex, err))
 		}
+
+		if err := k.recordLiveness(ctx, fpPk, epochNumber, !missed); err != nil {
+			panic(fmt.Errorf("failed to record liveness of finality provider %s: %w", fpPkHex, err))
+		}
 	}
 }
 
 // HandleFinalityProviderLiveness updates the voting history of the given finality provider and
 // detect sluggish the finality provider if the number of missed block is reached to the threshold in a
-// sliding window
+// sliding window. A sluggish finality provider is jailed for the jail duration, and its
+// voting history is reset
 func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpPk *types.BIP340PubKey, missed bool, height int64) error {
 	params := k.GetParams(ctx)
 	fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, fpPk.MustMarshal())
@@ -48,14 +60,26 @@ func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpPk *types.
 	}
 
 	// don't update missed blocks when finality provider is already detected slashed
-	if fp.IsSlashed() {
+	// or jailed
+	if fp.IsSlashed() || fp.IsJailed() {
 		return nil
 	}
 
-	updated, signInfo, err := k.updateSigningInfo(ctx, fpPk, missed, height)
+	// don't update missed blocks for heights before the finality provider is
+	// (re-)activated, e.g., heights before the finality provider gets unjailed
+	signInfo, err := k.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
 	if err != nil {
 		return err
 	}
+	if height < signInfo.StartHeight {
+		return nil
+	}
+
+	updated, updatedSignInfo, err := k.updateSigningInfo(ctx, fpPk, missed, height)
+	if err != nil {
+		return err
+	}
+	signInfo = *updatedSignInfo
 
 	signedBlocksWindow := params.SignedBlocksWindow
 	minSignedPerWindow := params.MinSignedPerWindowInt()
@@ -100,33 +124,56 @@ func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpPk *types.
 		}
 
 		finalitytypes.IncrementSluggishFinalityProviderCounter()
-	} else if fp.IsSluggish() {
-		updated = true
-
-		k.Logger(sdkCtx).Info(
-			"reverted sluggish finality provider",
-			"height", height,
-			"public_key", fpPk.MarshalHex(),
-		)
-
-		// change the sluggish flag of the finality provider to false
-		err = k.BTCStakingKeeper.RevertSluggishFinalityProvider(ctx, fpPk.MustMarshal())
-		if err != nil {
-			return fmt.Errorf("failed to revert sluggish finality provider %s: %w", fpPk.MarshalHex(), err)
+		if err := k.recordSluggish(ctx, fpPk); err != nil {
+			return err
 		}
 
-		if err := sdkCtx.EventManager().EmitTypedEvent(
-			finalitytypes.NewEventSluggishFinalityProviderReverted(fpPk),
-		); err != nil {
-			panic(fmt.Errorf("failed to emit sluggish finality provider reverted event for height %d: %w", height, err))
+		// jail the finality provider so that it will be removed from the
+		// active finality provider set
+		if err := k.jailSluggishFinalityProvider(ctx, fpPk, &signInfo); err != nil {
+			return err
 		}
-
-		finalitytypes.DecrementSluggishFinalityProviderCounter()
 	}
 
 	// Set the updated signing info
 	if updated {
-		return k.FinalityProviderSigningTracker.Set(ctx, fpPk.MustMarshal(), *signInfo)
+		return k.FinalityProviderSigningTracker.Set(ctx, fpPk.MustMarshal(), signInfo)
+	}
+
+	return nil
+}
+
+// jailSluggishFinalityProvider jails the given sluggish finality provider
+// until the jail duration has passed, and resets its voting history
+func (k Keeper) jailSluggishFinalityProvider(
+	ctx context.Context,
+	fpPk *types.BIP340PubKey,
+	signInfo *finalitytypes.FinalityProviderSigningInfo,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.BTCStakingKeeper.JailFinalityProvider(ctx, fpPk.MustMarshal()); err != nil {
+		return fmt.Errorf("failed to jail sluggish finality provider %s: %w", fpPk.MarshalHex(), err)
+	}
+
+	signInfo.JailedUntil = sdkCtx.HeaderInfo().Time.Add(k.GetParams(ctx).JailDuration)
+	// the voting history is reset so that the finality provider
+	// starts from a clean state once unjailed
+	signInfo.ResetMissedBlocksCounter()
+	if err := k.DeleteMissedBlockBitmap(ctx, fpPk); err != nil {
+		return fmt.Errorf("failed to remove the missed block bitmap of finality provider %s: %w", fpPk.MarshalHex(), err)
+	}
+
+	k.Logger(sdkCtx).Info(
+		"jailed sluggish finality provider",
+		"public_key", fpPk.MarshalHex(),
+		"jailed_until", signInfo.JailedUntil,
+	)
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(
+		finalitytypes.NewEventJailedFinalityProvider(fpPk),
+	); err != nil {
+		panic(fmt.Errorf("failed to emit jailed finality provider event: %w", err))
 	}
 
 	return nil
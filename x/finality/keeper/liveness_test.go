@@ -24,7 +24,7 @@ func FuzzHandleLiveness(f *testing.F) {
 		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
 		bsKeeper.EXPECT().GetParams(gomock.Any()).Return(bstypes.Params{MaxActiveFinalityProviders: 100}).AnyTimes()
 		iKeeper := types.NewMockIncentiveKeeper(ctrl)
-		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, nil)
 
 		mockedHooks := types.NewMockFinalityHooks(ctrl)
 		mockedHooks.EXPECT().AfterSluggishFinalityProviderDetected(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -33,7 +33,8 @@ func FuzzHandleLiveness(f *testing.F) {
 		params := fKeeper.GetParams(ctx)
 		fpPk, err := datagen.GenRandomBIP340PubKey(r)
 		require.NoError(t, err)
-		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(&bstypes.FinalityProvider{Sluggish: false}, nil).AnyTimes()
+		fp := &bstypes.FinalityProvider{Sluggish: false}
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(fp, nil).AnyTimes()
 		signingInfo := types.NewFinalityProviderSigningInfo(
 			fpPk,
 			1,
@@ -55,6 +56,13 @@ func FuzzHandleLiveness(f *testing.F) {
 		require.NoError(t, err)
 		require.Equal(t, int64(0), signingInfo.MissedBlocksCounter)
 
+		// the finality provider will be jailed once detected sluggish
+		bsKeeper.EXPECT().JailFinalityProvider(gomock.Any(), fpPk.MustMarshal()).DoAndReturn(
+			func(_ interface{}, _ []byte) error {
+				fp.Jailed = true
+				return nil
+			}).Times(1)
+
 		minSignedPerWindow := params.MinSignedPerWindowInt()
 		maxMissed := params.SignedBlocksWindow - minSignedPerWindow
 		// for blocks up to the inactivity boundary, mark the finality provider as having not signed
@@ -66,25 +74,29 @@ func FuzzHandleLiveness(f *testing.F) {
 			require.NoError(t, err)
 			if height < sluggishDetectedHeight-1 {
 				require.GreaterOrEqual(t, maxMissed, signingInfo.MissedBlocksCounter)
-			} else {
-				require.Less(t, maxMissed, signingInfo.MissedBlocksCounter)
 			}
 		}
 
-		// perform heights that not missed, expect the sluggish is reverted
-		bsKeeper.EXPECT().RevertSluggishFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(nil).AnyTimes()
-		sluggishRevertedHeight := height + maxMissed
-		for ; height < sluggishRevertedHeight; height++ {
-			err := fKeeper.HandleFinalityProviderLiveness(ctx, fpPk, false, height)
+		// the finality provider is jailed and its voting history is reset
+		require.True(t, fp.IsJailed())
+		require.Equal(t, int64(0), signingInfo.MissedBlocksCounter)
+		require.Equal(t, ctx.HeaderInfo().Time.Add(params.JailDuration), signingInfo.JailedUntil)
+		stats, err := fKeeper.GetFinalityProviderStats(ctx, fpPk)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), stats.SluggishCount)
+		for i := int64(0); i < params.SignedBlocksWindow; i++ {
+			missed, err := fKeeper.GetMissedBlockBitmapValue(ctx, fpPk, i)
 			require.NoError(t, err)
-			signingInfo, err = fKeeper.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
+			require.False(t, missed)
+		}
+
+		// liveness of a jailed finality provider is not tracked
+		for ; height < sluggishDetectedHeight+maxMissed; height++ {
+			err := fKeeper.HandleFinalityProviderLiveness(ctx, fpPk, true, height)
 			require.NoError(t, err)
-			if height < sluggishRevertedHeight-1 {
-				require.Less(t, maxMissed, signingInfo.MissedBlocksCounter)
-			} else {
-				// the sluggish fp is reverted
-				require.Equal(t, maxMissed, signingInfo.MissedBlocksCounter)
-			}
 		}
+		signingInfo, err = fKeeper.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
+		require.NoError(t, err)
+		require.Equal(t, int64(0), signingInfo.MissedBlocksCounter)
 	})
 }
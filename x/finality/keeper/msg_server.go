@@ -3,7 +3,9 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -44,16 +46,164 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// SkipStalledHeights skips the heights that the finality is halted at
+func (ms msgServer) SkipStalledHeights(goCtx context.Context, req *types.MsgSkipStalledHeights) (*types.MsgSkipStalledHeightsResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.SkipStalledHeights(ctx, req.EndHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSkipStalledHeightsResponse{}, nil
+}
+
 // AddFinalitySig adds a new vote to a given block
 func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinalitySig) (*types.MsgAddFinalitySigResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddFinalitySig)
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := ms.addFinalitySig(ctx, req, false); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddFinalitySigResponse{}, nil
+}
+
+// AddFinalitySigs adds a batch of votes of a finality provider over multiple
+// blocks. Each vote is processed in the same way as in AddFinalitySig, and a
+// rejected vote does not affect the other votes in the batch
+func (ms msgServer) AddFinalitySigs(goCtx context.Context, req *types.MsgAddFinalitySigs) (*types.MsgAddFinalitySigsResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddFinalitySigs)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.FpBtcPk == nil {
+		return nil, types.ErrInvalidFinalitySig.Wrap("empty finality provider BTC PK")
+	}
+	if len(req.Sigs) == 0 {
+		return nil, types.ErrInvalidFinalitySig.Wrap("empty list of finality signatures")
+	}
+
+	msgs := make([]*types.MsgAddFinalitySig, 0, len(req.Sigs))
+	for _, entry := range req.Sigs {
+		msgs = append(msgs, req.ToMsgAddFinalitySig(entry))
+	}
+	// batch verify the EOTS signatures of all votes at once. If the batch is
+	// invalid, fall back to verifying each vote individually so that only
+	// the invalid votes are rejected
+	sigsVerified := types.VerifyEOTSSigs(msgs, ms.eotsCache) == nil
+
+	results := make([]*types.FinalitySigResult, 0, len(msgs))
+	for _, msg := range msgs {
+		result := &types.FinalitySigResult{BlockHeight: msg.BlockHeight}
+
+		// process each vote in a cached context so that the state changes
+		// of a rejected vote are discarded
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := ms.addFinalitySig(cacheCtx, msg, sigsVerified); err != nil {
+			result.Error = err.Error()
+		} else {
+			writeCache()
+			result.Accepted = true
+		}
+		results = append(results, result)
+	}
+
+	return &types.MsgAddFinalitySigsResponse{Results: results}, nil
+}
+
+// SubmitFinalityEvidence handles an evidence that a finality provider signs two
+// conflicting blocks at the same height, which can be submitted by anyone.
+// Upon a valid evidence, the finality provider is slashed and the reporter
+// is rewarded
+func (ms msgServer) SubmitFinalityEvidence(goCtx context.Context, req *types.MsgSubmitFinalityEvidence) (*types.MsgSubmitFinalityEvidenceResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeySubmitFinalityEvidence)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reporterAddr, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, err
+	}
+	evidence := req.Evidence
+	if evidence == nil || evidence.FpBtcPk == nil {
+		return nil, types.ErrInvalidFinalityEvidence.Wrap("empty evidence")
+	}
+	// ensure the chain has reached the height of the evidence
+	if uint64(ctx.HeaderInfo().Height) < evidence.BlockHeight {
+		return nil, types.ErrHeightTooHigh
+	}
+
+	// ensure the finality provider exists and is not slashed yet
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, evidence.FpBtcPk.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+	if fp.IsSlashed() {
+		return nil, bstypes.ErrFpAlreadySlashed
+	}
+
+	// find the public randomness commitment for this height from this finality
+	// provider. If the public randomness is not committed under the current
+	// commitment, it may be committed under a superseded one
+	prCommit, superseded, err := ms.findPubRandCommitForEvidence(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// a finality signature under a superseded commitment is not a valid vote.
+	// A commitment is only superseded while it is for future heights without
+	// any vote, and votes are only accepted under the current commitment, so
+	// such a signature never counts towards finality. Thus, it does not
+	// conflict with the vote under the new commitment at the same height.
+	// Two finality signatures with the same public randomness under a
+	// superseded commitment still leak the BTC SK and are slashed as usual
+	if superseded && !evidence.IsSlashable() {
+		return nil, types.ErrInvalidFinalityEvidence.Wrap("a finality signature under a superseded public randomness commitment is not a valid vote")
+	}
+
+	// verify the evidence w.r.t. the public randomness commitment, including the
+	// public randomness inclusion proof and the two finality signatures
+	if err := types.VerifyFinalityEvidence(req, prCommit); err != nil {
+		return nil, err
+	}
+
+	// if the fork block in the evidence is the canonical block indexed by
+	// Babylon, swap the two blocks so that the stored evidence is consistent
+	// with the ones found upon AddFinalitySig
+	if indexedBlock, err := ms.GetBlock(ctx, evidence.BlockHeight); err == nil && bytes.Equal(indexedBlock.AppHash, evidence.ForkAppHash) {
+		evidence.CanonicalAppHash, evidence.ForkAppHash = evidence.ForkAppHash, evidence.CanonicalAppHash
+		evidence.CanonicalFinalitySig, evidence.ForkFinalitySig = evidence.ForkFinalitySig, evidence.CanonicalFinalitySig
+	}
+
+	// slash this finality provider, including setting its voting power to
+	// zero, extracting its BTC SK, and emit an event
+	ms.slashFinalityProvider(ctx, evidence.FpBtcPk, evidence)
+	// save evidence
+	if err := ms.recordEvidence(ctx, evidence); err != nil {
+		return nil, err
+	}
+
+	// reward the reporter of this evidence
+	ms.IncentiveKeeper.RewardFinalityEvidenceReporter(ctx, reporterAddr)
+
+	return &types.MsgSubmitFinalityEvidenceResponse{}, nil
+}
+
+// addFinalitySig verifies and adds a new vote to a given block. If the vote
+// conflicts with another vote of the same finality provider at the same
+// height, the finality provider is slashed. If eotsSigVerified is true, the
+// EOTS signature is already verified, e.g., via batch verification, and only
+// the public randomness inclusion proof is verified
+func (ms msgServer) addFinalitySig(ctx sdk.Context, req *types.MsgAddFinalitySig, eotsSigVerified bool) error {
 	// ensure the finality provider exists
 	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
 	if err != nil {
-		return nil, err
+		return err
 	}
 	// ensure the finality provider is not slashed at this time point
 	// NOTE: it's possible that the finality provider equivocates for height h, and the signature is processed at
@@ -73,39 +223,49 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 	//     corrupt a new finality provider and equivocate a historical block over and over again, making a previous block
 	//     unfinalisable forever
 	if fp.IsSlashed() {
-		return nil, bstypes.ErrFpAlreadySlashed
+		return bstypes.ErrFpAlreadySlashed
 	}
 
 	// ensure the finality provider has voting power at this height
 	if req.FpBtcPk == nil {
-		return nil, types.ErrInvalidFinalitySig.Wrap("empty finality provider BTC PK")
+		return types.ErrInvalidFinalitySig.Wrap("empty finality provider BTC PK")
 	}
 	fpPK := req.FpBtcPk
 	if ms.BTCStakingKeeper.GetVotingPower(ctx, fpPK.MustMarshal(), req.BlockHeight) == 0 {
-		return nil, types.ErrInvalidFinalitySig.Wrapf("the finality provider %v does not have voting power at height %d", fpPK.MustMarshal(), req.BlockHeight)
+		return types.ErrInvalidFinalitySig.Wrapf("the finality provider %v does not have voting power at height %d", fpPK.MustMarshal(), req.BlockHeight)
+	}
+
+	// ensure the height has not been pruned
+	if ms.IsHeightPruned(ctx, req.BlockHeight) {
+		return types.ErrHeightPruned.Wrapf("height: %d", req.BlockHeight)
 	}
 
 	// ensure the finality provider has not cast the same vote yet
 	if req.FinalitySig == nil {
-		return nil, types.ErrInvalidFinalitySig.Wrap("empty finality signature")
+		return types.ErrInvalidFinalitySig.Wrap("empty finality signature")
 	}
 	existingSig, err := ms.GetSig(ctx, req.BlockHeight, fpPK)
 	if err == nil && existingSig.Equals(req.FinalitySig) {
 		ms.Logger(ctx).Debug("Received duplicated finiality vote", "block height", req.BlockHeight, "finality provider", req.FpBtcPk)
 		// exactly same vote alreay exists, return success to the provider
-		return &types.MsgAddFinalitySigResponse{}, nil
+		return nil
 	}
 
 	// find the public randomness commitment for this height from this finality provider
 	prCommit, err := ms.GetPubRandCommitForHeight(ctx, req.FpBtcPk, req.BlockHeight)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// verify the finality signature message w.r.t. the public randomness commitment
 	// including the public randomness inclusion proof and the finality signature
-	if err := types.VerifyFinalitySig(req, prCommit); err != nil {
-		return nil, err
+	if eotsSigVerified {
+		err = types.VerifyFinalitySigInclusion(req, prCommit)
+	} else {
+		err = types.VerifyFinalitySig(req, prCommit, ms.eotsCache)
+	}
+	if err != nil {
+		return err
 	}
 	// the public randomness is good, set the public randomness
 	ms.SetPubRand(ctx, req.FpBtcPk, req.BlockHeight, *req.PubRand)
@@ -113,7 +273,7 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 	// verify whether the voted block is a fork or not
 	indexedBlock, err := ms.GetBlock(ctx, req.BlockHeight)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if !bytes.Equal(indexedBlock.AppHash, req.BlockAppHash) {
 		// the finality provider votes for a fork!
@@ -140,15 +300,24 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 		}
 
 		// save evidence
-		ms.SetEvidence(ctx, evidence)
+		if err := ms.recordEvidence(ctx, evidence); err != nil {
+			return err
+		}
 
 		// NOTE: we should NOT return error here, otherwise the state change triggered in this tx
 		// (including the evidence) will be rolled back
-		return &types.MsgAddFinalitySigResponse{}, nil
+		return nil
 	}
 
 	// this signature is good, add vote to DB
 	ms.SetSig(ctx, req.BlockHeight, fpPK, req.FinalitySig)
+	// keep the inclusion proof of the public randomness so that light clients
+	// can verify this vote against the public randomness commitment
+	ms.SetPubRandProof(ctx, fpPK, req.BlockHeight, req.Proof)
+	// record the vote in the statistics of the finality provider
+	if err := ms.recordVote(ctx, fpPK, req.BlockHeight); err != nil {
+		return err
+	}
 
 	// if this finality provider has signed the canonical block before,
 	// slash it via extracting its secret key, and emit an event
@@ -171,7 +340,7 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 		ms.slashFinalityProvider(ctx, req.FpBtcPk, evidence)
 	}
 
-	return &types.MsgAddFinalitySigResponse{}, nil
+	return nil
 }
 
 // CommitPubRandList commits a list of EOTS public randomness
@@ -208,37 +377,159 @@ func (ms msgServer) CommitPubRandList(goCtx context.Context, req *types.MsgCommi
 		Commitment:  req.Commitment,
 	}
 
-	// get last public randomness commitment
-	// TODO: allow committing public randomness earlier than existing ones?
-	lastPrCommit := ms.GetLastPubRandCommit(ctx, req.FpBtcPk)
+	if req.NumPubRand > math.MaxUint64-req.StartHeight {
+		return nil, types.ErrInvalidPubRand.Wrapf("the range of public randomness overflows (start height: %d, number: %d)", req.StartHeight, req.NumPubRand)
+	}
+	startHeight, endHeight := prCommit.Range()
+
+	// ensure the commitment has not been superseded before, otherwise anyone
+	// could replay the superseded commitment to override the newer one
+	if ms.IsPubRandCommitSuperseded(ctx, req.FpBtcPk, req.StartHeight, req.Commitment) {
+		return nil, types.ErrInvalidPubRand.Wrap("the public randomness commitment has been superseded before")
+	}
 
-	// this finality provider has not commit any public randomness,
-	// commit the given public randomness list and return
-	if lastPrCommit == nil {
-		ms.SetPubRandCommit(ctx, req.FpBtcPk, prCommit)
-		return &types.MsgCommitPubRandListResponse{}, nil
+	// ensure no public randomness has been used at any height in the range.
+	// Otherwise, the finality provider could cast a vote at such a height with
+	// a different public randomness, which cannot be slashed via extracting its
+	// secret key
+	if ms.HasPubRandInRange(ctx, req.FpBtcPk, startHeight, endHeight) {
+		return nil, types.ErrInvalidPubRand.Wrapf("public randomness has been used within heights [%d, %d]", startHeight, endHeight)
 	}
 
-	// ensure height and req.StartHeight do not overlap, i.e., height < req.StartHeight
-	lastPrHeightCommitted := lastPrCommit.EndHeight()
-	if req.StartHeight <= lastPrCommit.EndHeight() {
-		return nil, types.ErrInvalidPubRand.Wrapf("the start height (%d) has overlap with the height of the highest public randomness committed (%d)", req.StartHeight, lastPrHeightCommitted)
+	// the commitment may fill a gap between existing commitments, or supersede
+	// existing commitments that it overlaps with. The latter is only allowed if
+	// the superseded commitments are entirely for future heights, so that the
+	// finality provider can recover from losing its randomness
+	curHeight := uint64(ctx.HeaderInfo().Height)
+	overlappedPrCommits := ms.GetOverlappingPubRandCommits(ctx, req.FpBtcPk, startHeight, endHeight)
+	for _, overlappedPrCommit := range overlappedPrCommits {
+		if overlappedPrCommit.StartHeight <= curHeight {
+			return nil, types.ErrInvalidPubRand.Wrapf("the public randomness list has overlap with an existing commitment starting at height %d, which is not higher than the current height %d", overlappedPrCommit.StartHeight, curHeight)
+		}
+		if ms.HasPubRandInRange(ctx, req.FpBtcPk, overlappedPrCommit.StartHeight, overlappedPrCommit.EndHeight()) {
+			return nil, types.ErrInvalidPubRand.Wrapf("the public randomness list has overlap with an existing commitment starting at height %d, which has been used", overlappedPrCommit.StartHeight)
+		}
 	}
 
-	// all good, commit the given public randomness list
+	// all good, supersede the overlapped commitments and commit the given
+	// public randomness list
+	for _, overlappedPrCommit := range overlappedPrCommits {
+		ms.deletePubRandCommit(ctx, req.FpBtcPk, overlappedPrCommit.StartHeight)
+		ms.SetSupersededPubRandCommit(ctx, req.FpBtcPk, overlappedPrCommit)
+	}
 	ms.SetPubRandCommit(ctx, req.FpBtcPk, prCommit)
 	return &types.MsgCommitPubRandListResponse{}, nil
 }
 
+// UnjailFinalityProvider unjails a jailed finality provider once its jailing
+// period has passed, so that it can receive voting power again
+func (ms msgServer) UnjailFinalityProvider(goCtx context.Context, req *types.MsgUnjailFinalityProvider) (*types.MsgUnjailFinalityProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.FpBtcPk == nil {
+		return nil, bstypes.ErrFpNotFound.Wrap("empty finality provider public key")
+	}
+	fpPkBytes := req.FpBtcPk.MustMarshal()
+
+	// ensure the finality provider exists and the request is signed by it
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, fpPkBytes)
+	if err != nil {
+		return nil, err
+	}
+	if fp.Addr != req.Signer {
+		return nil, types.ErrInvalidSigner.Wrapf("the signer is not the finality provider; expected %s, got %s", fp.Addr, req.Signer)
+	}
+
+	// ensure the finality provider is jailed and the jailing period has passed
+	if !fp.IsJailed() {
+		return nil, bstypes.ErrFpNotJailed
+	}
+	signInfo, err := ms.FinalityProviderSigningTracker.Get(ctx, fpPkBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the signing info of finality provider %s: %w", req.FpBtcPk.MarshalHex(), err)
+	}
+	curBlockTime := ctx.HeaderInfo().Time
+	if !signInfo.IsJailingPeriodPassed(curBlockTime) {
+		return nil, types.ErrJailingPeriodNotPassed.Wrapf("jailed until: %s, current time: %s", signInfo.JailedUntil, curBlockTime)
+	}
+
+	if err := ms.BTCStakingKeeper.UnjailFinalityProvider(ctx, fpPkBytes); err != nil {
+		return nil, err
+	}
+	// unjailing clears the sluggish flag of the finality provider
+	if fp.IsSluggish() {
+		types.DecrementSluggishFinalityProviderCounter()
+	}
+
+	// reset the signing info so that the finality provider starts from
+	// a clean voting history
+	signInfo.StartHeight = ctx.HeaderInfo().Height
+	signInfo.ResetMissedBlocksCounter()
+	signInfo.JailedUntil = time.Time{}
+	if err := ms.DeleteMissedBlockBitmap(ctx, req.FpBtcPk); err != nil {
+		return nil, err
+	}
+	if err := ms.FinalityProviderSigningTracker.Set(ctx, fpPkBytes, signInfo); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventUnjailedFinalityProvider(req.FpBtcPk)); err != nil {
+		panic(fmt.Errorf("failed to emit EventUnjailedFinalityProvider event: %w", err))
+	}
+
+	return &types.MsgUnjailFinalityProviderResponse{}, nil
+}
+
+// findPubRandCommitForEvidence finds the public randomness commitment under
+// which the public randomness in the given evidence is committed, which is
+// either the current commitment for the height of the evidence, or one of the
+// superseded commitments for this height. It also returns whether the found
+// commitment is superseded
+func (ms msgServer) findPubRandCommitForEvidence(ctx context.Context, req *types.MsgSubmitFinalityEvidence) (*types.PubRandCommit, bool, error) {
+	evidence := req.Evidence
+	prCommit, err := ms.GetPubRandCommitForHeight(ctx, evidence.FpBtcPk, evidence.BlockHeight)
+	if err == nil {
+		if err = types.VerifyPubRandInclusion(req, prCommit); err == nil {
+			return prCommit, false, nil
+		}
+	}
+	// the inclusion proof locates the public randomness at the index
+	// (height - start height) of the commitment, which identifies the start
+	// height of the superseded commitment
+	if req.Proof != nil && req.Proof.Index >= 0 && uint64(req.Proof.Index) <= evidence.BlockHeight {
+		startHeight := evidence.BlockHeight - uint64(req.Proof.Index)
+		for _, supersededPrCommit := range ms.GetSupersededPubRandCommitsAt(ctx, evidence.FpBtcPk, startHeight) {
+			if types.VerifyPubRandInclusion(req, supersededPrCommit) == nil {
+				return supersededPrCommit, true, nil
+			}
+		}
+	}
+	if errors.Is(err, types.ErrPubRandNotFound) {
+		return nil, false, err
+	}
+	return nil, false, types.ErrInvalidFinalityEvidence.Wrap(err.Error())
+}
+
 // slashFinalityProvider slashes a finality provider with the given evidence
 // including setting its voting power to zero, extracting its BTC SK,
-// and emit an event
+// queueing the assembly of the slashing txs of its BTC delegations, and emit
+// an event
 func (k Keeper) slashFinalityProvider(ctx context.Context, fpBtcPk *bbn.BIP340PubKey, evidence *types.Evidence) {
 	// slash this finality provider, i.e., set its voting power to zero
 	if err := k.BTCStakingKeeper.SlashFinalityProvider(ctx, fpBtcPk.MustMarshal()); err != nil {
 		panic(fmt.Errorf("failed to slash finality provider: %v", err))
 	}
 
+	// extract its BTC SK and queue its BTC delegations, whose fully witnessed
+	// slashing txs will be assembled at the end of blocks
+	fpSK, err := evidence.ExtractBTCSK()
+	if err != nil {
+		panic(fmt.Errorf("failed to extract BTC SK from a slashable evidence: %w", err))
+	}
+	if err := k.BTCStakingKeeper.RecordSignedSlashingTxs(ctx, fpSK); err != nil {
+		panic(fmt.Errorf("failed to record signed slashing txs: %w", err))
+	}
+
 	// emit slashing event
 	eventSlashing := types.NewEventSlashedFinalityProvider(evidence)
 	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(eventSlashing); err != nil {
@@ -13,12 +13,13 @@ import (
 	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/babylonchain/babylon/x/finality/keeper"
 	"github.com/babylonchain/babylon/x/finality/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
 func setupMsgServer(t testing.TB) (*keeper.Keeper, types.MsgServer, context.Context) {
-	fKeeper, ctx := keepertest.FinalityKeeper(t, nil, nil)
+	fKeeper, ctx := keepertest.FinalityKeeper(t, nil, nil, nil)
 	return fKeeper, keeper.NewMsgServerImpl(*fKeeper), ctx
 }
 
@@ -37,7 +38,7 @@ func FuzzCommitPubRandList(f *testing.F) {
 		defer ctrl.Finish()
 
 		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
-		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil, nil)
 		ms := keeper.NewMsgServerImpl(*fKeeper)
 
 		// create a random finality provider
@@ -76,7 +77,9 @@ func FuzzCommitPubRandList(f *testing.F) {
 		lastPrCommit := fKeeper.GetLastPubRandCommit(ctx, fpBTCPK)
 		require.NotNil(t, lastPrCommit)
 
-		// Case 4: commit a pubrand list with overlap of the existing pubrand in KVStore and it should fail
+		// Case 4: commit a pubrand list with overlap of the existing pubrand in KVStore
+		// whose range has started and it should fail
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(startHeight)})
 		overlappedStartHeight := startHeight + numPubRand - 1 - datagen.RandomInt(r, 5)
 		_, msg, err = datagen.GenRandomMsgCommitPubRandList(r, btcSK, overlappedStartHeight, numPubRand)
 		require.NoError(t, err)
@@ -92,6 +95,78 @@ func FuzzCommitPubRandList(f *testing.F) {
 	})
 }
 
+func FuzzCommitPubRandList_GapAndSupersede(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPK.MustMarshal())).Return(true).AnyTimes()
+
+		commit := func(startHeight uint64, numPubRand uint64) (*types.MsgCommitPubRandList, error) {
+			_, msg, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+			require.NoError(t, err)
+			_, err = ms.CommitPubRandList(ctx, msg)
+			return msg, err
+		}
+
+		startHeight := 100 + datagen.RandomInt(r, 10)
+		numPubRand := 100 + datagen.RandomInt(r, 10)
+
+		// commit the first and the last pubrand lists, leaving a gap in between
+		_, err = commit(startHeight, numPubRand)
+		require.NoError(t, err)
+		lastMsg, err := commit(startHeight+3*numPubRand, numPubRand)
+		require.NoError(t, err)
+
+		// Case 1: filling the gap before the last commitment should succeed
+		_, err = commit(startHeight+numPubRand, numPubRand)
+		require.NoError(t, err)
+
+		// Case 2: superseding the last commitment, which is entirely for
+		// future heights and has not been used, should succeed
+		supersedingStartHeight := startHeight + 3*numPubRand - datagen.RandomInt(r, int(numPubRand))
+		supersedingMsg, err := commit(supersedingStartHeight, numPubRand)
+		require.NoError(t, err)
+		prCommit, err := fKeeper.GetPubRandCommitForHeight(ctx, fpBTCPK, startHeight+3*numPubRand)
+		require.NoError(t, err)
+		require.Equal(t, supersedingMsg.Commitment, prCommit.Commitment)
+		require.True(t, fKeeper.IsPubRandCommitSuperseded(ctx, fpBTCPK, lastMsg.StartHeight, lastMsg.Commitment))
+
+		// Case 3: replaying the superseded commitment should fail
+		_, err = ms.CommitPubRandList(ctx, lastMsg)
+		require.ErrorIs(t, err, types.ErrInvalidPubRand)
+
+		// Case 4: superseding a commitment whose public randomness has been
+		// used should fail, even if it is for future heights. Otherwise, the
+		// finality provider could vote at the same height with two different
+		// public randomness without leaking its secret key
+		usedHeight := supersedingStartHeight + datagen.RandomInt(r, int(numPubRand))
+		pubRand, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		fKeeper.SetPubRand(ctx, fpBTCPK, usedHeight, bbn.SchnorrPubRand(*pubRand))
+		_, err = commit(supersedingStartHeight+datagen.RandomInt(r, int(numPubRand)), numPubRand)
+		require.ErrorIs(t, err, types.ErrInvalidPubRand)
+		_, err = commit(usedHeight, numPubRand)
+		require.ErrorIs(t, err, types.ErrInvalidPubRand)
+
+		// Case 5: superseding a commitment whose range has started should fail
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(startHeight + datagen.RandomInt(r, int(numPubRand)))})
+		_, err = commit(startHeight+datagen.RandomInt(r, int(numPubRand)), numPubRand)
+		require.ErrorIs(t, err, types.ErrInvalidPubRand)
+	})
+}
+
 func FuzzAddFinalitySig(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -101,7 +176,7 @@ func FuzzAddFinalitySig(f *testing.F) {
 		defer ctrl.Finish()
 
 		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
-		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil, nil)
 		ms := keeper.NewMsgServerImpl(*fKeeper)
 
 		// create and register a random finality provider
@@ -159,6 +234,15 @@ func FuzzAddFinalitySig(f *testing.F) {
 		sig, err := fKeeper.GetSig(ctx, blockHeight, fpBTCPK)
 		require.NoError(t, err)
 		require.Equal(t, msg.FinalitySig.MustMarshal(), sig.MustMarshal())
+		// the inclusion proof of the public randomness is recorded
+		prProof, err := fKeeper.GetPubRandProof(ctx, fpBTCPK, blockHeight)
+		require.NoError(t, err)
+		require.Equal(t, msg.Proof, prProof)
+		// the vote is recorded in the statistics of the finality provider
+		stats, err := fKeeper.GetFinalityProviderStats(ctx, fpBTCPK)
+		require.NoError(t, err)
+		require.Equal(t, blockHeight, stats.FirstVotedHeight)
+		require.Equal(t, blockHeight, stats.LastVotedHeight)
 
 		// Case 4: In case of duplicate vote return success
 		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
@@ -173,6 +257,7 @@ func FuzzAddFinalitySig(f *testing.F) {
 		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
 		// mock slashing interface
 		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+		bsKeeper.EXPECT().RecordSignedSlashingTxs(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		// NOTE: even though this finality provider is slashed, the msg should be successful
 		// Otherwise the saved evidence will be rolled back
 		_, err = ms.AddFinalitySig(ctx, msg2)
@@ -194,6 +279,10 @@ func FuzzAddFinalitySig(f *testing.F) {
 		// not affect verification
 		require.True(t, btcSK.Key.Equals(&btcSK2.Key) || btcSK.Key.Negate().Equals(&btcSK2.Key))
 		require.Equal(t, btcSK.PubKey().SerializeCompressed()[1:], btcSK2.PubKey().SerializeCompressed()[1:])
+		// the evidence is counted in the statistics of the finality provider
+		stats, err = fKeeper.GetFinalityProviderStats(ctx, fpBTCPK)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), stats.EvidenceCount)
 
 		// Case 6: slashed finality provider cannot vote
 		fp.SlashedBabylonHeight = blockHeight
@@ -203,12 +292,425 @@ func FuzzAddFinalitySig(f *testing.F) {
 	})
 }
 
+func FuzzAddFinalitySigs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Any()).Return(uint64(1)).AnyTimes()
+		// commit some public randomness
+		startHeight := uint64(0)
+		numPubRand := uint64(200)
+		randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+
+		// index a random number of blocks and generate a vote for each of them
+		signer := datagen.GenRandomAccount().Address
+		numBlocks := datagen.RandomInt(r, 10) + 2
+		msg := &types.MsgAddFinalitySigs{
+			Signer:  signer,
+			FpBtcPk: fpBTCPK,
+		}
+		for blockHeight := uint64(1); blockHeight <= numBlocks; blockHeight++ {
+			blockAppHash := datagen.GenRandomByteArray(r, 32)
+			ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight), AppHash: blockAppHash})
+			fKeeper.IndexBlock(ctx)
+			vote, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight, randListInfo, blockAppHash)
+			require.NoError(t, err)
+			msg.Sigs = append(msg.Sigs, &types.FinalitySigEntry{
+				BlockHeight:  vote.BlockHeight,
+				PubRand:      vote.PubRand,
+				Proof:        vote.Proof,
+				BlockAppHash: vote.BlockAppHash,
+				FinalitySig:  vote.FinalitySig,
+			})
+		}
+
+		// tamper a random vote so that it will be rejected
+		invalidIdx := datagen.RandomInt(r, int(numBlocks))
+		validSig := msg.Sigs[invalidIdx].FinalitySig
+		invalidSig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		msg.Sigs[invalidIdx].FinalitySig = invalidSig
+
+		// fail if there is no vote
+		_, err = ms.AddFinalitySigs(ctx, &types.MsgAddFinalitySigs{Signer: signer, FpBtcPk: fpBTCPK})
+		require.Error(t, err)
+
+		resp, err := ms.AddFinalitySigs(ctx, msg)
+		require.NoError(t, err)
+		require.Len(t, resp.Results, int(numBlocks))
+		for i, result := range resp.Results {
+			blockHeight := uint64(i) + 1
+			require.Equal(t, blockHeight, result.BlockHeight)
+			if uint64(i) == invalidIdx {
+				// the tampered vote is rejected and nothing is stored
+				require.False(t, result.Accepted)
+				require.NotEmpty(t, result.Error)
+				_, err := fKeeper.GetSig(ctx, blockHeight, fpBTCPK)
+				require.Error(t, err)
+				_, err = fKeeper.GetPubRand(ctx, fpBTCPK, blockHeight)
+				require.Error(t, err)
+			} else {
+				// other votes are accepted
+				require.True(t, result.Accepted)
+				require.Empty(t, result.Error)
+				sig, err := fKeeper.GetSig(ctx, blockHeight, fpBTCPK)
+				require.NoError(t, err)
+				require.Equal(t, msg.Sigs[i].FinalitySig.MustMarshal(), sig.MustMarshal())
+			}
+		}
+
+		// resubmitting the batch with the valid vote succeeds, and the
+		// votes that have been cast are treated as duplicates
+		msg.Sigs[invalidIdx].FinalitySig = validSig
+		resp, err = ms.AddFinalitySigs(ctx, msg)
+		require.NoError(t, err)
+		for _, result := range resp.Results {
+			require.True(t, result.Accepted)
+		}
+		votes := fKeeper.GetVoters(ctx, invalidIdx+1)
+		require.Len(t, votes, 1)
+	})
+}
+
+func FuzzSubmitFinalityEvidence(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+		// commit some public randomness
+		startHeight := uint64(0)
+		numPubRand := uint64(200)
+		randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+
+		// the finality provider signs two conflicting blocks off-chain
+		reporter := datagen.GenRandomAccount().Address
+		blockHeight := startHeight + datagen.RandomInt(r, int(numPubRand))
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(startHeight + numPubRand)})
+		vote1, err := datagen.NewMsgAddFinalitySig(reporter, btcSK, startHeight, blockHeight, randListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		vote2, err := datagen.NewMsgAddFinalitySig(reporter, btcSK, startHeight, blockHeight, randListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		genMsg := func() *types.MsgSubmitFinalityEvidence {
+			return &types.MsgSubmitFinalityEvidence{
+				Signer: reporter,
+				Evidence: &types.Evidence{
+					FpBtcPk:              fpBTCPK,
+					BlockHeight:          blockHeight,
+					PubRand:              vote1.PubRand,
+					CanonicalAppHash:     vote1.BlockAppHash,
+					CanonicalFinalitySig: vote1.FinalitySig,
+					ForkAppHash:          vote2.BlockAppHash,
+					ForkFinalitySig:      vote2.FinalitySig,
+				},
+				Proof: vote1.Proof,
+			}
+		}
+
+		// Case 1: fail if the two blocks are not conflicting
+		msg := genMsg()
+		msg.Evidence.ForkAppHash = vote1.BlockAppHash
+		msg.Evidence.ForkFinalitySig = vote1.FinalitySig
+		_, err = ms.SubmitFinalityEvidence(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidFinalityEvidence)
+
+		// Case 2: fail if the public randomness is not committed at this height
+		msg = genMsg()
+		otherVote, err := datagen.NewMsgAddFinalitySig(reporter, btcSK, startHeight, (blockHeight+1)%numPubRand, randListInfo, vote1.BlockAppHash)
+		require.NoError(t, err)
+		msg.Proof = otherVote.Proof
+		_, err = ms.SubmitFinalityEvidence(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidFinalityEvidence)
+
+		// Case 3: fail if a finality signature is invalid
+		msg = genMsg()
+		msg.Evidence.ForkAppHash = datagen.GenRandomByteArray(r, 32)
+		_, err = ms.SubmitFinalityEvidence(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidFinalityEvidence)
+
+		// Case 4: the finality provider is slashed and the reporter is rewarded
+		msg = genMsg()
+		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+		bsKeeper.EXPECT().RecordSignedSlashingTxs(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		iKeeper.EXPECT().RewardFinalityEvidenceReporter(gomock.Any(), gomock.Eq(sdk.MustAccAddressFromBech32(reporter))).Times(1)
+		_, err = ms.SubmitFinalityEvidence(ctx, msg)
+		require.NoError(t, err)
+		// ensure the evidence has been stored and allows extracting the SK
+		evidence, err := fKeeper.GetEvidence(ctx, fpBTCPK, blockHeight)
+		require.NoError(t, err)
+		btcSK2, err := evidence.ExtractBTCSK()
+		require.NoError(t, err)
+		require.Equal(t, btcSK.PubKey().SerializeCompressed()[1:], btcSK2.PubKey().SerializeCompressed()[1:])
+		// the evidence is counted in the statistics of the finality provider
+		stats, err := fKeeper.GetFinalityProviderStats(ctx, fpBTCPK)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), stats.EvidenceCount)
+
+		// Case 5: fail if the finality provider is already slashed
+		fp.SlashedBabylonHeight = blockHeight + 1
+		_, err = ms.SubmitFinalityEvidence(ctx, genMsg())
+		require.ErrorIs(t, err, bstypes.ErrFpAlreadySlashed)
+	})
+}
+
+func FuzzSubmitFinalityEvidence_SupersededPubRand(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+
+		// commit public randomness for future heights
+		startHeight := 100 + datagen.RandomInt(r, 10)
+		numPubRand := uint64(100)
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
+		supersededRandListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+
+		// the finality provider signs a fork block at a future height off-chain,
+		// e.g., on a fork network that is ahead
+		reporter := datagen.GenRandomAccount().Address
+		blockHeight := startHeight + datagen.RandomInt(r, int(numPubRand))
+		forkVote, err := datagen.NewMsgAddFinalitySig(reporter, btcSK, startHeight, blockHeight, supersededRandListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+
+		// the finality provider then supersedes the commitment, and signs the
+		// canonical block at the same height with a different public randomness
+		randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+		require.True(t, fKeeper.IsPubRandCommitSuperseded(ctx, fpBTCPK, startHeight, supersededRandListInfo.Commitment))
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(startHeight + numPubRand)})
+		canonicalVote, err := datagen.NewMsgAddFinalitySig(reporter, btcSK, startHeight, blockHeight, randListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		require.NotEqual(t, forkVote.PubRand.MustMarshal(), canonicalVote.PubRand.MustMarshal())
+
+		// Case 1: the two finality signatures with different public randomness
+		// do not form an evidence that allows extracting the SK
+		_, err = ms.SubmitFinalityEvidence(ctx, &types.MsgSubmitFinalityEvidence{
+			Signer: reporter,
+			Evidence: &types.Evidence{
+				FpBtcPk:              fpBTCPK,
+				BlockHeight:          blockHeight,
+				PubRand:              forkVote.PubRand,
+				CanonicalAppHash:     canonicalVote.BlockAppHash,
+				CanonicalFinalitySig: canonicalVote.FinalitySig,
+				ForkAppHash:          forkVote.BlockAppHash,
+				ForkFinalitySig:      forkVote.FinalitySig,
+			},
+			Proof: forkVote.Proof,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidFinalityEvidence)
+
+		// Case 2: a single finality signature under the current commitment is
+		// not slashable
+		_, err = ms.SubmitFinalityEvidence(ctx, &types.MsgSubmitFinalityEvidence{
+			Signer: reporter,
+			Evidence: &types.Evidence{
+				FpBtcPk:         fpBTCPK,
+				BlockHeight:     blockHeight,
+				PubRand:         canonicalVote.PubRand,
+				ForkAppHash:     canonicalVote.BlockAppHash,
+				ForkFinalitySig: canonicalVote.FinalitySig,
+			},
+			Proof: canonicalVote.Proof,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidFinalityEvidence)
+
+		genMsg := func() *types.MsgSubmitFinalityEvidence {
+			return &types.MsgSubmitFinalityEvidence{
+				Signer: reporter,
+				Evidence: &types.Evidence{
+					FpBtcPk:         fpBTCPK,
+					BlockHeight:     blockHeight,
+					PubRand:         forkVote.PubRand,
+					ForkAppHash:     forkVote.BlockAppHash,
+					ForkFinalitySig: forkVote.FinalitySig,
+				},
+				Proof: forkVote.Proof,
+			}
+		}
+
+		// Case 3: fail if the finality signature under the superseded
+		// commitment is invalid
+		msg := genMsg()
+		msg.Evidence.ForkAppHash = datagen.GenRandomByteArray(r, 32)
+		_, err = ms.SubmitFinalityEvidence(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidFinalityEvidence)
+
+		// Case 4: a single finality signature under the superseded commitment
+		// is not a valid vote, thus not slashable
+		bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Eq(blockHeight)).Return(uint64(1)).Times(1)
+		_, err = ms.AddFinalitySig(ctx, forkVote)
+		require.ErrorIs(t, err, types.ErrInvalidFinalitySig)
+		_, err = ms.SubmitFinalityEvidence(ctx, genMsg())
+		require.ErrorIs(t, err, types.ErrInvalidFinalityEvidence)
+		require.False(t, fKeeper.HasEvidence(ctx, fpBTCPK, blockHeight))
+
+		// Case 5: two finality signatures with the same public randomness under
+		// the superseded commitment leak the SK, thus are slashable
+		forkVote2, err := datagen.NewMsgAddFinalitySig(reporter, btcSK, startHeight, blockHeight, supersededRandListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		msg = genMsg()
+		msg.Evidence.CanonicalAppHash = forkVote2.BlockAppHash
+		msg.Evidence.CanonicalFinalitySig = forkVote2.FinalitySig
+		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+		bsKeeper.EXPECT().RecordSignedSlashingTxs(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		iKeeper.EXPECT().RewardFinalityEvidenceReporter(gomock.Any(), gomock.Eq(sdk.MustAccAddressFromBech32(reporter))).Times(1)
+		_, err = ms.SubmitFinalityEvidence(ctx, msg)
+		require.NoError(t, err)
+		evidence, err := fKeeper.GetEvidence(ctx, fpBTCPK, blockHeight)
+		require.NoError(t, err)
+		btcSK2, err := evidence.ExtractBTCSK()
+		require.NoError(t, err)
+		require.Equal(t, btcSK.PubKey().SerializeCompressed()[1:], btcSK2.PubKey().SerializeCompressed()[1:])
+
+		// Case 6: fail if the finality provider is already slashed
+		fp.SlashedBabylonHeight = uint64(ctx.HeaderInfo().Height)
+		_, err = ms.SubmitFinalityEvidence(ctx, genMsg())
+		require.ErrorIs(t, err, bstypes.ErrFpAlreadySlashed)
+	})
+}
+
+func FuzzSupersedePubRand_CannotAvoidSlashing(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider with voting power
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Any()).Return(uint64(1)).AnyTimes()
+
+		// commit public randomness for future heights
+		startHeight := 100 + datagen.RandomInt(r, 10)
+		numPubRand := uint64(100)
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
+		randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+
+		// the finality provider votes for the canonical block at some height
+		// under the commitment
+		signer := datagen.GenRandomAccount().Address
+		blockHeight := startHeight + datagen.RandomInt(r, int(numPubRand-1))
+		blockAppHash := datagen.GenRandomByteArray(r, 32)
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight), AppHash: blockAppHash})
+		fKeeper.IndexBlock(ctx)
+		vote, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight, randListInfo, blockAppHash)
+		require.NoError(t, err)
+		_, err = ms.AddFinalitySig(ctx, vote)
+		require.NoError(t, err)
+
+		// superseding the commitment that has been voted under fails, either
+		// from its start height or from a future height
+		_, msgCommitPubRandList, err = datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.ErrorIs(t, err, types.ErrInvalidPubRand)
+		_, msgCommitPubRandList, err = datagen.GenRandomMsgCommitPubRandList(r, btcSK, blockHeight+1, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.ErrorIs(t, err, types.ErrInvalidPubRand)
+		prCommit, err := fKeeper.GetPubRandCommitForHeight(ctx, fpBTCPK, blockHeight)
+		require.NoError(t, err)
+		require.Equal(t, randListInfo.Commitment, prCommit.Commitment)
+		require.Empty(t, fKeeper.GetSupersededPubRandCommitsAt(ctx, fpBTCPK, startHeight))
+
+		// thus a conflicting vote under the commitment still leaks the SK, and
+		// the finality provider is slashed including its BTC delegations
+		forkVote, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight, randListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+		bsKeeper.EXPECT().RecordSignedSlashingTxs(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		_, err = ms.AddFinalitySig(ctx, forkVote)
+		require.NoError(t, err)
+		evidence, err := fKeeper.GetEvidence(ctx, fpBTCPK, blockHeight)
+		require.NoError(t, err)
+		btcSK2, err := evidence.ExtractBTCSK()
+		require.NoError(t, err)
+		require.Equal(t, btcSK.PubKey().SerializeCompressed()[1:], btcSK2.PubKey().SerializeCompressed()[1:])
+	})
+}
+
 func TestVoteForConflictingHashShouldRetrieveEvidenceAndSlash(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
-	fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+	fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil, nil)
 	ms := keeper.NewMsgServerImpl(*fKeeper)
 	// create and register a random finality provider
 	btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
@@ -265,6 +767,7 @@ func TestVoteForConflictingHashShouldRetrieveEvidenceAndSlash(t *testing.T) {
 		gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
 	bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(),
 		gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+	bsKeeper.EXPECT().RecordSignedSlashingTxs(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	_, err = ms.AddFinalitySig(ctx, msg)
 	require.NoError(t, err)
 	sig, err := fKeeper.GetSig(ctx, blockHeight, fpBTCPK)
@@ -272,3 +775,66 @@ func TestVoteForConflictingHashShouldRetrieveEvidenceAndSlash(t *testing.T) {
 	require.Equal(t, msg.FinalitySig.MustMarshal(),
 		sig.MustMarshal())
 }
+
+func FuzzUnjailFinalityProvider(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create a jailed finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		fp.Jailed = true
+		fpBTCPKBytes := fp.BtcPk.MustMarshal()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpBTCPKBytes).Return(fp, nil).AnyTimes()
+
+		// the finality provider is jailed until a random time
+		jailedUntil := time.Unix(int64(datagen.RandomInt(r, 1000000)+1), 0).UTC()
+		signingInfo := types.NewFinalityProviderSigningInfo(fp.BtcPk, 1, 0)
+		signingInfo.JailedUntil = jailedUntil
+		err = fKeeper.FinalityProviderSigningTracker.Set(ctx, fpBTCPKBytes, signingInfo)
+		require.NoError(t, err)
+
+		msg := &types.MsgUnjailFinalityProvider{
+			Signer:  fp.Addr,
+			FpBtcPk: fp.BtcPk,
+		}
+
+		// case 1: the signer is not the finality provider
+		msgWithWrongSigner := *msg
+		msgWithWrongSigner.Signer = datagen.GenRandomAccount().Address
+		_, err = ms.UnjailFinalityProvider(ctx, &msgWithWrongSigner)
+		require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+		// case 2: the jailing period has not passed yet
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: jailedUntil.Add(-time.Second)})
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.ErrorIs(t, err, types.ErrJailingPeriodNotPassed)
+
+		// case 3: the jailing period has passed
+		unjailHeight := int64(datagen.RandomInt(r, 100) + 10)
+		ctx = ctx.WithHeaderInfo(header.Info{Height: unjailHeight, Time: jailedUntil})
+		bsKeeper.EXPECT().UnjailFinalityProvider(gomock.Any(), fpBTCPKBytes).Return(nil).Times(1)
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.NoError(t, err)
+
+		// the signing info is reset
+		signingInfo, err = fKeeper.FinalityProviderSigningTracker.Get(ctx, fpBTCPKBytes)
+		require.NoError(t, err)
+		require.Equal(t, unjailHeight, signingInfo.StartHeight)
+		require.Equal(t, int64(0), signingInfo.MissedBlocksCounter)
+		require.True(t, signingInfo.JailedUntil.IsZero())
+
+		// case 4: the finality provider is not jailed
+		fp.Jailed = false
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.ErrorIs(t, err, bstypes.ErrFpNotJailed)
+	})
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// ProveFinality generates the proof that the block at the given height is
+// BTC-finalized. The store proofs are generated against the state at the
+// current height.
+func (k Keeper) ProveFinality(ctx context.Context, height uint64) (*types.FinalityProof, error) {
+	proofHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	// prove the indexed block
+	blockBytes, proofBlock, err := k.queryStore(types.StoreKey, types.GetBlockKey(height), proofHeight)
+	if err != nil {
+		return nil, err
+	}
+	if len(blockBytes) == 0 {
+		return nil, types.ErrBlockNotFound.Wrapf("height: %d", height)
+	}
+	var block types.IndexedBlock
+	k.cdc.MustUnmarshal(blockBytes, &block)
+	if !block.Finalized {
+		return nil, types.ErrBlockNotFinalized.Wrapf("height: %d", height)
+	}
+
+	// sort the voting power table to make the proof deterministic
+	fpSet := k.BTCStakingKeeper.GetVotingPowerTable(ctx, height)
+	fpPkHexList := make([]string, 0, len(fpSet))
+	for fpPkHex := range fpSet {
+		fpPkHexList = append(fpPkHexList, fpPkHex)
+	}
+	sort.Strings(fpPkHexList)
+
+	entries := make([]*types.FinalityProofEntry, 0, len(fpPkHexList))
+	for _, fpPkHex := range fpPkHexList {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpPkHex)
+		if err != nil {
+			return nil, err
+		}
+		entry, err := k.proveFinalityProofEntry(ctx, fpBTCPK, height, proofHeight)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prove the vote of finality provider %s: %w", fpPkHex, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return &types.FinalityProof{
+		Block:       &block,
+		ProofBlock:  proofBlock,
+		Entries:     entries,
+		ProofHeight: uint64(proofHeight),
+	}, nil
+}
+
+// proveFinalityProofEntry generates the proofs of the voting power and the
+// finality vote, if any, of the given finality provider at the given height
+func (k Keeper) proveFinalityProofEntry(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, height uint64, proofHeight int64) (*types.FinalityProofEntry, error) {
+	// prove the voting power
+	powerBytes, proofPower, err := k.queryStore(bstypes.StoreKey, types.GetVotingPowerKey(height, fpBTCPK), proofHeight)
+	if err != nil {
+		return nil, err
+	}
+	if len(powerBytes) == 0 {
+		return nil, fmt.Errorf("voting power not found at height %d", height)
+	}
+	entry := &types.FinalityProofEntry{
+		FpBtcPk:          fpBTCPK,
+		VotingPower:      sdk.BigEndianToUint64(powerBytes),
+		ProofVotingPower: proofPower,
+	}
+
+	// prove the finality signature, if any
+	sigBytes, proofSig, err := k.queryStore(types.StoreKey, types.GetVoteKey(height, fpBTCPK), proofHeight)
+	if err != nil {
+		return nil, err
+	}
+	if len(sigBytes) == 0 {
+		// the finality provider has not voted for the block
+		return entry, nil
+	}
+	sig, err := bbn.NewSchnorrEOTSSig(sigBytes)
+	if err != nil {
+		return nil, err
+	}
+	entry.FinalitySig = sig
+	entry.ProofFinalitySig = proofSig
+
+	// get the public randomness and its inclusion proof
+	entry.PubRand, err = k.GetPubRand(ctx, fpBTCPK, height)
+	if err != nil {
+		return nil, err
+	}
+	entry.ProofPubRand, err = k.GetPubRandProof(ctx, fpBTCPK, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// prove the public randomness commitment
+	prCommit, err := k.GetPubRandCommitForHeight(ctx, fpBTCPK, height)
+	if err != nil {
+		return nil, err
+	}
+	_, proofPrCommit, err := k.queryStore(types.StoreKey, types.GetPubRandCommitKey(fpBTCPK, prCommit.StartHeight), proofHeight)
+	if err != nil {
+		return nil, err
+	}
+	entry.PubRandCommit = prCommit
+	entry.ProofPubRandCommit = proofPrCommit
+
+	return entry, nil
+}
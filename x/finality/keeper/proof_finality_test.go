@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/keeper"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func FuzzProveFinality(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mount both the finality store and the btcstaking store so that
+		// proofs of the voting power table can be generated
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		fStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+		bsStoreKey := storetypes.NewKVStoreKey(bstypes.StoreKey)
+		stateStore.MountStoreWithDB(fStoreKey, storetypes.StoreTypeIAVL, nil)
+		stateStore.MountStoreWithDB(bsStoreKey, storetypes.StoreTypeIAVL, nil)
+		require.NoError(t, stateStore.LoadLatestVersion())
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper := keeper.NewKeeper(
+			codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+			runtime.NewKVStoreService(fStoreKey),
+			bsKeeper,
+			nil,
+			nil,
+			stateStore.(storetypes.Queryable),
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		)
+		ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+
+		// index a finalized block
+		blockHeight := datagen.RandomInt(r, 100) + 1
+		blockAppHash := datagen.GenRandomByteArray(r, 32)
+		fKeeper.SetBlock(ctx, &types.IndexedBlock{
+			Height:    blockHeight,
+			AppHash:   blockAppHash,
+			Finalized: true,
+		})
+
+		// generate a set of finality providers, in which more than 2/3 vote
+		numFPs := int(datagen.RandomInt(r, 10) + 3)
+		numVoters := numFPs*2/3 + 1
+		fpSet := map[string]uint64{}
+		fpBTCPKs := []*bbn.BIP340PubKey{}
+		bsStore := ctx.KVStore(bsStoreKey)
+		var (
+			voterSK          *btcec.PrivateKey
+			voterStartHeight uint64
+			voterRandList    *datagen.RandListInfo
+		)
+		for i := 0; i < numFPs; i++ {
+			btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+
+			// all finality providers have the same voting power
+			fpSet[fpBTCPK.MarshalHex()] = 100
+			fpBTCPKs = append(fpBTCPKs, fpBTCPK)
+			bsStore.Set(types.GetVotingPowerKey(blockHeight, fpBTCPK), sdk.Uint64ToBigEndian(100))
+
+			startHeight := datagen.RandomInt(r, int(blockHeight))
+			randListInfo, msgCommit, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, 200)
+			require.NoError(t, err)
+			fKeeper.SetPubRandCommit(ctx, fpBTCPK, &types.PubRandCommit{
+				StartHeight: msgCommit.StartHeight,
+				NumPubRand:  msgCommit.NumPubRand,
+				Commitment:  msgCommit.Commitment,
+			})
+
+			if i >= numVoters {
+				continue
+			}
+			if i == 0 {
+				voterSK, voterStartHeight, voterRandList = btcSK, startHeight, randListInfo
+			}
+			msg, err := datagen.NewMsgAddFinalitySig(datagen.GenRandomAccount().Address, btcSK, startHeight, blockHeight, randListInfo, blockAppHash)
+			require.NoError(t, err)
+			fKeeper.SetSig(ctx, blockHeight, fpBTCPK, msg.FinalitySig)
+			fKeeper.SetPubRand(ctx, fpBTCPK, blockHeight, *msg.PubRand)
+			fKeeper.SetPubRandProof(ctx, fpBTCPK, blockHeight, msg.Proof)
+		}
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Eq(blockHeight)).Return(fpSet).AnyTimes()
+
+		// commit the state and generate the finality proof against it
+		commitID := stateStore.Commit()
+		ctx = ctx.WithBlockHeight(commitID.Version)
+		proof, err := fKeeper.ProveFinality(ctx, blockHeight)
+		require.NoError(t, err)
+		require.Len(t, proof.Entries, numFPs)
+		require.Equal(t, uint64(commitID.Version), proof.ProofHeight)
+
+		// the finality proof is valid against the AppHash
+		err = types.VerifyFinalityProof(proof, commitID.Hash)
+		require.NoError(t, err)
+
+		// the finality proof is invalid against another AppHash
+		err = types.VerifyFinalityProof(proof, datagen.GenRandomByteArray(r, 32))
+		require.ErrorIs(t, err, types.ErrInvalidFinalityProof)
+
+		// the finality proof is invalid if the voting power is tampered
+		tamperedEntry := *proof.Entries[0]
+		tamperedEntry.VotingPower++
+		tamperedProof := *proof
+		tamperedProof.Entries = append([]*types.FinalityProofEntry{&tamperedEntry}, proof.Entries[1:]...)
+		err = types.VerifyFinalityProof(&tamperedProof, commitID.Hash)
+		require.ErrorIs(t, err, types.ErrInvalidFinalityProof)
+
+		// the finality proof does not reach the quorum if some votes are removed
+		unvotedProof := *proof
+		unvotedProof.Entries = nil
+		for i, entry := range proof.Entries {
+			e := *entry
+			if i < len(proof.Entries)/3+1 {
+				e.FinalitySig = nil
+			}
+			unvotedProof.Entries = append(unvotedProof.Entries, &e)
+		}
+		err = types.VerifyFinalityProof(&unvotedProof, commitID.Hash)
+		require.ErrorIs(t, err, types.ErrBlockNotFinalized)
+
+		// a block that is not finalized cannot be proven finalized by
+		// omitting the finality providers that have not voted from the
+		// voting power table, even if the remaining votes reach the quorum
+		unfinalizedHeight := blockHeight + 1
+		unfinalizedBlock := &types.IndexedBlock{
+			Height:    unfinalizedHeight,
+			AppHash:   datagen.GenRandomByteArray(r, 32),
+			Finalized: false,
+		}
+		fKeeper.SetBlock(ctx, unfinalizedBlock)
+		for _, fpBTCPK := range fpBTCPKs {
+			bsStore.Set(types.GetVotingPowerKey(unfinalizedHeight, fpBTCPK), sdk.Uint64ToBigEndian(100))
+		}
+		msg, err := datagen.NewMsgAddFinalitySig(datagen.GenRandomAccount().Address, voterSK, voterStartHeight, unfinalizedHeight, voterRandList, unfinalizedBlock.AppHash)
+		require.NoError(t, err)
+		fKeeper.SetSig(ctx, unfinalizedHeight, msg.FpBtcPk, msg.FinalitySig)
+		prCommit, err := fKeeper.GetPubRandCommitForHeight(ctx, msg.FpBtcPk, unfinalizedHeight)
+		require.NoError(t, err)
+		commitID = stateStore.Commit()
+
+		proveStore := func(storeKey string, key []byte) *cmtcrypto.ProofOps {
+			resp, err := stateStore.(storetypes.Queryable).Query(&storetypes.RequestQuery{
+				Path:   fmt.Sprintf("/%s/key", storeKey),
+				Data:   key,
+				Height: commitID.Version,
+				Prove:  true,
+			})
+			require.NoError(t, err)
+			return resp.ProofOps
+		}
+		forgedProof := &types.FinalityProof{
+			Block:      unfinalizedBlock,
+			ProofBlock: proveStore(types.StoreKey, types.GetBlockKey(unfinalizedHeight)),
+			Entries: []*types.FinalityProofEntry{{
+				FpBtcPk:            msg.FpBtcPk,
+				VotingPower:        100,
+				ProofVotingPower:   proveStore(bstypes.StoreKey, types.GetVotingPowerKey(unfinalizedHeight, msg.FpBtcPk)),
+				FinalitySig:        msg.FinalitySig,
+				ProofFinalitySig:   proveStore(types.StoreKey, types.GetVoteKey(unfinalizedHeight, msg.FpBtcPk)),
+				PubRand:            msg.PubRand,
+				ProofPubRand:       msg.Proof,
+				PubRandCommit:      prCommit,
+				ProofPubRandCommit: proveStore(types.StoreKey, types.GetPubRandCommitKey(msg.FpBtcPk, prCommit.StartHeight)),
+			}},
+			ProofHeight: uint64(commitID.Version),
+		}
+		err = types.VerifyFinalityProof(forgedProof, commitID.Hash)
+		require.ErrorIs(t, err, types.ErrBlockNotFinalized)
+	})
+}
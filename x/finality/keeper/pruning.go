@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// PruneHeights prunes the finality votes, public randomness and indexed blocks
+// at heights that are no longer needed, i.e., heights that have been finalized
+// (or are non-finalisable) and fall out of the retention window. At most
+// `MaxPrunedHeightsPerBlock` heights are pruned upon each invocation, so that
+// the amount of work per block is bounded.
+// NOTE: evidences and public randomness commitments are never pruned.
+func (k Keeper) PruneHeights(ctx context.Context) {
+	params := k.GetParams(ctx)
+	if params.RetentionWindow == 0 {
+		// pruning is disabled
+		return
+	}
+
+	// all heights before nextHeightToFinalize are either finalized or non-finalisable
+	nextHeightToFinalize := k.getNextHeightToFinalize(ctx)
+	if nextHeightToFinalize <= params.RetentionWindow {
+		return
+	}
+	pruneUntil := nextHeightToFinalize - params.RetentionWindow
+
+	startHeight := k.GetNextHeightToPrune(ctx)
+	if startHeight == 0 {
+		// nothing has been pruned yet, start from the earliest indexed block
+		earliestHeight, ok := k.getEarliestBlockHeight(ctx)
+		if !ok {
+			return
+		}
+		startHeight = earliestHeight
+	}
+	endHeight := min(pruneUntil, startHeight+params.MaxPrunedHeightsPerBlock)
+	if startHeight >= endHeight {
+		return
+	}
+
+	for height := startHeight; height < endHeight; height++ {
+		k.pruneHeight(ctx, height)
+	}
+	k.setNextHeightToPrune(ctx, endHeight)
+}
+
+// pruneHeight removes the finality votes, public randomness and the indexed
+// block at the given height
+func (k Keeper) pruneHeight(ctx context.Context, height uint64) {
+	// finality providers that might have public randomness at this height,
+	// including those voted for a fork, which have voting power at this height
+	fpPkHexSet := map[string]struct{}{}
+	for fpPkHex := range k.BTCStakingKeeper.GetVotingPowerTable(ctx, height) {
+		fpPkHexSet[fpPkHex] = struct{}{}
+	}
+	for fpPkHex := range k.GetVoters(ctx, height) {
+		fpPkHexSet[fpPkHex] = struct{}{}
+	}
+
+	for fpPkHex := range fpPkHexSet {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpPkHex)
+		if err != nil {
+			// failing to unmarshal finality provider's BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		k.deletePubRand(ctx, fpBTCPK, height)
+		k.deletePubRandProof(ctx, fpBTCPK, height)
+	}
+	k.deleteSigs(ctx, height)
+	k.deleteBlock(ctx, height)
+}
+
+// IsHeightPruned returns whether the finality votes, public randomness and
+// indexed block at the given height have been pruned
+func (k Keeper) IsHeightPruned(ctx context.Context, height uint64) bool {
+	return height < k.GetNextHeightToPrune(ctx)
+}
+
+// GetNextHeightToPrune gets the next height to prune, where all heights before
+// it have been pruned. It returns 0 if nothing has been pruned.
+func (k Keeper) GetNextHeightToPrune(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextHeightToPruneKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setNextHeightToPrune sets the next height to prune as the given height
+func (k Keeper) setNextHeightToPrune(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.NextHeightToPruneKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func FuzzPruneHeights(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil, nil)
+
+		params := types.DefaultParams()
+		params.RetentionWindow = datagen.RandomInt(r, 10) + uint64(params.FinalitySigTimeout) + 1
+		params.MaxPrunedHeightsPerBlock = datagen.RandomInt(r, 5) + 1
+		err := fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// index a list of blocks with votes and public randomness
+		activatedHeight := datagen.RandomInt(r, 10) + 1
+		numBlocks := datagen.RandomInt(r, 30) + params.RetentionWindow + 1
+		lastHeight := activatedHeight + numBlocks - 1
+		fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		appHashes := map[uint64][]byte{}
+		for i := activatedHeight; i <= lastHeight; i++ {
+			appHashes[i] = datagen.GenRandomByteArray(r, 32)
+			fKeeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:  i,
+				AppHash: appHashes[i],
+			})
+			sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			fKeeper.SetSig(ctx, i, fpBTCPK, sig)
+			pubRand := bbn.SchnorrPubRand(datagen.GenRandomByteArray(r, 32))
+			fKeeper.SetPubRand(ctx, fpBTCPK, i, pubRand)
+			fKeeper.SetPubRandProof(ctx, fpBTCPK, i, &cmtcrypto.Proof{Total: 1, LeafHash: datagen.GenRandomByteArray(r, 32)})
+		}
+
+		// none of the blocks has a finality provider set, so that all of them
+		// are non-finalisable after tallying
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(activatedHeight, nil).Times(1)
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ctx = datagen.WithCtxHeight(ctx, lastHeight)
+		fKeeper.TallyBlocks(ctx)
+
+		// prune heights until nothing can be pruned, where each invocation
+		// prunes at most MaxPrunedHeightsPerBlock heights
+		pruneUntil := lastHeight + 1 - params.RetentionWindow
+		require.Zero(t, fKeeper.GetNextHeightToPrune(ctx))
+		for {
+			before := fKeeper.GetNextHeightToPrune(ctx)
+			if before == 0 {
+				before = activatedHeight
+			}
+			fKeeper.PruneHeights(ctx)
+			after := fKeeper.GetNextHeightToPrune(ctx)
+			if after <= before {
+				break
+			}
+			require.LessOrEqual(t, after-before, params.MaxPrunedHeightsPerBlock)
+		}
+		require.Equal(t, pruneUntil, fKeeper.GetNextHeightToPrune(ctx))
+
+		for i := activatedHeight; i <= lastHeight; i++ {
+			if i < pruneUntil {
+				// heights out of the retention window are pruned
+				require.True(t, fKeeper.IsHeightPruned(ctx, i))
+				_, err := fKeeper.GetBlock(ctx, i)
+				require.Error(t, err)
+				_, err = fKeeper.GetBlockByAppHash(ctx, appHashes[i])
+				require.Error(t, err)
+				require.False(t, fKeeper.HasSig(ctx, i, fpBTCPK))
+				require.False(t, fKeeper.HasPubRand(ctx, fpBTCPK, i))
+				_, err = fKeeper.GetPubRandProof(ctx, fpBTCPK, i)
+				require.Error(t, err)
+
+				_, err = fKeeper.Block(ctx, &types.QueryBlockRequest{Height: i})
+				require.ErrorIs(t, err, types.ErrHeightPruned)
+				_, err = fKeeper.VotesAtHeight(ctx, &types.QueryVotesAtHeightRequest{Height: i})
+				require.ErrorIs(t, err, types.ErrHeightPruned)
+			} else {
+				// heights within the retention window are kept
+				require.False(t, fKeeper.IsHeightPruned(ctx, i))
+				_, err := fKeeper.GetBlock(ctx, i)
+				require.NoError(t, err)
+				_, err = fKeeper.GetBlockByAppHash(ctx, appHashes[i])
+				require.NoError(t, err)
+				require.True(t, fKeeper.HasSig(ctx, i, fpBTCPK))
+				require.True(t, fKeeper.HasPubRand(ctx, fpBTCPK, i))
+				_, err = fKeeper.GetPubRandProof(ctx, fpBTCPK, i)
+				require.NoError(t, err)
+			}
+		}
+	})
+}
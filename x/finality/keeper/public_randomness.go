@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/finality/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -56,6 +57,35 @@ func (k Keeper) GetLastPubRandCommit(ctx context.Context, fpBtcPK *bbn.BIP340Pub
 	return &prCommit
 }
 
+// GetOverlappingPubRandCommits retrieves all public randomness commitments of
+// the given finality provider that overlap with the given range of heights
+func (k Keeper) GetOverlappingPubRandCommits(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, startHeight uint64, endHeight uint64) []*types.PubRandCommit {
+	store := k.pubRandCommitFpStore(ctx, fpBtcPK)
+	// commitments of a finality provider do not overlap with each other, so
+	// iterating over those starting no later than endHeight in reverse order
+	// yields commitments with descending end heights
+	iter := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(endHeight+1))
+	defer iter.Close()
+
+	prCommits := []*types.PubRandCommit{}
+	for ; iter.Valid(); iter.Next() {
+		var prCommit types.PubRandCommit
+		k.cdc.MustUnmarshal(iter.Value(), &prCommit)
+		if prCommit.EndHeight() < startHeight {
+			break
+		}
+		prCommits = append(prCommits, &prCommit)
+	}
+	return prCommits
+}
+
+// deletePubRandCommit removes the public randomness commitment starting at
+// the given height for the given finality provider
+func (k Keeper) deletePubRandCommit(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, startHeight uint64) {
+	store := k.pubRandCommitFpStore(ctx, fpBtcPK)
+	store.Delete(sdk.Uint64ToBigEndian(startHeight))
+}
+
 // pubRandCommitFpStore returns the KVStore of the commitment of public randomness
 // prefix: PubRandKey
 // key: (finality provider PK || block height of the commitment)
@@ -74,9 +104,67 @@ func (k Keeper) pubRandCommitStore(ctx context.Context) prefix.Store {
 	return prefix.NewStore(storeAdapter, types.PubRandCommitKey)
 }
 
+/*
+	Superseded public randomness commitment storage
+*/
+
+// SetSupersededPubRandCommit records the given public randomness commitment of
+// the given finality provider as superseded, so that it cannot be committed again
+func (k Keeper) SetSupersededPubRandCommit(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, prCommit *types.PubRandCommit) {
+	store := k.supersededPubRandCommitFpStore(ctx, fpBtcPK)
+	key := append(sdk.Uint64ToBigEndian(prCommit.StartHeight), prCommit.Commitment...)
+	store.Set(key, k.cdc.MustMarshal(prCommit))
+}
+
+// IsPubRandCommitSuperseded checks whether the given commitment value starting
+// at the given height of the given finality provider has been superseded before
+func (k Keeper) IsPubRandCommitSuperseded(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, startHeight uint64, commitment []byte) bool {
+	if len(commitment) == 0 {
+		return false
+	}
+	store := k.supersededPubRandCommitFpStore(ctx, fpBtcPK)
+	return store.Has(append(sdk.Uint64ToBigEndian(startHeight), commitment...))
+}
+
+// GetSupersededPubRandCommitsAt returns the superseded public randomness
+// commitments of the given finality provider that start at the given height
+func (k Keeper) GetSupersededPubRandCommitsAt(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, startHeight uint64) []*types.PubRandCommit {
+	store := prefix.NewStore(k.supersededPubRandCommitFpStore(ctx, fpBtcPK), sdk.Uint64ToBigEndian(startHeight))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	prCommits := []*types.PubRandCommit{}
+	for ; iter.Valid(); iter.Next() {
+		var prCommit types.PubRandCommit
+		k.cdc.MustUnmarshal(iter.Value(), &prCommit)
+		prCommits = append(prCommits, &prCommit)
+	}
+	return prCommits
+}
+
+// supersededPubRandCommitFpStore returns the KVStore of the superseded
+// commitments of public randomness
+// prefix: SupersededPubRandCommitKey
+// key: (finality provider PK || start height of the commitment || commitment)
+// value: PubRandCommit
+func (k Keeper) supersededPubRandCommitFpStore(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) prefix.Store {
+	store := k.supersededPubRandCommitStore(ctx)
+	return prefix.NewStore(store, fpBtcPK.MustMarshal())
+}
+
+// supersededPubRandCommitStore returns the KVStore of the superseded
+// commitments of public randomness
+// prefix: SupersededPubRandCommitKey
+// key: (prefix)
+// value: PubRandCommit
+func (k Keeper) supersededPubRandCommitStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.SupersededPubRandCommitKey)
+}
+
 /*
 	Public randomness storage
-	TODO: remove public randomness storage?
+	NOTE: public randomness out of the retention window is pruned
 */
 
 // SetPubRand sets a public randomness at a given height for a given finality provider
@@ -99,6 +187,22 @@ func (k Keeper) GetPubRand(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, heigh
 	return bbn.NewSchnorrPubRand(prBytes)
 }
 
+// deletePubRand removes the public randomness at a given height for a given finality provider
+func (k Keeper) deletePubRand(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) {
+	store := k.pubRandFpStore(ctx, fpBtcPK)
+	store.Delete(sdk.Uint64ToBigEndian(height))
+}
+
+// HasPubRandInRange checks whether the given finality provider has any public
+// randomness recorded at a height within [startHeight, endHeight]
+func (k Keeper) HasPubRandInRange(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, startHeight uint64, endHeight uint64) bool {
+	store := k.pubRandFpStore(ctx, fpBtcPK)
+	iter := store.Iterator(sdk.Uint64ToBigEndian(startHeight), sdk.Uint64ToBigEndian(endHeight+1))
+	defer iter.Close()
+
+	return iter.Valid()
+}
+
 // GetLastPubRand retrieves the last public randomness committed by the given finality provider
 func (k Keeper) GetLastPubRand(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) (uint64, *bbn.SchnorrPubRand, error) {
 	store := k.pubRandFpStore(ctx, fpBtcPK)
@@ -136,3 +240,52 @@ func (k Keeper) pubRandStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.PubRandKey)
 }
+
+/*
+	Public randomness inclusion proof storage
+*/
+
+// SetPubRandProof sets the inclusion proof of the public randomness used at a
+// given height by a given finality provider
+func (k Keeper) SetPubRandProof(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64, proof *cmtcrypto.Proof) {
+	store := k.pubRandProofFpStore(ctx, fpBtcPK)
+	store.Set(sdk.Uint64ToBigEndian(height), k.cdc.MustMarshal(proof))
+}
+
+// GetPubRandProof gets the inclusion proof of the public randomness used at a
+// given height by a given finality provider
+func (k Keeper) GetPubRandProof(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) (*cmtcrypto.Proof, error) {
+	store := k.pubRandProofFpStore(ctx, fpBtcPK)
+	proofBytes := store.Get(sdk.Uint64ToBigEndian(height))
+	if len(proofBytes) == 0 {
+		return nil, types.ErrPubRandNotFound.Wrapf("no inclusion proof of public randomness at height %d", height)
+	}
+	var proof cmtcrypto.Proof
+	k.cdc.MustUnmarshal(proofBytes, &proof)
+	return &proof, nil
+}
+
+// deletePubRandProof removes the inclusion proof of the public randomness used
+// at a given height by a given finality provider
+func (k Keeper) deletePubRandProof(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) {
+	store := k.pubRandProofFpStore(ctx, fpBtcPK)
+	store.Delete(sdk.Uint64ToBigEndian(height))
+}
+
+// pubRandProofFpStore returns the KVStore of the inclusion proofs of public randomness
+// prefix: PubRandProofKey
+// key: (finality provider PK || block height)
+// value: Proof
+func (k Keeper) pubRandProofFpStore(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) prefix.Store {
+	prefixedStore := k.pubRandProofStore(ctx)
+	return prefix.NewStore(prefixedStore, fpBtcPK.MustMarshal())
+}
+
+// pubRandProofStore returns the KVStore of the inclusion proofs of public randomness
+// prefix: PubRandProofKey
+// key: (prefix)
+// value: Proof
+func (k Keeper) pubRandProofStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.PubRandProofKey)
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+)
+
+// queryStore queries a KV pair in the KVStore at the given version, where
+// - moduleStoreKey is the store key of a module, e.g., types.StoreKey
+// - key is the key of the queried KV pair, including the prefix, e.g., types.BlockKey || height
+// and returns
+// - value of this KV pair, which is empty if the key does not exist
+// - Merkle proof of this KV pair against the AppHash resulting from the given version
+// - error
+// (adapted from https://github.com/cosmos/cosmos-sdk/blob/v0.46.6/baseapp/abci.go#L774-L795)
+func (k Keeper) queryStore(moduleStoreKey string, key []byte, version int64) ([]byte, *cmtcrypto.ProofOps, error) {
+	// construct the query path for ABCI query
+	// since we are querying the DB directly, the path will not need prefix "/store" as done in ABCIQuery
+	// Instead, it will be formed as "/<moduleStoreKey>/key", e.g., "/finality/key"
+	path := fmt.Sprintf("/%s/key", moduleStoreKey)
+
+	// query the KV with Merkle proof
+	resp, err := k.storeQuerier.Query(&storetypes.RequestQuery{
+		Path:   path,
+		Data:   key,
+		Height: version,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.Code != 0 {
+		return nil, nil, fmt.Errorf("query (with path %s) failed with response: %v", path, resp)
+	}
+
+	return resp.Value, resp.ProofOps, nil
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func TestParamsQuery(t *testing.T) {
-	keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil)
+	keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil, nil)
 	params := types.DefaultParams()
 	err := keeper.SetParams(ctx, params)
 	require.NoError(t, err)
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// GetFinalityProviderStats returns the cumulative performance statistics of
+// the given finality provider. Empty statistics are returned if the finality
+// provider has no statistics yet.
+func (k Keeper) GetFinalityProviderStats(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) (*types.FinalityProviderStats, error) {
+	stats, err := k.FinalityProviderStatsTracker.Get(ctx, fpBtcPK.MustMarshal())
+	if errors.Is(err, collections.ErrNotFound) {
+		return &types.FinalityProviderStats{FpBtcPk: fpBtcPK}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// GetFinalityProviderEpochStats returns the performance statistics of the
+// given finality provider in the given epoch. Empty statistics are returned
+// if the finality provider has no statistics in the epoch.
+func (k Keeper) GetFinalityProviderEpochStats(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, epochNumber uint64) (*types.FinalityProviderEpochStats, error) {
+	stats, err := k.FinalityProviderEpochStatsTracker.Get(ctx, collections.Join(fpBtcPK.MustMarshal(), epochNumber))
+	if errors.Is(err, collections.ErrNotFound) {
+		return &types.FinalityProviderEpochStats{FpBtcPk: fpBtcPK, EpochNumber: epochNumber}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// GetRecentVotedBlocks returns the number of blocks that the given finality
+// provider is expected to vote and the number of them it has voted, over the
+// last numEpochs epochs up to (and including) the current epoch
+func (k Keeper) GetRecentVotedBlocks(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, numEpochs uint64) (uint64, uint64, error) {
+	if numEpochs == 0 {
+		return 0, 0, nil
+	}
+	curEpoch := k.EpochingKeeper.GetEpoch(ctx).EpochNumber
+	startEpoch := uint64(0)
+	if curEpoch+1 > numEpochs {
+		startEpoch = curEpoch + 1 - numEpochs
+	}
+
+	expectedVotes, votedBlocks := uint64(0), uint64(0)
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](fpBtcPK.MustMarshal()).
+		StartInclusive(startEpoch).
+		EndInclusive(curEpoch)
+	err := k.FinalityProviderEpochStatsTracker.Walk(ctx, rng, func(_ collections.Pair[[]byte, uint64], stats types.FinalityProviderEpochStats) (bool, error) {
+		expectedVotes += stats.ExpectedVotes
+		votedBlocks += stats.VotedBlocks
+		return false, nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return expectedVotes, votedBlocks, nil
+}
+
+// getEpochNumberByHeight returns the number of the epoch that the given
+// height belongs to, walking back from the current epoch
+func (k Keeper) getEpochNumberByHeight(ctx context.Context, height uint64) (uint64, error) {
+	epoch := k.EpochingKeeper.GetEpoch(ctx)
+	if height > epoch.GetLastBlockHeight() {
+		return 0, fmt.Errorf("height %d is after the current epoch %d", height, epoch.EpochNumber)
+	}
+	for !epoch.WithinBoundary(height) {
+		if epoch.EpochNumber == 0 {
+			return 0, fmt.Errorf("height %d does not belong to any epoch", height)
+		}
+		var err error
+		epoch, err = k.EpochingKeeper.GetHistoricalEpoch(ctx, epoch.EpochNumber-1)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return epoch.EpochNumber, nil
+}
+
+// recordVote records that the given finality provider has voted for the
+// canonical block at the given height
+func (k Keeper) recordVote(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) error {
+	return k.updateFinalityProviderStats(ctx, fpBtcPK, func(stats *types.FinalityProviderStats) {
+		if stats.FirstVotedHeight == 0 || height < stats.FirstVotedHeight {
+			stats.FirstVotedHeight = height
+		}
+		if height > stats.LastVotedHeight {
+			stats.LastVotedHeight = height
+		}
+	})
+}
+
+// recordLiveness records whether the given finality provider has voted for a
+// block that it is expected to vote, both cumulatively and in the given epoch
+func (k Keeper) recordLiveness(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, epochNumber uint64, voted bool) error {
+	err := k.updateFinalityProviderStats(ctx, fpBtcPK, func(stats *types.FinalityProviderStats) {
+		stats.ExpectedVotes++
+		if voted {
+			stats.VotedBlocks++
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	epochStats, err := k.GetFinalityProviderEpochStats(ctx, fpBtcPK, epochNumber)
+	if err != nil {
+		return err
+	}
+	epochStats.ExpectedVotes++
+	if voted {
+		epochStats.VotedBlocks++
+	}
+	return k.FinalityProviderEpochStatsTracker.Set(ctx, collections.Join(fpBtcPK.MustMarshal(), epochNumber), *epochStats)
+}
+
+// recordSluggish records that the given finality provider has been detected sluggish
+func (k Keeper) recordSluggish(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) error {
+	return k.updateFinalityProviderStats(ctx, fpBtcPK, func(stats *types.FinalityProviderStats) {
+		stats.SluggishCount++
+	})
+}
+
+// recordEvidence saves the given evidence, and counts it in the statistics of
+// the finality provider if there is no evidence at the same height yet
+func (k Keeper) recordEvidence(ctx context.Context, evidence *types.Evidence) error {
+	if !k.HasEvidence(ctx, evidence.FpBtcPk, evidence.BlockHeight) {
+		err := k.updateFinalityProviderStats(ctx, evidence.FpBtcPk, func(stats *types.FinalityProviderStats) {
+			stats.EvidenceCount++
+		})
+		if err != nil {
+			return err
+		}
+	}
+	k.SetEvidence(ctx, evidence)
+	return nil
+}
+
+// updateFinalityProviderStats applies the given update to the cumulative
+// performance statistics of the given finality provider
+func (k Keeper) updateFinalityProviderStats(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, update func(stats *types.FinalityProviderStats)) error {
+	stats, err := k.GetFinalityProviderStats(ctx, fpBtcPK)
+	if err != nil {
+		return err
+	}
+	update(stats)
+	return k.FinalityProviderStatsTracker.Set(ctx, fpBtcPK.MustMarshal(), *stats)
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func FuzzFinalityProviderStats(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		// epochs of a fixed number of blocks, where the current epoch is the
+		// one of the current height
+		numEpochs := datagen.RandomInt(r, 5) + 1
+		blocksPerEpoch := datagen.RandomInt(r, 10) + 1
+		epochOf := func(height uint64) uint64 {
+			if height == 0 {
+				return 0
+			}
+			return (height-1)/blocksPerEpoch + 1
+		}
+		genEpoch := func(epochNumber uint64) *epochingtypes.Epoch {
+			if epochNumber == 0 {
+				return &epochingtypes.Epoch{EpochNumber: 0}
+			}
+			return &epochingtypes.Epoch{
+				EpochNumber:          epochNumber,
+				FirstBlockHeight:     (epochNumber-1)*blocksPerEpoch + 1,
+				CurrentEpochInterval: blocksPerEpoch,
+			}
+		}
+		curHeight := uint64(0)
+		eKeeper := types.NewMockEpochingKeeper(ctrl)
+		eKeeper.EXPECT().GetEpoch(gomock.Any()).DoAndReturn(func(_ context.Context) *epochingtypes.Epoch {
+			return genEpoch(epochOf(curHeight))
+		}).AnyTimes()
+		eKeeper.EXPECT().GetHistoricalEpoch(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, epochNumber uint64) (*epochingtypes.Epoch, error) {
+			return genEpoch(epochNumber), nil
+		}).AnyTimes()
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil, eKeeper)
+
+		// generate a set of finality providers
+		numFPs := int(datagen.RandomInt(r, 5) + 1)
+		fpPKs := make([]*bbn.BIP340PubKey, 0, numFPs)
+		fpSet := map[string]uint64{}
+		for i := 0; i < numFPs; i++ {
+			fpPK, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			fpPKs = append(fpPKs, fpPK)
+			fpSet[fpPK.MarshalHex()] = 1
+			bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpPK.MustMarshal()).Return(&bstypes.FinalityProvider{}, nil).AnyTimes()
+			signingInfo := types.NewFinalityProviderSigningInfo(fpPK, 1, 0)
+			err = fKeeper.FinalityProviderSigningTracker.Set(ctx, fpPK.MustMarshal(), signingInfo)
+			require.NoError(t, err)
+		}
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Any()).Return(fpSet).AnyTimes()
+
+		// finality providers randomly vote for blocks in a number of epochs,
+		// where the number of blocks does not exceed the signed blocks window
+		// so that no finality provider is detected sluggish. Each height is
+		// examined a random number of blocks later, possibly in a later epoch,
+		// and its votes are counted in the epoch of the height
+		lag := datagen.RandomInt(r, int(blocksPerEpoch)+1)
+		votedBlocks := map[string]map[uint64]uint64{}
+		for _, fpPK := range fpPKs {
+			votedBlocks[fpPK.MarshalHex()] = map[uint64]uint64{}
+		}
+		for height := uint64(1); height <= numEpochs*blocksPerEpoch; height++ {
+			for _, fpPK := range fpPKs {
+				if r.Intn(2) == 0 {
+					continue
+				}
+				sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, height, fpPK, sig)
+				votedBlocks[fpPK.MarshalHex()][epochOf(height)]++
+			}
+			curHeight = height + lag
+			fKeeper.HandleLiveness(ctx, int64(height))
+		}
+		curHeight = numEpochs * blocksPerEpoch
+
+		// the statistics of each epoch are attributed to the epoch of the
+		// examined heights
+		for _, fpPK := range fpPKs {
+			for epoch := uint64(1); epoch <= numEpochs; epoch++ {
+				epochStats, err := fKeeper.GetFinalityProviderEpochStats(ctx, fpPK, epoch)
+				require.NoError(t, err)
+				require.Equal(t, blocksPerEpoch, epochStats.ExpectedVotes)
+				require.Equal(t, votedBlocks[fpPK.MarshalHex()][epoch], epochStats.VotedBlocks)
+			}
+		}
+
+		// the cumulative statistics are correct
+		for _, fpPK := range fpPKs {
+			total := uint64(0)
+			for _, voted := range votedBlocks[fpPK.MarshalHex()] {
+				total += voted
+			}
+			stats, err := fKeeper.GetFinalityProviderStats(ctx, fpPK)
+			require.NoError(t, err)
+			require.Equal(t, numEpochs*blocksPerEpoch, stats.ExpectedVotes)
+			require.Equal(t, total, stats.VotedBlocks)
+		}
+
+		// the statistics over the last epochs are correct
+		queriedEpochs := datagen.RandomInt(r, int(numEpochs)) + 1
+		resp, err := fKeeper.FinalityProviderStats(ctx, &types.QueryFinalityProviderStatsRequest{
+			NumEpochs:  queriedEpochs,
+			Pagination: &query.PageRequest{Limit: uint64(numFPs)},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Stats, numFPs)
+		for _, stats := range resp.Stats {
+			recentVoted := uint64(0)
+			for epoch := numEpochs - queriedEpochs + 1; epoch <= numEpochs; epoch++ {
+				recentVoted += votedBlocks[stats.FpBtcPkHex][epoch]
+			}
+			recentExpected := queriedEpochs * blocksPerEpoch
+			require.Equal(t, recentExpected, stats.RecentExpectedVotes)
+			require.Equal(t, recentVoted, stats.RecentVotedBlocks)
+			require.True(t, types.VotedShare(recentExpected, recentVoted).Equal(stats.RecentVotedShare))
+		}
+
+		// the statistics are paginated
+		resp, err = fKeeper.FinalityProviderStats(ctx, &types.QueryFinalityProviderStatsRequest{
+			NumEpochs:  queriedEpochs,
+			Pagination: &query.PageRequest{Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Stats, 1)
+	})
+}
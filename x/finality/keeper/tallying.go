@@ -38,6 +38,8 @@ func (k Keeper) TallyBlocks(ctx context.Context) {
 	// - has finality providers, finalised: impossible to happen, panic
 	// - does not have finality providers, finalised: impossible to happen, panic
 	// After this for loop, the blocks since earliest activated height are either finalised or non-finalisable
+	// stalledHeight is the height of the block without quorum that the loop stops at, if any
+	stalledHeight := uint64(0)
 	for i := startHeight; i <= uint64(sdkCtx.HeaderInfo().Height); i++ {
 		ib, err := k.GetBlock(ctx, i)
 		if err != nil {
@@ -50,12 +52,13 @@ func (k Keeper) TallyBlocks(ctx context.Context) {
 		if fpSet != nil && !ib.Finalized {
 			// has finality providers, non-finalised: tally and try to finalise the block
 			voterBTCPKs := k.GetVoters(ctx, ib.Height)
-			if tally(fpSet, voterBTCPKs) {
+			if types.Tally(fpSet, voterBTCPKs) {
 				// if this block gets >2/3 votes, finalise it
 				k.finalizeBlock(ctx, ib, voterBTCPKs)
 			} else {
 				// if not, then this block and all subsequent blocks should not be finalised
 				// thus, we need to break here
+				stalledHeight = ib.Height
 				break
 			}
 		} else if fpSet == nil && !ib.Finalized {
@@ -72,6 +75,9 @@ func (k Keeper) TallyBlocks(ctx context.Context) {
 			panic(fmt.Errorf("block %d is finalized, but does not have a finality provider set", ib.Height))
 		}
 	}
+
+	// record or remove the finality halt depending on how long the finality stalls
+	k.updateFinalityHalt(ctx, stalledHeight)
 }
 
 // finalizeBlock sets a block to be finalised in KVStore and distributes rewards to
@@ -97,19 +103,16 @@ func (k Keeper) finalizeBlock(ctx context.Context, block *types.IndexedBlock, vo
 	k.BTCStakingKeeper.RemoveVotingPowerDistCache(ctx, block.Height)
 	// record the last finalized height metric
 	types.RecordLastFinalizedHeight(block.Height)
-}
-
-// tally checks whether a block with the given finality provider set and votes reaches a quorum or not
-func tally(fpSet map[string]uint64, voterBTCPKs map[string]struct{}) bool {
-	totalPower := uint64(0)
-	votedPower := uint64(0)
-	for pkStr, power := range fpSet {
-		totalPower += power
-		if _, ok := voterBTCPKs[pkStr]; ok {
-			votedPower += power
+	// notify subscribers that the block is finalized
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(types.NewEventBlockFinalized(block)); err != nil {
+		panic(fmt.Errorf("failed to emit block finalized event for height %d: %w", block.Height, err))
+	}
+	// notify other modules that the block is finalized
+	if k.hooks != nil {
+		if err := k.hooks.AfterBlockFinalized(ctx, block.Height); err != nil {
+			panic(fmt.Errorf("failed to execute hooks after finalizing block at height %d: %w", block.Height, err))
 		}
 	}
-	return votedPower*3 > totalPower*2
 }
 
 // setNextHeightToFinalize sets the next height to finalise as the given height
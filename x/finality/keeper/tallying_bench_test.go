@@ -24,7 +24,7 @@ func benchmarkTallyBlocks(b *testing.B, numFPs int) {
 
 	bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
 	iKeeper := types.NewMockIncentiveKeeper(ctrl)
-	fKeeper, ctx := keepertest.FinalityKeeper(b, bsKeeper, iKeeper)
+	fKeeper, ctx := keepertest.FinalityKeeper(b, bsKeeper, iKeeper, nil)
 
 	// activate BTC staking protocol at a random height
 	activatedHeight := uint64(1)
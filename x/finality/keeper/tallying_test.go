@@ -12,6 +12,7 @@ import (
 	"github.com/babylonchain/babylon/x/finality/keeper"
 	"github.com/babylonchain/babylon/x/finality/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -26,7 +27,7 @@ func FuzzTallying_PanicCases(f *testing.F) {
 
 		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
 		iKeeper := types.NewMockIncentiveKeeper(ctrl)
-		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, nil)
 
 		// Case 1: expect to panic if tallying upon BTC staking protocol is not activated
 		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(uint64(0), bstypes.ErrBTCStakingNotActivated).Times(1)
@@ -56,7 +57,7 @@ func FuzzTallying_FinalizingNoBlock(f *testing.F) {
 
 		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
 		iKeeper := types.NewMockIncentiveKeeper(ctrl)
-		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, nil)
 
 		// activate BTC staking protocol at a random height
 		activatedHeight := datagen.RandomInt(r, 10) + 1
@@ -99,7 +100,7 @@ func FuzzTallying_FinalizingSomeBlocks(f *testing.F) {
 		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
 		bsKeeper.EXPECT().GetParams(gomock.Any()).Return(bstypes.Params{MaxActiveFinalityProviders: 100}).AnyTimes()
 		iKeeper := types.NewMockIncentiveKeeper(ctrl)
-		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, nil)
 
 		// activate BTC staking protocol at a random height
 		activatedHeight := datagen.RandomInt(r, 10) + 1
@@ -124,6 +125,14 @@ func FuzzTallying_FinalizingSomeBlocks(f *testing.F) {
 				require.NoError(t, err)
 			}
 		}
+		// hooks are notified about each finalized block in order
+		hooks := types.NewMockFinalityHooks(ctrl)
+		fKeeper.SetHooks(hooks)
+		finalizedCalls := make([]*gomock.Call, 0, numWithQCs)
+		for i := activatedHeight; i < activatedHeight+numWithQCs; i++ {
+			finalizedCalls = append(finalizedCalls, hooks.EXPECT().AfterBlockFinalized(gomock.Any(), i).Return(nil).Times(1))
+		}
+		gomock.InOrder(finalizedCalls...)
 		// we don't test incentive in this function
 		bsKeeper.EXPECT().GetVotingPowerDistCache(gomock.Any(), gomock.Any()).Return(bstypes.NewVotingPowerDistCache(), nil).Times(int(numWithQCs))
 		iKeeper.EXPECT().RewardBTCStaking(gomock.Any(), gomock.Any(), gomock.Any()).Return().Times(int(numWithQCs))
@@ -142,6 +151,18 @@ func FuzzTallying_FinalizingSomeBlocks(f *testing.F) {
 				require.False(t, ib.Finalized)
 			}
 		}
+		// the last finalized block is the last block with QC
+		lastFinalizedBlock, err := fKeeper.GetLastFinalizedBlock(ctx)
+		require.NoError(t, err)
+		require.Equal(t, activatedHeight+numWithQCs-1, lastFinalizedBlock.Height)
+		// an event is emitted for each finalized block
+		numFinalizedEvents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == proto.MessageName(&types.EventBlockFinalized{}) {
+				numFinalizedEvents++
+			}
+		}
+		require.Equal(t, int(numWithQCs), numFinalizedEvents)
 	})
 
 }
@@ -90,6 +90,21 @@ func (k Keeper) GetVoters(ctx context.Context, height uint64) map[string]struct{
 	return voterBTCPKs
 }
 
+// deleteSigs removes all EOTS signatures at a given height
+func (k Keeper) deleteSigs(ctx context.Context, height uint64) {
+	store := k.voteHeightStore(ctx, height)
+	iter := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // voteHeightStore returns the KVStore of the votes
 // prefix: VoteKey
 // key: (block height || finality provider PK)
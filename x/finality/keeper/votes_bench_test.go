@@ -23,7 +23,7 @@ func benchmarkAddFinalitySig(b *testing.B) {
 	defer ctrl.Finish()
 
 	bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
-	fKeeper, ctx := keepertest.FinalityKeeper(b, bsKeeper, nil)
+	fKeeper, ctx := keepertest.FinalityKeeper(b, bsKeeper, nil, nil)
 	ms := keeper.NewMsgServerImpl(*fKeeper)
 
 	// create a random finality provider
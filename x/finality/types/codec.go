@@ -10,7 +10,11 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCommitPubRandList{}, "finality/MsgCommitPubRandList", nil)
 	cdc.RegisterConcrete(&MsgAddFinalitySig{}, "finality/MsgAddFinalitySig", nil)
+	cdc.RegisterConcrete(&MsgAddFinalitySigs{}, "finality/MsgAddFinalitySigs", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "finality/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgUnjailFinalityProvider{}, "finality/MsgUnjailFinalityProvider", nil)
+	cdc.RegisterConcrete(&MsgSubmitFinalityEvidence{}, "finality/MsgSubmitFinalityEvidence", nil)
+	cdc.RegisterConcrete(&MsgSkipStalledHeights{}, "finality/MsgSkipStalledHeights", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -19,7 +23,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCommitPubRandList{},
 		&MsgAddFinalitySig{},
+		&MsgAddFinalitySigs{},
 		&MsgUpdateParams{},
+		&MsgUnjailFinalityProvider{},
+		&MsgSubmitFinalityEvidence{},
+		&MsgSkipStalledHeights{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
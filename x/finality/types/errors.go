@@ -6,15 +6,23 @@ import (
 
 // x/finality module sentinel errors
 var (
-	ErrBlockNotFound         = errorsmod.Register(ModuleName, 1100, "Block is not found")
-	ErrVoteNotFound          = errorsmod.Register(ModuleName, 1101, "vote is not found")
-	ErrHeightTooHigh         = errorsmod.Register(ModuleName, 1102, "the chain has not reached the given height yet")
-	ErrPubRandNotFound       = errorsmod.Register(ModuleName, 1103, "public randomness is not found")
-	ErrPubRandCommitNotFound = errorsmod.Register(ModuleName, 1104, "public randomness commitment is not found")
-	ErrNoPubRandYet          = errorsmod.Register(ModuleName, 1105, "the finality provider has not committed any public randomness yet")
-	ErrTooFewPubRand         = errorsmod.Register(ModuleName, 1106, "the request contains too few public randomness")
-	ErrInvalidPubRand        = errorsmod.Register(ModuleName, 1107, "the public randomness list is invalid")
-	ErrEvidenceNotFound      = errorsmod.Register(ModuleName, 1108, "evidence is not found")
-	ErrInvalidFinalitySig    = errorsmod.Register(ModuleName, 1109, "finality signature is not valid")
-	ErrNoSlashableEvidence   = errorsmod.Register(ModuleName, 1110, "there is no slashable evidence")
+	ErrBlockNotFound           = errorsmod.Register(ModuleName, 1100, "Block is not found")
+	ErrVoteNotFound            = errorsmod.Register(ModuleName, 1101, "vote is not found")
+	ErrHeightTooHigh           = errorsmod.Register(ModuleName, 1102, "the chain has not reached the given height yet")
+	ErrPubRandNotFound         = errorsmod.Register(ModuleName, 1103, "public randomness is not found")
+	ErrPubRandCommitNotFound   = errorsmod.Register(ModuleName, 1104, "public randomness commitment is not found")
+	ErrNoPubRandYet            = errorsmod.Register(ModuleName, 1105, "the finality provider has not committed any public randomness yet")
+	ErrTooFewPubRand           = errorsmod.Register(ModuleName, 1106, "the request contains too few public randomness")
+	ErrInvalidPubRand          = errorsmod.Register(ModuleName, 1107, "the public randomness list is invalid")
+	ErrEvidenceNotFound        = errorsmod.Register(ModuleName, 1108, "evidence is not found")
+	ErrInvalidFinalitySig      = errorsmod.Register(ModuleName, 1109, "finality signature is not valid")
+	ErrNoSlashableEvidence     = errorsmod.Register(ModuleName, 1110, "there is no slashable evidence")
+	ErrJailingPeriodNotPassed  = errorsmod.Register(ModuleName, 1111, "the jailing period is not passed")
+	ErrInvalidFinalityEvidence = errorsmod.Register(ModuleName, 1112, "the finality evidence is not valid")
+	ErrInvalidFinalityProof    = errorsmod.Register(ModuleName, 1113, "the finality proof is not valid")
+	ErrBlockNotFinalized       = errorsmod.Register(ModuleName, 1114, "the block is not finalized yet")
+	ErrHeightPruned            = errorsmod.Register(ModuleName, 1115, "the data at the height has been pruned")
+	ErrFinalityNotHalted       = errorsmod.Register(ModuleName, 1116, "the finality is not halted")
+	ErrInvalidSkipHeight       = errorsmod.Register(ModuleName, 1117, "the height to skip is not valid")
+	ErrInvalidSigner           = errorsmod.Register(ModuleName, 1118, "the signer is not authorized")
 )
@@ -1,6 +1,10 @@
 package types
 
-import "github.com/babylonchain/babylon/types"
+import (
+	"encoding/hex"
+
+	"github.com/babylonchain/babylon/types"
+)
 
 func NewEventSlashedFinalityProvider(evidence *Evidence) *EventSlashedFinalityProvider {
 	return &EventSlashedFinalityProvider{
@@ -15,3 +19,40 @@ func NewEventSluggishFinalityProviderDetected(fpPk *types.BIP340PubKey) *EventSl
 func NewEventSluggishFinalityProviderReverted(fpPk *types.BIP340PubKey) *EventSluggishFinalityProviderReverted {
 	return &EventSluggishFinalityProviderReverted{PublicKey: fpPk.MarshalHex()}
 }
+
+func NewEventJailedFinalityProvider(fpPk *types.BIP340PubKey) *EventJailedFinalityProvider {
+	return &EventJailedFinalityProvider{PublicKey: fpPk.MarshalHex()}
+}
+
+func NewEventUnjailedFinalityProvider(fpPk *types.BIP340PubKey) *EventUnjailedFinalityProvider {
+	return &EventUnjailedFinalityProvider{PublicKey: fpPk.MarshalHex()}
+}
+
+func NewEventBlockFinalized(block *IndexedBlock) *EventBlockFinalized {
+	return &EventBlockFinalized{
+		Height:    block.Height,
+		AppHash:   hex.EncodeToString(block.AppHash),
+		BlockHash: hex.EncodeToString(block.BlockHash),
+	}
+}
+
+func NewEventFinalityHalted(halt *FinalityHalt) *EventFinalityHalted {
+	return &EventFinalityHalted{
+		StalledHeight:  halt.StalledHeight,
+		HaltedAtHeight: halt.HaltedAtHeight,
+	}
+}
+
+func NewEventFinalityResumed(halt *FinalityHalt, resumedAtHeight uint64) *EventFinalityResumed {
+	return &EventFinalityResumed{
+		StalledHeight:   halt.StalledHeight,
+		ResumedAtHeight: resumedAtHeight,
+	}
+}
+
+func NewEventStalledHeightsSkipped(startHeight uint64, endHeight uint64) *EventStalledHeightsSkipped {
+	return &EventStalledHeightsSkipped{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
@@ -3,8 +3,12 @@ package types
 import (
 	"context"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	bbn "github.com/babylonchain/babylon/types"
 	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 )
 
 type BTCStakingKeeper interface {
@@ -12,24 +16,38 @@ type BTCStakingKeeper interface {
 	GetFinalityProvider(ctx context.Context, fpBTCPK []byte) (*bstypes.FinalityProvider, error)
 	HasFinalityProvider(ctx context.Context, fpBTCPK []byte) bool
 	SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
+	RecordSignedSlashingTxs(ctx context.Context, fpSK *btcec.PrivateKey) error
 	GetVotingPower(ctx context.Context, fpBTCPK []byte, height uint64) uint64
 	GetVotingPowerTable(ctx context.Context, height uint64) map[string]uint64
 	GetBTCStakingActivatedHeight(ctx context.Context) (uint64, error)
 	GetVotingPowerDistCache(ctx context.Context, height uint64) (*bstypes.VotingPowerDistCache, error)
 	RemoveVotingPowerDistCache(ctx context.Context, height uint64)
 	GetLastFinalizedEpoch(ctx context.Context) uint64
-	RevertSluggishFinalityProvider(ctx context.Context, fpBTCPK []byte) error
+	JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
+	UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 }
 
 // IncentiveKeeper defines the expected interface needed to distribute rewards.
 type IncentiveKeeper interface {
 	RewardBTCStaking(ctx context.Context, height uint64, filteredDc *bstypes.VotingPowerDistCache)
+	RewardFinalityEvidenceReporter(ctx context.Context, reporter sdk.AccAddress)
+}
+
+// EpochingKeeper defines the expected interface needed to retrieve the current and historical epochs.
+type EpochingKeeper interface {
+	GetEpoch(ctx context.Context) *epochingtypes.Epoch
+	GetHistoricalEpoch(ctx context.Context, epochNumber uint64) (*epochingtypes.Epoch, error)
 }
 
 type BtcStakingHooks interface {
 	AfterFinalityProviderActivated(ctx context.Context, btcPk *bbn.BIP340PubKey) error
+	AfterBTCDelegationActivated(ctx context.Context, btcDel *bstypes.BTCDelegation) error
+	AfterBTCDelegationUnbonded(ctx context.Context, btcDel *bstypes.BTCDelegation) error
+	AfterBTCDelegationExpired(ctx context.Context, btcDel *bstypes.BTCDelegation) error
+	AfterBTCDelegationSlashed(ctx context.Context, fpPk *bbn.BIP340PubKey, btcDel *bstypes.BTCDelegation) error
 }
 
 type FinalityHooks interface {
 	AfterSluggishFinalityProviderDetected(ctx context.Context, btcPk *bbn.BIP340PubKey) error
+	AfterBlockFinalized(ctx context.Context, height uint64) error
 }
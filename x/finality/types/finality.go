@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/babylonchain/babylon/crypto/eots"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -60,11 +61,15 @@ func (ib *IndexedBlock) MsgToSign() []byte {
 	return msgToSignForVote(ib.Height, ib.AppHash)
 }
 
-func (e *Evidence) canonicalMsgToSign() []byte {
+// CanonicalMsgToSign returns the message signed by the finality signature
+// over the canonical block
+func (e *Evidence) CanonicalMsgToSign() []byte {
 	return msgToSignForVote(e.BlockHeight, e.CanonicalAppHash)
 }
 
-func (e *Evidence) forkMsgToSign() []byte {
+// ForkMsgToSign returns the message signed by the finality signature over
+// the fork block
+func (e *Evidence) ForkMsgToSign() []byte {
 	return msgToSignForVote(e.BlockHeight, e.ForkAppHash)
 }
 
@@ -108,7 +113,40 @@ func (e *Evidence) ExtractBTCSK() (*btcec.PrivateKey, error) {
 	}
 	return eots.Extract(
 		btcPK, e.PubRand.ToFieldVal(),
-		e.canonicalMsgToSign(), e.CanonicalFinalitySig.ToModNScalar(), // msg and sig for canonical block
-		e.forkMsgToSign(), e.ForkFinalitySig.ToModNScalar(), // msg and sig for fork block
+		e.CanonicalMsgToSign(), e.CanonicalFinalitySig.ToModNScalar(), // msg and sig for canonical block
+		e.ForkMsgToSign(), e.ForkFinalitySig.ToModNScalar(), // msg and sig for fork block
 	)
 }
+
+// VerifyAndExtractBTCSK verifies the two finality signatures in the evidence
+// over the recomputed messages of the canonical and fork blocks, extracts the
+// BTC SK from them, and checks that the BTC SK corresponds to FpBtcPk. Unlike
+// ExtractBTCSK, it does not rely on the evidence having been verified, e.g.,
+// for an evidence obtained off-chain.
+func (e *Evidence) VerifyAndExtractBTCSK() (*btcec.PrivateKey, error) {
+	if !e.IsSlashable() {
+		return nil, fmt.Errorf("the evidence lacks some fields so does not allow extracting BTC SK")
+	}
+	if bytes.Equal(e.CanonicalAppHash, e.ForkAppHash) {
+		return nil, fmt.Errorf("the two blocks in the evidence are not conflicting")
+	}
+	btcPK, err := e.FpBtcPk.ToBTCPK()
+	if err != nil {
+		return nil, err
+	}
+	if err := eots.Verify(btcPK, e.PubRand.ToFieldVal(), e.CanonicalMsgToSign(), e.CanonicalFinalitySig.ToModNScalar()); err != nil {
+		return nil, fmt.Errorf("invalid finality signature over the canonical block: %w", err)
+	}
+	if err := eots.Verify(btcPK, e.PubRand.ToFieldVal(), e.ForkMsgToSign(), e.ForkFinalitySig.ToModNScalar()); err != nil {
+		return nil, fmt.Errorf("invalid finality signature over the fork block: %w", err)
+	}
+
+	btcSK, err := e.ExtractBTCSK()
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract BTC SK: %w", err)
+	}
+	if !bbn.NewBIP340PubKeyFromBTCPK(btcSK.PubKey()).Equals(e.FpBtcPk) {
+		return nil, fmt.Errorf("the extracted BTC SK does not correspond to the finality provider's BTC PK %s", e.FpBtcPk.MarshalHex())
+	}
+	return btcSK, nil
+}
@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +38,9 @@ type IndexedBlock struct {
 	// finalized indicates whether the IndexedBlock is finalised by 2/3
 	// finality providers or not
 	Finalized bool `protobuf:"varint,3,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// block_hash is the hash of the CometBFT block, which is empty for blocks
+	// indexed before it is recorded
+	BlockHash []byte `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (m *IndexedBlock) Reset()         { *m = IndexedBlock{} }
@@ -89,6 +97,13 @@ func (m *IndexedBlock) GetFinalized() bool {
 	return false
 }
 
+func (m *IndexedBlock) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
 // PubRandCommit is a commitment to a series of public randomness
 // currently, the commitment is a root of a Merkle tree that includes
 // a series of public randomness
@@ -243,6 +258,9 @@ type FinalityProviderSigningInfo struct {
 	// missed_blocks_counter defines a counter to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// jailed_until is the timestamp until which the finality provider is jailed
+	// due to being sluggish
+	JailedUntil time.Time `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
 }
 
 func (m *FinalityProviderSigningInfo) Reset()         { *m = FinalityProviderSigningInfo{} }
@@ -292,11 +310,433 @@ func (m *FinalityProviderSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *FinalityProviderSigningInfo) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+// FinalityProviderStats is the cumulative performance statistics of a
+// finality provider since it has voting power
+type FinalityProviderStats struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// expected_votes is the number of blocks that the finality provider is
+	// expected to vote, i.e., blocks at which it has voting power
+	ExpectedVotes uint64 `protobuf:"varint,2,opt,name=expected_votes,json=expectedVotes,proto3" json:"expected_votes,omitempty"`
+	// voted_blocks is the number of blocks in expected_votes that the finality
+	// provider has voted
+	VotedBlocks uint64 `protobuf:"varint,3,opt,name=voted_blocks,json=votedBlocks,proto3" json:"voted_blocks,omitempty"`
+	// first_voted_height is the height of the first block that the finality
+	// provider has voted
+	FirstVotedHeight uint64 `protobuf:"varint,4,opt,name=first_voted_height,json=firstVotedHeight,proto3" json:"first_voted_height,omitempty"`
+	// last_voted_height is the height of the last block that the finality
+	// provider has voted
+	LastVotedHeight uint64 `protobuf:"varint,5,opt,name=last_voted_height,json=lastVotedHeight,proto3" json:"last_voted_height,omitempty"`
+	// sluggish_count is the number of times that the finality provider has
+	// been detected sluggish
+	SluggishCount uint64 `protobuf:"varint,6,opt,name=sluggish_count,json=sluggishCount,proto3" json:"sluggish_count,omitempty"`
+	// evidence_count is the number of heights at which the finality provider
+	// has equivocation evidences
+	EvidenceCount uint64 `protobuf:"varint,7,opt,name=evidence_count,json=evidenceCount,proto3" json:"evidence_count,omitempty"`
+}
+
+func (m *FinalityProviderStats) Reset()         { *m = FinalityProviderStats{} }
+func (m *FinalityProviderStats) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderStats) ProtoMessage()    {}
+func (*FinalityProviderStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{4}
+}
+func (m *FinalityProviderStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderStats.Merge(m, src)
+}
+func (m *FinalityProviderStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderStats proto.InternalMessageInfo
+
+func (m *FinalityProviderStats) GetExpectedVotes() uint64 {
+	if m != nil {
+		return m.ExpectedVotes
+	}
+	return 0
+}
+
+func (m *FinalityProviderStats) GetVotedBlocks() uint64 {
+	if m != nil {
+		return m.VotedBlocks
+	}
+	return 0
+}
+
+func (m *FinalityProviderStats) GetFirstVotedHeight() uint64 {
+	if m != nil {
+		return m.FirstVotedHeight
+	}
+	return 0
+}
+
+func (m *FinalityProviderStats) GetLastVotedHeight() uint64 {
+	if m != nil {
+		return m.LastVotedHeight
+	}
+	return 0
+}
+
+func (m *FinalityProviderStats) GetSluggishCount() uint64 {
+	if m != nil {
+		return m.SluggishCount
+	}
+	return 0
+}
+
+func (m *FinalityProviderStats) GetEvidenceCount() uint64 {
+	if m != nil {
+		return m.EvidenceCount
+	}
+	return 0
+}
+
+// FinalityProviderEpochStats is the performance statistics of a finality
+// provider in an epoch
+type FinalityProviderEpochStats struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// epoch_number is the number of the epoch
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// expected_votes is the number of blocks evaluated in this epoch that the
+	// finality provider is expected to vote
+	ExpectedVotes uint64 `protobuf:"varint,3,opt,name=expected_votes,json=expectedVotes,proto3" json:"expected_votes,omitempty"`
+	// voted_blocks is the number of blocks in expected_votes that the finality
+	// provider has voted
+	VotedBlocks uint64 `protobuf:"varint,4,opt,name=voted_blocks,json=votedBlocks,proto3" json:"voted_blocks,omitempty"`
+}
+
+func (m *FinalityProviderEpochStats) Reset()         { *m = FinalityProviderEpochStats{} }
+func (m *FinalityProviderEpochStats) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderEpochStats) ProtoMessage()    {}
+func (*FinalityProviderEpochStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{5}
+}
+func (m *FinalityProviderEpochStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderEpochStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderEpochStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderEpochStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderEpochStats.Merge(m, src)
+}
+func (m *FinalityProviderEpochStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderEpochStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderEpochStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderEpochStats proto.InternalMessageInfo
+
+func (m *FinalityProviderEpochStats) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *FinalityProviderEpochStats) GetExpectedVotes() uint64 {
+	if m != nil {
+		return m.ExpectedVotes
+	}
+	return 0
+}
+
+func (m *FinalityProviderEpochStats) GetVotedBlocks() uint64 {
+	if m != nil {
+		return m.VotedBlocks
+	}
+	return 0
+}
+
+// FinalityProof is the proof that a Babylon block is BTC-finalized, which
+// allows light clients to verify the finalization of the block against an
+// AppHash of Babylon without replaying the state. All store proofs are
+// against the AppHash resulting from executing the block at proof_height,
+// i.e., the AppHash in the header of the block at proof_height + 1.
+type FinalityProof struct {
+	// block is the indexed block
+	Block *IndexedBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// proof_block is the proof that the indexed block is in the finality store
+	ProofBlock *crypto.ProofOps `protobuf:"bytes,2,opt,name=proof_block,json=proofBlock,proto3" json:"proof_block,omitempty"`
+	// entries is the voting power table at the block's height, together
+	// with the finality vote of each finality provider, if any
+	Entries []*FinalityProofEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	// proof_height is the height of the state which the store proofs are
+	// generated against
+	ProofHeight uint64 `protobuf:"varint,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty"`
+}
+
+func (m *FinalityProof) Reset()         { *m = FinalityProof{} }
+func (m *FinalityProof) String() string { return proto.CompactTextString(m) }
+func (*FinalityProof) ProtoMessage()    {}
+func (*FinalityProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{6}
+}
+func (m *FinalityProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProof.Merge(m, src)
+}
+func (m *FinalityProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProof proto.InternalMessageInfo
+
+func (m *FinalityProof) GetBlock() *IndexedBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *FinalityProof) GetProofBlock() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofBlock
+	}
+	return nil
+}
+
+func (m *FinalityProof) GetEntries() []*FinalityProofEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *FinalityProof) GetProofHeight() uint64 {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return 0
+}
+
+// FinalityProofEntry is the voting power of a finality provider at a height
+// together with its finality vote, if any, and the corresponding proofs
+type FinalityProofEntry struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// voting_power is the voting power of the finality provider at the height
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// proof_voting_power is the proof that the voting power is in the
+	// voting power table of the btcstaking store
+	ProofVotingPower *crypto.ProofOps `protobuf:"bytes,3,opt,name=proof_voting_power,json=proofVotingPower,proto3" json:"proof_voting_power,omitempty"`
+	// finality_sig is the finality signature of the finality provider on
+	// the block, or empty if the finality provider has not voted
+	FinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,4,opt,name=finality_sig,json=finalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"finality_sig,omitempty"`
+	// proof_finality_sig is the proof that the finality signature is in the
+	// finality store
+	ProofFinalitySig *crypto.ProofOps `protobuf:"bytes,5,opt,name=proof_finality_sig,json=proofFinalitySig,proto3" json:"proof_finality_sig,omitempty"`
+	// pub_rand is the public randomness used for the finality signature
+	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,6,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// proof_pub_rand is the Merkle proof that the public randomness is
+	// committed under the public randomness commitment
+	ProofPubRand *crypto.Proof `protobuf:"bytes,7,opt,name=proof_pub_rand,json=proofPubRand,proto3" json:"proof_pub_rand,omitempty"`
+	// pub_rand_commit is the public randomness commitment that includes the
+	// public randomness
+	PubRandCommit *PubRandCommit `protobuf:"bytes,8,opt,name=pub_rand_commit,json=pubRandCommit,proto3" json:"pub_rand_commit,omitempty"`
+	// proof_pub_rand_commit is the proof that the public randomness
+	// commitment is in the finality store
+	ProofPubRandCommit *crypto.ProofOps `protobuf:"bytes,9,opt,name=proof_pub_rand_commit,json=proofPubRandCommit,proto3" json:"proof_pub_rand_commit,omitempty"`
+}
+
+func (m *FinalityProofEntry) Reset()         { *m = FinalityProofEntry{} }
+func (m *FinalityProofEntry) String() string { return proto.CompactTextString(m) }
+func (*FinalityProofEntry) ProtoMessage()    {}
+func (*FinalityProofEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{7}
+}
+func (m *FinalityProofEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProofEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProofEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProofEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProofEntry.Merge(m, src)
+}
+func (m *FinalityProofEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProofEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProofEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProofEntry proto.InternalMessageInfo
+
+func (m *FinalityProofEntry) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *FinalityProofEntry) GetProofVotingPower() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofVotingPower
+	}
+	return nil
+}
+
+func (m *FinalityProofEntry) GetProofFinalitySig() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofFinalitySig
+	}
+	return nil
+}
+
+func (m *FinalityProofEntry) GetProofPubRand() *crypto.Proof {
+	if m != nil {
+		return m.ProofPubRand
+	}
+	return nil
+}
+
+func (m *FinalityProofEntry) GetPubRandCommit() *PubRandCommit {
+	if m != nil {
+		return m.PubRandCommit
+	}
+	return nil
+}
+
+func (m *FinalityProofEntry) GetProofPubRandCommit() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofPubRandCommit
+	}
+	return nil
+}
+
+// FinalityHalt records that the finality is halted, i.e., a block with active
+// finality providers has not received votes of more than 2/3 voting power for
+// too many blocks.
+type FinalityHalt struct {
+	// stalled_height is the height of the earliest non-finalized block that
+	// the finality stalls at
+	StalledHeight uint64 `protobuf:"varint,1,opt,name=stalled_height,json=stalledHeight,proto3" json:"stalled_height,omitempty"`
+	// halted_at_height is the height at which the halt was detected
+	HaltedAtHeight uint64 `protobuf:"varint,2,opt,name=halted_at_height,json=haltedAtHeight,proto3" json:"halted_at_height,omitempty"`
+}
+
+func (m *FinalityHalt) Reset()         { *m = FinalityHalt{} }
+func (m *FinalityHalt) String() string { return proto.CompactTextString(m) }
+func (*FinalityHalt) ProtoMessage()    {}
+func (*FinalityHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{8}
+}
+func (m *FinalityHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityHalt.Merge(m, src)
+}
+func (m *FinalityHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityHalt proto.InternalMessageInfo
+
+func (m *FinalityHalt) GetStalledHeight() uint64 {
+	if m != nil {
+		return m.StalledHeight
+	}
+	return 0
+}
+
+func (m *FinalityHalt) GetHaltedAtHeight() uint64 {
+	if m != nil {
+		return m.HaltedAtHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IndexedBlock)(nil), "babylon.finality.v1.IndexedBlock")
 	proto.RegisterType((*PubRandCommit)(nil), "babylon.finality.v1.PubRandCommit")
 	proto.RegisterType((*Evidence)(nil), "babylon.finality.v1.Evidence")
 	proto.RegisterType((*FinalityProviderSigningInfo)(nil), "babylon.finality.v1.FinalityProviderSigningInfo")
+	proto.RegisterType((*FinalityProviderStats)(nil), "babylon.finality.v1.FinalityProviderStats")
+	proto.RegisterType((*FinalityProviderEpochStats)(nil), "babylon.finality.v1.FinalityProviderEpochStats")
+	proto.RegisterType((*FinalityProof)(nil), "babylon.finality.v1.FinalityProof")
+	proto.RegisterType((*FinalityProofEntry)(nil), "babylon.finality.v1.FinalityProofEntry")
+	proto.RegisterType((*FinalityHalt)(nil), "babylon.finality.v1.FinalityHalt")
 }
 
 func init() {
@@ -304,41 +744,73 @@ func init() {
 }
 
 var fileDescriptor_ca5b87e52e3e6d02 = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0x6e, 0x68, 0x69, 0x3b, 0x37, 0x13, 0x90, 0x8d, 0xa9, 0x7c, 0x28, 0x2b, 0x39, 0xf5, 0x80,
-	0xda, 0x7d, 0x09, 0x71, 0x25, 0xd3, 0xd0, 0x0a, 0x07, 0x2a, 0x87, 0x13, 0x17, 0xcb, 0x71, 0xdc,
-	0xc4, 0x6a, 0x63, 0x5b, 0x89, 0x53, 0xad, 0xfc, 0x0a, 0x7e, 0xd6, 0x8e, 0x3b, 0xa2, 0x1d, 0x26,
-	0xd4, 0xfe, 0x0f, 0x84, 0xe2, 0xa4, 0xe9, 0x7a, 0x02, 0x81, 0xb8, 0xc5, 0xef, 0xfb, 0xea, 0xf9,
-	0x78, 0xfd, 0x38, 0xc0, 0xf1, 0xb1, 0xbf, 0x98, 0x09, 0x3e, 0x9c, 0x30, 0x8e, 0x67, 0x4c, 0x2d,
-	0x86, 0xf3, 0xe3, 0xea, 0x7b, 0x20, 0x13, 0xa1, 0x84, 0xb5, 0x57, 0xce, 0x0c, 0xaa, 0xfa, 0xfc,
-	0xf8, 0xf9, 0x7e, 0x28, 0x42, 0xa1, 0xfb, 0xc3, 0xfc, 0xab, 0x18, 0x75, 0x10, 0x30, 0x47, 0x3c,
-	0xa0, 0x57, 0x34, 0x70, 0x67, 0x82, 0x4c, 0xad, 0x03, 0xd0, 0x8c, 0x28, 0x0b, 0x23, 0xd5, 0x35,
-	0x7a, 0x46, 0xbf, 0x01, 0xcb, 0x93, 0xf5, 0x0c, 0xb4, 0xb1, 0x94, 0x28, 0xc2, 0x69, 0xd4, 0x7d,
-	0xd0, 0x33, 0xfa, 0x26, 0x6c, 0x61, 0x29, 0x2f, 0x71, 0x1a, 0x59, 0x2f, 0xc1, 0x4e, 0xc1, 0xf3,
-	0x95, 0x06, 0xdd, 0x7a, 0xcf, 0xe8, 0xb7, 0xe1, 0xa6, 0xe0, 0x28, 0xb0, 0x3b, 0xce, 0x7c, 0x88,
-	0x79, 0x70, 0x2e, 0xe2, 0x98, 0x29, 0xeb, 0x15, 0x30, 0x53, 0x85, 0x13, 0x85, 0xb6, 0x78, 0x3a,
-	0xba, 0x76, 0x59, 0x90, 0xf5, 0x80, 0xc9, 0xb3, 0x18, 0xc9, 0xcc, 0x47, 0x09, 0xe6, 0x81, 0x26,
-	0x6c, 0x40, 0xc0, 0xb3, 0xb8, 0x84, 0xb2, 0x6c, 0x00, 0x88, 0x86, 0x8b, 0x29, 0x57, 0x9a, 0xd4,
-	0x84, 0xf7, 0x2a, 0xce, 0xcf, 0x3a, 0x68, 0x5f, 0xcc, 0x59, 0x40, 0x39, 0xa1, 0x16, 0x04, 0x3b,
-	0x13, 0x89, 0x7c, 0x45, 0x90, 0x9c, 0x6a, 0x3a, 0xd3, 0x7d, 0x73, 0x7b, 0x77, 0x78, 0x12, 0x32,
-	0x15, 0x65, 0xfe, 0x80, 0x88, 0x78, 0x58, 0x2e, 0x8c, 0x44, 0x98, 0xf1, 0xf5, 0x61, 0xa8, 0x16,
-	0x92, 0xa6, 0x03, 0x77, 0x34, 0x3e, 0x3d, 0x3b, 0x1a, 0x67, 0xfe, 0x47, 0xba, 0x80, 0xad, 0x89,
-	0x74, 0x15, 0x19, 0x4f, 0x73, 0x17, 0x7e, 0xbe, 0xb0, 0xb5, 0x8b, 0x42, 0x62, 0x47, 0xd7, 0x4a,
-	0x17, 0x1e, 0x68, 0x57, 0x0e, 0xb4, 0x42, 0xf7, 0xed, 0xed, 0xdd, 0xe1, 0xd9, 0x9f, 0xb1, 0x7a,
-	0x24, 0xe2, 0x22, 0x49, 0x4a, 0xbf, 0xb0, 0x25, 0x4b, 0xe3, 0xaf, 0x81, 0x45, 0x30, 0x17, 0x9c,
-	0x11, 0x3c, 0x43, 0xd5, 0x8d, 0x34, 0xf4, 0x02, 0x1e, 0x57, 0x9d, 0x77, 0xe5, 0xd5, 0x38, 0x60,
-	0x77, 0x22, 0x92, 0xe9, 0x66, 0xf0, 0xa1, 0x1e, 0xec, 0xe4, 0xc5, 0xf5, 0x0c, 0x07, 0x07, 0x1b,
-	0xc4, 0x75, 0x60, 0x50, 0xca, 0xc2, 0x6e, 0xf3, 0x2f, 0x45, 0x5f, 0x7c, 0xfa, 0xec, 0x79, 0x2c,
-	0x84, 0xfb, 0x15, 0xee, 0xfb, 0x12, 0xd6, 0x63, 0xa1, 0x15, 0x80, 0x27, 0x5a, 0xd3, 0x16, 0x55,
-	0xeb, 0x1f, 0xa9, 0x1e, 0xe5, 0x90, 0xf7, 0x58, 0x9c, 0x6b, 0x03, 0xbc, 0x58, 0x9f, 0xc7, 0x89,
-	0xc8, 0xa3, 0x90, 0x78, 0x2c, 0xe4, 0x8c, 0x87, 0x23, 0x3e, 0x11, 0xff, 0x2b, 0x13, 0x5b, 0xc9,
-	0xce, 0x33, 0x51, 0xdf, 0x4e, 0xf6, 0x09, 0x78, 0x1a, 0xb3, 0x34, 0xa5, 0x01, 0xd2, 0x49, 0x49,
-	0x11, 0x11, 0x19, 0x57, 0x34, 0xd1, 0x01, 0xa9, 0xc3, 0xbd, 0xa2, 0xa9, 0x9f, 0x62, 0x7a, 0x5e,
-	0xb4, 0xdc, 0x0f, 0xd7, 0x4b, 0xdb, 0xb8, 0x59, 0xda, 0xc6, 0x8f, 0xa5, 0x6d, 0x7c, 0x5b, 0xd9,
-	0xb5, 0x9b, 0x95, 0x5d, 0xfb, 0xbe, 0xb2, 0x6b, 0x5f, 0x8e, 0x7e, 0xa7, 0xf6, 0x6a, 0xf3, 0x97,
-	0xd0, 0xc2, 0xfd, 0xa6, 0x7e, 0xf5, 0xa7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x32, 0x14,
-	0xff, 0x46, 0x04, 0x00, 0x00,
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x2d, 0xd9, 0x92, 0x87, 0x92, 0xed, 0x30, 0x71, 0xa0, 0x3a, 0x8d, 0x6c, 0x13, 0x08,
+	0x6a, 0x14, 0x05, 0x95, 0x28, 0x41, 0xdb, 0x43, 0x51, 0xc0, 0x0a, 0xdc, 0xda, 0x29, 0x90, 0x08,
+	0x54, 0x9a, 0x43, 0x7b, 0x20, 0xf8, 0xb3, 0x22, 0xb7, 0x26, 0x77, 0x09, 0x72, 0xa9, 0x5a, 0x3d,
+	0xf4, 0x19, 0xd2, 0x27, 0xe9, 0x6b, 0xe4, 0x98, 0x63, 0x11, 0x20, 0x6e, 0x61, 0x5f, 0xfb, 0x0c,
+	0x45, 0xc1, 0xdd, 0xa5, 0x44, 0xc6, 0x2e, 0x6c, 0x34, 0xf1, 0x4d, 0xfc, 0xf6, 0xdb, 0x99, 0x6f,
+	0x7e, 0x34, 0xb3, 0xa0, 0x3b, 0xb6, 0x33, 0x0d, 0x29, 0xe9, 0x8d, 0x31, 0xb1, 0x43, 0xcc, 0xa6,
+	0xbd, 0xc9, 0x83, 0xd9, 0x6f, 0x23, 0x4e, 0x28, 0xa3, 0xda, 0x4d, 0xc9, 0x31, 0x66, 0xf8, 0xe4,
+	0xc1, 0xe6, 0x2d, 0x9f, 0xfa, 0x94, 0x9f, 0xf7, 0xf2, 0x5f, 0x82, 0xba, 0xb9, 0xe5, 0x53, 0xea,
+	0x87, 0xa8, 0xc7, 0xbf, 0x9c, 0x6c, 0xdc, 0x63, 0x38, 0x42, 0x29, 0xb3, 0xa3, 0x58, 0x12, 0xee,
+	0x32, 0x44, 0x3c, 0x94, 0x44, 0x98, 0xb0, 0x9e, 0x9b, 0x4c, 0x63, 0x46, 0x73, 0x2e, 0x1d, 0x8b,
+	0x63, 0xfd, 0x57, 0x68, 0x1d, 0x12, 0x0f, 0x1d, 0x23, 0x6f, 0x10, 0x52, 0xf7, 0x48, 0xbb, 0x0d,
+	0xcb, 0x01, 0xc2, 0x7e, 0xc0, 0x3a, 0xca, 0xb6, 0xb2, 0x5b, 0x37, 0xe5, 0x97, 0xf6, 0x11, 0x34,
+	0xed, 0x38, 0xb6, 0x02, 0x3b, 0x0d, 0x3a, 0x8b, 0xdb, 0xca, 0x6e, 0xcb, 0x6c, 0xd8, 0x71, 0x7c,
+	0x60, 0xa7, 0x81, 0xf6, 0x31, 0xac, 0x08, 0x9d, 0xbf, 0x20, 0xaf, 0x53, 0xdb, 0x56, 0x76, 0x9b,
+	0xe6, 0x1c, 0xd0, 0xee, 0x02, 0x38, 0xb9, 0x65, 0x71, 0xb5, 0xce, 0xaf, 0xae, 0x70, 0x24, 0xbf,
+	0xac, 0x33, 0x68, 0x0f, 0x33, 0xc7, 0xb4, 0x89, 0xf7, 0x98, 0x46, 0x11, 0x66, 0xda, 0x0e, 0xb4,
+	0x52, 0x66, 0x27, 0xcc, 0xaa, 0xc8, 0x50, 0x39, 0x76, 0x20, 0xb4, 0x6c, 0x43, 0x8b, 0x64, 0x91,
+	0x15, 0x67, 0x8e, 0x95, 0xd8, 0xc4, 0xe3, 0x7a, 0xea, 0x26, 0x90, 0x2c, 0x92, 0xa6, 0xb4, 0x2e,
+	0x80, 0xcb, 0xcd, 0x45, 0x88, 0x30, 0xae, 0xa9, 0x65, 0x96, 0x10, 0xfd, 0x9f, 0x1a, 0x34, 0xf7,
+	0x27, 0xd8, 0x43, 0xc4, 0x45, 0x9a, 0x09, 0x2b, 0xe3, 0xd8, 0x72, 0x98, 0x6b, 0xc5, 0x47, 0xdc,
+	0x5d, 0x6b, 0xf0, 0xf9, 0x9b, 0x93, 0xad, 0xbe, 0x8f, 0x59, 0x90, 0x39, 0x86, 0x4b, 0xa3, 0x9e,
+	0xac, 0x87, 0x1b, 0xd8, 0x98, 0x14, 0x1f, 0x3d, 0x36, 0x8d, 0x51, 0x6a, 0x0c, 0x0e, 0x87, 0x0f,
+	0x1f, 0xdd, 0x1f, 0x66, 0xce, 0x77, 0x68, 0x6a, 0x36, 0xc6, 0xf1, 0x80, 0xb9, 0xc3, 0xa3, 0x3c,
+	0x0a, 0x19, 0xb5, 0x88, 0x42, 0x48, 0x54, 0x45, 0xdc, 0x22, 0x8a, 0x11, 0x34, 0x67, 0x11, 0x70,
+	0x85, 0x83, 0x2f, 0xdf, 0x9c, 0x6c, 0x3d, 0xba, 0x9a, 0xd7, 0x91, 0x1b, 0x10, 0x9a, 0x24, 0x32,
+	0x5e, 0xb3, 0x11, 0xcb, 0xc0, 0x3f, 0x03, 0xcd, 0xb5, 0x09, 0x25, 0xd8, 0xb5, 0x43, 0x6b, 0x56,
+	0x30, 0x91, 0xf5, 0xf5, 0xd9, 0xc9, 0x9e, 0xac, 0x9c, 0x0e, 0xed, 0x31, 0x4d, 0x8e, 0xe6, 0xc4,
+	0x25, 0x4e, 0x54, 0x73, 0xb0, 0xe0, 0x10, 0xb8, 0x3d, 0xb7, 0x58, 0xf4, 0xa3, 0x95, 0x62, 0xbf,
+	0xb3, 0xfc, 0x3f, 0x45, 0xef, 0x3f, 0x7b, 0x3e, 0x1a, 0x61, 0xdf, 0xbc, 0x35, 0xb3, 0xfb, 0x8d,
+	0x34, 0x3b, 0xc2, 0xbe, 0xe6, 0xc1, 0x0d, 0xae, 0xa9, 0xe2, 0xaa, 0xf1, 0x9e, 0xae, 0xd6, 0x72,
+	0x93, 0x25, 0x2f, 0xfa, 0x6f, 0x8b, 0x70, 0xa7, 0xf8, 0x1e, 0x26, 0x34, 0x6f, 0x85, 0x64, 0x84,
+	0x7d, 0x82, 0x89, 0x7f, 0x48, 0xc6, 0xf4, 0xba, 0x7a, 0xa2, 0xd2, 0xd9, 0x79, 0x4f, 0xd4, 0xaa,
+	0x9d, 0xdd, 0x87, 0x8d, 0x08, 0xa7, 0x29, 0xf2, 0x2c, 0xde, 0x29, 0xa9, 0xe5, 0xd2, 0x8c, 0x30,
+	0x94, 0xf0, 0x06, 0xa9, 0x99, 0x37, 0xc5, 0x21, 0xff, 0xa7, 0xa6, 0x8f, 0xc5, 0x91, 0xf6, 0x2d,
+	0xb4, 0x7e, 0xb2, 0x71, 0x88, 0x3c, 0x2b, 0x23, 0x0c, 0x87, 0xbc, 0xd8, 0x6a, 0x7f, 0xd3, 0x10,
+	0x83, 0xc1, 0x28, 0x06, 0x83, 0xf1, 0xbc, 0x18, 0x0c, 0x83, 0xe6, 0xab, 0x93, 0xad, 0x85, 0x97,
+	0x7f, 0x6e, 0x29, 0xa6, 0x2a, 0x6e, 0x7e, 0x9f, 0x5f, 0xd4, 0xdf, 0x2e, 0xc2, 0xc6, 0xb9, 0x9c,
+	0x30, 0x9b, 0xa5, 0xd7, 0x92, 0x8d, 0x7b, 0xb0, 0x8a, 0x8e, 0x63, 0xe4, 0x32, 0xe4, 0x59, 0x13,
+	0xca, 0x50, 0x2a, 0xff, 0x23, 0xed, 0x02, 0x7d, 0x91, 0x83, 0x79, 0xd2, 0xf2, 0xd3, 0x22, 0x21,
+	0x3c, 0x11, 0x75, 0x53, 0xe5, 0x98, 0xc8, 0x43, 0xde, 0xf3, 0x63, 0x9c, 0xa4, 0xcc, 0x12, 0x44,
+	0x99, 0xdd, 0x3a, 0x27, 0xae, 0xf3, 0x93, 0xdc, 0x94, 0x27, 0x53, 0xfc, 0x29, 0xdc, 0x08, 0xed,
+	0x77, 0xc9, 0x4b, 0x9c, 0xbc, 0x96, 0x1f, 0x94, 0xb9, 0xf7, 0x60, 0x35, 0x0d, 0x33, 0xdf, 0xc7,
+	0x69, 0x20, 0x2a, 0xc1, 0x7b, 0xbe, 0x6e, 0xb6, 0x0b, 0x94, 0xd7, 0x80, 0x87, 0x22, 0x87, 0x89,
+	0xa4, 0x35, 0x64, 0x28, 0x12, 0xe5, 0x34, 0xfd, 0xad, 0x02, 0x9b, 0xef, 0xe6, 0x77, 0x3f, 0xa6,
+	0x6e, 0x70, 0x7d, 0x49, 0xde, 0x81, 0x16, 0xca, 0x3d, 0x58, 0x24, 0x8b, 0x1c, 0x94, 0x14, 0x63,
+	0x88, 0x63, 0x4f, 0x39, 0x74, 0x41, 0x1d, 0x6a, 0x57, 0xa9, 0x43, 0xfd, 0x5c, 0x1d, 0xf4, 0xbf,
+	0x15, 0x68, 0x97, 0xe2, 0xa3, 0x63, 0xed, 0x0b, 0x58, 0xe2, 0x74, 0x1e, 0x8e, 0xda, 0xdf, 0x31,
+	0x2e, 0xd8, 0x6b, 0x46, 0x79, 0xfd, 0x98, 0x82, 0xaf, 0x7d, 0x05, 0x2a, 0x5f, 0x52, 0xc2, 0x1b,
+	0x97, 0xad, 0xf6, 0xef, 0x18, 0xf3, 0x55, 0x66, 0x88, 0x55, 0x66, 0x70, 0x3f, 0xcf, 0xe2, 0xd4,
+	0x04, 0xce, 0x17, 0x3b, 0x6c, 0x0f, 0x1a, 0x88, 0xb0, 0x04, 0xf3, 0x58, 0x6a, 0xbb, 0x6a, 0xff,
+	0x93, 0x0b, 0x1d, 0x57, 0xb4, 0xee, 0x13, 0x96, 0x4c, 0xcd, 0xe2, 0x5e, 0x1e, 0xae, 0x10, 0x50,
+	0xe9, 0x26, 0x21, 0x4a, 0x34, 0x87, 0xfe, 0xfb, 0x12, 0x68, 0xe7, 0x4d, 0x5c, 0x57, 0x19, 0x27,
+	0x94, 0x61, 0xe2, 0x5b, 0x31, 0xfd, 0x79, 0x5e, 0x46, 0x81, 0x0d, 0x73, 0x48, 0x3b, 0x04, 0x4d,
+	0x08, 0xae, 0x10, 0x6b, 0x97, 0x27, 0x6e, 0x9d, 0x5f, 0x7b, 0x51, 0x32, 0xf5, 0x23, 0xb4, 0x2a,
+	0xc3, 0xb7, 0xfe, 0x9e, 0xc3, 0x57, 0x1d, 0x97, 0xc6, 0xfb, 0x4c, 0x67, 0xc5, 0xc5, 0xd2, 0x55,
+	0x75, 0x96, 0x37, 0x45, 0x79, 0x81, 0x2e, 0x7f, 0xa8, 0x05, 0xfa, 0x35, 0xac, 0x0a, 0x7d, 0x33,
+	0xd3, 0x0d, 0xae, 0xad, 0xf3, 0x5f, 0xda, 0x4c, 0xd1, 0x28, 0xc5, 0xcb, 0xe3, 0x09, 0xac, 0x15,
+	0x37, 0x2d, 0xf1, 0xe0, 0xe8, 0x34, 0xb9, 0x01, 0xfd, 0xc2, 0x1e, 0xac, 0xbc, 0x7d, 0xcc, 0x76,
+	0x5c, 0x79, 0x0a, 0x3d, 0x85, 0x8d, 0xaa, 0x96, 0xc2, 0xe2, 0xca, 0xe5, 0xe9, 0xd2, 0xca, 0xaa,
+	0x84, 0x3d, 0xdd, 0x82, 0x56, 0x91, 0xbf, 0x03, 0x3b, 0x14, 0xe3, 0x8d, 0xd9, 0x61, 0x38, 0x9f,
+	0x83, 0x8a, 0x1c, 0x6f, 0x02, 0x95, 0x53, 0x70, 0x17, 0xd6, 0x03, 0x3b, 0xcc, 0xff, 0xfb, 0x36,
+	0xab, 0xbe, 0x67, 0x56, 0x05, 0xbe, 0x27, 0xd7, 0xd7, 0xe0, 0xc9, 0xab, 0xd3, 0xae, 0xf2, 0xfa,
+	0xb4, 0xab, 0xfc, 0x75, 0xda, 0x55, 0x5e, 0x9e, 0x75, 0x17, 0x5e, 0x9f, 0x75, 0x17, 0xfe, 0x38,
+	0xeb, 0x2e, 0xfc, 0x70, 0xff, 0xb2, 0xaa, 0x1c, 0xcf, 0xdf, 0xc3, 0xbc, 0x40, 0xce, 0x32, 0x5f,
+	0x5c, 0x0f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x01, 0x6a, 0x70, 0x30, 0x0b, 0x00, 0x00,
 }
 
 func (m *IndexedBlock) Marshal() (dAtA []byte, err error) {
@@ -361,6 +833,13 @@ func (m *IndexedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Finalized {
 		i--
 		if m.Finalized {
@@ -536,6 +1015,14 @@ func (m *FinalityProviderSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFinality(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintFinality(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -561,117 +1048,1311 @@ func (m *FinalityProviderSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFinality(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FinalityProviderStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *IndexedBlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FinalityProviderStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovFinality(uint64(m.Height))
+	if m.EvidenceCount != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.EvidenceCount))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.AppHash)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	if m.SluggishCount != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.SluggishCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Finalized {
-		n += 2
+	if m.LastVotedHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.LastVotedHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if m.FirstVotedHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.FirstVotedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotedBlocks != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExpectedVotes != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.ExpectedVotes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PubRandCommit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FinalityProviderEpochStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderEpochStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderEpochStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StartHeight != 0 {
-		n += 1 + sovFinality(uint64(m.StartHeight))
+	if m.VotedBlocks != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotedBlocks))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.NumPubRand != 0 {
-		n += 1 + sovFinality(uint64(m.NumPubRand))
+	if m.ExpectedVotes != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.ExpectedVotes))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Commitment)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	if m.EpochNumber != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Evidence) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FinalityProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FpBtcPk != nil {
-		l = m.FpBtcPk.Size()
-		n += 1 + l + sovFinality(uint64(l))
+	if m.ProofHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovFinality(uint64(m.BlockHeight))
+	if m.ProofBlock != nil {
+		{
+			size, err := m.ProofBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProofEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProofEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProofEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofPubRandCommit != nil {
+		{
+			size, err := m.ProofPubRandCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PubRandCommit != nil {
+		{
+			size, err := m.PubRandCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ProofPubRand != nil {
+		{
+			size, err := m.ProofPubRand.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
 	if m.PubRand != nil {
-		l = m.PubRand.Size()
-		n += 1 + l + sovFinality(uint64(l))
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.CanonicalAppHash)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	if m.ProofFinalitySig != nil {
+		{
+			size, err := m.ProofFinalitySig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ForkAppHash)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	if m.FinalitySig != nil {
+		{
+			size := m.FinalitySig.Size()
+			i -= size
+			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.CanonicalFinalitySig != nil {
-		l = m.CanonicalFinalitySig.Size()
-		n += 1 + l + sovFinality(uint64(l))
+	if m.ProofVotingPower != nil {
+		{
+			size, err := m.ProofVotingPower.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ForkFinalitySig != nil {
-		l = m.ForkFinalitySig.Size()
-		n += 1 + l + sovFinality(uint64(l))
+	if m.VotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaltedAtHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.HaltedAtHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StalledHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.StalledHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFinality(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFinality(uint64(m.Height))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *PubRandCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovFinality(uint64(m.StartHeight))
+	}
+	if m.NumPubRand != 0 {
+		n += 1 + sovFinality(uint64(m.NumPubRand))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *Evidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovFinality(uint64(m.BlockHeight))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	l = len(m.CanonicalAppHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	l = len(m.ForkAppHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.CanonicalFinalitySig != nil {
+		l = m.CanonicalFinalitySig.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.ForkFinalitySig != nil {
+		l = m.ForkFinalitySig.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalityProviderSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovFinality(uint64(m.StartHeight))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovFinality(uint64(m.MissedBlocksCounter))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovFinality(uint64(l))
+	return n
+}
+
+func (m *FinalityProviderStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.ExpectedVotes != 0 {
+		n += 1 + sovFinality(uint64(m.ExpectedVotes))
+	}
+	if m.VotedBlocks != 0 {
+		n += 1 + sovFinality(uint64(m.VotedBlocks))
+	}
+	if m.FirstVotedHeight != 0 {
+		n += 1 + sovFinality(uint64(m.FirstVotedHeight))
+	}
+	if m.LastVotedHeight != 0 {
+		n += 1 + sovFinality(uint64(m.LastVotedHeight))
+	}
+	if m.SluggishCount != 0 {
+		n += 1 + sovFinality(uint64(m.SluggishCount))
+	}
+	if m.EvidenceCount != 0 {
+		n += 1 + sovFinality(uint64(m.EvidenceCount))
+	}
+	return n
+}
+
+func (m *FinalityProviderEpochStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovFinality(uint64(m.EpochNumber))
+	}
+	if m.ExpectedVotes != 0 {
+		n += 1 + sovFinality(uint64(m.ExpectedVotes))
+	}
+	if m.VotedBlocks != 0 {
+		n += 1 + sovFinality(uint64(m.VotedBlocks))
+	}
+	return n
+}
+
+func (m *FinalityProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.ProofBlock != nil {
+		l = m.ProofBlock.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovFinality(uint64(m.ProofHeight))
+	}
+	return n
+}
+
+func (m *FinalityProofEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.VotingPower))
+	}
+	if m.ProofVotingPower != nil {
+		l = m.ProofVotingPower.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.FinalitySig != nil {
+		l = m.FinalitySig.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.ProofFinalitySig != nil {
+		l = m.ProofFinalitySig.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.ProofPubRand != nil {
+		l = m.ProofPubRand.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.PubRandCommit != nil {
+		l = m.PubRandCommit.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.ProofPubRandCommit != nil {
+		l = m.ProofPubRandCommit.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalityHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StalledHeight != 0 {
+		n += 1 + sovFinality(uint64(m.StalledHeight))
+	}
+	if m.HaltedAtHeight != 0 {
+		n += 1 + sovFinality(uint64(m.HaltedAtHeight))
+	}
+	return n
+}
+
+func sovFinality(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFinality(x uint64) (n int) {
+	return sovFinality(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubRandCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			}
+			m.NumPubRand = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPubRand |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CanonicalAppHash == nil {
+				m.CanonicalAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkAppHash == nil {
+				m.ForkAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.CanonicalFinalitySig = &v
+			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.ForkFinalitySig = &v
+			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *FinalityProviderSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FpBtcPk != nil {
-		l = m.FpBtcPk.Size()
-		n += 1 + l + sovFinality(uint64(l))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovFinality(uint64(m.StartHeight))
-	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovFinality(uint64(m.MissedBlocksCounter))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovFinality(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFinality(x uint64) (n int) {
-	return sovFinality(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -694,17 +2375,17 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexedBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -714,14 +2395,194 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedVotes", wireType)
+			}
+			m.ExpectedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedBlocks", wireType)
+			}
+			m.VotedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstVotedHeight", wireType)
+			}
+			m.FirstVotedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstVotedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVotedHeight", wireType)
+			}
+			m.LastVotedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastVotedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SluggishCount", wireType)
+			}
+			m.SluggishCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SluggishCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceCount", wireType)
+			}
+			m.EvidenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderEpochStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderEpochStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderEpochStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -748,16 +2609,36 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.AppHash == nil {
-				m.AppHash = []byte{}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedVotes", wireType)
 			}
-			var v int
+			m.ExpectedVotes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -767,12 +2648,30 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExpectedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedBlocks", wireType)
+			}
+			m.VotedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Finalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -794,7 +2693,7 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
+func (m *FinalityProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -817,17 +2716,17 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubRandCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			m.StartHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -837,16 +2736,33 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &IndexedBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofBlock", wireType)
 			}
-			m.NumPubRand = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -856,16 +2772,33 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPubRand |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofBlock == nil {
+				m.ProofBlock = &crypto.ProofOps{}
+			}
+			if err := m.ProofBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -875,26 +2808,45 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
+			m.Entries = append(m.Entries, &FinalityProofEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -916,7 +2868,7 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Evidence) Unmarshal(dAtA []byte) error {
+func (m *FinalityProofEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -939,10 +2891,10 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProofEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProofEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -982,9 +2934,9 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			m.BlockHeight = 0
+			m.VotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -994,16 +2946,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.VotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofVotingPower", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1013,30 +2965,31 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.SchnorrPubRand
-			m.PubRand = &v
-			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProofVotingPower == nil {
+				m.ProofVotingPower = &crypto.ProofOps{}
+			}
+			if err := m.ProofVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1063,16 +3016,17 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CanonicalAppHash == nil {
-				m.CanonicalAppHash = []byte{}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofFinalitySig", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1082,29 +3036,31 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ForkAppHash == nil {
-				m.ForkAppHash = []byte{}
+			if m.ProofFinalitySig == nil {
+				m.ProofFinalitySig = &crypto.ProofOps{}
+			}
+			if err := m.ProofFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1131,17 +3087,17 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
-			m.CanonicalFinalitySig = &v
-			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofPubRand", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1151,24 +3107,97 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
-			m.ForkFinalitySig = &v
-			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProofPubRand == nil {
+				m.ProofPubRand = &crypto.Proof{}
+			}
+			if err := m.ProofPubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubRandCommit == nil {
+				m.PubRandCommit = &PubRandCommit{}
+			}
+			if err := m.PubRandCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofPubRandCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofPubRandCommit == nil {
+				m.ProofPubRandCommit = &crypto.ProofOps{}
+			}
+			if err := m.ProofPubRandCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1193,7 +3222,7 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *FinalityHalt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1216,52 +3245,17 @@ func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalityProviderSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityHalt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalityProviderSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityHalt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFinality
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPk = &v
-			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StalledHeight", wireType)
 			}
-			m.StartHeight = 0
+			m.StalledHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1271,16 +3265,16 @@ func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				m.StalledHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedAtHeight", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			m.HaltedAtHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1290,7 +3284,7 @@ func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				m.HaltedAtHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// GetBlockKey returns the key of the indexed block at the given height in
+// the finality store
+func GetBlockKey(height uint64) []byte {
+	key := append([]byte{}, BlockKey...)
+	return append(key, sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetVoteKey returns the key of the finality signature of the given finality
+// provider at the given height in the finality store
+func GetVoteKey(height uint64, fpBtcPK *bbn.BIP340PubKey) []byte {
+	key := append([]byte{}, VoteKey...)
+	key = append(key, sdk.Uint64ToBigEndian(height)...)
+	return append(key, fpBtcPK.MustMarshal()...)
+}
+
+// GetPubRandCommitKey returns the key of the public randomness commitment of
+// the given finality provider starting at the given height in the finality store
+func GetPubRandCommitKey(fpBtcPK *bbn.BIP340PubKey, startHeight uint64) []byte {
+	key := append([]byte{}, PubRandCommitKey...)
+	key = append(key, fpBtcPK.MustMarshal()...)
+	return append(key, sdk.Uint64ToBigEndian(startHeight)...)
+}
+
+// GetVotingPowerKey returns the key of the voting power of the given finality
+// provider at the given height in the btcstaking store
+func GetVotingPowerKey(height uint64, fpBtcPK *bbn.BIP340PubKey) []byte {
+	key := append([]byte{}, bstypes.VotingPowerKey...)
+	key = append(key, sdk.Uint64ToBigEndian(height)...)
+	return append(key, fpBtcPK.MustMarshal()...)
+}
+
+// VerifyFinalityProof verifies that the block in the given finality proof is
+// BTC-finalized w.r.t. the given AppHash, which results from executing the
+// block at proof.ProofHeight. The verification includes
+// - verifying the block is committed to the AppHash and is marked as finalized
+// - verifying the voting power table is committed to the AppHash
+// - verifying each finality signature and public randomness commitment are committed to the AppHash
+// - verifying each public randomness is included in the commitment
+// - verifying each finality signature over the block
+// - verifying the voters have more than 2/3 of the total voting power, in the same way as tallying
+// The finality proof only proves the inclusion rather than the completeness of
+// the voting power table, so the votes alone do not prove finality, as a
+// prover can omit the finality providers that have not voted. The finalized
+// flag of the block, which Babylon sets upon tallying against the complete
+// voting power table, is what proves the finality.
+func VerifyFinalityProof(proof *FinalityProof, appHash []byte) error {
+	if proof == nil || proof.Block == nil {
+		return ErrInvalidFinalityProof.Wrap("empty block")
+	}
+	block := proof.Block
+	height := block.Height
+
+	// verify the indexed block
+	blockBytes, err := block.Marshal()
+	if err != nil {
+		return ErrInvalidFinalityProof.Wrapf("failed to marshal the block: %v", err)
+	}
+	if err := verifyStore(appHash, StoreKey, GetBlockKey(height), blockBytes, proof.ProofBlock); err != nil {
+		return ErrInvalidFinalityProof.Wrapf("invalid proof of the block: %v", err)
+	}
+	if !block.Finalized {
+		return ErrBlockNotFinalized.Wrapf("the block at height %d is not finalized", height)
+	}
+
+	fpSet := make(map[string]uint64, len(proof.Entries))
+	voterBTCPKs := make(map[string]struct{}, len(proof.Entries))
+	for _, entry := range proof.Entries {
+		if entry == nil || entry.FpBtcPk == nil {
+			return ErrInvalidFinalityProof.Wrap("empty finality provider")
+		}
+		fpPkHex := entry.FpBtcPk.MarshalHex()
+		if _, ok := fpSet[fpPkHex]; ok {
+			return ErrInvalidFinalityProof.Wrapf("duplicate finality provider %s", fpPkHex)
+		}
+
+		// verify the voting power of the finality provider
+		votingPowerKey := GetVotingPowerKey(height, entry.FpBtcPk)
+		votingPowerBytes := sdk.Uint64ToBigEndian(entry.VotingPower)
+		if err := verifyStore(appHash, bstypes.StoreKey, votingPowerKey, votingPowerBytes, entry.ProofVotingPower); err != nil {
+			return ErrInvalidFinalityProof.Wrapf("invalid proof of the voting power of finality provider %s: %v", fpPkHex, err)
+		}
+		fpSet[fpPkHex] = entry.VotingPower
+
+		// the finality provider has not voted for the block
+		if entry.FinalitySig == nil {
+			continue
+		}
+
+		// verify the finality vote of the finality provider
+		if err := entry.verifyVote(appHash, block); err != nil {
+			return ErrInvalidFinalityProof.Wrapf("invalid vote of finality provider %s: %v", fpPkHex, err)
+		}
+		voterBTCPKs[fpPkHex] = struct{}{}
+	}
+
+	if !Tally(fpSet, voterBTCPKs) {
+		return ErrBlockNotFinalized.Wrapf("the votes at height %d do not reach the quorum", height)
+	}
+	return nil
+}
+
+// verifyVote verifies the finality signature, the public randomness and the
+// public randomness commitment in the entry w.r.t. the given AppHash and block
+func (e *FinalityProofEntry) verifyVote(appHash []byte, block *IndexedBlock) error {
+	if err := verifyStore(appHash, StoreKey, GetVoteKey(block.Height, e.FpBtcPk), e.FinalitySig.MustMarshal(), e.ProofFinalitySig); err != nil {
+		return fmt.Errorf("invalid proof of the finality signature: %w", err)
+	}
+
+	prCommit := e.PubRandCommit
+	if prCommit == nil {
+		return fmt.Errorf("empty public randomness commitment")
+	}
+	prCommitBytes, err := prCommit.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal the public randomness commitment: %w", err)
+	}
+	if err := verifyStore(appHash, StoreKey, GetPubRandCommitKey(e.FpBtcPk, prCommit.StartHeight), prCommitBytes, e.ProofPubRandCommit); err != nil {
+		return fmt.Errorf("invalid proof of the public randomness commitment: %w", err)
+	}
+	if err := verifyPubRandInclusion(prCommit, block.Height, e.PubRand, e.ProofPubRand); err != nil {
+		return err
+	}
+
+	pk, err := e.FpBtcPk.ToBTCPK()
+	if err != nil {
+		return err
+	}
+	return eots.Verify(pk, e.PubRand.ToFieldVal(), msgToSignForVote(block.Height, block.AppHash), e.FinalitySig.ToModNScalar())
+}
+
+// Tally checks whether a block with the given finality provider set and votes reaches a quorum or not
+func Tally(fpSet map[string]uint64, voterBTCPKs map[string]struct{}) bool {
+	totalPower := uint64(0)
+	votedPower := uint64(0)
+	for pkStr, power := range fpSet {
+		totalPower += power
+		if _, ok := voterBTCPKs[pkStr]; ok {
+			votedPower += power
+		}
+	}
+	return votedPower*3 > totalPower*2
+}
+
+// verifyStore verifies whether a KV pair is committed to the Merkle root, with
+// the assistance of a Merkle proof. Unlike proofs of absence, only proofs of
+// existence of the exact KV pair pass the verification.
+func verifyStore(root []byte, moduleStoreKey string, key []byte, value []byte, proof *cmtcrypto.ProofOps) error {
+	if proof == nil {
+		return fmt.Errorf("empty proof")
+	}
+	prt := rootmulti.DefaultProofRuntime()
+
+	keypath := merkle.KeyPath{}
+	keypath = keypath.AppendKey([]byte(moduleStoreKey), merkle.KeyEncodingURL)
+	keypath = keypath.AppendKey(key, merkle.KeyEncodingURL)
+
+	return prt.VerifyValue(proof, root, keypath.String(), value)
+}
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,6 +18,323 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// TODO: add validate to IndexedBlocks, Evidences, VoteSigs, PublicRandomness
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.validateIndexedBlocks(); err != nil {
+		return err
+	}
+	if err := gs.validateEvidences(); err != nil {
+		return err
+	}
+	if err := gs.validateVoteSigs(); err != nil {
+		return err
+	}
+	if err := gs.validatePublicRandomness(); err != nil {
+		return err
+	}
+	if err := gs.validatePubRandCommits(); err != nil {
+		return err
+	}
+	if err := gs.validateSupersededPubRandCommits(); err != nil {
+		return err
+	}
+	if err := gs.validatePubRandProofs(); err != nil {
+		return err
+	}
+	if err := gs.validateFinalityProviderStats(); err != nil {
+		return err
+	}
+	if err := gs.validateNextHeightToPrune(); err != nil {
+		return err
+	}
+	if err := gs.validateFinalityHalt(); err != nil {
+		return err
+	}
+	return gs.validateSigningInfos()
+}
+
+// validateIndexedBlocks ensures there is at most one indexed block at each
+// height, and each of them has a well-formed AppHash
+func (gs GenesisState) validateIndexedBlocks() error {
+	seen := make(map[uint64]struct{}, len(gs.IndexedBlocks))
+	for _, ib := range gs.IndexedBlocks {
+		if ib == nil {
+			return fmt.Errorf("null indexed block")
+		}
+		if len(ib.AppHash) != 32 {
+			return fmt.Errorf("malformed AppHash of indexed block at height %d", ib.Height)
+		}
+		if _, ok := seen[ib.Height]; ok {
+			return fmt.Errorf("duplicate indexed block at height %d", ib.Height)
+		}
+		seen[ib.Height] = struct{}{}
+	}
+	return nil
+}
+
+// validateEvidences ensures the evidences are well-formed, and there is at
+// most one evidence of each finality provider at each height
+func (gs GenesisState) validateEvidences() error {
+	seen := make(map[string]struct{}, len(gs.Evidences))
+	for _, e := range gs.Evidences {
+		if e == nil {
+			return fmt.Errorf("null evidence")
+		}
+		if err := e.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid evidence: %w", err)
+		}
+		key := fmt.Sprintf("%s/%d", e.FpBtcPk.MarshalHex(), e.BlockHeight)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate evidence of finality provider %s at height %d", e.FpBtcPk.MarshalHex(), e.BlockHeight)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// validateVoteSigs ensures the votes are complete, and each finality provider
+// votes at most once at each height
+func (gs GenesisState) validateVoteSigs() error {
+	seen := make(map[string]struct{}, len(gs.VoteSigs))
+	for _, v := range gs.VoteSigs {
+		if v == nil || v.FpBtcPk == nil || v.FinalitySig == nil {
+			return fmt.Errorf("incomplete vote")
+		}
+		key := fmt.Sprintf("%s/%d", v.FpBtcPk.MarshalHex(), v.BlockHeight)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate vote of finality provider %s at height %d", v.FpBtcPk.MarshalHex(), v.BlockHeight)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// validatePublicRandomness ensures the public randomness is complete, and
+// each finality provider has at most one public randomness at each height
+func (gs GenesisState) validatePublicRandomness() error {
+	seen := make(map[string]struct{}, len(gs.PublicRandomness))
+	for _, pr := range gs.PublicRandomness {
+		if pr == nil || pr.FpBtcPk == nil || pr.PubRand == nil {
+			return fmt.Errorf("incomplete public randomness")
+		}
+		key := fmt.Sprintf("%s/%d", pr.FpBtcPk.MarshalHex(), pr.BlockHeight)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate public randomness of finality provider %s at height %d", pr.FpBtcPk.MarshalHex(), pr.BlockHeight)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// validatePubRandCommits ensures the public randomness commitments are
+// non-empty, and the commitments of each finality provider do not overlap
+func (gs GenesisState) validatePubRandCommits() error {
+	commits := make(map[string][]*PubRandCommit)
+	for _, c := range gs.PubRandCommit {
+		if c == nil || c.FpBtcPk == nil || c.PubRandCommit == nil {
+			return fmt.Errorf("incomplete public randomness commitment")
+		}
+		prc := c.PubRandCommit
+		if prc.NumPubRand == 0 {
+			return fmt.Errorf("empty public randomness commitment at height %d", prc.StartHeight)
+		}
+		if len(prc.Commitment) == 0 {
+			return fmt.Errorf("empty commitment value at height %d", prc.StartHeight)
+		}
+		if prc.StartHeight+prc.NumPubRand < prc.StartHeight {
+			return fmt.Errorf("public randomness commitment at height %d overflows", prc.StartHeight)
+		}
+		fpKey := c.FpBtcPk.MarshalHex()
+		for _, other := range commits[fpKey] {
+			if prc.StartHeight <= other.EndHeight() && other.StartHeight <= prc.EndHeight() {
+				return fmt.Errorf("overlapping public randomness commitments of finality provider %s at heights %d and %d",
+					fpKey, prc.StartHeight, other.StartHeight)
+			}
+		}
+		commits[fpKey] = append(commits[fpKey], prc)
+	}
+	return nil
+}
+
+// validateSupersededPubRandCommits ensures the superseded public randomness
+// commitments are non-empty and not among the active commitments
+func (gs GenesisState) validateSupersededPubRandCommits() error {
+	active := make(map[string]struct{}, len(gs.PubRandCommit))
+	for _, c := range gs.PubRandCommit {
+		active[fmt.Sprintf("%s/%d/%s", c.FpBtcPk.MarshalHex(), c.PubRandCommit.StartHeight, hex.EncodeToString(c.PubRandCommit.Commitment))] = struct{}{}
+	}
+	for _, c := range gs.SupersededPubRandCommits {
+		if c == nil || c.FpBtcPk == nil || c.PubRandCommit == nil || len(c.PubRandCommit.Commitment) == 0 {
+			return fmt.Errorf("incomplete superseded public randomness commitment")
+		}
+		key := fmt.Sprintf("%s/%d/%s", c.FpBtcPk.MarshalHex(), c.PubRandCommit.StartHeight, hex.EncodeToString(c.PubRandCommit.Commitment))
+		if _, ok := active[key]; ok {
+			return fmt.Errorf("superseded public randomness commitment of finality provider %s at height %d is still active",
+				c.FpBtcPk.MarshalHex(), c.PubRandCommit.StartHeight)
+		}
+	}
+	return nil
+}
+
+// validatePubRandProofs ensures there is at most one non-empty inclusion proof
+// of public randomness for each finality provider at each height
+func (gs GenesisState) validatePubRandProofs() error {
+	seen := make(map[string]struct{}, len(gs.PubRandProofs))
+	for _, prp := range gs.PubRandProofs {
+		if prp == nil || prp.FpBtcPk == nil || prp.Proof == nil {
+			return fmt.Errorf("incomplete inclusion proof of public randomness")
+		}
+		key := fmt.Sprintf("%s/%d", prp.FpBtcPk.MarshalHex(), prp.BlockHeight)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate inclusion proof of public randomness of finality provider %s at height %d", prp.FpBtcPk.MarshalHex(), prp.BlockHeight)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// validateFinalityProviderStats ensures there is at most one cumulative
+// statistics for each finality provider and at most one statistics for each
+// finality provider in each epoch, and no statistics has more voted blocks
+// than expected votes
+func (gs GenesisState) validateFinalityProviderStats() error {
+	seen := make(map[string]struct{}, len(gs.FpStats))
+	for _, stats := range gs.FpStats {
+		if stats == nil || stats.FpBtcPk == nil {
+			return fmt.Errorf("empty finality provider BTC PK in statistics")
+		}
+		fpKey := stats.FpBtcPk.MarshalHex()
+		if stats.VotedBlocks > stats.ExpectedVotes {
+			return fmt.Errorf("statistics of finality provider %s has more voted blocks than expected votes", fpKey)
+		}
+		if stats.FirstVotedHeight > stats.LastVotedHeight {
+			return fmt.Errorf("statistics of finality provider %s has a first voted height after the last voted height", fpKey)
+		}
+		if _, ok := seen[fpKey]; ok {
+			return fmt.Errorf("duplicate statistics of finality provider %s", fpKey)
+		}
+		seen[fpKey] = struct{}{}
+	}
+
+	seenEpoch := make(map[string]struct{}, len(gs.FpEpochStats))
+	for _, stats := range gs.FpEpochStats {
+		if stats == nil || stats.FpBtcPk == nil {
+			return fmt.Errorf("empty finality provider BTC PK in epoch statistics")
+		}
+		fpKey := stats.FpBtcPk.MarshalHex()
+		if stats.VotedBlocks > stats.ExpectedVotes {
+			return fmt.Errorf("statistics of finality provider %s in epoch %d has more voted blocks than expected votes", fpKey, stats.EpochNumber)
+		}
+		key := fmt.Sprintf("%s/%d", fpKey, stats.EpochNumber)
+		if _, ok := seenEpoch[key]; ok {
+			return fmt.Errorf("duplicate statistics of finality provider %s in epoch %d", fpKey, stats.EpochNumber)
+		}
+		seenEpoch[key] = struct{}{}
+	}
+	return nil
+}
+
+// validateNextHeightToPrune ensures there are no indexed blocks, votes, public
+// randomness or inclusion proofs of public randomness at pruned heights
+func (gs GenesisState) validateNextHeightToPrune() error {
+	for _, ib := range gs.IndexedBlocks {
+		if ib.Height < gs.NextHeightToPrune {
+			return fmt.Errorf("indexed block at height %d is before the next height to prune %d", ib.Height, gs.NextHeightToPrune)
+		}
+	}
+	for _, v := range gs.VoteSigs {
+		if v.BlockHeight < gs.NextHeightToPrune {
+			return fmt.Errorf("vote at height %d is before the next height to prune %d", v.BlockHeight, gs.NextHeightToPrune)
+		}
+	}
+	for _, pr := range gs.PublicRandomness {
+		if pr.BlockHeight < gs.NextHeightToPrune {
+			return fmt.Errorf("public randomness at height %d is before the next height to prune %d", pr.BlockHeight, gs.NextHeightToPrune)
+		}
+	}
+	for _, prp := range gs.PubRandProofs {
+		if prp.BlockHeight < gs.NextHeightToPrune {
+			return fmt.Errorf("inclusion proof of public randomness at height %d is before the next height to prune %d", prp.BlockHeight, gs.NextHeightToPrune)
+		}
+	}
+	return nil
+}
+
+// validateFinalityHalt ensures the finality halt, if any, is detected after the
+// height that the finality stalls at, and the block at that height is not
+// finalized
+func (gs GenesisState) validateFinalityHalt() error {
+	halt := gs.FinalityHalt
+	if halt == nil {
+		return nil
+	}
+	if halt.StalledHeight == 0 {
+		return fmt.Errorf("finality halt has a zero stalled height")
+	}
+	if halt.HaltedAtHeight <= halt.StalledHeight {
+		return fmt.Errorf("finality halt is detected at height %d, not after the stalled height %d", halt.HaltedAtHeight, halt.StalledHeight)
+	}
+	for _, ib := range gs.IndexedBlocks {
+		if ib.Height == halt.StalledHeight && ib.Finalized {
+			return fmt.Errorf("the block at the stalled height %d of the finality halt is finalized", halt.StalledHeight)
+		}
+	}
+	return nil
+}
+
+// validateSigningInfos ensures each finality provider has at most one signing
+// info that matches its BTC PK, and the missed blocks belong to finality
+// providers with signing infos and fall into the signed blocks window
+func (gs GenesisState) validateSigningInfos() error {
+	seen := make(map[string]struct{}, len(gs.SigningInfos))
+	for _, info := range gs.SigningInfos {
+		if info.FpBtcPk == nil {
+			return fmt.Errorf("empty finality provider BTC PK in signing info")
+		}
+		fpKey := info.FpBtcPk.MarshalHex()
+		if info.FpSigningInfo.FpBtcPk == nil || !info.FpSigningInfo.FpBtcPk.Equals(info.FpBtcPk) {
+			return fmt.Errorf("signing info of finality provider %s has a mismatched BTC PK", fpKey)
+		}
+		if info.FpSigningInfo.MissedBlocksCounter < 0 {
+			return fmt.Errorf("signing info of finality provider %s has a negative missed blocks counter", fpKey)
+		}
+		if _, ok := seen[fpKey]; ok {
+			return fmt.Errorf("duplicate signing info of finality provider %s", fpKey)
+		}
+		seen[fpKey] = struct{}{}
+	}
+
+	seenMissed := make(map[string]struct{}, len(gs.MissedBlocks))
+	for _, missedBlocks := range gs.MissedBlocks {
+		if missedBlocks.FpBtcPk == nil {
+			return fmt.Errorf("empty finality provider BTC PK in missed blocks")
+		}
+		fpKey := missedBlocks.FpBtcPk.MarshalHex()
+		if _, ok := seen[fpKey]; !ok {
+			return fmt.Errorf("missed blocks of finality provider %s have no signing info", fpKey)
+		}
+		if _, ok := seenMissed[fpKey]; ok {
+			return fmt.Errorf("duplicate missed blocks of finality provider %s", fpKey)
+		}
+		seenMissed[fpKey] = struct{}{}
+		for _, mb := range missedBlocks.MissedBlocks {
+			if mb.Index < 0 || mb.Index >= gs.Params.SignedBlocksWindow {
+				return fmt.Errorf("missed block index %d of finality provider %s is out of the signed blocks window", mb.Index, fpKey)
+			}
+		}
+	}
+	return nil
+}
+
+// GenesisStateFromAppState returns x/finality GenesisState given raw application
+// genesis state.
+func GenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+
+	if appState[ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+
+	return genesisState
 }
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -44,6 +45,25 @@ type GenesisState struct {
 	// missed_blocks represents a map between finality provider public key and their
 	// missed blocks.
 	MissedBlocks []FinalityProviderMissedBlocks `protobuf:"bytes,8,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// superseded_pub_rand_commits contains all the public randomness commitments
+	// that have been superseded by later commitments and cannot be committed again.
+	SupersededPubRandCommits []*PubRandCommitWithPK `protobuf:"bytes,9,rep,name=superseded_pub_rand_commits,json=supersededPubRandCommits,proto3" json:"superseded_pub_rand_commits,omitempty"`
+	// pub_rand_proofs contains the inclusion proofs of the public randomness
+	// used in the finality votes of finality providers.
+	PubRandProofs []*PubRandProof `protobuf:"bytes,10,rep,name=pub_rand_proofs,json=pubRandProofs,proto3" json:"pub_rand_proofs,omitempty"`
+	// fp_stats contains the cumulative performance statistics of all finality
+	// providers.
+	FpStats []*FinalityProviderStats `protobuf:"bytes,11,rep,name=fp_stats,json=fpStats,proto3" json:"fp_stats,omitempty"`
+	// fp_epoch_stats contains the per-epoch performance statistics of all
+	// finality providers.
+	FpEpochStats []*FinalityProviderEpochStats `protobuf:"bytes,12,rep,name=fp_epoch_stats,json=fpEpochStats,proto3" json:"fp_epoch_stats,omitempty"`
+	// next_height_to_prune is the next height to prune, where the finality
+	// votes, public randomness and indexed blocks at all heights before it have
+	// been pruned. It is 0 if nothing has been pruned.
+	NextHeightToPrune uint64 `protobuf:"varint,13,opt,name=next_height_to_prune,json=nextHeightToPrune,proto3" json:"next_height_to_prune,omitempty"`
+	// finality_halt is the record of the finality halt, which is null if the
+	// finality is not halted.
+	FinalityHalt *FinalityHalt `protobuf:"bytes,14,opt,name=finality_halt,json=finalityHalt,proto3" json:"finality_halt,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -135,6 +155,48 @@ func (m *GenesisState) GetMissedBlocks() []FinalityProviderMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetSupersededPubRandCommits() []*PubRandCommitWithPK {
+	if m != nil {
+		return m.SupersededPubRandCommits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPubRandProofs() []*PubRandProof {
+	if m != nil {
+		return m.PubRandProofs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFpStats() []*FinalityProviderStats {
+	if m != nil {
+		return m.FpStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFpEpochStats() []*FinalityProviderEpochStats {
+	if m != nil {
+		return m.FpEpochStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextHeightToPrune() uint64 {
+	if m != nil {
+		return m.NextHeightToPrune
+	}
+	return 0
+}
+
+func (m *GenesisState) GetFinalityHalt() *FinalityHalt {
+	if m != nil {
+		return m.FinalityHalt
+	}
+	return nil
+}
+
 // VoteSig the vote of an finality provider
 // with the block of the vote, the finality provider btc public key and the vote signature.
 type VoteSig struct {
@@ -237,6 +299,65 @@ func (m *PublicRandomness) GetBlockHeight() uint64 {
 	return 0
 }
 
+// PubRandProof is the inclusion proof of the public randomness that a finality
+// provider has used at a height.
+type PubRandProof struct {
+	// block_height is the height of block at which the public randomness is used.
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider.
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// proof is the proof that the public randomness is committed under the
+	// finality provider's commitment.
+	Proof *crypto.Proof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *PubRandProof) Reset()         { *m = PubRandProof{} }
+func (m *PubRandProof) String() string { return proto.CompactTextString(m) }
+func (*PubRandProof) ProtoMessage()    {}
+func (*PubRandProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52dc577f74d797d1, []int{3}
+}
+func (m *PubRandProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubRandProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubRandProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubRandProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubRandProof.Merge(m, src)
+}
+func (m *PubRandProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubRandProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubRandProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubRandProof proto.InternalMessageInfo
+
+func (m *PubRandProof) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PubRandProof) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 // PubRandCommitWithPK is the public randomness commitment with the finality provider's BTC public key
 type PubRandCommitWithPK struct {
 	// fp_btc_pk is the BTC PK of the finality provider that commits the public randomness
@@ -249,7 +370,7 @@ func (m *PubRandCommitWithPK) Reset()         { *m = PubRandCommitWithPK{} }
 func (m *PubRandCommitWithPK) String() string { return proto.CompactTextString(m) }
 func (*PubRandCommitWithPK) ProtoMessage()    {}
 func (*PubRandCommitWithPK) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52dc577f74d797d1, []int{3}
+	return fileDescriptor_52dc577f74d797d1, []int{4}
 }
 func (m *PubRandCommitWithPK) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +418,7 @@ func (m *SigningInfo) Reset()         { *m = SigningInfo{} }
 func (m *SigningInfo) String() string { return proto.CompactTextString(m) }
 func (*SigningInfo) ProtoMessage()    {}
 func (*SigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52dc577f74d797d1, []int{4}
+	return fileDescriptor_52dc577f74d797d1, []int{5}
 }
 func (m *SigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +467,7 @@ func (m *FinalityProviderMissedBlocks) Reset()         { *m = FinalityProviderMi
 func (m *FinalityProviderMissedBlocks) String() string { return proto.CompactTextString(m) }
 func (*FinalityProviderMissedBlocks) ProtoMessage()    {}
 func (*FinalityProviderMissedBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52dc577f74d797d1, []int{5}
+	return fileDescriptor_52dc577f74d797d1, []int{6}
 }
 func (m *FinalityProviderMissedBlocks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +515,7 @@ func (m *MissedBlock) Reset()         { *m = MissedBlock{} }
 func (m *MissedBlock) String() string { return proto.CompactTextString(m) }
 func (*MissedBlock) ProtoMessage()    {}
 func (*MissedBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52dc577f74d797d1, []int{6}
+	return fileDescriptor_52dc577f74d797d1, []int{7}
 }
 func (m *MissedBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,6 +562,7 @@ func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.finality.v1.GenesisState")
 	proto.RegisterType((*VoteSig)(nil), "babylon.finality.v1.VoteSig")
 	proto.RegisterType((*PublicRandomness)(nil), "babylon.finality.v1.PublicRandomness")
+	proto.RegisterType((*PubRandProof)(nil), "babylon.finality.v1.PubRandProof")
 	proto.RegisterType((*PubRandCommitWithPK)(nil), "babylon.finality.v1.PubRandCommitWithPK")
 	proto.RegisterType((*SigningInfo)(nil), "babylon.finality.v1.SigningInfo")
 	proto.RegisterType((*FinalityProviderMissedBlocks)(nil), "babylon.finality.v1.FinalityProviderMissedBlocks")
@@ -450,51 +572,63 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/genesis.proto", fileDescriptor_52dc577f74d797d1) }
 
 var fileDescriptor_52dc577f74d797d1 = []byte{
-	// 689 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x5f, 0x6b, 0xdb, 0x3c,
-	0x14, 0xc6, 0xe3, 0x24, 0x4d, 0x52, 0x25, 0x79, 0xdb, 0x57, 0x2d, 0x2f, 0xa6, 0x6f, 0x97, 0xa6,
-	0x86, 0x41, 0xae, 0x9c, 0xfe, 0x63, 0xac, 0xf4, 0x2e, 0xa3, 0x5b, 0xdb, 0x30, 0x66, 0xe4, 0xb1,
-	0xc1, 0x36, 0x66, 0x6c, 0x47, 0x76, 0x44, 0x63, 0xcb, 0x58, 0x4a, 0x68, 0xbe, 0xc5, 0xbe, 0xcc,
-	0xae, 0xc7, 0xee, 0x7a, 0xd9, 0xcb, 0x51, 0xb6, 0x30, 0xda, 0x2f, 0x32, 0x22, 0x3b, 0x8d, 0x97,
-	0x7a, 0x6d, 0x19, 0x2b, 0xbb, 0x93, 0x4e, 0x9e, 0xf3, 0xcb, 0x39, 0xd2, 0x73, 0x64, 0xb0, 0x6e,
-	0x99, 0xd6, 0xb0, 0x47, 0xfd, 0xa6, 0x43, 0x7c, 0xb3, 0x47, 0xf8, 0xb0, 0x39, 0xd8, 0x6c, 0xba,
-	0xd8, 0xc7, 0x8c, 0x30, 0x35, 0x08, 0x29, 0xa7, 0x70, 0x29, 0x96, 0xa8, 0x13, 0x89, 0x3a, 0xd8,
-	0x5c, 0x59, 0x76, 0xa9, 0x4b, 0xc5, 0xef, 0xcd, 0xf1, 0x2a, 0x92, 0xae, 0xd4, 0xd3, 0x68, 0x81,
-	0x19, 0x9a, 0x5e, 0x0c, 0x5b, 0x51, 0xd2, 0x14, 0x57, 0x60, 0xa1, 0x51, 0xbe, 0xe6, 0x41, 0xe5,
-	0x59, 0x54, 0x82, 0xce, 0x4d, 0x8e, 0xe1, 0x2e, 0x28, 0x44, 0x10, 0x59, 0xaa, 0x4b, 0x8d, 0xf2,
-	0xd6, 0xff, 0x6a, 0x4a, 0x49, 0xaa, 0x26, 0x24, 0xad, 0xfc, 0xe9, 0x68, 0x2d, 0x83, 0xe2, 0x04,
-	0x78, 0x00, 0xfe, 0x21, 0x7e, 0x07, 0x9f, 0xe0, 0x8e, 0x61, 0xf5, 0xa8, 0x7d, 0xcc, 0xe4, 0x6c,
-	0x3d, 0xd7, 0x28, 0x6f, 0xad, 0xa7, 0x22, 0x0e, 0x23, 0x69, 0x6b, 0xac, 0x44, 0x55, 0x92, 0xd8,
-	0x31, 0xb8, 0x07, 0xe6, 0xf1, 0x80, 0x74, 0xb0, 0x6f, 0x63, 0x26, 0xe7, 0x04, 0xe4, 0x41, 0x2a,
-	0x64, 0x3f, 0x56, 0xa1, 0xa9, 0x1e, 0xee, 0x82, 0xf9, 0x01, 0xe5, 0xd8, 0x60, 0xc4, 0x65, 0x72,
-	0x5e, 0x24, 0xaf, 0xa6, 0x26, 0xbf, 0xa2, 0x1c, 0xeb, 0xc4, 0x45, 0xa5, 0x41, 0xb4, 0x60, 0x10,
-	0x81, 0x7f, 0x83, 0xbe, 0xd5, 0x23, 0xb6, 0x11, 0x9a, 0x7e, 0x87, 0x7a, 0x3e, 0x66, 0x4c, 0x9e,
-	0x13, 0x88, 0x87, 0xe9, 0xe7, 0x20, 0xd4, 0xe8, 0x4a, 0x8c, 0x16, 0x83, 0x99, 0x08, 0xd4, 0xc0,
-	0x42, 0xd0, 0xb7, 0x04, 0xd0, 0xb0, 0xa9, 0xe7, 0x11, 0x2e, 0x17, 0x04, 0xb1, 0xf1, 0x2b, 0xe2,
-	0x38, 0xf9, 0x89, 0x50, 0xbe, 0x26, 0xbc, 0xab, 0xb5, 0x51, 0x35, 0x48, 0x06, 0x61, 0x1b, 0x54,
-	0x19, 0x71, 0x7d, 0xe2, 0xbb, 0x06, 0xf1, 0x1d, 0xca, 0xe4, 0xa2, 0xe0, 0xd5, 0x53, 0x79, 0x7a,
-	0xa4, 0x3c, 0xf4, 0x1d, 0x1a, 0x5f, 0x57, 0x85, 0x4d, 0x43, 0x0c, 0xbe, 0x03, 0x55, 0x8f, 0x30,
-	0x36, 0xbd, 0xb3, 0x92, 0x80, 0x6d, 0xa6, 0xc2, 0x9e, 0xc6, 0x6b, 0x2d, 0xa4, 0xe3, 0xe3, 0x0e,
-	0x9f, 0x8b, 0xcc, 0xe8, 0xd2, 0x26, 0x74, 0x2f, 0x11, 0x53, 0xbe, 0x49, 0xa0, 0x18, 0x1f, 0x33,
-	0x5c, 0x07, 0x15, 0xf1, 0x17, 0x46, 0x17, 0x13, 0xb7, 0xcb, 0x85, 0xbf, 0xf2, 0xa8, 0x2c, 0x62,
-	0x07, 0x22, 0x04, 0x11, 0x98, 0x77, 0x02, 0xc3, 0xe2, 0xb6, 0x11, 0x1c, 0xcb, 0xd9, 0xba, 0xd4,
-	0xa8, 0xb4, 0x1e, 0x9d, 0x8f, 0xd6, 0xb6, 0x5c, 0xc2, 0xbb, 0x7d, 0x4b, 0xb5, 0xa9, 0xd7, 0x8c,
-	0xcb, 0xb2, 0xbb, 0x26, 0xf1, 0x27, 0x9b, 0x26, 0x1f, 0x06, 0x98, 0xa9, 0xad, 0x43, 0x6d, 0x7b,
-	0x67, 0x43, 0xeb, 0x5b, 0x6d, 0x3c, 0x44, 0x45, 0x27, 0x68, 0x71, 0x5b, 0x3b, 0x86, 0x6f, 0x41,
-	0x65, 0xd2, 0xc2, 0xd8, 0x12, 0x72, 0x4e, 0x60, 0x1f, 0x9f, 0x8f, 0xd6, 0x76, 0xee, 0x86, 0xd5,
-	0xed, 0xae, 0x4f, 0xc3, 0x70, 0xff, 0xc5, 0x4b, 0x7d, 0xec, 0x96, 0xf2, 0x84, 0xa6, 0x13, 0x57,
-	0x19, 0x49, 0x60, 0x71, 0xd6, 0x03, 0x7f, 0xab, 0x51, 0x1d, 0x94, 0x26, 0x46, 0xfb, 0xed, 0x26,
-	0x63, 0xf7, 0xa1, 0x62, 0xec, 0x38, 0xe5, 0xa3, 0x04, 0x96, 0x52, 0x2c, 0xf9, 0x73, 0x03, 0xd2,
-	0x9f, 0x69, 0xe0, 0xe8, 0xfa, 0xa4, 0x64, 0xc5, 0x1b, 0xa4, 0xdc, 0x3e, 0x29, 0x33, 0x33, 0xa2,
-	0x7c, 0x96, 0x40, 0x39, 0x61, 0xfd, 0x7b, 0xa9, 0xf7, 0x3d, 0x58, 0x70, 0x02, 0x23, 0x39, 0x8a,
-	0x71, 0xbd, 0x1b, 0x77, 0x1a, 0x9e, 0xeb, 0x93, 0x59, 0x75, 0x82, 0x44, 0x50, 0xf9, 0x24, 0x81,
-	0xd5, 0x9b, 0x26, 0xee, 0x5e, 0x9a, 0x6a, 0xcf, 0xbe, 0x07, 0xd9, 0x1b, 0x1e, 0x97, 0x44, 0x35,
-	0xa9, 0xe3, 0xbf, 0x07, 0xca, 0x09, 0x09, 0x5c, 0x06, 0x73, 0xe2, 0x9d, 0x17, 0xb5, 0xe6, 0x50,
-	0xb4, 0x81, 0xff, 0x81, 0x42, 0x94, 0x24, 0x4e, 0xaf, 0x84, 0xe2, 0x5d, 0xeb, 0xe8, 0xf4, 0xa2,
-	0x26, 0x9d, 0x5d, 0xd4, 0xa4, 0xef, 0x17, 0x35, 0xe9, 0xc3, 0x65, 0x2d, 0x73, 0x76, 0x59, 0xcb,
-	0x7c, 0xb9, 0xac, 0x65, 0xde, 0x6c, 0xdc, 0xd6, 0xe0, 0xc9, 0xf4, 0x93, 0x27, 0x7a, 0xb5, 0x0a,
-	0xe2, 0x6b, 0xb7, 0xfd, 0x23, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x93, 0x7e, 0xf6, 0x83, 0x07, 0x00,
-	0x00,
+	// 889 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0x8e, 0xf2, 0xeb, 0xd0, 0x76, 0xda, 0xb2, 0xc1, 0x40, 0xa4, 0xad, 0xe3, 0x08, 0x18, 0x60,
+	0xec, 0x42, 0x4a, 0xd2, 0x62, 0x58, 0xd1, 0x3b, 0x0f, 0xe9, 0x92, 0x06, 0xc3, 0x04, 0xba, 0xdb,
+	0x80, 0x6d, 0x98, 0x20, 0xc9, 0x94, 0x4c, 0xc4, 0x22, 0x09, 0x91, 0x36, 0xe2, 0xb7, 0xd8, 0x5b,
+	0xec, 0x09, 0x76, 0x3d, 0xec, 0xae, 0x97, 0xbd, 0x1c, 0x0a, 0x2c, 0x18, 0x92, 0x17, 0x19, 0x44,
+	0xca, 0xb1, 0xea, 0x6a, 0xa9, 0x37, 0xb4, 0xc8, 0x9d, 0x0e, 0xf9, 0x9d, 0x4f, 0xdf, 0x39, 0x3a,
+	0x3f, 0x02, 0x7b, 0x61, 0x10, 0x4e, 0x86, 0x9c, 0xb9, 0x31, 0x65, 0xc1, 0x90, 0xaa, 0x89, 0x3b,
+	0x3e, 0x70, 0x13, 0xc2, 0x88, 0xa4, 0xd2, 0x11, 0x19, 0x57, 0x1c, 0xde, 0x2f, 0x20, 0xce, 0x14,
+	0xe2, 0x8c, 0x0f, 0x76, 0xb6, 0x13, 0x9e, 0x70, 0x7d, 0xef, 0xe6, 0x4f, 0x06, 0xba, 0xd3, 0xae,
+	0x62, 0x13, 0x41, 0x16, 0xa4, 0x05, 0xd9, 0x8e, 0x5d, 0x85, 0xb8, 0x26, 0x36, 0x98, 0x47, 0x8a,
+	0xb0, 0x3e, 0xc9, 0x52, 0xca, 0x94, 0x1b, 0x65, 0x13, 0xa1, 0xb8, 0x2b, 0x32, 0xce, 0x63, 0x73,
+	0x6d, 0xff, 0x5a, 0x03, 0x8d, 0xaf, 0x8c, 0xc2, 0x9e, 0x0a, 0x14, 0x81, 0x4f, 0xc1, 0xba, 0x79,
+	0x07, 0xb2, 0xda, 0x56, 0xa7, 0x7e, 0xf8, 0xc0, 0xa9, 0x50, 0xec, 0x78, 0x1a, 0xd2, 0x5d, 0x7d,
+	0x75, 0xb1, 0xbb, 0x84, 0x0b, 0x07, 0x78, 0x0c, 0xb6, 0x28, 0xeb, 0x93, 0x73, 0xd2, 0xf7, 0xc3,
+	0x21, 0x8f, 0xce, 0x24, 0x5a, 0x6e, 0xaf, 0x74, 0xea, 0x87, 0x7b, 0x95, 0x14, 0x27, 0x06, 0xda,
+	0xcd, 0x91, 0xb8, 0x49, 0x4b, 0x96, 0x84, 0xcf, 0xc0, 0x26, 0x19, 0xd3, 0x3e, 0x61, 0x11, 0x91,
+	0x68, 0x45, 0x93, 0x3c, 0xaa, 0x24, 0x39, 0x2a, 0x50, 0x78, 0x86, 0x87, 0x4f, 0xc1, 0xe6, 0x98,
+	0x2b, 0xe2, 0x4b, 0x9a, 0x48, 0xb4, 0xaa, 0x9d, 0x1f, 0x56, 0x3a, 0x7f, 0xc7, 0x15, 0xe9, 0xd1,
+	0x04, 0xd7, 0xc6, 0xe6, 0x41, 0x42, 0x0c, 0xee, 0x89, 0x51, 0x38, 0xa4, 0x91, 0x9f, 0x05, 0xac,
+	0xcf, 0x53, 0x46, 0xa4, 0x44, 0x6b, 0x9a, 0xe2, 0xd3, 0xea, 0x3c, 0x68, 0x34, 0xbe, 0x06, 0xe3,
+	0xbb, 0x62, 0xee, 0x04, 0x7a, 0xe0, 0x8e, 0x18, 0x85, 0x9a, 0xd0, 0x8f, 0x78, 0x9a, 0x52, 0x85,
+	0xd6, 0x35, 0x63, 0xe7, 0xdf, 0x18, 0x73, 0xe7, 0x2f, 0x35, 0xf2, 0x7b, 0xaa, 0x06, 0xde, 0x29,
+	0x6e, 0x8a, 0xf2, 0x21, 0x3c, 0x05, 0x4d, 0x49, 0x13, 0x46, 0x59, 0xe2, 0x53, 0x16, 0x73, 0x89,
+	0x36, 0x34, 0x5f, 0xbb, 0x92, 0xaf, 0x67, 0x90, 0x27, 0x2c, 0xe6, 0xc5, 0xe7, 0x6a, 0xc8, 0xd9,
+	0x91, 0x84, 0x3f, 0x81, 0x66, 0x4a, 0xa5, 0x9c, 0x7d, 0xb3, 0x9a, 0x26, 0x3b, 0xa8, 0x24, 0x7b,
+	0x5e, 0x3c, 0x7b, 0x19, 0xcf, 0xd3, 0x9d, 0x7d, 0xad, 0x3d, 0xcd, 0x47, 0x9b, 0xb2, 0xa7, 0xa5,
+	0x33, 0x98, 0x80, 0x07, 0x72, 0x24, 0x48, 0x26, 0x49, 0x9f, 0xf4, 0xfd, 0xb9, 0x3c, 0x48, 0xb4,
+	0xf9, 0x1f, 0x13, 0x81, 0x66, 0x64, 0x6f, 0x5d, 0x4b, 0x78, 0x52, 0xca, 0xb2, 0xae, 0x6f, 0x89,
+	0xc0, 0x0d, 0xc5, 0x57, 0x78, 0x7b, 0x39, 0xf2, 0x3a, 0xbd, 0xda, 0x92, 0xf0, 0x08, 0xd4, 0x62,
+	0xe1, 0x4b, 0x15, 0x28, 0x89, 0xea, 0x9a, 0xe3, 0xb3, 0x85, 0x92, 0x91, 0xf7, 0x8f, 0xc4, 0x1b,
+	0xb1, 0xd0, 0x0f, 0xf0, 0x5b, 0xb0, 0x15, 0x0b, 0x9f, 0x08, 0x1e, 0x0d, 0x0a, 0xb2, 0x86, 0x26,
+	0x73, 0x17, 0x22, 0x3b, 0xca, 0xfd, 0x0c, 0x63, 0x23, 0x16, 0x33, 0x0b, 0xba, 0x60, 0x9b, 0x91,
+	0x73, 0xe5, 0x0f, 0x08, 0x4d, 0x06, 0xca, 0x57, 0xdc, 0x17, 0xd9, 0x88, 0x11, 0xd4, 0x6c, 0x5b,
+	0x9d, 0x55, 0x7c, 0x2f, 0xbf, 0x3b, 0xd6, 0x57, 0x2f, 0xb9, 0x97, 0x5f, 0xc0, 0xe7, 0xa0, 0x39,
+	0x7d, 0x91, 0x3f, 0x08, 0x86, 0x0a, 0x6d, 0xe9, 0xbe, 0xde, 0xbb, 0x51, 0xc6, 0x71, 0x30, 0x54,
+	0xb8, 0x11, 0x97, 0x2c, 0xfb, 0x2f, 0x0b, 0x6c, 0x14, 0x1d, 0x03, 0xf7, 0x40, 0x43, 0x57, 0x4b,
+	0xa1, 0x42, 0x8f, 0x8a, 0x55, 0x5c, 0xd7, 0x67, 0xe6, 0xed, 0x10, 0x83, 0xcd, 0x58, 0xf8, 0xa1,
+	0x8a, 0x7c, 0x71, 0x86, 0x96, 0xdb, 0x56, 0xa7, 0xd1, 0xfd, 0xfc, 0xcd, 0xc5, 0xee, 0x61, 0x42,
+	0xd5, 0x60, 0x14, 0x3a, 0x11, 0x4f, 0xdd, 0x42, 0x40, 0x34, 0x08, 0x28, 0x9b, 0x1a, 0xae, 0x9a,
+	0x08, 0x22, 0x9d, 0xee, 0x89, 0xf7, 0xf8, 0xc9, 0xbe, 0x37, 0x0a, 0x4f, 0xc9, 0x24, 0x4f, 0x69,
+	0x57, 0x45, 0xde, 0x19, 0xfc, 0x11, 0x5c, 0x4b, 0xca, 0xbb, 0x1b, 0xad, 0x68, 0xda, 0x2f, 0xde,
+	0x5c, 0xec, 0x3e, 0x59, 0x8c, 0xb6, 0x17, 0x0d, 0x18, 0xcf, 0xb2, 0xa3, 0x6f, 0x5e, 0xf6, 0xf2,
+	0xc6, 0xaf, 0x4f, 0xd9, 0x7a, 0x34, 0xb1, 0x2f, 0x2c, 0x70, 0x77, 0xbe, 0x9d, 0x6f, 0x2b, 0xd0,
+	0x1e, 0xa8, 0x4d, 0xab, 0xf9, 0x7f, 0x07, 0x59, 0x94, 0x38, 0xde, 0x28, 0xaa, 0xdb, 0xfe, 0xcd,
+	0x02, 0x8d, 0x72, 0xdd, 0xdf, 0x56, 0x70, 0x0e, 0x58, 0xd3, 0x1d, 0xaa, 0x23, 0xab, 0x1f, 0x22,
+	0x67, 0xb6, 0xa1, 0x1c, 0xb3, 0xa1, 0x1c, 0xd3, 0x97, 0x06, 0x96, 0xeb, 0xbe, 0x5f, 0x31, 0x0c,
+	0xde, 0xd6, 0x66, 0x7d, 0x18, 0x6d, 0x2f, 0xde, 0x1d, 0xd6, 0xcb, 0x5a, 0xa5, 0xfd, 0xfe, 0x19,
+	0x35, 0x37, 0xa6, 0xed, 0x3f, 0x2c, 0x50, 0x2f, 0x4d, 0xdf, 0x8f, 0xa2, 0xf7, 0x67, 0x70, 0x27,
+	0x9f, 0x55, 0xa5, 0x6d, 0x50, 0xe8, 0xdd, 0x5f, 0x6c, 0x64, 0xbd, 0xb3, 0x1c, 0x9a, 0xb1, 0x28,
+	0x1d, 0xda, 0xbf, 0x5b, 0xe0, 0xe1, 0x4d, 0x43, 0xff, 0xa3, 0x04, 0x75, 0x3a, 0xbf, 0x92, 0x96,
+	0x6f, 0xd8, 0x6f, 0x25, 0x35, 0x55, 0x1b, 0xc8, 0x7e, 0x06, 0xea, 0x25, 0x08, 0xdc, 0x06, 0x6b,
+	0xfa, 0x57, 0x43, 0x6b, 0x5d, 0xc1, 0xc6, 0x80, 0x9f, 0x80, 0x75, 0xe3, 0xa4, 0xb3, 0x57, 0xc3,
+	0x85, 0xd5, 0x7d, 0xf1, 0xea, 0xb2, 0x65, 0xbd, 0xbe, 0x6c, 0x59, 0x7f, 0x5f, 0xb6, 0xac, 0x5f,
+	0xae, 0x5a, 0x4b, 0xaf, 0xaf, 0x5a, 0x4b, 0x7f, 0x5e, 0xb5, 0x96, 0x7e, 0xd8, 0x7f, 0x5f, 0x80,
+	0xe7, 0xb3, 0x9f, 0x32, 0x1d, 0x6b, 0xb8, 0xae, 0x7f, 0xb8, 0x1e, 0xff, 0x13, 0x00, 0x00, 0xff,
+	0xff, 0x86, 0x22, 0x9f, 0xff, 0x25, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -517,6 +651,79 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FinalityHalt != nil {
+		{
+			size, err := m.FinalityHalt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.NextHeightToPrune != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextHeightToPrune))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.FpEpochStats) > 0 {
+		for iNdEx := len(m.FpEpochStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FpEpochStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FpStats) > 0 {
+		for iNdEx := len(m.FpStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FpStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PubRandProofs) > 0 {
+		for iNdEx := len(m.PubRandProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubRandProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SupersededPubRandCommits) > 0 {
+		for iNdEx := len(m.SupersededPubRandCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupersededPubRandCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -732,6 +939,58 @@ func (m *PublicRandomness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PubRandProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubRandProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubRandProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PubRandCommitWithPK) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -972,6 +1231,37 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SupersededPubRandCommits) > 0 {
+		for _, e := range m.SupersededPubRandCommits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PubRandProofs) > 0 {
+		for _, e := range m.PubRandProofs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FpStats) > 0 {
+		for _, e := range m.FpStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FpEpochStats) > 0 {
+		for _, e := range m.FpEpochStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextHeightToPrune != 0 {
+		n += 1 + sovGenesis(uint64(m.NextHeightToPrune))
+	}
+	if m.FinalityHalt != nil {
+		l = m.FinalityHalt.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1015,6 +1305,26 @@ func (m *PublicRandomness) Size() (n int) {
 	return n
 }
 
+func (m *PubRandProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *PubRandCommitWithPK) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1387,6 +1697,197 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededPubRandCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededPubRandCommits = append(m.SupersededPubRandCommits, &PubRandCommitWithPK{})
+			if err := m.SupersededPubRandCommits[len(m.SupersededPubRandCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubRandProofs = append(m.PubRandProofs, &PubRandProof{})
+			if err := m.PubRandProofs[len(m.PubRandProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpStats = append(m.FpStats, &FinalityProviderStats{})
+			if err := m.FpStats[len(m.FpStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpEpochStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpEpochStats = append(m.FpEpochStats, &FinalityProviderEpochStats{})
+			if err := m.FpEpochStats[len(m.FpEpochStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeightToPrune", wireType)
+			}
+			m.NextHeightToPrune = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeightToPrune |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityHalt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalityHalt == nil {
+				m.FinalityHalt = &FinalityHalt{}
+			}
+			if err := m.FinalityHalt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1686,6 +2187,146 @@ func (m *PublicRandomness) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PubRandProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubRandProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubRandProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PubRandCommitWithPK) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
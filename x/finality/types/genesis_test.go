@@ -1,10 +1,12 @@
 package types_test
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/x/finality/types"
 )
 
@@ -28,6 +30,17 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid finality hook contract",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.FinalityHookContracts = []string{"not-an-address"}
+					return params
+				}(),
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -40,3 +53,191 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ValidateCrossReferences(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+
+	fpSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	evidence, err := datagen.GenRandomEvidence(r, fpSK, 10)
+	require.NoError(t, err)
+	fpBTCPK := evidence.FpBtcPk
+
+	// genGenesis returns a consistent genesis state of a finality provider
+	genGenesis := func() *types.GenesisState {
+		return &types.GenesisState{
+			Params: types.DefaultParams(),
+			IndexedBlocks: []*types.IndexedBlock{{
+				Height:  10,
+				AppHash: evidence.CanonicalAppHash,
+			}},
+			Evidences: []*types.Evidence{evidence},
+			VoteSigs: []*types.VoteSig{{
+				BlockHeight: 10,
+				FpBtcPk:     fpBTCPK,
+				FinalitySig: evidence.CanonicalFinalitySig,
+			}},
+			PublicRandomness: []*types.PublicRandomness{{
+				BlockHeight: 10,
+				FpBtcPk:     fpBTCPK,
+				PubRand:     evidence.PubRand,
+			}},
+			PubRandCommit: []*types.PubRandCommitWithPK{{
+				FpBtcPk: fpBTCPK,
+				PubRandCommit: &types.PubRandCommit{
+					StartHeight: 10,
+					NumPubRand:  100,
+					Commitment:  datagen.GenRandomByteArray(r, 32),
+				},
+			}},
+			SigningInfos: []types.SigningInfo{{
+				FpBtcPk:       fpBTCPK,
+				FpSigningInfo: types.NewFinalityProviderSigningInfo(fpBTCPK, 1, 1),
+			}},
+			MissedBlocks: []types.FinalityProviderMissedBlocks{{
+				FpBtcPk:      fpBTCPK,
+				MissedBlocks: []types.MissedBlock{{Index: 1, Missed: true}},
+			}},
+		}
+	}
+
+	tests := []struct {
+		desc   string
+		mutate func(gs *types.GenesisState)
+		valid  bool
+	}{
+		{
+			desc:   "consistent genesis state",
+			mutate: func(gs *types.GenesisState) {},
+			valid:  true,
+		},
+		{
+			desc: "duplicate indexed block",
+			mutate: func(gs *types.GenesisState) {
+				gs.IndexedBlocks = append(gs.IndexedBlocks, gs.IndexedBlocks[0])
+			},
+			valid: false,
+		},
+		{
+			desc: "next height to prune before the indexed data",
+			mutate: func(gs *types.GenesisState) {
+				gs.NextHeightToPrune = 10
+			},
+			valid: true,
+		},
+		{
+			desc: "indexed data at a pruned height",
+			mutate: func(gs *types.GenesisState) {
+				gs.NextHeightToPrune = 11
+			},
+			valid: false,
+		},
+		{
+			desc: "finality halted at the indexed block",
+			mutate: func(gs *types.GenesisState) {
+				gs.FinalityHalt = &types.FinalityHalt{StalledHeight: 10, HaltedAtHeight: 20}
+			},
+			valid: true,
+		},
+		{
+			desc: "finality halt detected at the stalled height",
+			mutate: func(gs *types.GenesisState) {
+				gs.FinalityHalt = &types.FinalityHalt{StalledHeight: 10, HaltedAtHeight: 10}
+			},
+			valid: false,
+		},
+		{
+			desc: "finality halted at a finalized block",
+			mutate: func(gs *types.GenesisState) {
+				gs.IndexedBlocks[0].Finalized = true
+				gs.FinalityHalt = &types.FinalityHalt{StalledHeight: 10, HaltedAtHeight: 20}
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate vote",
+			mutate: func(gs *types.GenesisState) {
+				gs.VoteSigs = append(gs.VoteSigs, gs.VoteSigs[0])
+			},
+			valid: false,
+		},
+		{
+			desc: "malformed evidence",
+			mutate: func(gs *types.GenesisState) {
+				e := *evidence
+				e.ForkAppHash = nil
+				gs.Evidences = []*types.Evidence{&e}
+			},
+			valid: false,
+		},
+		{
+			desc: "overlapping public randomness commitments",
+			mutate: func(gs *types.GenesisState) {
+				gs.PubRandCommit = append(gs.PubRandCommit, &types.PubRandCommitWithPK{
+					FpBtcPk: fpBTCPK,
+					PubRandCommit: &types.PubRandCommit{
+						StartHeight: 109,
+						NumPubRand:  100,
+						Commitment:  datagen.GenRandomByteArray(r, 32),
+					},
+				})
+			},
+			valid: false,
+		},
+		{
+			desc: "active public randomness commitment marked as superseded",
+			mutate: func(gs *types.GenesisState) {
+				gs.SupersededPubRandCommits = append(gs.SupersededPubRandCommits, gs.PubRandCommit[0])
+			},
+			valid: false,
+		},
+		{
+			desc: "missed blocks without signing info",
+			mutate: func(gs *types.GenesisState) {
+				gs.SigningInfos = nil
+			},
+			valid: false,
+		},
+		{
+			desc: "missed block out of the signed blocks window",
+			mutate: func(gs *types.GenesisState) {
+				gs.MissedBlocks[0].MissedBlocks[0].Index = gs.Params.SignedBlocksWindow
+			},
+			valid: false,
+		},
+		{
+			desc: "finality provider statistics with more voted blocks than expected votes",
+			mutate: func(gs *types.GenesisState) {
+				gs.FpStats = append(gs.FpStats, &types.FinalityProviderStats{
+					FpBtcPk:       gs.SigningInfos[0].FpBtcPk,
+					ExpectedVotes: 1,
+					VotedBlocks:   2,
+				})
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate finality provider epoch statistics",
+			mutate: func(gs *types.GenesisState) {
+				stats := &types.FinalityProviderEpochStats{
+					FpBtcPk:     gs.SigningInfos[0].FpBtcPk,
+					EpochNumber: 1,
+				}
+				gs.FpEpochStats = append(gs.FpEpochStats, stats, stats)
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			gs := genGenesis()
+			tc.mutate(gs)
+			err := gs.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
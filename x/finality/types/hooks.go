@@ -24,3 +24,13 @@ func (h MultiFinalityHooks) AfterSluggishFinalityProviderDetected(ctx context.Co
 
 	return nil
 }
+
+func (h MultiFinalityHooks) AfterBlockFinalized(ctx context.Context, height uint64) error {
+	for i := range h {
+		if err := h[i].AfterBlockFinalized(ctx, height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -41,15 +41,24 @@ const (
 )
 
 var (
-	BlockKey                                   = []byte{0x01}             // key prefix for blocks
-	VoteKey                                    = []byte{0x02}             // key prefix for votes
-	PubRandKey                                 = []byte{0x03}             // key prefix for public randomness
-	PubRandCommitKey                           = []byte{0x04}             // key prefix for commitment of public randomness
-	ParamsKey                                  = []byte{0x05}             // key prefix for the parameters
-	EvidenceKey                                = []byte{0x06}             // key prefix for evidences
-	NextHeightToFinalizeKey                    = []byte{0x07}             // key prefix for next height to finalise
-	FinalityProviderSigningInfoKeyPrefix       = collections.NewPrefix(8) // key prefix for signing info
-	FinalityProviderMissedBlockBitmapKeyPrefix = collections.NewPrefix(9) // key prefix for missed block bitmap
+	BlockKey                                   = []byte{0x01}              // key prefix for blocks
+	VoteKey                                    = []byte{0x02}              // key prefix for votes
+	PubRandKey                                 = []byte{0x03}              // key prefix for public randomness
+	PubRandCommitKey                           = []byte{0x04}              // key prefix for commitment of public randomness
+	ParamsKey                                  = []byte{0x05}              // key prefix for the parameters
+	EvidenceKey                                = []byte{0x06}              // key prefix for evidences
+	NextHeightToFinalizeKey                    = []byte{0x07}              // key prefix for next height to finalise
+	FinalityProviderSigningInfoKeyPrefix       = collections.NewPrefix(8)  // key prefix for signing info
+	FinalityProviderMissedBlockBitmapKeyPrefix = collections.NewPrefix(9)  // key prefix for missed block bitmap
+	SupersededPubRandCommitKey                 = []byte{0x0A}              // key prefix for superseded commitments of public randomness
+	PubRandProofKey                            = []byte{0x0B}              // key prefix for inclusion proofs of public randomness
+	NextHeightToPruneKey                       = []byte{0x0C}              // key prefix for next height to prune
+	FinalityProviderStatsKeyPrefix             = collections.NewPrefix(13) // key prefix for cumulative stats of finality providers
+	FinalityProviderEpochStatsKeyPrefix        = collections.NewPrefix(14) // key prefix for per-epoch stats of finality providers
+	BlockHeightByBlockHashKey                  = []byte{0x0F}              // key prefix for heights of blocks indexed by CometBFT block hashes
+	BlockHeightByAppHashKey                    = []byte{0x10}              // key prefix for heights of blocks indexed by AppHashes
+	LastFinalizedHeightKey                     = []byte{0x11}              // key prefix for the height of the last finalized block
+	FinalityHaltKey                            = []byte{0x12}              // key prefix for the finality halt record
 )
 
 // FinalityProviderSigningInfoKey - stored by finality provider public key in BIP340
@@ -7,8 +7,10 @@ import (
 
 // performance oriented metrics measuring the execution time of each message
 const (
-	MetricsKeyCommitPubRandList = "commit_pub_rand_list"
-	MetricsKeyAddFinalitySig    = "add_finality_sig"
+	MetricsKeyCommitPubRandList      = "commit_pub_rand_list"
+	MetricsKeyAddFinalitySig         = "add_finality_sig"
+	MetricsKeyAddFinalitySigs        = "add_finality_sigs"
+	MetricsKeySubmitFinalityEvidence = "submit_finality_evidence"
 )
 
 const (
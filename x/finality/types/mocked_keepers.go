@@ -10,6 +10,9 @@ import (
 
 	types "github.com/babylonchain/babylon/types"
 	types0 "github.com/babylonchain/babylon/x/btcstaking/types"
+	types1 "github.com/babylonchain/babylon/x/epoching/types"
+	btcec "github.com/btcsuite/btcd/btcec/v2"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -151,30 +154,44 @@ func (mr *MockBTCStakingKeeperMockRecorder) HasFinalityProvider(ctx, fpBTCPK int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).HasFinalityProvider), ctx, fpBTCPK)
 }
 
-// RemoveVotingPowerDistCache mocks base method.
-func (m *MockBTCStakingKeeper) RemoveVotingPowerDistCache(ctx context.Context, height uint64) {
+// JailFinalityProvider mocks base method.
+func (m *MockBTCStakingKeeper) JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "RemoveVotingPowerDistCache", ctx, height)
+	ret := m.ctrl.Call(m, "JailFinalityProvider", ctx, fpBTCPK)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RemoveVotingPowerDistCache indicates an expected call of RemoveVotingPowerDistCache.
-func (mr *MockBTCStakingKeeperMockRecorder) RemoveVotingPowerDistCache(ctx, height interface{}) *gomock.Call {
+// JailFinalityProvider indicates an expected call of JailFinalityProvider.
+func (mr *MockBTCStakingKeeperMockRecorder) JailFinalityProvider(ctx, fpBTCPK interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVotingPowerDistCache", reflect.TypeOf((*MockBTCStakingKeeper)(nil).RemoveVotingPowerDistCache), ctx, height)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).JailFinalityProvider), ctx, fpBTCPK)
 }
 
-// RevertSluggishFinalityProvider mocks base method.
-func (m *MockBTCStakingKeeper) RevertSluggishFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+// RecordSignedSlashingTxs mocks base method.
+func (m *MockBTCStakingKeeper) RecordSignedSlashingTxs(ctx context.Context, fpSK *btcec.PrivateKey) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevertSluggishFinalityProvider", ctx, fpBTCPK)
+	ret := m.ctrl.Call(m, "RecordSignedSlashingTxs", ctx, fpSK)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RevertSluggishFinalityProvider indicates an expected call of RevertSluggishFinalityProvider.
-func (mr *MockBTCStakingKeeperMockRecorder) RevertSluggishFinalityProvider(ctx, fpBTCPK interface{}) *gomock.Call {
+// RecordSignedSlashingTxs indicates an expected call of RecordSignedSlashingTxs.
+func (mr *MockBTCStakingKeeperMockRecorder) RecordSignedSlashingTxs(ctx, fpSK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSignedSlashingTxs", reflect.TypeOf((*MockBTCStakingKeeper)(nil).RecordSignedSlashingTxs), ctx, fpSK)
+}
+
+// RemoveVotingPowerDistCache mocks base method.
+func (m *MockBTCStakingKeeper) RemoveVotingPowerDistCache(ctx context.Context, height uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveVotingPowerDistCache", ctx, height)
+}
+
+// RemoveVotingPowerDistCache indicates an expected call of RemoveVotingPowerDistCache.
+func (mr *MockBTCStakingKeeperMockRecorder) RemoveVotingPowerDistCache(ctx, height interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertSluggishFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).RevertSluggishFinalityProvider), ctx, fpBTCPK)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVotingPowerDistCache", reflect.TypeOf((*MockBTCStakingKeeper)(nil).RemoveVotingPowerDistCache), ctx, height)
 }
 
 // SlashFinalityProvider mocks base method.
@@ -191,6 +208,20 @@ func (mr *MockBTCStakingKeeperMockRecorder) SlashFinalityProvider(ctx, fpBTCPK i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).SlashFinalityProvider), ctx, fpBTCPK)
 }
 
+// UnjailFinalityProvider mocks base method.
+func (m *MockBTCStakingKeeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnjailFinalityProvider", ctx, fpBTCPK)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnjailFinalityProvider indicates an expected call of UnjailFinalityProvider.
+func (mr *MockBTCStakingKeeperMockRecorder) UnjailFinalityProvider(ctx, fpBTCPK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnjailFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).UnjailFinalityProvider), ctx, fpBTCPK)
+}
+
 // MockIncentiveKeeper is a mock of IncentiveKeeper interface.
 type MockIncentiveKeeper struct {
 	ctrl     *gomock.Controller
@@ -226,6 +257,70 @@ func (mr *MockIncentiveKeeperMockRecorder) RewardBTCStaking(ctx, height, filtere
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewardBTCStaking", reflect.TypeOf((*MockIncentiveKeeper)(nil).RewardBTCStaking), ctx, height, filteredDc)
 }
 
+// RewardFinalityEvidenceReporter mocks base method.
+func (m *MockIncentiveKeeper) RewardFinalityEvidenceReporter(ctx context.Context, reporter types2.AccAddress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RewardFinalityEvidenceReporter", ctx, reporter)
+}
+
+// RewardFinalityEvidenceReporter indicates an expected call of RewardFinalityEvidenceReporter.
+func (mr *MockIncentiveKeeperMockRecorder) RewardFinalityEvidenceReporter(ctx, reporter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewardFinalityEvidenceReporter", reflect.TypeOf((*MockIncentiveKeeper)(nil).RewardFinalityEvidenceReporter), ctx, reporter)
+}
+
+// MockEpochingKeeper is a mock of EpochingKeeper interface.
+type MockEpochingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockEpochingKeeperMockRecorder
+}
+
+// MockEpochingKeeperMockRecorder is the mock recorder for MockEpochingKeeper.
+type MockEpochingKeeperMockRecorder struct {
+	mock *MockEpochingKeeper
+}
+
+// NewMockEpochingKeeper creates a new mock instance.
+func NewMockEpochingKeeper(ctrl *gomock.Controller) *MockEpochingKeeper {
+	mock := &MockEpochingKeeper{ctrl: ctrl}
+	mock.recorder = &MockEpochingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEpochingKeeper) EXPECT() *MockEpochingKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetEpoch mocks base method.
+func (m *MockEpochingKeeper) GetEpoch(ctx context.Context) *types1.Epoch {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEpoch", ctx)
+	ret0, _ := ret[0].(*types1.Epoch)
+	return ret0
+}
+
+// GetEpoch indicates an expected call of GetEpoch.
+func (mr *MockEpochingKeeperMockRecorder) GetEpoch(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetEpoch), ctx)
+}
+
+// GetHistoricalEpoch mocks base method.
+func (m *MockEpochingKeeper) GetHistoricalEpoch(ctx context.Context, epochNumber uint64) (*types1.Epoch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoricalEpoch", ctx, epochNumber)
+	ret0, _ := ret[0].(*types1.Epoch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoricalEpoch indicates an expected call of GetHistoricalEpoch.
+func (mr *MockEpochingKeeperMockRecorder) GetHistoricalEpoch(ctx, epochNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoricalEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetHistoricalEpoch), ctx, epochNumber)
+}
+
 // MockBtcStakingHooks is a mock of BtcStakingHooks interface.
 type MockBtcStakingHooks struct {
 	ctrl     *gomock.Controller
@@ -249,6 +344,62 @@ func (m *MockBtcStakingHooks) EXPECT() *MockBtcStakingHooksMockRecorder {
 	return m.recorder
 }
 
+// AfterBTCDelegationActivated mocks base method.
+func (m *MockBtcStakingHooks) AfterBTCDelegationActivated(ctx context.Context, btcDel *types0.BTCDelegation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterBTCDelegationActivated", ctx, btcDel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterBTCDelegationActivated indicates an expected call of AfterBTCDelegationActivated.
+func (mr *MockBtcStakingHooksMockRecorder) AfterBTCDelegationActivated(ctx, btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBTCDelegationActivated", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterBTCDelegationActivated), ctx, btcDel)
+}
+
+// AfterBTCDelegationExpired mocks base method.
+func (m *MockBtcStakingHooks) AfterBTCDelegationExpired(ctx context.Context, btcDel *types0.BTCDelegation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterBTCDelegationExpired", ctx, btcDel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterBTCDelegationExpired indicates an expected call of AfterBTCDelegationExpired.
+func (mr *MockBtcStakingHooksMockRecorder) AfterBTCDelegationExpired(ctx, btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBTCDelegationExpired", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterBTCDelegationExpired), ctx, btcDel)
+}
+
+// AfterBTCDelegationSlashed mocks base method.
+func (m *MockBtcStakingHooks) AfterBTCDelegationSlashed(ctx context.Context, fpPk *types.BIP340PubKey, btcDel *types0.BTCDelegation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterBTCDelegationSlashed", ctx, fpPk, btcDel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterBTCDelegationSlashed indicates an expected call of AfterBTCDelegationSlashed.
+func (mr *MockBtcStakingHooksMockRecorder) AfterBTCDelegationSlashed(ctx, fpPk, btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBTCDelegationSlashed", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterBTCDelegationSlashed), ctx, fpPk, btcDel)
+}
+
+// AfterBTCDelegationUnbonded mocks base method.
+func (m *MockBtcStakingHooks) AfterBTCDelegationUnbonded(ctx context.Context, btcDel *types0.BTCDelegation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterBTCDelegationUnbonded", ctx, btcDel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterBTCDelegationUnbonded indicates an expected call of AfterBTCDelegationUnbonded.
+func (mr *MockBtcStakingHooksMockRecorder) AfterBTCDelegationUnbonded(ctx, btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBTCDelegationUnbonded", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterBTCDelegationUnbonded), ctx, btcDel)
+}
+
 // AfterFinalityProviderActivated mocks base method.
 func (m *MockBtcStakingHooks) AfterFinalityProviderActivated(ctx context.Context, btcPk *types.BIP340PubKey) error {
 	m.ctrl.T.Helper()
@@ -286,6 +437,20 @@ func (m *MockFinalityHooks) EXPECT() *MockFinalityHooksMockRecorder {
 	return m.recorder
 }
 
+// AfterBlockFinalized mocks base method.
+func (m *MockFinalityHooks) AfterBlockFinalized(ctx context.Context, height uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterBlockFinalized", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterBlockFinalized indicates an expected call of AfterBlockFinalized.
+func (mr *MockFinalityHooksMockRecorder) AfterBlockFinalized(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterBlockFinalized", reflect.TypeOf((*MockFinalityHooks)(nil).AfterBlockFinalized), ctx, height)
+}
+
 // AfterSluggishFinalityProviderDetected mocks base method.
 func (m *MockFinalityHooks) AfterSluggishFinalityProviderDetected(ctx context.Context, btcPk *types.BIP340PubKey) error {
 	m.ctrl.T.Helper()
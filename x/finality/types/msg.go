@@ -1,11 +1,13 @@
 package types
 
 import (
+	"bytes"
 	fmt "fmt"
 
 	"github.com/babylonchain/babylon/crypto/eots"
-	"github.com/cometbft/cometbft/crypto/merkle"
-	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/babylonchain/babylon/crypto/pubrand"
+	bbn "github.com/babylonchain/babylon/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,65 +16,155 @@ var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgAddFinalitySig{}
 	_ sdk.Msg = &MsgCommitPubRandList{}
+	_ sdk.Msg = &MsgUnjailFinalityProvider{}
+	_ sdk.Msg = &MsgAddFinalitySigs{}
+	_ sdk.Msg = &MsgSubmitFinalityEvidence{}
+	_ sdk.Msg = &MsgSkipStalledHeights{}
 )
 
 func (m *MsgAddFinalitySig) MsgToSign() []byte {
 	return msgToSignForVote(m.BlockHeight, m.BlockAppHash)
 }
 
+// ToMsgAddFinalitySig converts the given entry of MsgAddFinalitySigs to
+// a MsgAddFinalitySig
+func (m *MsgAddFinalitySigs) ToMsgAddFinalitySig(entry *FinalitySigEntry) *MsgAddFinalitySig {
+	return &MsgAddFinalitySig{
+		Signer:       m.Signer,
+		FpBtcPk:      m.FpBtcPk,
+		BlockHeight:  entry.BlockHeight,
+		PubRand:      entry.PubRand,
+		Proof:        entry.Proof,
+		BlockAppHash: entry.BlockAppHash,
+		FinalitySig:  entry.FinalitySig,
+	}
+}
+
 // VerifyFinalitySig verifies the finality signature message w.r.t. the
 // public randomness commitment. The verification includes
 // - verifying the proof of inclusion of the given public randomness
 // - verifying the finality signature w.r.t. the given block height/hash
-func VerifyFinalitySig(m *MsgAddFinalitySig, prCommit *PubRandCommit) error {
-	// verify the index of the public randomness
-	heightOfProof := prCommit.StartHeight + uint64(m.Proof.Index)
-	if m.BlockHeight != heightOfProof {
-		return ErrInvalidFinalitySig.Wrapf("the inclusion proof (for height %d) does not correspond to the given height (%d) in the message", heightOfProof, m.BlockHeight)
-	}
-	// verify the total number of randomness is same as in the commit
-	if uint64(m.Proof.Total) != prCommit.NumPubRand {
-		return ErrInvalidFinalitySig.Wrapf("the total number of public randomnesses in the proof (%d) does not match the number of public randomnesses committed (%d)", m.Proof.Total, prCommit.NumPubRand)
-	}
-	// verify the proof of inclusion for this public randomness
-	unwrappedProof, err := merkle.ProofFromProto(m.Proof)
-	if err != nil {
-		return ErrInvalidFinalitySig.Wrapf("failed to unwrap proof: %v", err)
-	}
-	if err := unwrappedProof.Verify(prCommit.Commitment, *m.PubRand); err != nil {
-		return ErrInvalidFinalitySig.Wrapf("the inclusion proof of the public randomness is invalid: %v", err)
+// The given cache, if not nil, is used for parsing the finality provider's
+// BTC PK.
+func VerifyFinalitySig(m *MsgAddFinalitySig, prCommit *PubRandCommit, cache *eots.Cache) error {
+	if err := VerifyFinalitySigInclusion(m, prCommit); err != nil {
+		return err
 	}
 
 	// public randomness is good, verify finality signature
 	msgToSign := m.MsgToSign()
-	pk, err := m.FpBtcPk.ToBTCPK()
+	pk, err := parseFpBtcPK(m.FpBtcPk, cache)
 	if err != nil {
 		return err
 	}
 	return eots.Verify(pk, m.PubRand.ToFieldVal(), msgToSign, m.FinalitySig.ToModNScalar())
 }
 
-// HashToSign returns a 32-byte hash of (start_height || num_pub_rand || commitment)
-// The signature in MsgCommitPubRandList will be on this hash
-func (m *MsgCommitPubRandList) HashToSign() ([]byte, error) {
-	hasher := tmhash.New()
-	if _, err := hasher.Write(sdk.Uint64ToBigEndian(m.StartHeight)); err != nil {
-		return nil, err
+// VerifyFinalitySigInclusion verifies the proof of inclusion of the public
+// randomness in the finality signature message w.r.t. the public randomness
+// commitment, without verifying the finality signature itself
+func VerifyFinalitySigInclusion(m *MsgAddFinalitySig, prCommit *PubRandCommit) error {
+	if err := verifyPubRandInclusion(prCommit, m.BlockHeight, m.PubRand, m.Proof); err != nil {
+		return ErrInvalidFinalitySig.Wrap(err.Error())
+	}
+	return nil
+}
+
+// VerifyEOTSSigs verifies the EOTS signatures in the given finality signature
+// messages at once via batch verification. It returns nil only if all the
+// signatures are valid, without verifying the public randomness inclusion
+// proofs. An error does not indicate which signatures are invalid, which can
+// be identified via VerifyFinalitySig. The given cache, if not nil, is used
+// for parsing the finality providers' BTC PKs and lifting the public
+// randomness.
+func VerifyEOTSSigs(msgs []*MsgAddFinalitySig, cache *eots.Cache) error {
+	bv := eots.NewBatchVerifier(cache)
+	for _, m := range msgs {
+		if m.FpBtcPk == nil || m.PubRand == nil || m.FinalitySig == nil {
+			return ErrInvalidFinalitySig.Wrap("empty field in finality signature")
+		}
+		pk, err := parseFpBtcPK(m.FpBtcPk, cache)
+		if err != nil {
+			return err
+		}
+		bv.Add(pk, m.PubRand.ToFieldVal(), m.MsgToSign(), m.FinalitySig.ToModNScalar())
 	}
-	if _, err := hasher.Write(sdk.Uint64ToBigEndian(m.NumPubRand)); err != nil {
-		return nil, err
+	if err := bv.Verify(); err != nil {
+		return ErrInvalidFinalitySig.Wrap(err.Error())
 	}
-	if _, err := hasher.Write(m.Commitment); err != nil {
-		return nil, err
+	return nil
+}
+
+// parseFpBtcPK parses the given finality provider BTC PK, via the given cache
+// if it is not nil
+func parseFpBtcPK(fpBtcPK *bbn.BIP340PubKey, cache *eots.Cache) (*eots.PublicKey, error) {
+	if cache == nil {
+		return fpBtcPK.ToBTCPK()
 	}
-	return hasher.Sum(nil), nil
+	return cache.ParsePubKey(*fpBtcPK)
 }
 
-func (m *MsgCommitPubRandList) VerifySig() error {
-	msgHash, err := m.HashToSign()
+// VerifyFinalityEvidence verifies the evidence in the message w.r.t. the
+// public randomness commitment. The verification includes
+// - verifying the proof of inclusion of the public randomness in the evidence
+// - verifying the two finality signatures over the two conflicting blocks
+func VerifyFinalityEvidence(m *MsgSubmitFinalityEvidence, prCommit *PubRandCommit) error {
+	e := m.Evidence
+	if e == nil {
+		return ErrInvalidFinalityEvidence.Wrap("empty evidence")
+	}
+	if err := e.ValidateBasic(); err != nil {
+		return ErrInvalidFinalityEvidence.Wrap(err.Error())
+	}
+	if !e.IsSlashable() {
+		return ErrInvalidFinalityEvidence.Wrap("empty CanonicalFinalitySig")
+	}
+	if bytes.Equal(e.CanonicalAppHash, e.ForkAppHash) {
+		return ErrInvalidFinalityEvidence.Wrap("the two blocks in the evidence are not conflicting")
+	}
+	if err := verifyPubRandInclusion(prCommit, e.BlockHeight, e.PubRand, m.Proof); err != nil {
+		return ErrInvalidFinalityEvidence.Wrap(err.Error())
+	}
+
+	// public randomness is good, verify the two finality signatures
+	pk, err := e.FpBtcPk.ToBTCPK()
 	if err != nil {
 		return err
 	}
+	if err := eots.Verify(pk, e.PubRand.ToFieldVal(), e.CanonicalMsgToSign(), e.CanonicalFinalitySig.ToModNScalar()); err != nil {
+		return ErrInvalidFinalityEvidence.Wrapf("invalid finality signature over the canonical block: %v", err)
+	}
+	if err := eots.Verify(pk, e.PubRand.ToFieldVal(), e.ForkMsgToSign(), e.ForkFinalitySig.ToModNScalar()); err != nil {
+		return ErrInvalidFinalityEvidence.Wrapf("invalid finality signature over the fork block: %v", err)
+	}
+	return nil
+}
+
+// VerifyPubRandInclusion verifies that the public randomness in the given
+// evidence is committed in the given public randomness commitment
+func VerifyPubRandInclusion(m *MsgSubmitFinalityEvidence, prCommit *PubRandCommit) error {
+	if m.Evidence == nil {
+		return ErrInvalidFinalityEvidence.Wrap("empty evidence")
+	}
+	return verifyPubRandInclusion(prCommit, m.Evidence.BlockHeight, m.Evidence.PubRand, m.Proof)
+}
+
+// verifyPubRandInclusion verifies that the given public randomness at the
+// given height is committed in the public randomness commitment
+func verifyPubRandInclusion(prCommit *PubRandCommit, height uint64, pubRand *bbn.SchnorrPubRand, proof *cmtcrypto.Proof) error {
+	if pubRand == nil {
+		return fmt.Errorf("empty public randomness")
+	}
+	return pubrand.VerifyInclusion(prCommit.Commitment, prCommit.StartHeight, prCommit.NumPubRand, height, *pubRand, proof)
+}
+
+// HashToSign returns a 32-byte hash of (start_height || num_pub_rand || commitment)
+// The signature in MsgCommitPubRandList will be on this hash
+func (m *MsgCommitPubRandList) HashToSign() ([]byte, error) {
+	return pubrand.HashToSign(m.StartHeight, m.NumPubRand, m.Commitment), nil
+}
+
+func (m *MsgCommitPubRandList) VerifySig() error {
 	pk, err := m.FpBtcPk.ToBTCPK()
 	if err != nil {
 		return err
@@ -84,8 +176,5 @@ func (m *MsgCommitPubRandList) VerifySig() error {
 	if err != nil {
 		return err
 	}
-	if !schnorrSig.Verify(msgHash, pk) {
-		return fmt.Errorf("failed to verify signature")
-	}
-	return nil
+	return pubrand.VerifyCommitSig(pk, m.StartHeight, m.NumPubRand, m.Commitment, schnorrSig)
 }
@@ -37,9 +37,55 @@ func FuzzMsgAddFinalitySig(f *testing.F) {
 			Commitment:  randListInfo.Commitment,
 		}
 
-		// verify the finality signature message
-		err = types.VerifyFinalitySig(msg, prCommit)
+		// verify the finality signature message, with and without a cache
+		err = types.VerifyFinalitySig(msg, prCommit, nil)
 		require.NoError(t, err)
+		err = types.VerifyFinalitySig(msg, prCommit, eots.NewCache(10))
+		require.NoError(t, err)
+	})
+}
+
+func FuzzVerifyEOTSSigs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		sk, err := eots.KeyGen(r)
+		require.NoError(t, err)
+
+		numPubRand := uint64(100)
+		randListInfo, err := datagen.GenRandomPubRandList(r, numPubRand)
+		require.NoError(t, err)
+
+		// generate votes over a random number of blocks
+		startHeight := datagen.RandomInt(r, 10)
+		numVotes := datagen.RandomInt(r, 10) + 2
+		signer := datagen.GenRandomAccount().Address
+		msgs := make([]*types.MsgAddFinalitySig, 0, numVotes)
+		for i := uint64(0); i < numVotes; i++ {
+			msg, err := datagen.NewMsgAddFinalitySig(signer, sk, startHeight, startHeight+i, randListInfo, datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			msgs = append(msgs, msg)
+		}
+
+		// the batch of valid votes is valid, with and without a cache
+		cache := eots.NewCache(10)
+		err = types.VerifyEOTSSigs(msgs, nil)
+		require.NoError(t, err)
+		err = types.VerifyEOTSSigs(msgs, cache)
+		require.NoError(t, err)
+
+		// the batch with a vote over another block is invalid
+		invalidIdx := datagen.RandomInt(r, int(numVotes))
+		msgs[invalidIdx].BlockAppHash = datagen.GenRandomByteArray(r, 32)
+		err = types.VerifyEOTSSigs(msgs, cache)
+		require.ErrorIs(t, err, types.ErrInvalidFinalitySig)
+
+		// the batch with a vote without signature is invalid
+		msgs[invalidIdx].FinalitySig = nil
+		err = types.VerifyEOTSSigs(msgs, cache)
+		require.ErrorIs(t, err, types.ErrInvalidFinalitySig)
 	})
 }
 
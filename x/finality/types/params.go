@@ -2,8 +2,10 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -13,6 +15,12 @@ const (
 	DefaultSignedBlocksWindow = int64(100)
 	DefaultMinPubRand         = 100
 	DefaultFinalitySigTimeout = 3
+	DefaultJailDuration       = 24 * time.Hour
+	// DefaultRetentionWindow is roughly 1 week with 10s block time
+	DefaultRetentionWindow          = uint64(60480)
+	DefaultMaxPrunedHeightsPerBlock = uint64(10)
+	// DefaultMaxStalledBlocks is roughly 1 hour with 10s block time
+	DefaultMaxStalledBlocks = uint64(360)
 )
 
 var (
@@ -28,6 +36,11 @@ func DefaultParams() Params {
 		SignedBlocksWindow: DefaultSignedBlocksWindow,
 		MinSignedPerWindow: DefaultMinSignedPerWindow,
 		MinPubRand:         DefaultMinPubRand,
+		JailDuration:       DefaultJailDuration,
+
+		RetentionWindow:          DefaultRetentionWindow,
+		MaxPrunedHeightsPerBlock: DefaultMaxPrunedHeightsPerBlock,
+		MaxStalledBlocks:         DefaultMaxStalledBlocks,
 	}
 }
 
@@ -67,6 +80,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateJailDuration(p.JailDuration); err != nil {
+		return err
+	}
+
+	if err := validateRetentionWindow(p.RetentionWindow, p.FinalitySigTimeout); err != nil {
+		return err
+	}
+
+	if err := validateMaxPrunedHeightsPerBlock(p.MaxPrunedHeightsPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateFinalityHookContracts(p.FinalityHookContracts); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -115,6 +144,59 @@ func validateMinSignedPerWindow(i interface{}) error {
 	return nil
 }
 
+func validateJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+// validateRetentionWindow ensures the retained heights cover the heights whose
+// finality votes are still examined for liveness, unless pruning is disabled
+func validateRetentionWindow(retentionWindow uint64, finalitySigTimeout int64) error {
+	if retentionWindow != 0 && retentionWindow <= uint64(finalitySigTimeout) {
+		return fmt.Errorf("retention window (%d) must be larger than finality vote timeout (%d)", retentionWindow, finalitySigTimeout)
+	}
+
+	return nil
+}
+
+func validateMaxPrunedHeightsPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max pruned heights per block must be positive")
+	}
+
+	return nil
+}
+
+// validateFinalityHookContracts ensures the finality hook contracts are valid
+// and distinct bech32 addresses
+func validateFinalityHookContracts(contracts []string) error {
+	seen := make(map[string]struct{}, len(contracts))
+	for _, contract := range contracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid finality hook contract address %s: %w", contract, err)
+		}
+		if _, ok := seen[contract]; ok {
+			return fmt.Errorf("duplicate finality hook contract address %s", contract)
+		}
+		seen[contract] = struct{}{}
+	}
+
+	return nil
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
@@ -10,15 +10,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +43,23 @@ type Params struct {
 	// min_pub_rand is the minimum number of public randomness each
 	// message should commit
 	MinPubRand uint64 `protobuf:"varint,4,opt,name=min_pub_rand,json=minPubRand,proto3" json:"min_pub_rand,omitempty"`
+	// jail_duration is the minimum period of time that a finality provider remains jailed
+	// after being detected sluggish
+	JailDuration time.Duration `protobuf:"bytes,5,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+	// retention_window is the number of finalized heights whose finality votes,
+	// public randomness and indexed blocks are retained before being pruned.
+	// Zero means never pruning.
+	RetentionWindow uint64 `protobuf:"varint,6,opt,name=retention_window,json=retentionWindow,proto3" json:"retention_window,omitempty"`
+	// max_pruned_heights_per_block is the maximum number of heights that are
+	// pruned at each block
+	MaxPrunedHeightsPerBlock uint64 `protobuf:"varint,7,opt,name=max_pruned_heights_per_block,json=maxPrunedHeightsPerBlock,proto3" json:"max_pruned_heights_per_block,omitempty"`
+	// finality_hook_contracts is the list of bech32 addresses of CosmWasm
+	// contracts that are notified via sudo calls upon each finalized block
+	FinalityHookContracts []string `protobuf:"bytes,8,rep,name=finality_hook_contracts,json=finalityHookContracts,proto3" json:"finality_hook_contracts,omitempty"`
+	// max_stalled_blocks is the number of blocks that the finality may stall at
+	// a block without quorum before the finality is considered halted.
+	// Zero means never considering the finality halted.
+	MaxStalledBlocks uint64 `protobuf:"varint,9,opt,name=max_stalled_blocks,json=maxStalledBlocks,proto3" json:"max_stalled_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -94,6 +115,41 @@ func (m *Params) GetMinPubRand() uint64 {
 	return 0
 }
 
+func (m *Params) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetRetentionWindow() uint64 {
+	if m != nil {
+		return m.RetentionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunedHeightsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedHeightsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetFinalityHookContracts() []string {
+	if m != nil {
+		return m.FinalityHookContracts
+	}
+	return nil
+}
+
+func (m *Params) GetMaxStalledBlocks() uint64 {
+	if m != nil {
+		return m.MaxStalledBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.finality.v1.Params")
 }
@@ -101,30 +157,40 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/params.proto", fileDescriptor_25539c9a61c72ee9) }
 
 var fileDescriptor_25539c9a61c72ee9 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0x31, 0x4b, 0xfb, 0x40,
-	0x18, 0xc6, 0x73, 0xff, 0x96, 0x0e, 0xa1, 0xcb, 0x3f, 0x56, 0xa8, 0x15, 0xd2, 0xe0, 0x54, 0x04,
-	0x73, 0x2d, 0x82, 0x83, 0x63, 0xe9, 0x24, 0x0e, 0x25, 0x15, 0x04, 0x97, 0x70, 0x49, 0xce, 0xf4,
-	0xa5, 0xbd, 0xbb, 0x90, 0x4b, 0x5a, 0xf3, 0x2d, 0x1c, 0x1d, 0x1d, 0x1d, 0x1d, 0xfc, 0x10, 0x1d,
-	0x8b, 0x93, 0x38, 0x14, 0x69, 0x07, 0x3f, 0x86, 0xd2, 0xbb, 0x04, 0x97, 0xe3, 0xde, 0xfb, 0x3d,
-	0xef, 0x3d, 0xcf, 0xbd, 0x67, 0x3a, 0x01, 0x09, 0x8a, 0xb9, 0xe0, 0xf8, 0x1e, 0x38, 0x99, 0x43,
-	0x56, 0xe0, 0xc5, 0x00, 0x27, 0x24, 0x25, 0x4c, 0xba, 0x49, 0x2a, 0x32, 0x61, 0x1d, 0x94, 0x0a,
-	0xb7, 0x52, 0xb8, 0x8b, 0x41, 0xa7, 0x15, 0x8b, 0x58, 0x28, 0x8e, 0xf7, 0x3b, 0x2d, 0xed, 0xfc,
-	0x27, 0x0c, 0xb8, 0xc0, 0x6a, 0x2d, 0x8f, 0x8e, 0x42, 0x21, 0x99, 0x90, 0xbe, 0xd6, 0xea, 0x42,
-	0xa3, 0x93, 0x1f, 0x64, 0x36, 0xc6, 0xca, 0xc9, 0xea, 0x9b, 0x2d, 0x09, 0x31, 0xa7, 0x91, 0x1f,
-	0xcc, 0x45, 0x38, 0x93, 0xfe, 0x12, 0x78, 0x24, 0x96, 0x6d, 0xe4, 0xa0, 0x5e, 0xcd, 0xb3, 0x34,
-	0x1b, 0x2a, 0x74, 0xab, 0xc8, 0xbe, 0xa3, 0xca, 0xe3, 0x4b, 0x88, 0xfd, 0x0c, 0x18, 0x15, 0x79,
-	0xd6, 0xfe, 0xa7, 0x3b, 0x2a, 0x36, 0x81, 0xf8, 0x46, 0x13, 0x0b, 0xcc, 0x43, 0x06, 0xdc, 0x2f,
-	0x7d, 0x12, 0x9a, 0x56, 0x26, 0x35, 0x07, 0xf5, 0x9a, 0xc3, 0x8b, 0xd5, 0xa6, 0x6b, 0x7c, 0x6e,
-	0xba, 0xc7, 0x3a, 0xa3, 0x8c, 0x66, 0x2e, 0x08, 0xcc, 0x48, 0x36, 0x75, 0xaf, 0x69, 0x4c, 0xc2,
-	0x62, 0x44, 0xc3, 0xf7, 0xb7, 0x33, 0xb3, 0x7c, 0xc2, 0x88, 0x86, 0x2f, 0xdf, 0xaf, 0xa7, 0xc8,
-	0xb3, 0x18, 0xf0, 0x89, 0xba, 0x73, 0x4c, 0xd3, 0x32, 0x9c, 0x63, 0x36, 0xf7, 0x56, 0x49, 0x1e,
-	0xf8, 0x29, 0xe1, 0x51, 0xbb, 0xee, 0xa0, 0x5e, 0xdd, 0x33, 0x19, 0xf0, 0x71, 0x1e, 0x78, 0x84,
-	0x47, 0x97, 0xf5, 0xa7, 0xe7, 0xae, 0x31, 0xbc, 0x5a, 0x6d, 0x6d, 0xb4, 0xde, 0xda, 0xe8, 0x6b,
-	0x6b, 0xa3, 0xc7, 0x9d, 0x6d, 0xac, 0x77, 0xb6, 0xf1, 0xb1, 0xb3, 0x8d, 0xbb, 0x7e, 0x0c, 0xd9,
-	0x34, 0x0f, 0xdc, 0x50, 0x30, 0x5c, 0xce, 0x3f, 0x9c, 0x12, 0xe0, 0x55, 0x81, 0x1f, 0xfe, 0x3e,
-	0x2c, 0x2b, 0x12, 0x2a, 0x83, 0x86, 0x1a, 0xea, 0xf9, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb0,
-	0x39, 0x84, 0xbb, 0xd1, 0x01, 0x00, 0x00,
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x92, 0x31, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0xe3, 0x7f, 0xf3, 0x0f, 0xed, 0x11, 0x44, 0x31, 0xad, 0x70, 0x0b, 0x72, 0x2c, 0xa6,
+	0x80, 0xc0, 0x6e, 0x41, 0xea, 0xc0, 0xc0, 0x10, 0x32, 0x44, 0x88, 0x21, 0x72, 0x90, 0x90, 0x58,
+	0xac, 0xb3, 0x7d, 0xb5, 0x8f, 0xf8, 0xee, 0xb5, 0x7c, 0xe7, 0x36, 0xf9, 0x16, 0x8c, 0x15, 0x13,
+	0x23, 0x23, 0x03, 0x1f, 0xa2, 0x63, 0xc5, 0x84, 0x18, 0x0a, 0x4a, 0x06, 0xbe, 0x06, 0xf2, 0x9d,
+	0x2f, 0x2c, 0x51, 0xde, 0xfb, 0x3d, 0xaf, 0x9f, 0xf7, 0x7d, 0xee, 0x90, 0x17, 0xe3, 0x78, 0x59,
+	0x00, 0x0f, 0x4e, 0x29, 0xc7, 0x05, 0x95, 0xcb, 0xe0, 0xec, 0x38, 0x28, 0x71, 0x85, 0x99, 0xf0,
+	0xcb, 0x0a, 0x24, 0xd8, 0x77, 0x5b, 0x85, 0x6f, 0x14, 0xfe, 0xd9, 0xf1, 0xe1, 0x5e, 0x06, 0x19,
+	0x28, 0x1e, 0x34, 0xff, 0xb4, 0xf4, 0xf0, 0x0e, 0x66, 0x94, 0x43, 0xa0, 0x7e, 0xdb, 0xa3, 0x83,
+	0x04, 0x04, 0x03, 0x11, 0x69, 0xad, 0x2e, 0x5a, 0xe4, 0x66, 0x00, 0x59, 0x41, 0x02, 0x55, 0xc5,
+	0xf5, 0x69, 0x90, 0xd6, 0x15, 0x96, 0x14, 0xb8, 0xe6, 0x0f, 0x3f, 0x75, 0x51, 0x6f, 0xaa, 0x26,
+	0xb1, 0x8f, 0xd0, 0x9e, 0xa0, 0x19, 0x27, 0x69, 0x14, 0x17, 0x90, 0xcc, 0x45, 0x74, 0x4e, 0x79,
+	0x0a, 0xe7, 0x8e, 0xe5, 0x59, 0xc3, 0xad, 0xd0, 0xd6, 0x6c, 0xa4, 0xd0, 0x3b, 0x45, 0x9a, 0x0e,
+	0x33, 0x6f, 0x24, 0x68, 0x16, 0x49, 0xca, 0x08, 0xd4, 0xd2, 0xf9, 0x4f, 0x77, 0x18, 0x36, 0xa3,
+	0xd9, 0x5b, 0x4d, 0x6c, 0x8a, 0xf6, 0x19, 0xe5, 0x51, 0xeb, 0x53, 0x92, 0xca, 0x98, 0x6c, 0x79,
+	0xd6, 0xb0, 0x3f, 0x3a, 0xb9, 0xbc, 0x1e, 0x74, 0x7e, 0x5e, 0x0f, 0xee, 0xeb, 0x1d, 0x44, 0x3a,
+	0xf7, 0x29, 0x04, 0x0c, 0xcb, 0xdc, 0x7f, 0x43, 0x32, 0x9c, 0x2c, 0xc7, 0x24, 0xf9, 0xfe, 0xed,
+	0x29, 0x6a, 0x57, 0x1c, 0x93, 0xe4, 0xcb, 0x9f, 0xaf, 0x8f, 0xad, 0xd0, 0x66, 0x94, 0xcf, 0xd4,
+	0x37, 0xa7, 0xa4, 0x6a, 0x87, 0xf3, 0x50, 0xbf, 0xb1, 0x2a, 0xeb, 0x38, 0xaa, 0x30, 0x4f, 0x9d,
+	0xae, 0x67, 0x0d, 0xbb, 0x21, 0x62, 0x94, 0x4f, 0xeb, 0x38, 0xc4, 0x3c, 0xb5, 0x27, 0xe8, 0xd6,
+	0x07, 0x4c, 0x8b, 0xc8, 0x44, 0xe2, 0xfc, 0xef, 0x59, 0xc3, 0x9b, 0xcf, 0x0e, 0x7c, 0x9d, 0x99,
+	0x6f, 0x32, 0xf3, 0xc7, 0xad, 0x60, 0xb4, 0xdd, 0xcc, 0x77, 0xf1, 0x6b, 0x60, 0x85, 0xfd, 0xa6,
+	0xd3, 0x9c, 0xdb, 0x8f, 0xd0, 0x6e, 0x45, 0x24, 0xe1, 0x4d, 0x61, 0x36, 0xea, 0x29, 0xbf, 0xdb,
+	0x9b, 0xf3, 0x76, 0xac, 0x97, 0xe8, 0x01, 0xc3, 0x8b, 0xa8, 0xac, 0xea, 0x26, 0x81, 0x9c, 0xd0,
+	0x2c, 0x97, 0x42, 0x25, 0xa1, 0x52, 0x77, 0x6e, 0xa8, 0x36, 0x87, 0xe1, 0xc5, 0x54, 0x49, 0x26,
+	0x5a, 0x31, 0x25, 0x95, 0x8a, 0xde, 0x3e, 0x41, 0xf7, 0x36, 0x99, 0xe7, 0x00, 0xf3, 0x28, 0x01,
+	0x2e, 0x2b, 0x9c, 0x48, 0xe1, 0x6c, 0x7b, 0x5b, 0xc3, 0x9d, 0x70, 0xdf, 0xe0, 0x09, 0xc0, 0xfc,
+	0x95, 0x81, 0xf6, 0x13, 0x64, 0x37, 0xbe, 0x42, 0xe2, 0xa2, 0xd8, 0x5c, 0xb1, 0xb3, 0xa3, 0xdc,
+	0x76, 0x19, 0x5e, 0xcc, 0x34, 0xd0, 0xf7, 0xfb, 0xa2, 0x7b, 0xf1, 0x79, 0xd0, 0x19, 0xbd, 0xbe,
+	0x5c, 0xb9, 0xd6, 0xd5, 0xca, 0xb5, 0x7e, 0xaf, 0x5c, 0xeb, 0xe3, 0xda, 0xed, 0x5c, 0xad, 0xdd,
+	0xce, 0x8f, 0xb5, 0xdb, 0x79, 0x7f, 0x94, 0x51, 0x99, 0xd7, 0xb1, 0x9f, 0x00, 0x0b, 0xda, 0xa7,
+	0x9b, 0xe4, 0x98, 0x72, 0x53, 0x04, 0x8b, 0x7f, 0x6f, 0x5d, 0x2e, 0x4b, 0x22, 0xe2, 0x9e, 0x4a,
+	0xf3, 0xf9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xde, 0x2d, 0x42, 0xd5, 0x0c, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -147,6 +213,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxStalledBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStalledBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.FinalityHookContracts) > 0 {
+		for iNdEx := len(m.FinalityHookContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FinalityHookContracts[iNdEx])
+			copy(dAtA[i:], m.FinalityHookContracts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.FinalityHookContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxPrunedHeightsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrunedHeightsPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RetentionWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetentionWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
 	if m.MinPubRand != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinPubRand))
 		i--
@@ -203,6 +301,23 @@ func (m *Params) Size() (n int) {
 	if m.MinPubRand != 0 {
 		n += 1 + sovParams(uint64(m.MinPubRand))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.RetentionWindow != 0 {
+		n += 1 + sovParams(uint64(m.RetentionWindow))
+	}
+	if m.MaxPrunedHeightsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrunedHeightsPerBlock))
+	}
+	if len(m.FinalityHookContracts) > 0 {
+		for _, s := range m.FinalityHookContracts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxStalledBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxStalledBlocks))
+	}
 	return n
 }
 
@@ -331,6 +446,128 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionWindow", wireType)
+			}
+			m.RetentionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedHeightsPerBlock", wireType)
+			}
+			m.MaxPrunedHeightsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedHeightsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityHookContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityHookContracts = append(m.FinalityHookContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStalledBlocks", wireType)
+			}
+			m.MaxStalledBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStalledBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
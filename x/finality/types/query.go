@@ -18,3 +18,15 @@ func NewQueriedBlockStatus(status string) (QueriedBlockStatus, error) {
 	}
 	return QueriedBlockStatus_NON_FINALIZED, fmt.Errorf("invalid queried block status %s", status)
 }
+
+// NewQueriedHashType takes a human-readable queried hash type format and returns our custom enum.
+// Options: BlockHash | AppHash
+func NewQueriedHashType(hashType string) (QueriedHashType, error) {
+	if hashType == "BlockHash" {
+		return QueriedHashType_BLOCK_HASH, nil
+	}
+	if hashType == "AppHash" {
+		return QueriedHashType_APP_HASH, nil
+	}
+	return QueriedHashType_BLOCK_HASH, fmt.Errorf("invalid queried hash type %s", hashType)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	bbntypes "github.com/babylonchain/babylon/types"
 )
 
@@ -26,3 +28,9 @@ func (si *FinalityProviderSigningInfo) DecrementMissedBlocksCounter() {
 func (si *FinalityProviderSigningInfo) ResetMissedBlocksCounter() {
 	si.MissedBlocksCounter = 0
 }
+
+// IsJailingPeriodPassed returns whether the jailing period of the finality
+// provider has passed at the given time
+func (si *FinalityProviderSigningInfo) IsJailingPeriodPassed(curBlockTime time.Time) bool {
+	return !curBlockTime.Before(si.JailedUntil)
+}
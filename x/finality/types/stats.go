@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// VotedShare returns votedBlocks / expectedVotes, or zero if expectedVotes is zero
+func VotedShare(expectedVotes uint64, votedBlocks uint64) sdkmath.LegacyDec {
+	if expectedVotes == 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+	return sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(votedBlocks)).
+		QuoInt(sdkmath.NewIntFromUint64(expectedVotes))
+}
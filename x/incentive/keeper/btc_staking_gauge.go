@@ -39,6 +39,27 @@ func (k Keeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc
 	// TODO: handle the change in the gauge due to the truncating operations
 }
 
+// RewardFinalityEvidenceReporter rewards the reporter of a finality provider's
+// equivocation evidence with a portion of the BTC staking gauge at the current height
+func (k Keeper) RewardFinalityEvidenceReporter(ctx context.Context, reporter sdk.AccAddress) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	gauge := k.GetBTCStakingGauge(ctx, height)
+	if gauge == nil {
+		// no BTC staking reward at this height, nothing to reward
+		return
+	}
+	portion := k.GetParams(ctx).FinalityEvidenceReporterPortion
+	coinsForReporter := gauge.GetCoinsPortion(portion)
+	if coinsForReporter.IsZero() {
+		return
+	}
+	// the coins are already in the incentive module account, so move them
+	// from the BTC staking gauge to the reporter's reward gauge
+	gauge.Coins = gauge.Coins.Sub(coinsForReporter...)
+	k.SetBTCStakingGauge(ctx, height, gauge)
+	k.accumulateRewardGauge(ctx, types.FinalityEvidenceReporterType, reporter, coinsForReporter)
+}
+
 func (k Keeper) accumulateBTCStakingReward(ctx context.Context, btcStakingReward sdk.Coins) {
 	// update BTC staking gauge
 	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
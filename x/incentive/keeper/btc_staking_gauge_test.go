@@ -83,3 +83,40 @@ func FuzzRewardBTCStaking(f *testing.F) {
 		require.True(t, gauge.Coins.IsAllGTE(distributedCoins))
 	})
 }
+
+func FuzzRewardFinalityEvidenceReporter(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// create incentive keeper
+		keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, nil)
+		height := datagen.RandomInt(r, 1000)
+		ctx = datagen.WithCtxHeight(ctx, height)
+		reporter := datagen.GenRandomAccount().GetAddress()
+
+		// no reward if there is no BTC staking gauge at the current height
+		keeper.RewardFinalityEvidenceReporter(ctx, reporter)
+		require.Nil(t, keeper.GetRewardGauge(ctx, types.FinalityEvidenceReporterType, reporter))
+
+		// set a random gauge
+		gauge := datagen.GenRandomGauge(r)
+		keeper.SetBTCStakingGauge(ctx, height, gauge)
+
+		// expected values
+		portion := keeper.GetParams(ctx).FinalityEvidenceReporterPortion
+		coinsForReporter := gauge.GetCoinsPortion(portion)
+		coinsLeft := gauge.Coins.Sub(coinsForReporter...)
+
+		// reward the reporter
+		keeper.RewardFinalityEvidenceReporter(ctx, reporter)
+
+		// the reporter gets its portion out of the BTC staking gauge
+		rg := keeper.GetRewardGauge(ctx, types.FinalityEvidenceReporterType, reporter)
+		require.NotNil(t, rg)
+		require.Equal(t, coinsForReporter, rg.Coins)
+		newGauge := keeper.GetBTCStakingGauge(ctx, height)
+		require.NotNil(t, newGauge)
+		require.Equal(t, coinsLeft, newGauge.Coins)
+	})
+}
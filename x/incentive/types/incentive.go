@@ -63,10 +63,11 @@ const (
 	ReporterType
 	FinalityProviderType
 	BTCDelegationType
+	FinalityEvidenceReporterType
 )
 
 func GetAllStakeholderTypes() []StakeholderType {
-	return []StakeholderType{SubmitterType, ReporterType, FinalityProviderType, BTCDelegationType}
+	return []StakeholderType{SubmitterType, ReporterType, FinalityProviderType, BTCDelegationType, FinalityEvidenceReporterType}
 }
 
 func NewStakeHolderType(stBytes []byte) (StakeholderType, error) {
@@ -81,6 +82,8 @@ func NewStakeHolderType(stBytes []byte) (StakeholderType, error) {
 		return FinalityProviderType, nil
 	} else if stBytes[0] == byte(BTCDelegationType) {
 		return BTCDelegationType, nil
+	} else if stBytes[0] == byte(FinalityEvidenceReporterType) {
+		return FinalityEvidenceReporterType, nil
 	} else {
 		return SubmitterType, fmt.Errorf("invalid stBytes")
 	}
@@ -95,6 +98,8 @@ func NewStakeHolderTypeFromString(stStr string) (StakeholderType, error) {
 		return FinalityProviderType, nil
 	} else if stStr == "btc_delegation" {
 		return BTCDelegationType, nil
+	} else if stStr == "finality_evidence_reporter" {
+		return FinalityEvidenceReporterType, nil
 	} else {
 		return SubmitterType, fmt.Errorf("invalid stStr")
 	}
@@ -113,6 +118,8 @@ func (st StakeholderType) String() string {
 		return "finality_provider"
 	} else if st == BTCDelegationType {
 		return "btc_delegation"
+	} else if st == FinalityEvidenceReporterType {
+		return "finality_evidence_reporter"
 	}
 	panic("invalid stakeholder type")
 }
@@ -21,6 +21,8 @@ func DefaultParams() Params {
 		SubmitterPortion:  math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
 		ReporterPortion:   math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
 		BtcStakingPortion: math.LegacyNewDecWithPrec(2, 1), // 2 * 10^{-1} = 0.2
+		// 1 * 10^{-1} = 0.1 of the BTC staking rewards at the height the evidence is submitted
+		FinalityEvidenceReporterPortion: math.LegacyNewDecWithPrec(1, 1),
 	}
 }
 
@@ -60,6 +62,12 @@ func (p Params) Validate() error {
 	if p.BtcStakingPortion.IsNil() {
 		return fmt.Errorf("BtcStakingPortion should not be nil")
 	}
+	if p.FinalityEvidenceReporterPortion.IsNil() {
+		return fmt.Errorf("FinalityEvidenceReporterPortion should not be nil")
+	}
+	if p.FinalityEvidenceReporterPortion.IsNegative() || p.FinalityEvidenceReporterPortion.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("FinalityEvidenceReporterPortion should be in range [0, 1]")
+	}
 
 	// sum of all portions should be less than 1
 	if p.TotalPortion().GTE(math.LegacyOneDec()) {
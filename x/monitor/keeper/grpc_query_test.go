@@ -116,6 +116,8 @@ func FuzzQueryReportedCheckpointBtcHeight(f *testing.F) {
 		mockEk.EXPECT().GetValidatorSet(gomock.Any(), gomock.Eq(mockCkptWithMeta.Ckpt.EpochNum)).Return(valSet).AnyTimes()
 		// make sure voting power is always sufficient
 		mockEk.EXPECT().GetTotalVotingPower(gomock.Any(), gomock.Eq(mockCkptWithMeta.Ckpt.EpochNum)).Return(int64(0)).AnyTimes()
+		// the epoch of the checkpoint has begun
+		mockEk.EXPECT().GetEpoch(gomock.Any()).Return(&types2.Epoch{EpochNumber: mockCkptWithMeta.Ckpt.EpochNum}).AnyTimes()
 		err = ck.AddRawCheckpoint(
 			ctx,
 			mockCkptWithMeta,
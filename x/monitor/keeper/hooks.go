@@ -32,6 +32,9 @@ func (h Hooks) BeforeSlashThreshold(ctx context.Context, valSet etypes.Validator
 func (h Hooks) AfterBlsKeyRegistered(ctx context.Context, valAddr sdk.ValAddress) error {
 	return nil
 }
+func (h Hooks) AfterBlsKeyRotated(ctx context.Context, valAddr sdk.ValAddress, epoch uint64) error {
+	return nil
+}
 func (h Hooks) AfterRawCheckpointSealed(ctx context.Context, epoch uint64) error {
 	return nil
 }